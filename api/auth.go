@@ -0,0 +1,185 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gobgpapi
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+type Role string
+
+const (
+	ROLE_READ_ONLY  Role = "read-only"
+	ROLE_READ_WRITE Role = "read-write"
+)
+
+// readOnlyMethods are the RPCs which don't change the state of gobgpd.
+// The others need the read-write role.
+var readOnlyMethods = map[string]bool{
+	"GetServer":           true,
+	"GetNeighbor":         true,
+	"GetRib":              true,
+	"MonitorRib":          true,
+	"MonitorPeerState":    true,
+	"GetRpki":             true,
+	"GetRoa":              true,
+	"GetVrf":              true,
+	"GetDefinedSet":       true,
+	"GetStatement":        true,
+	"GetPolicy":           true,
+	"GetPolicyAssignment": true,
+	"GetRibInfo":          true,
+	"GetDampenedPath":     true,
+	"GetCandidateDiff":    true,
+	"GetCommitHistory":    true,
+	"GetConfig":           true,
+	"MonitorEvents":       true,
+	"GetHealthCheck":      true,
+	"GetPeerGroup":        true,
+}
+
+// AuthConfig is the transport security and the authentication of the
+// gRPC API. The zero value disables both.
+type AuthConfig struct {
+	// CertFile and KeyFile enable TLS.
+	CertFile string
+	KeyFile  string
+	// ClientCAFile enables the verification of the client certificates.
+	ClientCAFile string
+	// Tokens maps the bearer tokens to their roles. The requests
+	// without a known token are rejected if any token is configured.
+	Tokens map[string]Role
+}
+
+// ReadTokenFile reads the tokens from the file which has a token and
+// its role ("read-only" or "read-write") per line.
+func ReadTokenFile(path string) (map[string]Role, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	tokens := make(map[string]Role)
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		elems := strings.Fields(line)
+		if len(elems) != 2 {
+			return nil, fmt.Errorf("%s:%d: invalid line", path, n)
+		}
+		switch role := Role(elems[1]); role {
+		case ROLE_READ_ONLY, ROLE_READ_WRITE:
+			tokens[elems[0]] = role
+		default:
+			return nil, fmt.Errorf("%s:%d: invalid role %s", path, n, elems[1])
+		}
+	}
+	return tokens, scanner.Err()
+}
+
+func (c *AuthConfig) serverOptions() ([]grpc.ServerOption, error) {
+	opts := make([]grpc.ServerOption, 0, 3)
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the certificate: %s", err)
+		}
+		tlsConfig := &tls.Config{
+			Certificates: []tls.Certificate{cert},
+		}
+		if c.ClientCAFile != "" {
+			b, err := ioutil.ReadFile(c.ClientCAFile)
+			if err != nil {
+				return nil, err
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(b) {
+				return nil, fmt.Errorf("no certificate in %s", c.ClientCAFile)
+			}
+			tlsConfig.ClientCAs = pool
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	} else if c.ClientCAFile != "" {
+		return nil, fmt.Errorf("client certificate verification needs TLS")
+	}
+
+	if len(c.Tokens) > 0 {
+		if c.CertFile == "" {
+			log.WithFields(log.Fields{
+				"Topic": "grpc",
+			}).Warn("tokens are sent in plain text without TLS")
+		}
+		opts = append(opts, grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if err := c.authorize(ctx, info.FullMethod); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}))
+		opts = append(opts, grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := c.authorize(ss.Context(), info.FullMethod); err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		}))
+	}
+	return opts, nil
+}
+
+func (c *AuthConfig) authorize(ctx context.Context, fullMethod string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var role Role
+	for _, v := range md["authorization"] {
+		if strings.HasPrefix(v, "Bearer ") {
+			if r, ok := c.Tokens[strings.TrimPrefix(v, "Bearer ")]; ok {
+				role = r
+				break
+			}
+		}
+	}
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	switch role {
+	case ROLE_READ_WRITE:
+		return nil
+	case ROLE_READ_ONLY:
+		if readOnlyMethods[method] {
+			return nil
+		}
+		return grpc.Errorf(codes.PermissionDenied, "%s needs the read-write role", method)
+	}
+	log.WithFields(log.Fields{
+		"Topic": "grpc",
+		"Key":   method,
+	}).Warn("unauthenticated request")
+	return grpc.Errorf(codes.Unauthenticated, "invalid token")
+}
@@ -148,6 +148,53 @@ It has these top-level messages:
 	TableInfo
 	GetRibInfoRequest
 	GetRibInfoResponse
+	PeerGroup
+	PeerGroupConf
+	AddPeerGroupRequest
+	AddPeerGroupResponse
+	DeletePeerGroupRequest
+	DeletePeerGroupResponse
+	UpdatePeerGroupRequest
+	UpdatePeerGroupResponse
+	DynamicNeighbor
+	AddDynamicNeighborRequest
+	AddDynamicNeighborResponse
+	DeleteDynamicNeighborRequest
+	DeleteDynamicNeighborResponse
+	DampenedPath
+	GetDampenedPathRequest
+	GetDampenedPathResponse
+	AsPathOptions
+	ErrorHandling
+	AigpAction
+	BfdConfig
+	BfdState
+	Bfd
+	ConfigDiff
+	GetCandidateDiffRequest
+	GetCandidateDiffResponse
+	CommitCandidateRequest
+	CommitCandidateResponse
+	DiscardCandidateRequest
+	DiscardCandidateResponse
+	ConfigCommit
+	GetCommitHistoryRequest
+	GetCommitHistoryResponse
+	RollbackConfigRequest
+	RollbackConfigResponse
+	GetConfigRequest
+	GetConfigResponse
+	MonitorEventsRequest
+	Event
+	CommunityCount
+	DefaultOriginate
+	ConditionalAdvertisement
+	HealthCheckRoute
+	HealthCheck
+	GetHealthCheckRequest
+	GetHealthCheckResponse
+	GetPeerGroupRequest
+	GetPeerGroupResponse
 */
 package gobgpapi
 
@@ -378,6 +425,33 @@ func (x PolicyType) String() string {
 }
 func (PolicyType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
+type RouteOrigin int32
+
+const (
+	RouteOrigin_ORIGIN_NONE       RouteOrigin = 0
+	RouteOrigin_ORIGIN_IGP        RouteOrigin = 1
+	RouteOrigin_ORIGIN_EGP        RouteOrigin = 2
+	RouteOrigin_ORIGIN_INCOMPLETE RouteOrigin = 3
+)
+
+var RouteOrigin_name = map[int32]string{
+	0: "ORIGIN_NONE",
+	1: "ORIGIN_IGP",
+	2: "ORIGIN_EGP",
+	3: "ORIGIN_INCOMPLETE",
+}
+var RouteOrigin_value = map[string]int32{
+	"ORIGIN_NONE":       0,
+	"ORIGIN_IGP":        1,
+	"ORIGIN_EGP":        2,
+	"ORIGIN_INCOMPLETE": 3,
+}
+
+func (x RouteOrigin) String() string {
+	return proto.EnumName(RouteOrigin_name, int32(x))
+}
+func (RouteOrigin) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
 type SoftResetNeighborRequest_SoftResetDirection int32
 
 const (
@@ -650,7 +724,8 @@ func (*DeletePathResponse) ProtoMessage()               {}
 func (*DeletePathResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 type AddNeighborRequest struct {
-	Peer *Peer `protobuf:"bytes,1,opt,name=peer" json:"peer,omitempty"`
+	Peer      *Peer `protobuf:"bytes,1,opt,name=peer" json:"peer,omitempty"`
+	Candidate bool  `protobuf:"varint,2,opt,name=candidate" json:"candidate,omitempty"`
 }
 
 func (m *AddNeighborRequest) Reset()                    { *m = AddNeighborRequest{} }
@@ -665,6 +740,13 @@ func (m *AddNeighborRequest) GetPeer() *Peer {
 	return nil
 }
 
+func (m *AddNeighborRequest) GetCandidate() bool {
+	if m != nil {
+		return m.Candidate
+	}
+	return false
+}
+
 type AddNeighborResponse struct {
 }
 
@@ -674,7 +756,8 @@ func (*AddNeighborResponse) ProtoMessage()               {}
 func (*AddNeighborResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 type DeleteNeighborRequest struct {
-	Peer *Peer `protobuf:"bytes,1,opt,name=peer" json:"peer,omitempty"`
+	Peer      *Peer `protobuf:"bytes,1,opt,name=peer" json:"peer,omitempty"`
+	Candidate bool  `protobuf:"varint,2,opt,name=candidate" json:"candidate,omitempty"`
 }
 
 func (m *DeleteNeighborRequest) Reset()                    { *m = DeleteNeighborRequest{} }
@@ -689,6 +772,13 @@ func (m *DeleteNeighborRequest) GetPeer() *Peer {
 	return nil
 }
 
+func (m *DeleteNeighborRequest) GetCandidate() bool {
+	if m != nil {
+		return m.Candidate
+	}
+	return false
+}
+
 type DeleteNeighborResponse struct {
 }
 
@@ -946,9 +1036,12 @@ func (*InjectMrtResponse) ProtoMessage()               {}
 func (*InjectMrtResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 type AddBmpRequest struct {
-	Address string                         `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
-	Port    uint32                         `protobuf:"varint,2,opt,name=port" json:"port,omitempty"`
-	Type    AddBmpRequest_MonitoringPolicy `protobuf:"varint,3,opt,name=type,enum=gobgpapi.AddBmpRequest_MonitoringPolicy" json:"type,omitempty"`
+	Address       string                         `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Port          uint32                         `protobuf:"varint,2,opt,name=port" json:"port,omitempty"`
+	Type          AddBmpRequest_MonitoringPolicy `protobuf:"varint,3,opt,name=type,enum=gobgpapi.AddBmpRequest_MonitoringPolicy" json:"type,omitempty"`
+	LocRib        bool                           `protobuf:"varint,4,opt,name=loc_rib,json=locRib" json:"loc_rib,omitempty"`
+	AdjRibOut     bool                           `protobuf:"varint,5,opt,name=adj_rib_out,json=adjRibOut" json:"adj_rib_out,omitempty"`
+	AdjRibOutType AddBmpRequest_MonitoringPolicy `protobuf:"varint,6,opt,name=adj_rib_out_type,json=adjRibOutType,enum=gobgpapi.AddBmpRequest_MonitoringPolicy" json:"adj_rib_out_type,omitempty"`
 }
 
 func (m *AddBmpRequest) Reset()                    { *m = AddBmpRequest{} }
@@ -977,6 +1070,27 @@ func (m *AddBmpRequest) GetType() AddBmpRequest_MonitoringPolicy {
 	return AddBmpRequest_PRE
 }
 
+func (m *AddBmpRequest) GetLocRib() bool {
+	if m != nil {
+		return m.LocRib
+	}
+	return false
+}
+
+func (m *AddBmpRequest) GetAdjRibOut() bool {
+	if m != nil {
+		return m.AdjRibOut
+	}
+	return false
+}
+
+func (m *AddBmpRequest) GetAdjRibOutType() AddBmpRequest_MonitoringPolicy {
+	if m != nil {
+		return m.AdjRibOutType
+	}
+	return AddBmpRequest_PRE
+}
+
 type AddBmpResponse struct {
 }
 
@@ -1018,8 +1132,10 @@ func (*DeleteBmpResponse) ProtoMessage()               {}
 func (*DeleteBmpResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 type MonitorRibRequest struct {
-	Table   *Table `protobuf:"bytes,1,opt,name=table" json:"table,omitempty"`
-	Current bool   `protobuf:"varint,2,opt,name=current" json:"current,omitempty"`
+	Table       *Table `protobuf:"bytes,1,opt,name=table" json:"table,omitempty"`
+	Current     bool   `protobuf:"varint,2,opt,name=current" json:"current,omitempty"`
+	ResumeFrom  uint64 `protobuf:"varint,3,opt,name=resume_from,json=resumeFrom" json:"resume_from,omitempty"`
+	ResumeEpoch uint64 `protobuf:"varint,4,opt,name=resume_epoch,json=resumeEpoch" json:"resume_epoch,omitempty"`
 }
 
 func (m *MonitorRibRequest) Reset()                    { *m = MonitorRibRequest{} }
@@ -1041,6 +1157,20 @@ func (m *MonitorRibRequest) GetCurrent() bool {
 	return false
 }
 
+func (m *MonitorRibRequest) GetResumeFrom() uint64 {
+	if m != nil {
+		return m.ResumeFrom
+	}
+	return 0
+}
+
+func (m *MonitorRibRequest) GetResumeEpoch() uint64 {
+	if m != nil {
+		return m.ResumeEpoch
+	}
+	return 0
+}
+
 type RPKIConf struct {
 	Address    string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
 	RemotePort string `protobuf:"bytes,2,opt,name=remote_port,json=remotePort" json:"remote_port,omitempty"`
@@ -1594,7 +1724,8 @@ func (m *GetDefinedSetResponse) GetSets() []*DefinedSet {
 }
 
 type AddDefinedSetRequest struct {
-	Set *DefinedSet `protobuf:"bytes,1,opt,name=set" json:"set,omitempty"`
+	Set       *DefinedSet `protobuf:"bytes,1,opt,name=set" json:"set,omitempty"`
+	Candidate bool        `protobuf:"varint,2,opt,name=candidate" json:"candidate,omitempty"`
 }
 
 func (m *AddDefinedSetRequest) Reset()                    { *m = AddDefinedSetRequest{} }
@@ -1609,6 +1740,13 @@ func (m *AddDefinedSetRequest) GetSet() *DefinedSet {
 	return nil
 }
 
+func (m *AddDefinedSetRequest) GetCandidate() bool {
+	if m != nil {
+		return m.Candidate
+	}
+	return false
+}
+
 type AddDefinedSetResponse struct {
 }
 
@@ -1618,8 +1756,9 @@ func (*AddDefinedSetResponse) ProtoMessage()               {}
 func (*AddDefinedSetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
 
 type DeleteDefinedSetRequest struct {
-	Set *DefinedSet `protobuf:"bytes,1,opt,name=set" json:"set,omitempty"`
-	All bool        `protobuf:"varint,2,opt,name=all" json:"all,omitempty"`
+	Set       *DefinedSet `protobuf:"bytes,1,opt,name=set" json:"set,omitempty"`
+	All       bool        `protobuf:"varint,2,opt,name=all" json:"all,omitempty"`
+	Candidate bool        `protobuf:"varint,3,opt,name=candidate" json:"candidate,omitempty"`
 }
 
 func (m *DeleteDefinedSetRequest) Reset()                    { *m = DeleteDefinedSetRequest{} }
@@ -1641,6 +1780,13 @@ func (m *DeleteDefinedSetRequest) GetAll() bool {
 	return false
 }
 
+func (m *DeleteDefinedSetRequest) GetCandidate() bool {
+	if m != nil {
+		return m.Candidate
+	}
+	return false
+}
+
 type DeleteDefinedSetResponse struct {
 }
 
@@ -1650,7 +1796,8 @@ func (*DeleteDefinedSetResponse) ProtoMessage()               {}
 func (*DeleteDefinedSetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
 
 type ReplaceDefinedSetRequest struct {
-	Set *DefinedSet `protobuf:"bytes,1,opt,name=set" json:"set,omitempty"`
+	Set       *DefinedSet `protobuf:"bytes,1,opt,name=set" json:"set,omitempty"`
+	Candidate bool        `protobuf:"varint,2,opt,name=candidate" json:"candidate,omitempty"`
 }
 
 func (m *ReplaceDefinedSetRequest) Reset()                    { *m = ReplaceDefinedSetRequest{} }
@@ -1665,6 +1812,13 @@ func (m *ReplaceDefinedSetRequest) GetSet() *DefinedSet {
 	return nil
 }
 
+func (m *ReplaceDefinedSetRequest) GetCandidate() bool {
+	if m != nil {
+		return m.Candidate
+	}
+	return false
+}
+
 type ReplaceDefinedSetResponse struct {
 }
 
@@ -1806,6 +1960,7 @@ type AddPolicyRequest struct {
 	// if this flag is set, gobgpd won't define new statements
 	// but refer existing statements using statement's names in this arguments.
 	ReferExistingStatements bool `protobuf:"varint,2,opt,name=refer_existing_statements,json=referExistingStatements" json:"refer_existing_statements,omitempty"`
+	Candidate               bool `protobuf:"varint,3,opt,name=candidate" json:"candidate,omitempty"`
 }
 
 func (m *AddPolicyRequest) Reset()                    { *m = AddPolicyRequest{} }
@@ -1827,6 +1982,13 @@ func (m *AddPolicyRequest) GetReferExistingStatements() bool {
 	return false
 }
 
+func (m *AddPolicyRequest) GetCandidate() bool {
+	if m != nil {
+		return m.Candidate
+	}
+	return false
+}
+
 type AddPolicyResponse struct {
 }
 
@@ -1841,6 +2003,7 @@ type DeletePolicyRequest struct {
 	// even if some statements get not used by any policy by this operation.
 	PreserveStatements bool `protobuf:"varint,2,opt,name=preserve_statements,json=preserveStatements" json:"preserve_statements,omitempty"`
 	All                bool `protobuf:"varint,3,opt,name=all" json:"all,omitempty"`
+	Candidate          bool `protobuf:"varint,4,opt,name=candidate" json:"candidate,omitempty"`
 }
 
 func (m *DeletePolicyRequest) Reset()                    { *m = DeletePolicyRequest{} }
@@ -1869,6 +2032,13 @@ func (m *DeletePolicyRequest) GetAll() bool {
 	return false
 }
 
+func (m *DeletePolicyRequest) GetCandidate() bool {
+	if m != nil {
+		return m.Candidate
+	}
+	return false
+}
+
 type DeletePolicyResponse struct {
 }
 
@@ -1885,6 +2055,7 @@ type ReplacePolicyRequest struct {
 	// if this flag is set, gobgpd won't delete any statements
 	// even if some statements get not used by any policy by this operation.
 	PreserveStatements bool `protobuf:"varint,3,opt,name=preserve_statements,json=preserveStatements" json:"preserve_statements,omitempty"`
+	Candidate          bool `protobuf:"varint,4,opt,name=candidate" json:"candidate,omitempty"`
 }
 
 func (m *ReplacePolicyRequest) Reset()                    { *m = ReplacePolicyRequest{} }
@@ -1913,6 +2084,13 @@ func (m *ReplacePolicyRequest) GetPreserveStatements() bool {
 	return false
 }
 
+func (m *ReplacePolicyRequest) GetCandidate() bool {
+	if m != nil {
+		return m.Candidate
+	}
+	return false
+}
+
 type ReplacePolicyResponse struct {
 }
 
@@ -1955,6 +2133,7 @@ func (m *GetPolicyAssignmentResponse) GetAssignment() *PolicyAssignment {
 
 type AddPolicyAssignmentRequest struct {
 	Assignment *PolicyAssignment `protobuf:"bytes,1,opt,name=assignment" json:"assignment,omitempty"`
+	Candidate  bool              `protobuf:"varint,2,opt,name=candidate" json:"candidate,omitempty"`
 }
 
 func (m *AddPolicyAssignmentRequest) Reset()                    { *m = AddPolicyAssignmentRequest{} }
@@ -1969,6 +2148,13 @@ func (m *AddPolicyAssignmentRequest) GetAssignment() *PolicyAssignment {
 	return nil
 }
 
+func (m *AddPolicyAssignmentRequest) GetCandidate() bool {
+	if m != nil {
+		return m.Candidate
+	}
+	return false
+}
+
 type AddPolicyAssignmentResponse struct {
 }
 
@@ -1980,6 +2166,7 @@ func (*AddPolicyAssignmentResponse) Descriptor() ([]byte, []int) { return fileDe
 type DeletePolicyAssignmentRequest struct {
 	Assignment *PolicyAssignment `protobuf:"bytes,1,opt,name=assignment" json:"assignment,omitempty"`
 	All        bool              `protobuf:"varint,2,opt,name=all" json:"all,omitempty"`
+	Candidate  bool              `protobuf:"varint,3,opt,name=candidate" json:"candidate,omitempty"`
 }
 
 func (m *DeletePolicyAssignmentRequest) Reset()                    { *m = DeletePolicyAssignmentRequest{} }
@@ -2001,6 +2188,13 @@ func (m *DeletePolicyAssignmentRequest) GetAll() bool {
 	return false
 }
 
+func (m *DeletePolicyAssignmentRequest) GetCandidate() bool {
+	if m != nil {
+		return m.Candidate
+	}
+	return false
+}
+
 type DeletePolicyAssignmentResponse struct {
 }
 
@@ -2011,6 +2205,7 @@ func (*DeletePolicyAssignmentResponse) Descriptor() ([]byte, []int) { return fil
 
 type ReplacePolicyAssignmentRequest struct {
 	Assignment *PolicyAssignment `protobuf:"bytes,1,opt,name=assignment" json:"assignment,omitempty"`
+	Candidate  bool              `protobuf:"varint,2,opt,name=candidate" json:"candidate,omitempty"`
 }
 
 func (m *ReplacePolicyAssignmentRequest) Reset()                    { *m = ReplacePolicyAssignmentRequest{} }
@@ -2025,6 +2220,13 @@ func (m *ReplacePolicyAssignmentRequest) GetAssignment() *PolicyAssignment {
 	return nil
 }
 
+func (m *ReplacePolicyAssignmentRequest) GetCandidate() bool {
+	if m != nil {
+		return m.Candidate
+	}
+	return false
+}
+
 type ReplacePolicyAssignmentResponse struct {
 }
 
@@ -2116,6 +2318,8 @@ type Path struct {
 	NeighborIp         string   `protobuf:"bytes,14,opt,name=neighbor_ip,json=neighborIp" json:"neighbor_ip,omitempty"`
 	Uuid               []byte   `protobuf:"bytes,15,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	IsNexthopInvalid   bool     `protobuf:"varint,16,opt,name=is_nexthop_invalid,json=isNexthopInvalid" json:"is_nexthop_invalid,omitempty"`
+	Identifier         uint32   `protobuf:"varint,17,opt,name=identifier" json:"identifier,omitempty"`
+	LocalIdentifier    uint32   `protobuf:"varint,18,opt,name=local_identifier,json=localIdentifier" json:"local_identifier,omitempty"`
 }
 
 func (m *Path) Reset()                    { *m = Path{} }
@@ -2235,11 +2439,28 @@ func (m *Path) GetIsNexthopInvalid() bool {
 	return false
 }
 
+func (m *Path) GetIdentifier() uint32 {
+	if m != nil {
+		return m.Identifier
+	}
+	return 0
+}
+
+func (m *Path) GetLocalIdentifier() uint32 {
+	if m != nil {
+		return m.LocalIdentifier
+	}
+	return 0
+}
+
 type Destination struct {
 	Prefix          string  `protobuf:"bytes,1,opt,name=prefix" json:"prefix,omitempty"`
 	Paths           []*Path `protobuf:"bytes,2,rep,name=paths" json:"paths,omitempty"`
 	LongerPrefixes  bool    `protobuf:"varint,3,opt,name=longer_prefixes,json=longerPrefixes" json:"longer_prefixes,omitempty"`
 	ShorterPrefixes bool    `protobuf:"varint,4,opt,name=shorter_prefixes,json=shorterPrefixes" json:"shorter_prefixes,omitempty"`
+	Sequence        uint64  `protobuf:"varint,5,opt,name=sequence" json:"sequence,omitempty"`
+	Snapshot        bool    `protobuf:"varint,6,opt,name=snapshot" json:"snapshot,omitempty"`
+	Epoch           uint64  `protobuf:"varint,7,opt,name=epoch" json:"epoch,omitempty"`
 }
 
 func (m *Destination) Reset()                    { *m = Destination{} }
@@ -2275,6 +2496,27 @@ func (m *Destination) GetShorterPrefixes() bool {
 	return false
 }
 
+func (m *Destination) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Destination) GetSnapshot() bool {
+	if m != nil {
+		return m.Snapshot
+	}
+	return false
+}
+
+func (m *Destination) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
 type Table struct {
 	Type         Resource       `protobuf:"varint,1,opt,name=type,enum=gobgpapi.Resource" json:"type,omitempty"`
 	Name         string         `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
@@ -2405,6 +2647,9 @@ type Peer struct {
 	Timers         *Timers         `protobuf:"bytes,7,opt,name=timers" json:"timers,omitempty"`
 	Transport      *Transport      `protobuf:"bytes,8,opt,name=transport" json:"transport,omitempty"`
 	RouteServer    *RouteServer    `protobuf:"bytes,9,opt,name=route_server,json=routeServer" json:"route_server,omitempty"`
+	AsPathOptions  *AsPathOptions  `protobuf:"bytes,10,opt,name=as_path_options,json=asPathOptions" json:"as_path_options,omitempty"`
+	ErrorHandling  *ErrorHandling  `protobuf:"bytes,11,opt,name=error_handling,json=errorHandling" json:"error_handling,omitempty"`
+	Bfd            *Bfd            `protobuf:"bytes,12,opt,name=bfd" json:"bfd,omitempty"`
 }
 
 func (m *Peer) Reset()                    { *m = Peer{} }
@@ -2475,6 +2720,27 @@ func (m *Peer) GetRouteServer() *RouteServer {
 	return nil
 }
 
+func (m *Peer) GetAsPathOptions() *AsPathOptions {
+	if m != nil {
+		return m.AsPathOptions
+	}
+	return nil
+}
+
+func (m *Peer) GetErrorHandling() *ErrorHandling {
+	if m != nil {
+		return m.ErrorHandling
+	}
+	return nil
+}
+
+func (m *Peer) GetBfd() *Bfd {
+	if m != nil {
+		return m.Bfd
+	}
+	return nil
+}
+
 type ApplyPolicy struct {
 	InPolicy     *PolicyAssignment `protobuf:"bytes,1,opt,name=in_policy,json=inPolicy" json:"in_policy,omitempty"`
 	ExportPolicy *PolicyAssignment `protobuf:"bytes,2,opt,name=export_policy,json=exportPolicy" json:"export_policy,omitempty"`
@@ -2540,23 +2806,25 @@ func (m *PrefixLimit) GetShutdownThresholdPct() uint32 {
 }
 
 type PeerConf struct {
-	AuthPassword      string         `protobuf:"bytes,1,opt,name=auth_password,json=authPassword" json:"auth_password,omitempty"`
-	Description       string         `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
-	LocalAs           uint32         `protobuf:"varint,3,opt,name=local_as,json=localAs" json:"local_as,omitempty"`
-	NeighborAddress   string         `protobuf:"bytes,4,opt,name=neighbor_address,json=neighborAddress" json:"neighbor_address,omitempty"`
-	PeerAs            uint32         `protobuf:"varint,5,opt,name=peer_as,json=peerAs" json:"peer_as,omitempty"`
-	PeerGroup         string         `protobuf:"bytes,6,opt,name=peer_group,json=peerGroup" json:"peer_group,omitempty"`
-	PeerType          uint32         `protobuf:"varint,7,opt,name=peer_type,json=peerType" json:"peer_type,omitempty"`
-	RemovePrivateAs   uint32         `protobuf:"varint,8,opt,name=remove_private_as,json=removePrivateAs" json:"remove_private_as,omitempty"`
-	RouteFlapDamping  bool           `protobuf:"varint,9,opt,name=route_flap_damping,json=routeFlapDamping" json:"route_flap_damping,omitempty"`
-	SendCommunity     uint32         `protobuf:"varint,10,opt,name=send_community,json=sendCommunity" json:"send_community,omitempty"`
-	RemoteCap         [][]byte       `protobuf:"bytes,11,rep,name=remote_cap,json=remoteCap,proto3" json:"remote_cap,omitempty"`
-	LocalCap          [][]byte       `protobuf:"bytes,12,rep,name=local_cap,json=localCap,proto3" json:"local_cap,omitempty"`
-	Id                string         `protobuf:"bytes,13,opt,name=id" json:"id,omitempty"`
-	PrefixLimits      []*PrefixLimit `protobuf:"bytes,14,rep,name=prefix_limits,json=prefixLimits" json:"prefix_limits,omitempty"`
-	LocalAddress      string         `protobuf:"bytes,15,opt,name=local_address,json=localAddress" json:"local_address,omitempty"`
-	NeighborInterface string         `protobuf:"bytes,16,opt,name=neighbor_interface,json=neighborInterface" json:"neighbor_interface,omitempty"`
-	Vrf               string         `protobuf:"bytes,17,opt,name=vrf" json:"vrf,omitempty"`
+	AuthPassword              string                      `protobuf:"bytes,1,opt,name=auth_password,json=authPassword" json:"auth_password,omitempty"`
+	Description               string                      `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	LocalAs                   uint32                      `protobuf:"varint,3,opt,name=local_as,json=localAs" json:"local_as,omitempty"`
+	NeighborAddress           string                      `protobuf:"bytes,4,opt,name=neighbor_address,json=neighborAddress" json:"neighbor_address,omitempty"`
+	PeerAs                    uint32                      `protobuf:"varint,5,opt,name=peer_as,json=peerAs" json:"peer_as,omitempty"`
+	PeerGroup                 string                      `protobuf:"bytes,6,opt,name=peer_group,json=peerGroup" json:"peer_group,omitempty"`
+	PeerType                  uint32                      `protobuf:"varint,7,opt,name=peer_type,json=peerType" json:"peer_type,omitempty"`
+	RemovePrivateAs           uint32                      `protobuf:"varint,8,opt,name=remove_private_as,json=removePrivateAs" json:"remove_private_as,omitempty"`
+	RouteFlapDamping          bool                        `protobuf:"varint,9,opt,name=route_flap_damping,json=routeFlapDamping" json:"route_flap_damping,omitempty"`
+	SendCommunity             uint32                      `protobuf:"varint,10,opt,name=send_community,json=sendCommunity" json:"send_community,omitempty"`
+	RemoteCap                 [][]byte                    `protobuf:"bytes,11,rep,name=remote_cap,json=remoteCap,proto3" json:"remote_cap,omitempty"`
+	LocalCap                  [][]byte                    `protobuf:"bytes,12,rep,name=local_cap,json=localCap,proto3" json:"local_cap,omitempty"`
+	Id                        string                      `protobuf:"bytes,13,opt,name=id" json:"id,omitempty"`
+	PrefixLimits              []*PrefixLimit              `protobuf:"bytes,14,rep,name=prefix_limits,json=prefixLimits" json:"prefix_limits,omitempty"`
+	LocalAddress              string                      `protobuf:"bytes,15,opt,name=local_address,json=localAddress" json:"local_address,omitempty"`
+	NeighborInterface         string                      `protobuf:"bytes,16,opt,name=neighbor_interface,json=neighborInterface" json:"neighbor_interface,omitempty"`
+	Vrf                       string                      `protobuf:"bytes,17,opt,name=vrf" json:"vrf,omitempty"`
+	DefaultOriginates         []*DefaultOriginate         `protobuf:"bytes,18,rep,name=default_originates,json=defaultOriginates" json:"default_originates,omitempty"`
+	ConditionalAdvertisements []*ConditionalAdvertisement `protobuf:"bytes,19,rep,name=conditional_advertisements,json=conditionalAdvertisements" json:"conditional_advertisements,omitempty"`
 }
 
 func (m *PeerConf) Reset()                    { *m = PeerConf{} }
@@ -2683,6 +2951,20 @@ func (m *PeerConf) GetVrf() string {
 	return ""
 }
 
+func (m *PeerConf) GetDefaultOriginates() []*DefaultOriginate {
+	if m != nil {
+		return m.DefaultOriginates
+	}
+	return nil
+}
+
+func (m *PeerConf) GetConditionalAdvertisements() []*ConditionalAdvertisement {
+	if m != nil {
+		return m.ConditionalAdvertisements
+	}
+	return nil
+}
+
 type EbgpMultihop struct {
 	Enabled     bool   `protobuf:"varint,1,opt,name=enabled" json:"enabled,omitempty"`
 	MultihopTtl uint32 `protobuf:"varint,2,opt,name=multihop_ttl,json=multihopTtl" json:"multihop_ttl,omitempty"`
@@ -2732,27 +3014,32 @@ func (m *RouteReflector) GetRouteReflectorClusterId() string {
 }
 
 type PeerState struct {
-	AuthPassword          string               `protobuf:"bytes,1,opt,name=auth_password,json=authPassword" json:"auth_password,omitempty"`
-	Description           string               `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
-	LocalAs               uint32               `protobuf:"varint,3,opt,name=local_as,json=localAs" json:"local_as,omitempty"`
-	Messages              *Messages            `protobuf:"bytes,4,opt,name=messages" json:"messages,omitempty"`
-	NeighborAddress       string               `protobuf:"bytes,5,opt,name=neighbor_address,json=neighborAddress" json:"neighbor_address,omitempty"`
-	PeerAs                uint32               `protobuf:"varint,6,opt,name=peer_as,json=peerAs" json:"peer_as,omitempty"`
-	PeerGroup             string               `protobuf:"bytes,7,opt,name=peer_group,json=peerGroup" json:"peer_group,omitempty"`
-	PeerType              uint32               `protobuf:"varint,8,opt,name=peer_type,json=peerType" json:"peer_type,omitempty"`
-	Queues                *Queues              `protobuf:"bytes,9,opt,name=queues" json:"queues,omitempty"`
-	RemovePrivateAs       uint32               `protobuf:"varint,10,opt,name=remove_private_as,json=removePrivateAs" json:"remove_private_as,omitempty"`
-	RouteFlapDamping      bool                 `protobuf:"varint,11,opt,name=route_flap_damping,json=routeFlapDamping" json:"route_flap_damping,omitempty"`
-	SendCommunity         uint32               `protobuf:"varint,12,opt,name=send_community,json=sendCommunity" json:"send_community,omitempty"`
-	SessionState          uint32               `protobuf:"varint,13,opt,name=session_state,json=sessionState" json:"session_state,omitempty"`
-	SupportedCapabilities []string             `protobuf:"bytes,14,rep,name=supported_capabilities,json=supportedCapabilities" json:"supported_capabilities,omitempty"`
-	BgpState              string               `protobuf:"bytes,15,opt,name=bgp_state,json=bgpState" json:"bgp_state,omitempty"`
-	AdminState            PeerState_AdminState `protobuf:"varint,16,opt,name=admin_state,json=adminState,enum=gobgpapi.PeerState_AdminState" json:"admin_state,omitempty"`
-	Received              uint32               `protobuf:"varint,17,opt,name=received" json:"received,omitempty"`
-	Accepted              uint32               `protobuf:"varint,18,opt,name=accepted" json:"accepted,omitempty"`
-	Advertised            uint32               `protobuf:"varint,19,opt,name=advertised" json:"advertised,omitempty"`
-	OutQ                  uint32               `protobuf:"varint,20,opt,name=out_q,json=outQ" json:"out_q,omitempty"`
-	Flops                 uint32               `protobuf:"varint,21,opt,name=flops" json:"flops,omitempty"`
+	AuthPassword            string               `protobuf:"bytes,1,opt,name=auth_password,json=authPassword" json:"auth_password,omitempty"`
+	Description             string               `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	LocalAs                 uint32               `protobuf:"varint,3,opt,name=local_as,json=localAs" json:"local_as,omitempty"`
+	Messages                *Messages            `protobuf:"bytes,4,opt,name=messages" json:"messages,omitempty"`
+	NeighborAddress         string               `protobuf:"bytes,5,opt,name=neighbor_address,json=neighborAddress" json:"neighbor_address,omitempty"`
+	PeerAs                  uint32               `protobuf:"varint,6,opt,name=peer_as,json=peerAs" json:"peer_as,omitempty"`
+	PeerGroup               string               `protobuf:"bytes,7,opt,name=peer_group,json=peerGroup" json:"peer_group,omitempty"`
+	PeerType                uint32               `protobuf:"varint,8,opt,name=peer_type,json=peerType" json:"peer_type,omitempty"`
+	Queues                  *Queues              `protobuf:"bytes,9,opt,name=queues" json:"queues,omitempty"`
+	RemovePrivateAs         uint32               `protobuf:"varint,10,opt,name=remove_private_as,json=removePrivateAs" json:"remove_private_as,omitempty"`
+	RouteFlapDamping        bool                 `protobuf:"varint,11,opt,name=route_flap_damping,json=routeFlapDamping" json:"route_flap_damping,omitempty"`
+	SendCommunity           uint32               `protobuf:"varint,12,opt,name=send_community,json=sendCommunity" json:"send_community,omitempty"`
+	SessionState            uint32               `protobuf:"varint,13,opt,name=session_state,json=sessionState" json:"session_state,omitempty"`
+	SupportedCapabilities   []string             `protobuf:"bytes,14,rep,name=supported_capabilities,json=supportedCapabilities" json:"supported_capabilities,omitempty"`
+	BgpState                string               `protobuf:"bytes,15,opt,name=bgp_state,json=bgpState" json:"bgp_state,omitempty"`
+	AdminState              PeerState_AdminState `protobuf:"varint,16,opt,name=admin_state,json=adminState,enum=gobgpapi.PeerState_AdminState" json:"admin_state,omitempty"`
+	Received                uint32               `protobuf:"varint,17,opt,name=received" json:"received,omitempty"`
+	Accepted                uint32               `protobuf:"varint,18,opt,name=accepted" json:"accepted,omitempty"`
+	Advertised              uint32               `protobuf:"varint,19,opt,name=advertised" json:"advertised,omitempty"`
+	OutQ                    uint32               `protobuf:"varint,20,opt,name=out_q,json=outQ" json:"out_q,omitempty"`
+	Flops                   uint32               `protobuf:"varint,21,opt,name=flops" json:"flops,omitempty"`
+	ErroneousUpdateMessages uint32               `protobuf:"varint,22,opt,name=erroneous_update_messages,json=erroneousUpdateMessages" json:"erroneous_update_messages,omitempty"`
+	SessionResetCount       uint32               `protobuf:"varint,23,opt,name=session_reset_count,json=sessionResetCount" json:"session_reset_count,omitempty"`
+	AfiSafiDisableCount     uint32               `protobuf:"varint,24,opt,name=afi_safi_disable_count,json=afiSafiDisableCount" json:"afi_safi_disable_count,omitempty"`
+	TreatAsWithdrawCount    uint32               `protobuf:"varint,25,opt,name=treat_as_withdraw_count,json=treatAsWithdrawCount" json:"treat_as_withdraw_count,omitempty"`
+	AttributeDiscardCount   uint32               `protobuf:"varint,26,opt,name=attribute_discard_count,json=attributeDiscardCount" json:"attribute_discard_count,omitempty"`
 }
 
 func (m *PeerState) Reset()                    { *m = PeerState{} }
@@ -2907,6 +3194,41 @@ func (m *PeerState) GetFlops() uint32 {
 	return 0
 }
 
+func (m *PeerState) GetErroneousUpdateMessages() uint32 {
+	if m != nil {
+		return m.ErroneousUpdateMessages
+	}
+	return 0
+}
+
+func (m *PeerState) GetSessionResetCount() uint32 {
+	if m != nil {
+		return m.SessionResetCount
+	}
+	return 0
+}
+
+func (m *PeerState) GetAfiSafiDisableCount() uint32 {
+	if m != nil {
+		return m.AfiSafiDisableCount
+	}
+	return 0
+}
+
+func (m *PeerState) GetTreatAsWithdrawCount() uint32 {
+	if m != nil {
+		return m.TreatAsWithdrawCount
+	}
+	return 0
+}
+
+func (m *PeerState) GetAttributeDiscardCount() uint32 {
+	if m != nil {
+		return m.AttributeDiscardCount
+	}
+	return 0
+}
+
 type Messages struct {
 	Received *Message `protobuf:"bytes,1,opt,name=received" json:"received,omitempty"`
 	Sent     *Message `protobuf:"bytes,2,opt,name=sent" json:"sent,omitempty"`
@@ -3155,6 +3477,9 @@ type Transport struct {
 	RemoteAddress string `protobuf:"bytes,5,opt,name=remote_address,json=remoteAddress" json:"remote_address,omitempty"`
 	RemotePort    uint32 `protobuf:"varint,6,opt,name=remote_port,json=remotePort" json:"remote_port,omitempty"`
 	TcpMss        uint32 `protobuf:"varint,7,opt,name=tcp_mss,json=tcpMss" json:"tcp_mss,omitempty"`
+	TtlSecurity   bool   `protobuf:"varint,8,opt,name=ttl_security,json=ttlSecurity" json:"ttl_security,omitempty"`
+	Ttl           uint32 `protobuf:"varint,9,opt,name=ttl" json:"ttl,omitempty"`
+	MinTtl        uint32 `protobuf:"varint,10,opt,name=min_ttl,json=minTtl" json:"min_ttl,omitempty"`
 }
 
 func (m *Transport) Reset()                    { *m = Transport{} }
@@ -3211,6 +3536,27 @@ func (m *Transport) GetTcpMss() uint32 {
 	return 0
 }
 
+func (m *Transport) GetTtlSecurity() bool {
+	if m != nil {
+		return m.TtlSecurity
+	}
+	return false
+}
+
+func (m *Transport) GetTtl() uint32 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *Transport) GetMinTtl() uint32 {
+	if m != nil {
+		return m.MinTtl
+	}
+	return 0
+}
+
 type RouteServer struct {
 	RouteServerClient bool `protobuf:"varint,1,opt,name=route_server_client,json=routeServerClient" json:"route_server_client,omitempty"`
 }
@@ -3357,6 +3703,12 @@ type Conditions struct {
 	RpkiResult        int32                `protobuf:"varint,7,opt,name=rpki_result,json=rpkiResult" json:"rpki_result,omitempty"`
 	RouteType         Conditions_RouteType `protobuf:"varint,8,opt,name=route_type,json=routeType,enum=gobgpapi.Conditions_RouteType" json:"route_type,omitempty"`
 	LargeCommunitySet *MatchSet            `protobuf:"bytes,9,opt,name=large_community_set,json=largeCommunitySet" json:"large_community_set,omitempty"`
+	MedEq             uint32               `protobuf:"varint,10,opt,name=med_eq,json=medEq" json:"med_eq,omitempty"`
+	OriginEq          RouteOrigin          `protobuf:"varint,11,opt,name=origin_eq,json=originEq,enum=gobgpapi.RouteOrigin" json:"origin_eq,omitempty"`
+	NextHopInList     []string             `protobuf:"bytes,12,rep,name=next_hop_in_list,json=nextHopInList" json:"next_hop_in_list,omitempty"`
+	AfiSafiIn         []uint32             `protobuf:"varint,13,rep,packed,name=afi_safi_in,json=afiSafiIn" json:"afi_safi_in,omitempty"`
+	LocalPrefEq       uint32               `protobuf:"varint,14,opt,name=local_pref_eq,json=localPrefEq" json:"local_pref_eq,omitempty"`
+	CommunityCount    *CommunityCount      `protobuf:"bytes,15,opt,name=community_count,json=communityCount" json:"community_count,omitempty"`
 }
 
 func (m *Conditions) Reset()                    { *m = Conditions{} }
@@ -3427,6 +3779,48 @@ func (m *Conditions) GetLargeCommunitySet() *MatchSet {
 	return nil
 }
 
+func (m *Conditions) GetMedEq() uint32 {
+	if m != nil {
+		return m.MedEq
+	}
+	return 0
+}
+
+func (m *Conditions) GetOriginEq() RouteOrigin {
+	if m != nil {
+		return m.OriginEq
+	}
+	return RouteOrigin_ORIGIN_NONE
+}
+
+func (m *Conditions) GetNextHopInList() []string {
+	if m != nil {
+		return m.NextHopInList
+	}
+	return nil
+}
+
+func (m *Conditions) GetAfiSafiIn() []uint32 {
+	if m != nil {
+		return m.AfiSafiIn
+	}
+	return nil
+}
+
+func (m *Conditions) GetLocalPrefEq() uint32 {
+	if m != nil {
+		return m.LocalPrefEq
+	}
+	return 0
+}
+
+func (m *Conditions) GetCommunityCount() *CommunityCount {
+	if m != nil {
+		return m.CommunityCount
+	}
+	return nil
+}
+
 type CommunityAction struct {
 	Type        CommunityActionType `protobuf:"varint,1,opt,name=type,enum=gobgpapi.CommunityActionType" json:"type,omitempty"`
 	Communities []string            `protobuf:"bytes,2,rep,name=communities" json:"communities,omitempty"`
@@ -3556,6 +3950,8 @@ type Actions struct {
 	Nexthop        *NexthopAction   `protobuf:"bytes,6,opt,name=nexthop" json:"nexthop,omitempty"`
 	LocalPref      *LocalPrefAction `protobuf:"bytes,7,opt,name=local_pref,json=localPref" json:"local_pref,omitempty"`
 	LargeCommunity *CommunityAction `protobuf:"bytes,8,opt,name=large_community,json=largeCommunity" json:"large_community,omitempty"`
+	Aigp           *AigpAction      `protobuf:"bytes,9,opt,name=aigp" json:"aigp,omitempty"`
+	Origin         RouteOrigin      `protobuf:"varint,10,opt,name=origin,enum=gobgpapi.RouteOrigin" json:"origin,omitempty"`
 }
 
 func (m *Actions) Reset()                    { *m = Actions{} }
@@ -3619,6 +4015,20 @@ func (m *Actions) GetLargeCommunity() *CommunityAction {
 	return nil
 }
 
+func (m *Actions) GetAigp() *AigpAction {
+	if m != nil {
+		return m.Aigp
+	}
+	return nil
+}
+
+func (m *Actions) GetOrigin() RouteOrigin {
+	if m != nil {
+		return m.Origin
+	}
+	return RouteOrigin_ORIGIN_NONE
+}
+
 type Statement struct {
 	Name       string      `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	Conditions *Conditions `protobuf:"bytes,2,opt,name=conditions" json:"conditions,omitempty"`
@@ -3858,6 +4268,7 @@ type Global struct {
 	ListenAddresses  []string `protobuf:"bytes,4,rep,name=listen_addresses,json=listenAddresses" json:"listen_addresses,omitempty"`
 	Families         []uint32 `protobuf:"varint,5,rep,packed,name=families" json:"families,omitempty"`
 	UseMultiplePaths bool     `protobuf:"varint,6,opt,name=use_multiple_paths,json=useMultiplePaths" json:"use_multiple_paths,omitempty"`
+	PendingRestart   []string `protobuf:"bytes,7,rep,name=pending_restart,json=pendingRestart" json:"pending_restart,omitempty"`
 }
 
 func (m *Global) Reset()                    { *m = Global{} }
@@ -3907,6 +4318,13 @@ func (m *Global) GetUseMultiplePaths() bool {
 	return false
 }
 
+func (m *Global) GetPendingRestart() []string {
+	if m != nil {
+		return m.PendingRestart
+	}
+	return nil
+}
+
 type TableInfo struct {
 	Type           Resource `protobuf:"varint,1,opt,name=type,enum=gobgpapi.Resource" json:"type,omitempty"`
 	Name           string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
@@ -3995,40 +4413,1464 @@ func (m *GetRibInfoResponse) GetInfo() *TableInfo {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GetNeighborRequest)(nil), "gobgpapi.GetNeighborRequest")
-	proto.RegisterType((*GetNeighborResponse)(nil), "gobgpapi.GetNeighborResponse")
-	proto.RegisterType((*Arguments)(nil), "gobgpapi.Arguments")
-	proto.RegisterType((*AddPathRequest)(nil), "gobgpapi.AddPathRequest")
-	proto.RegisterType((*AddPathResponse)(nil), "gobgpapi.AddPathResponse")
-	proto.RegisterType((*DeletePathRequest)(nil), "gobgpapi.DeletePathRequest")
-	proto.RegisterType((*DeletePathResponse)(nil), "gobgpapi.DeletePathResponse")
-	proto.RegisterType((*AddNeighborRequest)(nil), "gobgpapi.AddNeighborRequest")
-	proto.RegisterType((*AddNeighborResponse)(nil), "gobgpapi.AddNeighborResponse")
-	proto.RegisterType((*DeleteNeighborRequest)(nil), "gobgpapi.DeleteNeighborRequest")
-	proto.RegisterType((*DeleteNeighborResponse)(nil), "gobgpapi.DeleteNeighborResponse")
-	proto.RegisterType((*ResetNeighborRequest)(nil), "gobgpapi.ResetNeighborRequest")
-	proto.RegisterType((*ResetNeighborResponse)(nil), "gobgpapi.ResetNeighborResponse")
-	proto.RegisterType((*SoftResetNeighborRequest)(nil), "gobgpapi.SoftResetNeighborRequest")
-	proto.RegisterType((*SoftResetNeighborResponse)(nil), "gobgpapi.SoftResetNeighborResponse")
-	proto.RegisterType((*ShutdownNeighborRequest)(nil), "gobgpapi.ShutdownNeighborRequest")
-	proto.RegisterType((*ShutdownNeighborResponse)(nil), "gobgpapi.ShutdownNeighborResponse")
-	proto.RegisterType((*EnableNeighborRequest)(nil), "gobgpapi.EnableNeighborRequest")
-	proto.RegisterType((*EnableNeighborResponse)(nil), "gobgpapi.EnableNeighborResponse")
-	proto.RegisterType((*DisableNeighborRequest)(nil), "gobgpapi.DisableNeighborRequest")
-	proto.RegisterType((*DisableNeighborResponse)(nil), "gobgpapi.DisableNeighborResponse")
-	proto.RegisterType((*EnableMrtRequest)(nil), "gobgpapi.EnableMrtRequest")
-	proto.RegisterType((*EnableMrtResponse)(nil), "gobgpapi.EnableMrtResponse")
-	proto.RegisterType((*DisableMrtRequest)(nil), "gobgpapi.DisableMrtRequest")
-	proto.RegisterType((*DisableMrtResponse)(nil), "gobgpapi.DisableMrtResponse")
-	proto.RegisterType((*InjectMrtRequest)(nil), "gobgpapi.InjectMrtRequest")
-	proto.RegisterType((*InjectMrtResponse)(nil), "gobgpapi.InjectMrtResponse")
-	proto.RegisterType((*AddBmpRequest)(nil), "gobgpapi.AddBmpRequest")
-	proto.RegisterType((*AddBmpResponse)(nil), "gobgpapi.AddBmpResponse")
-	proto.RegisterType((*DeleteBmpRequest)(nil), "gobgpapi.DeleteBmpRequest")
-	proto.RegisterType((*DeleteBmpResponse)(nil), "gobgpapi.DeleteBmpResponse")
-	proto.RegisterType((*MonitorRibRequest)(nil), "gobgpapi.MonitorRibRequest")
-	proto.RegisterType((*RPKIConf)(nil), "gobgpapi.RPKIConf")
+type PeerGroup struct {
+	Families       []uint32        `protobuf:"varint,1,rep,packed,name=families" json:"families,omitempty"`
+	ApplyPolicy    *ApplyPolicy    `protobuf:"bytes,2,opt,name=apply_policy,json=applyPolicy" json:"apply_policy,omitempty"`
+	Conf           *PeerGroupConf  `protobuf:"bytes,3,opt,name=conf" json:"conf,omitempty"`
+	EbgpMultihop   *EbgpMultihop   `protobuf:"bytes,4,opt,name=ebgp_multihop,json=ebgpMultihop" json:"ebgp_multihop,omitempty"`
+	RouteReflector *RouteReflector `protobuf:"bytes,5,opt,name=route_reflector,json=routeReflector" json:"route_reflector,omitempty"`
+	Timers         *Timers         `protobuf:"bytes,6,opt,name=timers" json:"timers,omitempty"`
+	Transport      *Transport      `protobuf:"bytes,7,opt,name=transport" json:"transport,omitempty"`
+	RouteServer    *RouteServer    `protobuf:"bytes,8,opt,name=route_server,json=routeServer" json:"route_server,omitempty"`
+	AsPathOptions  *AsPathOptions  `protobuf:"bytes,9,opt,name=as_path_options,json=asPathOptions" json:"as_path_options,omitempty"`
+	ErrorHandling  *ErrorHandling  `protobuf:"bytes,10,opt,name=error_handling,json=errorHandling" json:"error_handling,omitempty"`
+	Bfd            *Bfd            `protobuf:"bytes,11,opt,name=bfd" json:"bfd,omitempty"`
+}
+
+func (m *PeerGroup) Reset()                    { *m = PeerGroup{} }
+func (m *PeerGroup) String() string            { return proto.CompactTextString(m) }
+func (*PeerGroup) ProtoMessage()               {}
+func (*PeerGroup) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{139} }
+
+func (m *PeerGroup) GetFamilies() []uint32 {
+	if m != nil {
+		return m.Families
+	}
+	return nil
+}
+
+func (m *PeerGroup) GetApplyPolicy() *ApplyPolicy {
+	if m != nil {
+		return m.ApplyPolicy
+	}
+	return nil
+}
+
+func (m *PeerGroup) GetConf() *PeerGroupConf {
+	if m != nil {
+		return m.Conf
+	}
+	return nil
+}
+
+func (m *PeerGroup) GetEbgpMultihop() *EbgpMultihop {
+	if m != nil {
+		return m.EbgpMultihop
+	}
+	return nil
+}
+
+func (m *PeerGroup) GetRouteReflector() *RouteReflector {
+	if m != nil {
+		return m.RouteReflector
+	}
+	return nil
+}
+
+func (m *PeerGroup) GetTimers() *Timers {
+	if m != nil {
+		return m.Timers
+	}
+	return nil
+}
+
+func (m *PeerGroup) GetTransport() *Transport {
+	if m != nil {
+		return m.Transport
+	}
+	return nil
+}
+
+func (m *PeerGroup) GetRouteServer() *RouteServer {
+	if m != nil {
+		return m.RouteServer
+	}
+	return nil
+}
+
+func (m *PeerGroup) GetAsPathOptions() *AsPathOptions {
+	if m != nil {
+		return m.AsPathOptions
+	}
+	return nil
+}
+
+func (m *PeerGroup) GetErrorHandling() *ErrorHandling {
+	if m != nil {
+		return m.ErrorHandling
+	}
+	return nil
+}
+
+func (m *PeerGroup) GetBfd() *Bfd {
+	if m != nil {
+		return m.Bfd
+	}
+	return nil
+}
+
+type PeerGroupConf struct {
+	AuthPassword     string `protobuf:"bytes,1,opt,name=auth_password,json=authPassword" json:"auth_password,omitempty"`
+	Description      string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	LocalAs          uint32 `protobuf:"varint,3,opt,name=local_as,json=localAs" json:"local_as,omitempty"`
+	PeerAs           uint32 `protobuf:"varint,4,opt,name=peer_as,json=peerAs" json:"peer_as,omitempty"`
+	PeerGroupName    string `protobuf:"bytes,5,opt,name=peer_group_name,json=peerGroupName" json:"peer_group_name,omitempty"`
+	PeerType         uint32 `protobuf:"varint,6,opt,name=peer_type,json=peerType" json:"peer_type,omitempty"`
+	RemovePrivateAs  uint32 `protobuf:"varint,7,opt,name=remove_private_as,json=removePrivateAs" json:"remove_private_as,omitempty"`
+	RouteFlapDamping bool   `protobuf:"varint,8,opt,name=route_flap_damping,json=routeFlapDamping" json:"route_flap_damping,omitempty"`
+	SendCommunity    uint32 `protobuf:"varint,9,opt,name=send_community,json=sendCommunity" json:"send_community,omitempty"`
+}
+
+func (m *PeerGroupConf) Reset()                    { *m = PeerGroupConf{} }
+func (m *PeerGroupConf) String() string            { return proto.CompactTextString(m) }
+func (*PeerGroupConf) ProtoMessage()               {}
+func (*PeerGroupConf) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{140} }
+
+func (m *PeerGroupConf) GetAuthPassword() string {
+	if m != nil {
+		return m.AuthPassword
+	}
+	return ""
+}
+
+func (m *PeerGroupConf) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *PeerGroupConf) GetLocalAs() uint32 {
+	if m != nil {
+		return m.LocalAs
+	}
+	return 0
+}
+
+func (m *PeerGroupConf) GetPeerAs() uint32 {
+	if m != nil {
+		return m.PeerAs
+	}
+	return 0
+}
+
+func (m *PeerGroupConf) GetPeerGroupName() string {
+	if m != nil {
+		return m.PeerGroupName
+	}
+	return ""
+}
+
+func (m *PeerGroupConf) GetPeerType() uint32 {
+	if m != nil {
+		return m.PeerType
+	}
+	return 0
+}
+
+func (m *PeerGroupConf) GetRemovePrivateAs() uint32 {
+	if m != nil {
+		return m.RemovePrivateAs
+	}
+	return 0
+}
+
+func (m *PeerGroupConf) GetRouteFlapDamping() bool {
+	if m != nil {
+		return m.RouteFlapDamping
+	}
+	return false
+}
+
+func (m *PeerGroupConf) GetSendCommunity() uint32 {
+	if m != nil {
+		return m.SendCommunity
+	}
+	return 0
+}
+
+type AddPeerGroupRequest struct {
+	PeerGroup *PeerGroup `protobuf:"bytes,1,opt,name=peer_group,json=peerGroup" json:"peer_group,omitempty"`
+	Candidate bool       `protobuf:"varint,2,opt,name=candidate" json:"candidate,omitempty"`
+}
+
+func (m *AddPeerGroupRequest) Reset()                    { *m = AddPeerGroupRequest{} }
+func (m *AddPeerGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*AddPeerGroupRequest) ProtoMessage()               {}
+func (*AddPeerGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{141} }
+
+func (m *AddPeerGroupRequest) GetPeerGroup() *PeerGroup {
+	if m != nil {
+		return m.PeerGroup
+	}
+	return nil
+}
+
+func (m *AddPeerGroupRequest) GetCandidate() bool {
+	if m != nil {
+		return m.Candidate
+	}
+	return false
+}
+
+type AddPeerGroupResponse struct {
+}
+
+func (m *AddPeerGroupResponse) Reset()                    { *m = AddPeerGroupResponse{} }
+func (m *AddPeerGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*AddPeerGroupResponse) ProtoMessage()               {}
+func (*AddPeerGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{142} }
+
+type DeletePeerGroupRequest struct {
+	PeerGroup *PeerGroup `protobuf:"bytes,1,opt,name=peer_group,json=peerGroup" json:"peer_group,omitempty"`
+	Candidate bool       `protobuf:"varint,2,opt,name=candidate" json:"candidate,omitempty"`
+}
+
+func (m *DeletePeerGroupRequest) Reset()                    { *m = DeletePeerGroupRequest{} }
+func (m *DeletePeerGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeletePeerGroupRequest) ProtoMessage()               {}
+func (*DeletePeerGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{143} }
+
+func (m *DeletePeerGroupRequest) GetPeerGroup() *PeerGroup {
+	if m != nil {
+		return m.PeerGroup
+	}
+	return nil
+}
+
+func (m *DeletePeerGroupRequest) GetCandidate() bool {
+	if m != nil {
+		return m.Candidate
+	}
+	return false
+}
+
+type DeletePeerGroupResponse struct {
+}
+
+func (m *DeletePeerGroupResponse) Reset()                    { *m = DeletePeerGroupResponse{} }
+func (m *DeletePeerGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeletePeerGroupResponse) ProtoMessage()               {}
+func (*DeletePeerGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{144} }
+
+type UpdatePeerGroupRequest struct {
+	PeerGroup     *PeerGroup `protobuf:"bytes,1,opt,name=peer_group,json=peerGroup" json:"peer_group,omitempty"`
+	DoSoftResetIn bool       `protobuf:"varint,2,opt,name=do_soft_reset_in,json=doSoftResetIn" json:"do_soft_reset_in,omitempty"`
+	Candidate     bool       `protobuf:"varint,3,opt,name=candidate" json:"candidate,omitempty"`
+}
+
+func (m *UpdatePeerGroupRequest) Reset()                    { *m = UpdatePeerGroupRequest{} }
+func (m *UpdatePeerGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdatePeerGroupRequest) ProtoMessage()               {}
+func (*UpdatePeerGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{145} }
+
+func (m *UpdatePeerGroupRequest) GetPeerGroup() *PeerGroup {
+	if m != nil {
+		return m.PeerGroup
+	}
+	return nil
+}
+
+func (m *UpdatePeerGroupRequest) GetDoSoftResetIn() bool {
+	if m != nil {
+		return m.DoSoftResetIn
+	}
+	return false
+}
+
+func (m *UpdatePeerGroupRequest) GetCandidate() bool {
+	if m != nil {
+		return m.Candidate
+	}
+	return false
+}
+
+type UpdatePeerGroupResponse struct {
+	NeedsSoftResetIn bool `protobuf:"varint,1,opt,name=needs_soft_reset_in,json=needsSoftResetIn" json:"needs_soft_reset_in,omitempty"`
+}
+
+func (m *UpdatePeerGroupResponse) Reset()                    { *m = UpdatePeerGroupResponse{} }
+func (m *UpdatePeerGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdatePeerGroupResponse) ProtoMessage()               {}
+func (*UpdatePeerGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{146} }
+
+func (m *UpdatePeerGroupResponse) GetNeedsSoftResetIn() bool {
+	if m != nil {
+		return m.NeedsSoftResetIn
+	}
+	return false
+}
+
+type DynamicNeighbor struct {
+	Prefix    string `protobuf:"bytes,1,opt,name=prefix" json:"prefix,omitempty"`
+	PeerGroup string `protobuf:"bytes,2,opt,name=peer_group,json=peerGroup" json:"peer_group,omitempty"`
+}
+
+func (m *DynamicNeighbor) Reset()                    { *m = DynamicNeighbor{} }
+func (m *DynamicNeighbor) String() string            { return proto.CompactTextString(m) }
+func (*DynamicNeighbor) ProtoMessage()               {}
+func (*DynamicNeighbor) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{147} }
+
+func (m *DynamicNeighbor) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *DynamicNeighbor) GetPeerGroup() string {
+	if m != nil {
+		return m.PeerGroup
+	}
+	return ""
+}
+
+type AddDynamicNeighborRequest struct {
+	DynamicNeighbor *DynamicNeighbor `protobuf:"bytes,1,opt,name=dynamic_neighbor,json=dynamicNeighbor" json:"dynamic_neighbor,omitempty"`
+	Candidate       bool             `protobuf:"varint,2,opt,name=candidate" json:"candidate,omitempty"`
+}
+
+func (m *AddDynamicNeighborRequest) Reset()                    { *m = AddDynamicNeighborRequest{} }
+func (m *AddDynamicNeighborRequest) String() string            { return proto.CompactTextString(m) }
+func (*AddDynamicNeighborRequest) ProtoMessage()               {}
+func (*AddDynamicNeighborRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{148} }
+
+func (m *AddDynamicNeighborRequest) GetDynamicNeighbor() *DynamicNeighbor {
+	if m != nil {
+		return m.DynamicNeighbor
+	}
+	return nil
+}
+
+func (m *AddDynamicNeighborRequest) GetCandidate() bool {
+	if m != nil {
+		return m.Candidate
+	}
+	return false
+}
+
+type AddDynamicNeighborResponse struct {
+}
+
+func (m *AddDynamicNeighborResponse) Reset()                    { *m = AddDynamicNeighborResponse{} }
+func (m *AddDynamicNeighborResponse) String() string            { return proto.CompactTextString(m) }
+func (*AddDynamicNeighborResponse) ProtoMessage()               {}
+func (*AddDynamicNeighborResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{149} }
+
+type DeleteDynamicNeighborRequest struct {
+	DynamicNeighbor *DynamicNeighbor `protobuf:"bytes,1,opt,name=dynamic_neighbor,json=dynamicNeighbor" json:"dynamic_neighbor,omitempty"`
+	Candidate       bool             `protobuf:"varint,2,opt,name=candidate" json:"candidate,omitempty"`
+}
+
+func (m *DeleteDynamicNeighborRequest) Reset()                    { *m = DeleteDynamicNeighborRequest{} }
+func (m *DeleteDynamicNeighborRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteDynamicNeighborRequest) ProtoMessage()               {}
+func (*DeleteDynamicNeighborRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{150} }
+
+func (m *DeleteDynamicNeighborRequest) GetDynamicNeighbor() *DynamicNeighbor {
+	if m != nil {
+		return m.DynamicNeighbor
+	}
+	return nil
+}
+
+func (m *DeleteDynamicNeighborRequest) GetCandidate() bool {
+	if m != nil {
+		return m.Candidate
+	}
+	return false
+}
+
+type DeleteDynamicNeighborResponse struct {
+}
+
+func (m *DeleteDynamicNeighborResponse) Reset()                    { *m = DeleteDynamicNeighborResponse{} }
+func (m *DeleteDynamicNeighborResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteDynamicNeighborResponse) ProtoMessage()               {}
+func (*DeleteDynamicNeighborResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{151} }
+
+type DampenedPath struct {
+	Path      *Path  `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+	Penalty   uint32 `protobuf:"varint,2,opt,name=penalty" json:"penalty,omitempty"`
+	Flaps     uint32 `protobuf:"varint,3,opt,name=flaps" json:"flaps,omitempty"`
+	ReuseTime int64  `protobuf:"varint,4,opt,name=reuse_time,json=reuseTime" json:"reuse_time,omitempty"`
+}
+
+func (m *DampenedPath) Reset()                    { *m = DampenedPath{} }
+func (m *DampenedPath) String() string            { return proto.CompactTextString(m) }
+func (*DampenedPath) ProtoMessage()               {}
+func (*DampenedPath) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{152} }
+
+func (m *DampenedPath) GetPath() *Path {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *DampenedPath) GetPenalty() uint32 {
+	if m != nil {
+		return m.Penalty
+	}
+	return 0
+}
+
+func (m *DampenedPath) GetFlaps() uint32 {
+	if m != nil {
+		return m.Flaps
+	}
+	return 0
+}
+
+func (m *DampenedPath) GetReuseTime() int64 {
+	if m != nil {
+		return m.ReuseTime
+	}
+	return 0
+}
+
+type GetDampenedPathRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Family  uint32 `protobuf:"varint,2,opt,name=family" json:"family,omitempty"`
+}
+
+func (m *GetDampenedPathRequest) Reset()                    { *m = GetDampenedPathRequest{} }
+func (m *GetDampenedPathRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDampenedPathRequest) ProtoMessage()               {}
+func (*GetDampenedPathRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{153} }
+
+func (m *GetDampenedPathRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetDampenedPathRequest) GetFamily() uint32 {
+	if m != nil {
+		return m.Family
+	}
+	return 0
+}
+
+type GetDampenedPathResponse struct {
+	Paths []*DampenedPath `protobuf:"bytes,1,rep,name=paths" json:"paths,omitempty"`
+}
+
+func (m *GetDampenedPathResponse) Reset()                    { *m = GetDampenedPathResponse{} }
+func (m *GetDampenedPathResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetDampenedPathResponse) ProtoMessage()               {}
+func (*GetDampenedPathResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{154} }
+
+func (m *GetDampenedPathResponse) GetPaths() []*DampenedPath {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+type AsPathOptions struct {
+	AllowOwnAs    uint32 `protobuf:"varint,1,opt,name=allow_own_as,json=allowOwnAs" json:"allow_own_as,omitempty"`
+	ReplacePeerAs bool   `protobuf:"varint,2,opt,name=replace_peer_as,json=replacePeerAs" json:"replace_peer_as,omitempty"`
+}
+
+func (m *AsPathOptions) Reset()                    { *m = AsPathOptions{} }
+func (m *AsPathOptions) String() string            { return proto.CompactTextString(m) }
+func (*AsPathOptions) ProtoMessage()               {}
+func (*AsPathOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{155} }
+
+func (m *AsPathOptions) GetAllowOwnAs() uint32 {
+	if m != nil {
+		return m.AllowOwnAs
+	}
+	return 0
+}
+
+func (m *AsPathOptions) GetReplacePeerAs() bool {
+	if m != nil {
+		return m.ReplacePeerAs
+	}
+	return false
+}
+
+type ErrorHandling struct {
+	TreatAsWithdraw bool `protobuf:"varint,1,opt,name=treat_as_withdraw,json=treatAsWithdraw" json:"treat_as_withdraw,omitempty"`
+}
+
+func (m *ErrorHandling) Reset()                    { *m = ErrorHandling{} }
+func (m *ErrorHandling) String() string            { return proto.CompactTextString(m) }
+func (*ErrorHandling) ProtoMessage()               {}
+func (*ErrorHandling) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{156} }
+
+func (m *ErrorHandling) GetTreatAsWithdraw() bool {
+	if m != nil {
+		return m.TreatAsWithdraw
+	}
+	return false
+}
+
+type AigpAction struct {
+	Value  uint64 `protobuf:"varint,1,opt,name=value" json:"value,omitempty"`
+	Remove bool   `protobuf:"varint,2,opt,name=remove" json:"remove,omitempty"`
+}
+
+func (m *AigpAction) Reset()                    { *m = AigpAction{} }
+func (m *AigpAction) String() string            { return proto.CompactTextString(m) }
+func (*AigpAction) ProtoMessage()               {}
+func (*AigpAction) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{157} }
+
+func (m *AigpAction) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *AigpAction) GetRemove() bool {
+	if m != nil {
+		return m.Remove
+	}
+	return false
+}
+
+type BfdConfig struct {
+	Enabled               bool   `protobuf:"varint,1,opt,name=enabled" json:"enabled,omitempty"`
+	Multihop              bool   `protobuf:"varint,2,opt,name=multihop" json:"multihop,omitempty"`
+	DesiredMinTxInterval  uint32 `protobuf:"varint,3,opt,name=desired_min_tx_interval,json=desiredMinTxInterval" json:"desired_min_tx_interval,omitempty"`
+	RequiredMinRxInterval uint32 `protobuf:"varint,4,opt,name=required_min_rx_interval,json=requiredMinRxInterval" json:"required_min_rx_interval,omitempty"`
+	DetectMultiplier      uint32 `protobuf:"varint,5,opt,name=detect_multiplier,json=detectMultiplier" json:"detect_multiplier,omitempty"`
+}
+
+func (m *BfdConfig) Reset()                    { *m = BfdConfig{} }
+func (m *BfdConfig) String() string            { return proto.CompactTextString(m) }
+func (*BfdConfig) ProtoMessage()               {}
+func (*BfdConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{158} }
+
+func (m *BfdConfig) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *BfdConfig) GetMultihop() bool {
+	if m != nil {
+		return m.Multihop
+	}
+	return false
+}
+
+func (m *BfdConfig) GetDesiredMinTxInterval() uint32 {
+	if m != nil {
+		return m.DesiredMinTxInterval
+	}
+	return 0
+}
+
+func (m *BfdConfig) GetRequiredMinRxInterval() uint32 {
+	if m != nil {
+		return m.RequiredMinRxInterval
+	}
+	return 0
+}
+
+func (m *BfdConfig) GetDetectMultiplier() uint32 {
+	if m != nil {
+		return m.DetectMultiplier
+	}
+	return 0
+}
+
+type BfdState struct {
+	SessionState        string `protobuf:"bytes,1,opt,name=session_state,json=sessionState" json:"session_state,omitempty"`
+	Diagnostic          string `protobuf:"bytes,2,opt,name=diagnostic" json:"diagnostic,omitempty"`
+	LocalDiscriminator  uint32 `protobuf:"varint,3,opt,name=local_discriminator,json=localDiscriminator" json:"local_discriminator,omitempty"`
+	RemoteDiscriminator uint32 `protobuf:"varint,4,opt,name=remote_discriminator,json=remoteDiscriminator" json:"remote_discriminator,omitempty"`
+	DetectionTime       uint32 `protobuf:"varint,5,opt,name=detection_time,json=detectionTime" json:"detection_time,omitempty"`
+	Uptime              int64  `protobuf:"varint,6,opt,name=uptime" json:"uptime,omitempty"`
+	UpCount             uint32 `protobuf:"varint,7,opt,name=up_count,json=upCount" json:"up_count,omitempty"`
+}
+
+func (m *BfdState) Reset()                    { *m = BfdState{} }
+func (m *BfdState) String() string            { return proto.CompactTextString(m) }
+func (*BfdState) ProtoMessage()               {}
+func (*BfdState) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{159} }
+
+func (m *BfdState) GetSessionState() string {
+	if m != nil {
+		return m.SessionState
+	}
+	return ""
+}
+
+func (m *BfdState) GetDiagnostic() string {
+	if m != nil {
+		return m.Diagnostic
+	}
+	return ""
+}
+
+func (m *BfdState) GetLocalDiscriminator() uint32 {
+	if m != nil {
+		return m.LocalDiscriminator
+	}
+	return 0
+}
+
+func (m *BfdState) GetRemoteDiscriminator() uint32 {
+	if m != nil {
+		return m.RemoteDiscriminator
+	}
+	return 0
+}
+
+func (m *BfdState) GetDetectionTime() uint32 {
+	if m != nil {
+		return m.DetectionTime
+	}
+	return 0
+}
+
+func (m *BfdState) GetUptime() int64 {
+	if m != nil {
+		return m.Uptime
+	}
+	return 0
+}
+
+func (m *BfdState) GetUpCount() uint32 {
+	if m != nil {
+		return m.UpCount
+	}
+	return 0
+}
+
+type Bfd struct {
+	Config *BfdConfig `protobuf:"bytes,1,opt,name=config" json:"config,omitempty"`
+	State  *BfdState  `protobuf:"bytes,2,opt,name=state" json:"state,omitempty"`
+}
+
+func (m *Bfd) Reset()                    { *m = Bfd{} }
+func (m *Bfd) String() string            { return proto.CompactTextString(m) }
+func (*Bfd) ProtoMessage()               {}
+func (*Bfd) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{160} }
+
+func (m *Bfd) GetConfig() *BfdConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *Bfd) GetState() *BfdState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+type ConfigDiff struct {
+	Type      string `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Running   string `protobuf:"bytes,3,opt,name=running" json:"running,omitempty"`
+	Candidate string `protobuf:"bytes,4,opt,name=candidate" json:"candidate,omitempty"`
+}
+
+func (m *ConfigDiff) Reset()                    { *m = ConfigDiff{} }
+func (m *ConfigDiff) String() string            { return proto.CompactTextString(m) }
+func (*ConfigDiff) ProtoMessage()               {}
+func (*ConfigDiff) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{161} }
+
+func (m *ConfigDiff) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ConfigDiff) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ConfigDiff) GetRunning() string {
+	if m != nil {
+		return m.Running
+	}
+	return ""
+}
+
+func (m *ConfigDiff) GetCandidate() string {
+	if m != nil {
+		return m.Candidate
+	}
+	return ""
+}
+
+type GetCandidateDiffRequest struct {
+}
+
+func (m *GetCandidateDiffRequest) Reset()                    { *m = GetCandidateDiffRequest{} }
+func (m *GetCandidateDiffRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetCandidateDiffRequest) ProtoMessage()               {}
+func (*GetCandidateDiffRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{162} }
+
+type GetCandidateDiffResponse struct {
+	Diffs []*ConfigDiff `protobuf:"bytes,1,rep,name=diffs" json:"diffs,omitempty"`
+}
+
+func (m *GetCandidateDiffResponse) Reset()                    { *m = GetCandidateDiffResponse{} }
+func (m *GetCandidateDiffResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetCandidateDiffResponse) ProtoMessage()               {}
+func (*GetCandidateDiffResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{163} }
+
+func (m *GetCandidateDiffResponse) GetDiffs() []*ConfigDiff {
+	if m != nil {
+		return m.Diffs
+	}
+	return nil
+}
+
+type CommitCandidateRequest struct {
+}
+
+func (m *CommitCandidateRequest) Reset()                    { *m = CommitCandidateRequest{} }
+func (m *CommitCandidateRequest) String() string            { return proto.CompactTextString(m) }
+func (*CommitCandidateRequest) ProtoMessage()               {}
+func (*CommitCandidateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{164} }
+
+type CommitCandidateResponse struct {
+	Id uint32 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *CommitCandidateResponse) Reset()                    { *m = CommitCandidateResponse{} }
+func (m *CommitCandidateResponse) String() string            { return proto.CompactTextString(m) }
+func (*CommitCandidateResponse) ProtoMessage()               {}
+func (*CommitCandidateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{165} }
+
+func (m *CommitCandidateResponse) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DiscardCandidateRequest struct {
+}
+
+func (m *DiscardCandidateRequest) Reset()                    { *m = DiscardCandidateRequest{} }
+func (m *DiscardCandidateRequest) String() string            { return proto.CompactTextString(m) }
+func (*DiscardCandidateRequest) ProtoMessage()               {}
+func (*DiscardCandidateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{166} }
+
+type DiscardCandidateResponse struct {
+}
+
+func (m *DiscardCandidateResponse) Reset()                    { *m = DiscardCandidateResponse{} }
+func (m *DiscardCandidateResponse) String() string            { return proto.CompactTextString(m) }
+func (*DiscardCandidateResponse) ProtoMessage()               {}
+func (*DiscardCandidateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{167} }
+
+type ConfigCommit struct {
+	Id   uint32 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Time int64  `protobuf:"varint,2,opt,name=time" json:"time,omitempty"`
+}
+
+func (m *ConfigCommit) Reset()                    { *m = ConfigCommit{} }
+func (m *ConfigCommit) String() string            { return proto.CompactTextString(m) }
+func (*ConfigCommit) ProtoMessage()               {}
+func (*ConfigCommit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{168} }
+
+func (m *ConfigCommit) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ConfigCommit) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+type GetCommitHistoryRequest struct {
+}
+
+func (m *GetCommitHistoryRequest) Reset()                    { *m = GetCommitHistoryRequest{} }
+func (m *GetCommitHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetCommitHistoryRequest) ProtoMessage()               {}
+func (*GetCommitHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{169} }
+
+type GetCommitHistoryResponse struct {
+	Commits []*ConfigCommit `protobuf:"bytes,1,rep,name=commits" json:"commits,omitempty"`
+}
+
+func (m *GetCommitHistoryResponse) Reset()                    { *m = GetCommitHistoryResponse{} }
+func (m *GetCommitHistoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetCommitHistoryResponse) ProtoMessage()               {}
+func (*GetCommitHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{170} }
+
+func (m *GetCommitHistoryResponse) GetCommits() []*ConfigCommit {
+	if m != nil {
+		return m.Commits
+	}
+	return nil
+}
+
+type RollbackConfigRequest struct {
+	Id uint32 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *RollbackConfigRequest) Reset()                    { *m = RollbackConfigRequest{} }
+func (m *RollbackConfigRequest) String() string            { return proto.CompactTextString(m) }
+func (*RollbackConfigRequest) ProtoMessage()               {}
+func (*RollbackConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{171} }
+
+func (m *RollbackConfigRequest) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type RollbackConfigResponse struct {
+	Id uint32 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *RollbackConfigResponse) Reset()                    { *m = RollbackConfigResponse{} }
+func (m *RollbackConfigResponse) String() string            { return proto.CompactTextString(m) }
+func (*RollbackConfigResponse) ProtoMessage()               {}
+func (*RollbackConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{172} }
+
+func (m *RollbackConfigResponse) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetConfigRequest struct {
+	Format string `protobuf:"bytes,1,opt,name=format" json:"format,omitempty"`
+}
+
+func (m *GetConfigRequest) Reset()                    { *m = GetConfigRequest{} }
+func (m *GetConfigRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetConfigRequest) ProtoMessage()               {}
+func (*GetConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{173} }
+
+func (m *GetConfigRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+type GetConfigResponse struct {
+	Config string `protobuf:"bytes,1,opt,name=config" json:"config,omitempty"`
+}
+
+func (m *GetConfigResponse) Reset()                    { *m = GetConfigResponse{} }
+func (m *GetConfigResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetConfigResponse) ProtoMessage()               {}
+func (*GetConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{174} }
+
+func (m *GetConfigResponse) GetConfig() string {
+	if m != nil {
+		return m.Config
+	}
+	return ""
+}
+
+type MonitorEventsRequest struct {
+	Types       []string `protobuf:"bytes,1,rep,name=types" json:"types,omitempty"`
+	Families    []uint32 `protobuf:"varint,2,rep,packed,name=families" json:"families,omitempty"`
+	Neighbors   []string `protobuf:"bytes,3,rep,name=neighbors" json:"neighbors,omitempty"`
+	PrefixSet   string   `protobuf:"bytes,4,opt,name=prefix_set,json=prefixSet" json:"prefix_set,omitempty"`
+	Communities []string `protobuf:"bytes,5,rep,name=communities" json:"communities,omitempty"`
+	Current     bool     `protobuf:"varint,6,opt,name=current" json:"current,omitempty"`
+}
+
+func (m *MonitorEventsRequest) Reset()                    { *m = MonitorEventsRequest{} }
+func (m *MonitorEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*MonitorEventsRequest) ProtoMessage()               {}
+func (*MonitorEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{175} }
+
+func (m *MonitorEventsRequest) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *MonitorEventsRequest) GetFamilies() []uint32 {
+	if m != nil {
+		return m.Families
+	}
+	return nil
+}
+
+func (m *MonitorEventsRequest) GetNeighbors() []string {
+	if m != nil {
+		return m.Neighbors
+	}
+	return nil
+}
+
+func (m *MonitorEventsRequest) GetPrefixSet() string {
+	if m != nil {
+		return m.PrefixSet
+	}
+	return ""
+}
+
+func (m *MonitorEventsRequest) GetCommunities() []string {
+	if m != nil {
+		return m.Communities
+	}
+	return nil
+}
+
+func (m *MonitorEventsRequest) GetCurrent() bool {
+	if m != nil {
+		return m.Current
+	}
+	return false
+}
+
+type Event struct {
+	Sequence    uint64   `protobuf:"varint,1,opt,name=sequence" json:"sequence,omitempty"`
+	Type        string   `protobuf:"bytes,2,opt,name=type" json:"type,omitempty"`
+	Timestamp   int64    `protobuf:"varint,3,opt,name=timestamp" json:"timestamp,omitempty"`
+	Neighbor    string   `protobuf:"bytes,4,opt,name=neighbor" json:"neighbor,omitempty"`
+	PeerAs      uint32   `protobuf:"varint,5,opt,name=peer_as,json=peerAs" json:"peer_as,omitempty"`
+	Family      string   `protobuf:"bytes,6,opt,name=family" json:"family,omitempty"`
+	Prefix      string   `protobuf:"bytes,7,opt,name=prefix" json:"prefix,omitempty"`
+	Withdrawal  bool     `protobuf:"varint,8,opt,name=withdrawal" json:"withdrawal,omitempty"`
+	Nexthop     string   `protobuf:"bytes,9,opt,name=nexthop" json:"nexthop,omitempty"`
+	AsPath      string   `protobuf:"bytes,10,opt,name=as_path,json=asPath" json:"as_path,omitempty"`
+	Origin      string   `protobuf:"bytes,11,opt,name=origin" json:"origin,omitempty"`
+	HasMed      bool     `protobuf:"varint,12,opt,name=has_med,json=hasMed" json:"has_med,omitempty"`
+	Med         uint32   `protobuf:"varint,13,opt,name=med" json:"med,omitempty"`
+	LocalPref   uint32   `protobuf:"varint,14,opt,name=local_pref,json=localPref" json:"local_pref,omitempty"`
+	Communities []string `protobuf:"bytes,15,rep,name=communities" json:"communities,omitempty"`
+	State       string   `protobuf:"bytes,16,opt,name=state" json:"state,omitempty"`
+	AdminState  string   `protobuf:"bytes,17,opt,name=admin_state,json=adminState" json:"admin_state,omitempty"`
+	Dropped     uint64   `protobuf:"varint,18,opt,name=dropped" json:"dropped,omitempty"`
+}
+
+func (m *Event) Reset()                    { *m = Event{} }
+func (m *Event) String() string            { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()               {}
+func (*Event) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{176} }
+
+func (m *Event) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Event) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Event) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Event) GetNeighbor() string {
+	if m != nil {
+		return m.Neighbor
+	}
+	return ""
+}
+
+func (m *Event) GetPeerAs() uint32 {
+	if m != nil {
+		return m.PeerAs
+	}
+	return 0
+}
+
+func (m *Event) GetFamily() string {
+	if m != nil {
+		return m.Family
+	}
+	return ""
+}
+
+func (m *Event) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *Event) GetWithdrawal() bool {
+	if m != nil {
+		return m.Withdrawal
+	}
+	return false
+}
+
+func (m *Event) GetNexthop() string {
+	if m != nil {
+		return m.Nexthop
+	}
+	return ""
+}
+
+func (m *Event) GetAsPath() string {
+	if m != nil {
+		return m.AsPath
+	}
+	return ""
+}
+
+func (m *Event) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+func (m *Event) GetHasMed() bool {
+	if m != nil {
+		return m.HasMed
+	}
+	return false
+}
+
+func (m *Event) GetMed() uint32 {
+	if m != nil {
+		return m.Med
+	}
+	return 0
+}
+
+func (m *Event) GetLocalPref() uint32 {
+	if m != nil {
+		return m.LocalPref
+	}
+	return 0
+}
+
+func (m *Event) GetCommunities() []string {
+	if m != nil {
+		return m.Communities
+	}
+	return nil
+}
+
+func (m *Event) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *Event) GetAdminState() string {
+	if m != nil {
+		return m.AdminState
+	}
+	return ""
+}
+
+func (m *Event) GetDropped() uint64 {
+	if m != nil {
+		return m.Dropped
+	}
+	return 0
+}
+
+type CommunityCount struct {
+	Type  AsPathLengthType `protobuf:"varint,1,opt,name=type,enum=gobgpapi.AsPathLengthType" json:"type,omitempty"`
+	Count uint32           `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *CommunityCount) Reset()                    { *m = CommunityCount{} }
+func (m *CommunityCount) String() string            { return proto.CompactTextString(m) }
+func (*CommunityCount) ProtoMessage()               {}
+func (*CommunityCount) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{177} }
+
+func (m *CommunityCount) GetType() AsPathLengthType {
+	if m != nil {
+		return m.Type
+	}
+	return AsPathLengthType_EQ
+}
+
+func (m *CommunityCount) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type DefaultOriginate struct {
+	Family      uint32 `protobuf:"varint,1,opt,name=family" json:"family,omitempty"`
+	RoutePolicy string `protobuf:"bytes,2,opt,name=route_policy,json=routePolicy" json:"route_policy,omitempty"`
+	Originated  bool   `protobuf:"varint,3,opt,name=originated" json:"originated,omitempty"`
+}
+
+func (m *DefaultOriginate) Reset()                    { *m = DefaultOriginate{} }
+func (m *DefaultOriginate) String() string            { return proto.CompactTextString(m) }
+func (*DefaultOriginate) ProtoMessage()               {}
+func (*DefaultOriginate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{178} }
+
+func (m *DefaultOriginate) GetFamily() uint32 {
+	if m != nil {
+		return m.Family
+	}
+	return 0
+}
+
+func (m *DefaultOriginate) GetRoutePolicy() string {
+	if m != nil {
+		return m.RoutePolicy
+	}
+	return ""
+}
+
+func (m *DefaultOriginate) GetOriginated() bool {
+	if m != nil {
+		return m.Originated
+	}
+	return false
+}
+
+type ConditionalAdvertisement struct {
+	AdvertisePolicy   string `protobuf:"bytes,1,opt,name=advertise_policy,json=advertisePolicy" json:"advertise_policy,omitempty"`
+	ExistPrefixSet    string `protobuf:"bytes,2,opt,name=exist_prefix_set,json=existPrefixSet" json:"exist_prefix_set,omitempty"`
+	NonExistPrefixSet string `protobuf:"bytes,3,opt,name=non_exist_prefix_set,json=nonExistPrefixSet" json:"non_exist_prefix_set,omitempty"`
+	ConditionMet      bool   `protobuf:"varint,4,opt,name=condition_met,json=conditionMet" json:"condition_met,omitempty"`
+	TrackedPaths      uint32 `protobuf:"varint,5,opt,name=tracked_paths,json=trackedPaths" json:"tracked_paths,omitempty"`
+}
+
+func (m *ConditionalAdvertisement) Reset()                    { *m = ConditionalAdvertisement{} }
+func (m *ConditionalAdvertisement) String() string            { return proto.CompactTextString(m) }
+func (*ConditionalAdvertisement) ProtoMessage()               {}
+func (*ConditionalAdvertisement) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{179} }
+
+func (m *ConditionalAdvertisement) GetAdvertisePolicy() string {
+	if m != nil {
+		return m.AdvertisePolicy
+	}
+	return ""
+}
+
+func (m *ConditionalAdvertisement) GetExistPrefixSet() string {
+	if m != nil {
+		return m.ExistPrefixSet
+	}
+	return ""
+}
+
+func (m *ConditionalAdvertisement) GetNonExistPrefixSet() string {
+	if m != nil {
+		return m.NonExistPrefixSet
+	}
+	return ""
+}
+
+func (m *ConditionalAdvertisement) GetConditionMet() bool {
+	if m != nil {
+		return m.ConditionMet
+	}
+	return false
+}
+
+func (m *ConditionalAdvertisement) GetTrackedPaths() uint32 {
+	if m != nil {
+		return m.TrackedPaths
+	}
+	return 0
+}
+
+type HealthCheckRoute struct {
+	Prefix              string   `protobuf:"bytes,1,opt,name=prefix" json:"prefix,omitempty"`
+	NextHop             string   `protobuf:"bytes,2,opt,name=next_hop,json=nextHop" json:"next_hop,omitempty"`
+	Med                 uint32   `protobuf:"varint,3,opt,name=med" json:"med,omitempty"`
+	Communities         []string `protobuf:"bytes,4,rep,name=communities" json:"communities,omitempty"`
+	DegradedMed         uint32   `protobuf:"varint,5,opt,name=degraded_med,json=degradedMed" json:"degraded_med,omitempty"`
+	DegradedCommunities []string `protobuf:"bytes,6,rep,name=degraded_communities,json=degradedCommunities" json:"degraded_communities,omitempty"`
+}
+
+func (m *HealthCheckRoute) Reset()                    { *m = HealthCheckRoute{} }
+func (m *HealthCheckRoute) String() string            { return proto.CompactTextString(m) }
+func (*HealthCheckRoute) ProtoMessage()               {}
+func (*HealthCheckRoute) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{180} }
+
+func (m *HealthCheckRoute) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *HealthCheckRoute) GetNextHop() string {
+	if m != nil {
+		return m.NextHop
+	}
+	return ""
+}
+
+func (m *HealthCheckRoute) GetMed() uint32 {
+	if m != nil {
+		return m.Med
+	}
+	return 0
+}
+
+func (m *HealthCheckRoute) GetCommunities() []string {
+	if m != nil {
+		return m.Communities
+	}
+	return nil
+}
+
+func (m *HealthCheckRoute) GetDegradedMed() uint32 {
+	if m != nil {
+		return m.DegradedMed
+	}
+	return 0
+}
+
+func (m *HealthCheckRoute) GetDegradedCommunities() []string {
+	if m != nil {
+		return m.DegradedCommunities
+	}
+	return nil
+}
+
+type HealthCheck struct {
+	Name           string              `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Type           uint32              `protobuf:"varint,2,opt,name=type" json:"type,omitempty"`
+	Address        string              `protobuf:"bytes,3,opt,name=address" json:"address,omitempty"`
+	Url            string              `protobuf:"bytes,4,opt,name=url" json:"url,omitempty"`
+	ExpectedStatus uint32              `protobuf:"varint,5,opt,name=expected_status,json=expectedStatus" json:"expected_status,omitempty"`
+	Command        string              `protobuf:"bytes,6,opt,name=command" json:"command,omitempty"`
+	Interval       uint32              `protobuf:"varint,7,opt,name=interval" json:"interval,omitempty"`
+	Timeout        uint32              `protobuf:"varint,8,opt,name=timeout" json:"timeout,omitempty"`
+	Rise           uint32              `protobuf:"varint,9,opt,name=rise" json:"rise,omitempty"`
+	Fall           uint32              `protobuf:"varint,10,opt,name=fall" json:"fall,omitempty"`
+	Up             bool                `protobuf:"varint,11,opt,name=up" json:"up,omitempty"`
+	Successes      uint32              `protobuf:"varint,12,opt,name=successes" json:"successes,omitempty"`
+	Failures       uint32              `protobuf:"varint,13,opt,name=failures" json:"failures,omitempty"`
+	LastError      string              `protobuf:"bytes,14,opt,name=last_error,json=lastError" json:"last_error,omitempty"`
+	LastChange     int64               `protobuf:"varint,15,opt,name=last_change,json=lastChange" json:"last_change,omitempty"`
+	Routes         []*HealthCheckRoute `protobuf:"bytes,16,rep,name=routes" json:"routes,omitempty"`
+}
+
+func (m *HealthCheck) Reset()                    { *m = HealthCheck{} }
+func (m *HealthCheck) String() string            { return proto.CompactTextString(m) }
+func (*HealthCheck) ProtoMessage()               {}
+func (*HealthCheck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{181} }
+
+func (m *HealthCheck) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *HealthCheck) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *HealthCheck) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *HealthCheck) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *HealthCheck) GetExpectedStatus() uint32 {
+	if m != nil {
+		return m.ExpectedStatus
+	}
+	return 0
+}
+
+func (m *HealthCheck) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *HealthCheck) GetInterval() uint32 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *HealthCheck) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *HealthCheck) GetRise() uint32 {
+	if m != nil {
+		return m.Rise
+	}
+	return 0
+}
+
+func (m *HealthCheck) GetFall() uint32 {
+	if m != nil {
+		return m.Fall
+	}
+	return 0
+}
+
+func (m *HealthCheck) GetUp() bool {
+	if m != nil {
+		return m.Up
+	}
+	return false
+}
+
+func (m *HealthCheck) GetSuccesses() uint32 {
+	if m != nil {
+		return m.Successes
+	}
+	return 0
+}
+
+func (m *HealthCheck) GetFailures() uint32 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *HealthCheck) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *HealthCheck) GetLastChange() int64 {
+	if m != nil {
+		return m.LastChange
+	}
+	return 0
+}
+
+func (m *HealthCheck) GetRoutes() []*HealthCheckRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+type GetHealthCheckRequest struct {
+}
+
+func (m *GetHealthCheckRequest) Reset()                    { *m = GetHealthCheckRequest{} }
+func (m *GetHealthCheckRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetHealthCheckRequest) ProtoMessage()               {}
+func (*GetHealthCheckRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{182} }
+
+type GetHealthCheckResponse struct {
+	HealthChecks []*HealthCheck `protobuf:"bytes,1,rep,name=health_checks,json=healthChecks" json:"health_checks,omitempty"`
+}
+
+func (m *GetHealthCheckResponse) Reset()                    { *m = GetHealthCheckResponse{} }
+func (m *GetHealthCheckResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetHealthCheckResponse) ProtoMessage()               {}
+func (*GetHealthCheckResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{183} }
+
+func (m *GetHealthCheckResponse) GetHealthChecks() []*HealthCheck {
+	if m != nil {
+		return m.HealthChecks
+	}
+	return nil
+}
+
+type GetPeerGroupRequest struct {
+}
+
+func (m *GetPeerGroupRequest) Reset()                    { *m = GetPeerGroupRequest{} }
+func (m *GetPeerGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetPeerGroupRequest) ProtoMessage()               {}
+func (*GetPeerGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{184} }
+
+type GetPeerGroupResponse struct {
+	PeerGroups []*PeerGroup `protobuf:"bytes,1,rep,name=peer_groups,json=peerGroups" json:"peer_groups,omitempty"`
+}
+
+func (m *GetPeerGroupResponse) Reset()                    { *m = GetPeerGroupResponse{} }
+func (m *GetPeerGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetPeerGroupResponse) ProtoMessage()               {}
+func (*GetPeerGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{185} }
+
+func (m *GetPeerGroupResponse) GetPeerGroups() []*PeerGroup {
+	if m != nil {
+		return m.PeerGroups
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetNeighborRequest)(nil), "gobgpapi.GetNeighborRequest")
+	proto.RegisterType((*GetNeighborResponse)(nil), "gobgpapi.GetNeighborResponse")
+	proto.RegisterType((*Arguments)(nil), "gobgpapi.Arguments")
+	proto.RegisterType((*AddPathRequest)(nil), "gobgpapi.AddPathRequest")
+	proto.RegisterType((*AddPathResponse)(nil), "gobgpapi.AddPathResponse")
+	proto.RegisterType((*DeletePathRequest)(nil), "gobgpapi.DeletePathRequest")
+	proto.RegisterType((*DeletePathResponse)(nil), "gobgpapi.DeletePathResponse")
+	proto.RegisterType((*AddNeighborRequest)(nil), "gobgpapi.AddNeighborRequest")
+	proto.RegisterType((*AddNeighborResponse)(nil), "gobgpapi.AddNeighborResponse")
+	proto.RegisterType((*DeleteNeighborRequest)(nil), "gobgpapi.DeleteNeighborRequest")
+	proto.RegisterType((*DeleteNeighborResponse)(nil), "gobgpapi.DeleteNeighborResponse")
+	proto.RegisterType((*ResetNeighborRequest)(nil), "gobgpapi.ResetNeighborRequest")
+	proto.RegisterType((*ResetNeighborResponse)(nil), "gobgpapi.ResetNeighborResponse")
+	proto.RegisterType((*SoftResetNeighborRequest)(nil), "gobgpapi.SoftResetNeighborRequest")
+	proto.RegisterType((*SoftResetNeighborResponse)(nil), "gobgpapi.SoftResetNeighborResponse")
+	proto.RegisterType((*ShutdownNeighborRequest)(nil), "gobgpapi.ShutdownNeighborRequest")
+	proto.RegisterType((*ShutdownNeighborResponse)(nil), "gobgpapi.ShutdownNeighborResponse")
+	proto.RegisterType((*EnableNeighborRequest)(nil), "gobgpapi.EnableNeighborRequest")
+	proto.RegisterType((*EnableNeighborResponse)(nil), "gobgpapi.EnableNeighborResponse")
+	proto.RegisterType((*DisableNeighborRequest)(nil), "gobgpapi.DisableNeighborRequest")
+	proto.RegisterType((*DisableNeighborResponse)(nil), "gobgpapi.DisableNeighborResponse")
+	proto.RegisterType((*EnableMrtRequest)(nil), "gobgpapi.EnableMrtRequest")
+	proto.RegisterType((*EnableMrtResponse)(nil), "gobgpapi.EnableMrtResponse")
+	proto.RegisterType((*DisableMrtRequest)(nil), "gobgpapi.DisableMrtRequest")
+	proto.RegisterType((*DisableMrtResponse)(nil), "gobgpapi.DisableMrtResponse")
+	proto.RegisterType((*InjectMrtRequest)(nil), "gobgpapi.InjectMrtRequest")
+	proto.RegisterType((*InjectMrtResponse)(nil), "gobgpapi.InjectMrtResponse")
+	proto.RegisterType((*AddBmpRequest)(nil), "gobgpapi.AddBmpRequest")
+	proto.RegisterType((*AddBmpResponse)(nil), "gobgpapi.AddBmpResponse")
+	proto.RegisterType((*DeleteBmpRequest)(nil), "gobgpapi.DeleteBmpRequest")
+	proto.RegisterType((*DeleteBmpResponse)(nil), "gobgpapi.DeleteBmpResponse")
+	proto.RegisterType((*MonitorRibRequest)(nil), "gobgpapi.MonitorRibRequest")
+	proto.RegisterType((*RPKIConf)(nil), "gobgpapi.RPKIConf")
 	proto.RegisterType((*RPKIState)(nil), "gobgpapi.RPKIState")
 	proto.RegisterType((*Rpki)(nil), "gobgpapi.Rpki")
 	proto.RegisterType((*GetRpkiRequest)(nil), "gobgpapi.GetRpkiRequest")
@@ -4135,6 +5977,53 @@ func init() {
 	proto.RegisterType((*TableInfo)(nil), "gobgpapi.TableInfo")
 	proto.RegisterType((*GetRibInfoRequest)(nil), "gobgpapi.GetRibInfoRequest")
 	proto.RegisterType((*GetRibInfoResponse)(nil), "gobgpapi.GetRibInfoResponse")
+	proto.RegisterType((*PeerGroup)(nil), "gobgpapi.PeerGroup")
+	proto.RegisterType((*PeerGroupConf)(nil), "gobgpapi.PeerGroupConf")
+	proto.RegisterType((*AddPeerGroupRequest)(nil), "gobgpapi.AddPeerGroupRequest")
+	proto.RegisterType((*AddPeerGroupResponse)(nil), "gobgpapi.AddPeerGroupResponse")
+	proto.RegisterType((*DeletePeerGroupRequest)(nil), "gobgpapi.DeletePeerGroupRequest")
+	proto.RegisterType((*DeletePeerGroupResponse)(nil), "gobgpapi.DeletePeerGroupResponse")
+	proto.RegisterType((*UpdatePeerGroupRequest)(nil), "gobgpapi.UpdatePeerGroupRequest")
+	proto.RegisterType((*UpdatePeerGroupResponse)(nil), "gobgpapi.UpdatePeerGroupResponse")
+	proto.RegisterType((*DynamicNeighbor)(nil), "gobgpapi.DynamicNeighbor")
+	proto.RegisterType((*AddDynamicNeighborRequest)(nil), "gobgpapi.AddDynamicNeighborRequest")
+	proto.RegisterType((*AddDynamicNeighborResponse)(nil), "gobgpapi.AddDynamicNeighborResponse")
+	proto.RegisterType((*DeleteDynamicNeighborRequest)(nil), "gobgpapi.DeleteDynamicNeighborRequest")
+	proto.RegisterType((*DeleteDynamicNeighborResponse)(nil), "gobgpapi.DeleteDynamicNeighborResponse")
+	proto.RegisterType((*DampenedPath)(nil), "gobgpapi.DampenedPath")
+	proto.RegisterType((*GetDampenedPathRequest)(nil), "gobgpapi.GetDampenedPathRequest")
+	proto.RegisterType((*GetDampenedPathResponse)(nil), "gobgpapi.GetDampenedPathResponse")
+	proto.RegisterType((*AsPathOptions)(nil), "gobgpapi.AsPathOptions")
+	proto.RegisterType((*ErrorHandling)(nil), "gobgpapi.ErrorHandling")
+	proto.RegisterType((*AigpAction)(nil), "gobgpapi.AigpAction")
+	proto.RegisterType((*BfdConfig)(nil), "gobgpapi.BfdConfig")
+	proto.RegisterType((*BfdState)(nil), "gobgpapi.BfdState")
+	proto.RegisterType((*Bfd)(nil), "gobgpapi.Bfd")
+	proto.RegisterType((*ConfigDiff)(nil), "gobgpapi.ConfigDiff")
+	proto.RegisterType((*GetCandidateDiffRequest)(nil), "gobgpapi.GetCandidateDiffRequest")
+	proto.RegisterType((*GetCandidateDiffResponse)(nil), "gobgpapi.GetCandidateDiffResponse")
+	proto.RegisterType((*CommitCandidateRequest)(nil), "gobgpapi.CommitCandidateRequest")
+	proto.RegisterType((*CommitCandidateResponse)(nil), "gobgpapi.CommitCandidateResponse")
+	proto.RegisterType((*DiscardCandidateRequest)(nil), "gobgpapi.DiscardCandidateRequest")
+	proto.RegisterType((*DiscardCandidateResponse)(nil), "gobgpapi.DiscardCandidateResponse")
+	proto.RegisterType((*ConfigCommit)(nil), "gobgpapi.ConfigCommit")
+	proto.RegisterType((*GetCommitHistoryRequest)(nil), "gobgpapi.GetCommitHistoryRequest")
+	proto.RegisterType((*GetCommitHistoryResponse)(nil), "gobgpapi.GetCommitHistoryResponse")
+	proto.RegisterType((*RollbackConfigRequest)(nil), "gobgpapi.RollbackConfigRequest")
+	proto.RegisterType((*RollbackConfigResponse)(nil), "gobgpapi.RollbackConfigResponse")
+	proto.RegisterType((*GetConfigRequest)(nil), "gobgpapi.GetConfigRequest")
+	proto.RegisterType((*GetConfigResponse)(nil), "gobgpapi.GetConfigResponse")
+	proto.RegisterType((*MonitorEventsRequest)(nil), "gobgpapi.MonitorEventsRequest")
+	proto.RegisterType((*Event)(nil), "gobgpapi.Event")
+	proto.RegisterType((*CommunityCount)(nil), "gobgpapi.CommunityCount")
+	proto.RegisterType((*DefaultOriginate)(nil), "gobgpapi.DefaultOriginate")
+	proto.RegisterType((*ConditionalAdvertisement)(nil), "gobgpapi.ConditionalAdvertisement")
+	proto.RegisterType((*HealthCheckRoute)(nil), "gobgpapi.HealthCheckRoute")
+	proto.RegisterType((*HealthCheck)(nil), "gobgpapi.HealthCheck")
+	proto.RegisterType((*GetHealthCheckRequest)(nil), "gobgpapi.GetHealthCheckRequest")
+	proto.RegisterType((*GetHealthCheckResponse)(nil), "gobgpapi.GetHealthCheckResponse")
+	proto.RegisterType((*GetPeerGroupRequest)(nil), "gobgpapi.GetPeerGroupRequest")
+	proto.RegisterType((*GetPeerGroupResponse)(nil), "gobgpapi.GetPeerGroupResponse")
 	proto.RegisterEnum("gobgpapi.Resource", Resource_name, Resource_value)
 	proto.RegisterEnum("gobgpapi.DefinedType", DefinedType_name, DefinedType_value)
 	proto.RegisterEnum("gobgpapi.MatchType", MatchType_name, MatchType_value)
@@ -4143,6 +6032,7 @@ func init() {
 	proto.RegisterEnum("gobgpapi.CommunityActionType", CommunityActionType_name, CommunityActionType_value)
 	proto.RegisterEnum("gobgpapi.MedActionType", MedActionType_name, MedActionType_value)
 	proto.RegisterEnum("gobgpapi.PolicyType", PolicyType_name, PolicyType_value)
+	proto.RegisterEnum("gobgpapi.RouteOrigin", RouteOrigin_name, RouteOrigin_value)
 	proto.RegisterEnum("gobgpapi.SoftResetNeighborRequest_SoftResetDirection", SoftResetNeighborRequest_SoftResetDirection_name, SoftResetNeighborRequest_SoftResetDirection_value)
 	proto.RegisterEnum("gobgpapi.AddBmpRequest_MonitoringPolicy", AddBmpRequest_MonitoringPolicy_name, AddBmpRequest_MonitoringPolicy_value)
 	proto.RegisterEnum("gobgpapi.PeerState_AdminState", PeerState_AdminState_name, PeerState_AdminState_value)
@@ -4211,6 +6101,21 @@ type GobgpApiClient interface {
 	DeletePolicyAssignment(ctx context.Context, in *DeletePolicyAssignmentRequest, opts ...grpc.CallOption) (*DeletePolicyAssignmentResponse, error)
 	ReplacePolicyAssignment(ctx context.Context, in *ReplacePolicyAssignmentRequest, opts ...grpc.CallOption) (*ReplacePolicyAssignmentResponse, error)
 	GetRibInfo(ctx context.Context, in *GetRibInfoRequest, opts ...grpc.CallOption) (*GetRibInfoResponse, error)
+	AddPeerGroup(ctx context.Context, in *AddPeerGroupRequest, opts ...grpc.CallOption) (*AddPeerGroupResponse, error)
+	DeletePeerGroup(ctx context.Context, in *DeletePeerGroupRequest, opts ...grpc.CallOption) (*DeletePeerGroupResponse, error)
+	UpdatePeerGroup(ctx context.Context, in *UpdatePeerGroupRequest, opts ...grpc.CallOption) (*UpdatePeerGroupResponse, error)
+	AddDynamicNeighbor(ctx context.Context, in *AddDynamicNeighborRequest, opts ...grpc.CallOption) (*AddDynamicNeighborResponse, error)
+	DeleteDynamicNeighbor(ctx context.Context, in *DeleteDynamicNeighborRequest, opts ...grpc.CallOption) (*DeleteDynamicNeighborResponse, error)
+	GetDampenedPath(ctx context.Context, in *GetDampenedPathRequest, opts ...grpc.CallOption) (*GetDampenedPathResponse, error)
+	GetCandidateDiff(ctx context.Context, in *GetCandidateDiffRequest, opts ...grpc.CallOption) (*GetCandidateDiffResponse, error)
+	CommitCandidate(ctx context.Context, in *CommitCandidateRequest, opts ...grpc.CallOption) (*CommitCandidateResponse, error)
+	DiscardCandidate(ctx context.Context, in *DiscardCandidateRequest, opts ...grpc.CallOption) (*DiscardCandidateResponse, error)
+	GetCommitHistory(ctx context.Context, in *GetCommitHistoryRequest, opts ...grpc.CallOption) (*GetCommitHistoryResponse, error)
+	RollbackConfig(ctx context.Context, in *RollbackConfigRequest, opts ...grpc.CallOption) (*RollbackConfigResponse, error)
+	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
+	MonitorEvents(ctx context.Context, in *MonitorEventsRequest, opts ...grpc.CallOption) (GobgpApi_MonitorEventsClient, error)
+	GetHealthCheck(ctx context.Context, in *GetHealthCheckRequest, opts ...grpc.CallOption) (*GetHealthCheckResponse, error)
+	GetPeerGroup(ctx context.Context, in *GetPeerGroupRequest, opts ...grpc.CallOption) (*GetPeerGroupResponse, error)
 }
 
 type gobgpApiClient struct {
@@ -4724,27 +6629,185 @@ func (c *gobgpApiClient) AddPolicyAssignment(ctx context.Context, in *AddPolicyA
 	return out, nil
 }
 
-func (c *gobgpApiClient) DeletePolicyAssignment(ctx context.Context, in *DeletePolicyAssignmentRequest, opts ...grpc.CallOption) (*DeletePolicyAssignmentResponse, error) {
-	out := new(DeletePolicyAssignmentResponse)
-	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/DeletePolicyAssignment", in, out, c.cc, opts...)
+func (c *gobgpApiClient) DeletePolicyAssignment(ctx context.Context, in *DeletePolicyAssignmentRequest, opts ...grpc.CallOption) (*DeletePolicyAssignmentResponse, error) {
+	out := new(DeletePolicyAssignmentResponse)
+	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/DeletePolicyAssignment", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) ReplacePolicyAssignment(ctx context.Context, in *ReplacePolicyAssignmentRequest, opts ...grpc.CallOption) (*ReplacePolicyAssignmentResponse, error) {
+	out := new(ReplacePolicyAssignmentResponse)
+	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/ReplacePolicyAssignment", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) GetRibInfo(ctx context.Context, in *GetRibInfoRequest, opts ...grpc.CallOption) (*GetRibInfoResponse, error) {
+	out := new(GetRibInfoResponse)
+	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/GetRibInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) AddPeerGroup(ctx context.Context, in *AddPeerGroupRequest, opts ...grpc.CallOption) (*AddPeerGroupResponse, error) {
+	out := new(AddPeerGroupResponse)
+	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/AddPeerGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) DeletePeerGroup(ctx context.Context, in *DeletePeerGroupRequest, opts ...grpc.CallOption) (*DeletePeerGroupResponse, error) {
+	out := new(DeletePeerGroupResponse)
+	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/DeletePeerGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) UpdatePeerGroup(ctx context.Context, in *UpdatePeerGroupRequest, opts ...grpc.CallOption) (*UpdatePeerGroupResponse, error) {
+	out := new(UpdatePeerGroupResponse)
+	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/UpdatePeerGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) AddDynamicNeighbor(ctx context.Context, in *AddDynamicNeighborRequest, opts ...grpc.CallOption) (*AddDynamicNeighborResponse, error) {
+	out := new(AddDynamicNeighborResponse)
+	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/AddDynamicNeighbor", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) DeleteDynamicNeighbor(ctx context.Context, in *DeleteDynamicNeighborRequest, opts ...grpc.CallOption) (*DeleteDynamicNeighborResponse, error) {
+	out := new(DeleteDynamicNeighborResponse)
+	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/DeleteDynamicNeighbor", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) GetDampenedPath(ctx context.Context, in *GetDampenedPathRequest, opts ...grpc.CallOption) (*GetDampenedPathResponse, error) {
+	out := new(GetDampenedPathResponse)
+	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/GetDampenedPath", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) GetCandidateDiff(ctx context.Context, in *GetCandidateDiffRequest, opts ...grpc.CallOption) (*GetCandidateDiffResponse, error) {
+	out := new(GetCandidateDiffResponse)
+	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/GetCandidateDiff", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) CommitCandidate(ctx context.Context, in *CommitCandidateRequest, opts ...grpc.CallOption) (*CommitCandidateResponse, error) {
+	out := new(CommitCandidateResponse)
+	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/CommitCandidate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) DiscardCandidate(ctx context.Context, in *DiscardCandidateRequest, opts ...grpc.CallOption) (*DiscardCandidateResponse, error) {
+	out := new(DiscardCandidateResponse)
+	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/DiscardCandidate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) GetCommitHistory(ctx context.Context, in *GetCommitHistoryRequest, opts ...grpc.CallOption) (*GetCommitHistoryResponse, error) {
+	out := new(GetCommitHistoryResponse)
+	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/GetCommitHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) RollbackConfig(ctx context.Context, in *RollbackConfigRequest, opts ...grpc.CallOption) (*RollbackConfigResponse, error) {
+	out := new(RollbackConfigResponse)
+	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/RollbackConfig", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error) {
+	out := new(GetConfigResponse)
+	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/GetConfig", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) MonitorEvents(ctx context.Context, in *MonitorEventsRequest, opts ...grpc.CallOption) (GobgpApi_MonitorEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_GobgpApi_serviceDesc.Streams[3], c.cc, "/gobgpapi.GobgpApi/MonitorEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &gobgpApiMonitorEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *gobgpApiClient) ReplacePolicyAssignment(ctx context.Context, in *ReplacePolicyAssignmentRequest, opts ...grpc.CallOption) (*ReplacePolicyAssignmentResponse, error) {
-	out := new(ReplacePolicyAssignmentResponse)
-	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/ReplacePolicyAssignment", in, out, c.cc, opts...)
+type GobgpApi_MonitorEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type gobgpApiMonitorEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *gobgpApiMonitorEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gobgpApiClient) GetHealthCheck(ctx context.Context, in *GetHealthCheckRequest, opts ...grpc.CallOption) (*GetHealthCheckResponse, error) {
+	out := new(GetHealthCheckResponse)
+	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/GetHealthCheck", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gobgpApiClient) GetRibInfo(ctx context.Context, in *GetRibInfoRequest, opts ...grpc.CallOption) (*GetRibInfoResponse, error) {
-	out := new(GetRibInfoResponse)
-	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/GetRibInfo", in, out, c.cc, opts...)
+func (c *gobgpApiClient) GetPeerGroup(ctx context.Context, in *GetPeerGroupRequest, opts ...grpc.CallOption) (*GetPeerGroupResponse, error) {
+	out := new(GetPeerGroupResponse)
+	err := grpc.Invoke(ctx, "/gobgpapi.GobgpApi/GetPeerGroup", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4805,6 +6868,21 @@ type GobgpApiServer interface {
 	DeletePolicyAssignment(context.Context, *DeletePolicyAssignmentRequest) (*DeletePolicyAssignmentResponse, error)
 	ReplacePolicyAssignment(context.Context, *ReplacePolicyAssignmentRequest) (*ReplacePolicyAssignmentResponse, error)
 	GetRibInfo(context.Context, *GetRibInfoRequest) (*GetRibInfoResponse, error)
+	AddPeerGroup(context.Context, *AddPeerGroupRequest) (*AddPeerGroupResponse, error)
+	DeletePeerGroup(context.Context, *DeletePeerGroupRequest) (*DeletePeerGroupResponse, error)
+	UpdatePeerGroup(context.Context, *UpdatePeerGroupRequest) (*UpdatePeerGroupResponse, error)
+	AddDynamicNeighbor(context.Context, *AddDynamicNeighborRequest) (*AddDynamicNeighborResponse, error)
+	DeleteDynamicNeighbor(context.Context, *DeleteDynamicNeighborRequest) (*DeleteDynamicNeighborResponse, error)
+	GetDampenedPath(context.Context, *GetDampenedPathRequest) (*GetDampenedPathResponse, error)
+	GetCandidateDiff(context.Context, *GetCandidateDiffRequest) (*GetCandidateDiffResponse, error)
+	CommitCandidate(context.Context, *CommitCandidateRequest) (*CommitCandidateResponse, error)
+	DiscardCandidate(context.Context, *DiscardCandidateRequest) (*DiscardCandidateResponse, error)
+	GetCommitHistory(context.Context, *GetCommitHistoryRequest) (*GetCommitHistoryResponse, error)
+	RollbackConfig(context.Context, *RollbackConfigRequest) (*RollbackConfigResponse, error)
+	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
+	MonitorEvents(*MonitorEventsRequest, GobgpApi_MonitorEventsServer) error
+	GetHealthCheck(context.Context, *GetHealthCheckRequest) (*GetHealthCheckResponse, error)
+	GetPeerGroup(context.Context, *GetPeerGroupRequest) (*GetPeerGroupResponse, error)
 }
 
 func RegisterGobgpApiServer(s *grpc.Server, srv GobgpApiServer) {
@@ -5743,6 +7821,279 @@ func _GobgpApi_GetRibInfo_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GobgpApi_AddPeerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPeerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).AddPeerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gobgpapi.GobgpApi/AddPeerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).AddPeerGroup(ctx, req.(*AddPeerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_DeletePeerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePeerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).DeletePeerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gobgpapi.GobgpApi/DeletePeerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).DeletePeerGroup(ctx, req.(*DeletePeerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_UpdatePeerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePeerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).UpdatePeerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gobgpapi.GobgpApi/UpdatePeerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).UpdatePeerGroup(ctx, req.(*UpdatePeerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_AddDynamicNeighbor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDynamicNeighborRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).AddDynamicNeighbor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gobgpapi.GobgpApi/AddDynamicNeighbor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).AddDynamicNeighbor(ctx, req.(*AddDynamicNeighborRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_DeleteDynamicNeighbor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDynamicNeighborRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).DeleteDynamicNeighbor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gobgpapi.GobgpApi/DeleteDynamicNeighbor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).DeleteDynamicNeighbor(ctx, req.(*DeleteDynamicNeighborRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_GetDampenedPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDampenedPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).GetDampenedPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gobgpapi.GobgpApi/GetDampenedPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).GetDampenedPath(ctx, req.(*GetDampenedPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_GetCandidateDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandidateDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).GetCandidateDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gobgpapi.GobgpApi/GetCandidateDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).GetCandidateDiff(ctx, req.(*GetCandidateDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_CommitCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).CommitCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gobgpapi.GobgpApi/CommitCandidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).CommitCandidate(ctx, req.(*CommitCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_DiscardCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).DiscardCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gobgpapi.GobgpApi/DiscardCandidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).DiscardCandidate(ctx, req.(*DiscardCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_GetCommitHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommitHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).GetCommitHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gobgpapi.GobgpApi/GetCommitHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).GetCommitHistory(ctx, req.(*GetCommitHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_RollbackConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).RollbackConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gobgpapi.GobgpApi/RollbackConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).RollbackConfig(ctx, req.(*RollbackConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gobgpapi.GobgpApi/GetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_MonitorEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MonitorEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GobgpApiServer).MonitorEvents(m, &gobgpApiMonitorEventsServer{stream})
+}
+
+type GobgpApi_MonitorEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type gobgpApiMonitorEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *gobgpApiMonitorEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GobgpApi_GetHealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).GetHealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gobgpapi.GobgpApi/GetHealthCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).GetHealthCheck(ctx, req.(*GetHealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_GetPeerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).GetPeerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gobgpapi.GobgpApi/GetPeerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).GetPeerGroup(ctx, req.(*GetPeerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GobgpApi_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gobgpapi.GobgpApi",
 	HandlerType: (*GobgpApiServer)(nil),
@@ -5939,6 +8290,62 @@ var _GobgpApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetRibInfo",
 			Handler:    _GobgpApi_GetRibInfo_Handler,
 		},
+		{
+			MethodName: "AddPeerGroup",
+			Handler:    _GobgpApi_AddPeerGroup_Handler,
+		},
+		{
+			MethodName: "DeletePeerGroup",
+			Handler:    _GobgpApi_DeletePeerGroup_Handler,
+		},
+		{
+			MethodName: "UpdatePeerGroup",
+			Handler:    _GobgpApi_UpdatePeerGroup_Handler,
+		},
+		{
+			MethodName: "AddDynamicNeighbor",
+			Handler:    _GobgpApi_AddDynamicNeighbor_Handler,
+		},
+		{
+			MethodName: "DeleteDynamicNeighbor",
+			Handler:    _GobgpApi_DeleteDynamicNeighbor_Handler,
+		},
+		{
+			MethodName: "GetDampenedPath",
+			Handler:    _GobgpApi_GetDampenedPath_Handler,
+		},
+		{
+			MethodName: "GetCandidateDiff",
+			Handler:    _GobgpApi_GetCandidateDiff_Handler,
+		},
+		{
+			MethodName: "CommitCandidate",
+			Handler:    _GobgpApi_CommitCandidate_Handler,
+		},
+		{
+			MethodName: "DiscardCandidate",
+			Handler:    _GobgpApi_DiscardCandidate_Handler,
+		},
+		{
+			MethodName: "GetCommitHistory",
+			Handler:    _GobgpApi_GetCommitHistory_Handler,
+		},
+		{
+			MethodName: "RollbackConfig",
+			Handler:    _GobgpApi_RollbackConfig_Handler,
+		},
+		{
+			MethodName: "GetConfig",
+			Handler:    _GobgpApi_GetConfig_Handler,
+		},
+		{
+			MethodName: "GetHealthCheck",
+			Handler:    _GobgpApi_GetHealthCheck_Handler,
+		},
+		{
+			MethodName: "GetPeerGroup",
+			Handler:    _GobgpApi_GetPeerGroup_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -5956,6 +8363,11 @@ var _GobgpApi_serviceDesc = grpc.ServiceDesc{
 			Handler:       _GobgpApi_InjectMrt_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "MonitorEvents",
+			Handler:       _GobgpApi_MonitorEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "gobgp.proto",
 }
@@ -5963,362 +8375,512 @@ var _GobgpApi_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("gobgp.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 5707 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xd4, 0x7c, 0xdd, 0x6f, 0x1b, 0x49,
-	0x72, 0xb8, 0xf8, 0x21, 0x8a, 0x2c, 0x92, 0x12, 0xd5, 0xfa, 0xa2, 0xe9, 0xef, 0xb9, 0xf3, 0x59,
-	0xf6, 0xed, 0x7a, 0x77, 0xbd, 0x7b, 0xde, 0xfb, 0xad, 0x77, 0xf7, 0x96, 0x96, 0x68, 0x99, 0xb7,
-	0xfa, 0xe0, 0xb6, 0x68, 0x9f, 0x7d, 0xbf, 0x24, 0x93, 0x11, 0xa7, 0x29, 0x4d, 0x76, 0x38, 0x33,
-	0x3b, 0xd3, 0xd4, 0x4a, 0x08, 0x90, 0x00, 0x97, 0xc7, 0x20, 0xff, 0x41, 0x80, 0xbc, 0x1f, 0x92,
-	0x87, 0x3c, 0x05, 0xc8, 0x73, 0x2e, 0x08, 0x70, 0x40, 0xfe, 0x81, 0x00, 0xc9, 0x43, 0xfe, 0x80,
-	0xbc, 0xe6, 0x31, 0xe8, 0x8f, 0x99, 0xe9, 0xf9, 0xa0, 0x2c, 0x3b, 0xbe, 0x04, 0x79, 0x12, 0xa7,
-	0xaa, 0xba, 0xba, 0xba, 0xbb, 0xaa, 0xba, 0xba, 0xba, 0x4b, 0x50, 0x3f, 0x76, 0x8f, 0x8e, 0xbd,
-	0x07, 0x9e, 0xef, 0x52, 0x17, 0x55, 0xf9, 0x87, 0xe1, 0x59, 0xda, 0x57, 0x80, 0x76, 0x08, 0xdd,
-	0x27, 0xd6, 0xf1, 0xc9, 0x91, 0xeb, 0x63, 0xf2, 0xdd, 0x94, 0x04, 0x14, 0xdd, 0x87, 0x16, 0x71,
-	0x8c, 0x23, 0x9b, 0x74, 0xcd, 0x53, 0xe2, 0x53, 0x2b, 0x20, 0x66, 0xbb, 0x70, 0xab, 0xb0, 0x59,
-	0xc5, 0x19, 0xb8, 0xf6, 0x18, 0x56, 0x12, 0x1c, 0x02, 0xcf, 0x75, 0x02, 0x82, 0x7e, 0x08, 0xf3,
-	0x1e, 0x21, 0x7e, 0xd0, 0x2e, 0xdc, 0x2a, 0x6d, 0xd6, 0x1f, 0x2e, 0x3e, 0x08, 0xbb, 0x7c, 0x30,
-	0x20, 0xc4, 0xc7, 0x02, 0xa9, 0x1d, 0x43, 0xad, 0xeb, 0x1f, 0x4f, 0x27, 0xc4, 0xa1, 0x01, 0x7a,
-	0x00, 0x55, 0x9f, 0x04, 0xee, 0xd4, 0x1f, 0x11, 0xde, 0xdb, 0xe2, 0x43, 0x14, 0xb7, 0xc2, 0x12,
-	0x83, 0x23, 0x1a, 0xb4, 0x0e, 0x95, 0xb1, 0x31, 0xb1, 0xec, 0xf3, 0x76, 0xf1, 0x56, 0x61, 0xb3,
-	0x89, 0xe5, 0x17, 0x42, 0x50, 0x76, 0x8c, 0x09, 0x69, 0x97, 0x6e, 0x15, 0x36, 0x6b, 0x98, 0xff,
-	0xd6, 0xfe, 0x18, 0x16, 0xbb, 0xa6, 0x39, 0x30, 0xe8, 0x49, 0x38, 0xc6, 0x37, 0xed, 0x6d, 0x0d,
-	0x2a, 0xa7, 0xfe, 0x58, 0xb7, 0x4c, 0xde, 0x5b, 0x0d, 0xcf, 0x9f, 0xfa, 0xe3, 0xbe, 0x89, 0x34,
-	0x28, 0x7b, 0x06, 0x3d, 0xe1, 0x9d, 0x25, 0x87, 0xc9, 0xfa, 0xe2, 0x38, 0xed, 0x0e, 0x2c, 0x45,
-	0x9d, 0xcb, 0xe9, 0x41, 0x50, 0x9e, 0x4e, 0x2d, 0x31, 0xab, 0x0d, 0xcc, 0x7f, 0x6b, 0xbf, 0x2e,
-	0xc0, 0xf2, 0x36, 0xb1, 0x09, 0x25, 0xbf, 0x03, 0x39, 0xe3, 0xc9, 0x2a, 0x25, 0x26, 0x2b, 0x94,
-	0xbf, 0x3c, 0x5b, 0xfe, 0x48, 0xd8, 0x79, 0x45, 0xd8, 0x55, 0x40, 0xaa, 0xac, 0x62, 0x58, 0xda,
-	0x4f, 0x01, 0x75, 0x4d, 0x33, 0xad, 0x4e, 0xac, 0x0f, 0x42, 0x7c, 0x2e, 0x7e, 0x56, 0x15, 0x38,
-	0x4e, 0x5b, 0x83, 0x95, 0x44, 0x4b, 0xc9, 0xf0, 0x31, 0xac, 0x89, 0x6e, 0xde, 0x86, 0x67, 0x1b,
-	0xd6, 0xd3, 0x8d, 0x25, 0xdb, 0x17, 0xb0, 0x8a, 0x49, 0x90, 0x55, 0xfc, 0x36, 0x2c, 0x18, 0xa6,
-	0xe9, 0x93, 0x20, 0xe0, 0x8c, 0x6b, 0x38, 0xfc, 0x44, 0x3f, 0x84, 0xe6, 0xc8, 0x9d, 0x4c, 0xa6,
-	0x8e, 0x35, 0x32, 0xa8, 0xe5, 0x3a, 0x72, 0x76, 0x93, 0x40, 0x6d, 0x03, 0xd6, 0x52, 0x7c, 0x65,
-	0x87, 0x7f, 0x5f, 0x80, 0xf6, 0xa1, 0x3b, 0xa6, 0x6f, 0xd8, 0xeb, 0x21, 0xd4, 0x4c, 0xcb, 0x27,
-	0xa3, 0xa8, 0xc7, 0xc5, 0x87, 0x3f, 0x89, 0x87, 0x3a, 0x8b, 0x61, 0x8c, 0xd8, 0x0e, 0x1b, 0xe3,
-	0x98, 0x8f, 0xf6, 0x01, 0xa0, 0x2c, 0x01, 0xaa, 0x40, 0xb1, 0xbf, 0xdf, 0x9a, 0x43, 0x0b, 0x50,
-	0x3a, 0x78, 0x3e, 0x6c, 0x15, 0x50, 0x15, 0xca, 0x4f, 0x0e, 0x86, 0xcf, 0x5a, 0x45, 0xed, 0x2a,
-	0x5c, 0xc9, 0xe9, 0x4a, 0x8e, 0xec, 0x15, 0x6c, 0x1c, 0x9e, 0x4c, 0xa9, 0xe9, 0x7e, 0xef, 0xbc,
-	0xeb, 0xd9, 0xec, 0x40, 0x3b, 0xcb, 0x5a, 0x76, 0xfb, 0x11, 0xac, 0xf5, 0xb8, 0x2b, 0xba, 0x74,
-	0xa7, 0x4c, 0x1d, 0xd2, 0x4d, 0x24, 0xb3, 0x97, 0xb0, 0xbe, 0x6d, 0x05, 0x6f, 0xc4, 0xed, 0x92,
-	0x43, 0xb8, 0x02, 0x1b, 0x19, 0xce, 0xb2, 0xd3, 0x63, 0x68, 0x09, 0x71, 0xf6, 0x7c, 0x1a, 0x76,
-	0x77, 0x15, 0x6a, 0xe6, 0x74, 0xe2, 0xe9, 0xf4, 0xdc, 0x13, 0xd6, 0x3e, 0x8f, 0xab, 0x0c, 0x30,
-	0x3c, 0xf7, 0x08, 0xea, 0x40, 0x75, 0x6c, 0xd9, 0x84, 0xfb, 0x36, 0xd1, 0x59, 0xf4, 0xcd, 0x70,
-	0x96, 0x43, 0x89, 0x7f, 0x6a, 0xd8, 0xdc, 0xc0, 0xcb, 0x38, 0xfa, 0xd6, 0x56, 0x60, 0x59, 0xe9,
-	0x48, 0xf6, 0xbe, 0x02, 0xcb, 0x52, 0xb0, 0xb8, 0x7b, 0x6e, 0xd4, 0x0a, 0x50, 0x92, 0xfe, 0x29,
-	0xb4, 0xfa, 0xce, 0x1f, 0x91, 0x11, 0x55, 0x04, 0x7d, 0x47, 0x5e, 0x89, 0xed, 0x12, 0x06, 0x3d,
-	0x09, 0xda, 0xa5, 0xcc, 0x2e, 0xc1, 0xdc, 0x8a, 0x40, 0x32, 0x59, 0x15, 0x01, 0xa4, 0x54, 0x7f,
-	0x5d, 0x80, 0x66, 0xd7, 0x34, 0x9f, 0x4c, 0xbc, 0xd7, 0xaf, 0x15, 0x82, 0xb2, 0xe7, 0xfa, 0x54,
-	0xee, 0x13, 0xfc, 0x37, 0xfa, 0x1c, 0xca, 0x7c, 0x96, 0x4b, 0x5c, 0xfa, 0xcd, 0xb8, 0xe7, 0x04,
-	0xd3, 0x07, 0x7b, 0xae, 0x63, 0x51, 0xd7, 0xb7, 0x9c, 0xe3, 0x81, 0x6b, 0x5b, 0xa3, 0x73, 0xcc,
-	0x5b, 0x69, 0x1f, 0x40, 0x2b, 0x8d, 0x61, 0x96, 0x33, 0xc0, 0xbd, 0xd6, 0x1c, 0xb3, 0x9c, 0xc1,
-	0xc1, 0x61, 0xd2, 0x86, 0x5a, 0x7c, 0x03, 0xe2, 0x8c, 0xe5, 0x00, 0xbe, 0x82, 0x96, 0xf0, 0x4e,
-	0x6f, 0x3b, 0x04, 0xbe, 0x86, 0x31, 0x07, 0xc9, 0x76, 0x08, 0xcb, 0x52, 0x32, 0x6c, 0x1d, 0x85,
-	0x7c, 0xef, 0xc0, 0x3c, 0x65, 0xcb, 0x2a, 0xdd, 0xe5, 0x52, 0x3c, 0xda, 0x21, 0x03, 0x63, 0x81,
-	0x65, 0xdd, 0x8f, 0xa6, 0xbe, 0x4f, 0x1c, 0xd1, 0x4f, 0x15, 0x87, 0x9f, 0x5a, 0x0f, 0xaa, 0x78,
-	0xf0, 0x75, 0x7f, 0xcb, 0x75, 0xc6, 0x17, 0x08, 0x79, 0x13, 0xea, 0x3e, 0x99, 0xb8, 0x94, 0xe8,
-	0x91, 0xac, 0x35, 0x0c, 0x02, 0x34, 0x60, 0x12, 0xff, 0x65, 0x19, 0x6a, 0x8c, 0xcf, 0x21, 0x35,
-	0x28, 0xdf, 0xc0, 0xa7, 0x1e, 0xb5, 0x26, 0x42, 0xac, 0x12, 0x96, 0x5f, 0x4c, 0x99, 0x99, 0xcd,
-	0x73, 0x4c, 0x91, 0x63, 0xa2, 0x6f, 0xb4, 0x08, 0xc5, 0xa9, 0xc7, 0x17, 0xad, 0x8a, 0x8b, 0x53,
-	0x4f, 0x74, 0x39, 0x72, 0x7d, 0x53, 0xb7, 0xbc, 0xd3, 0x4f, 0xf8, 0x36, 0xd6, 0x64, 0x5d, 0x32,
-	0x50, 0xdf, 0x3b, 0xfd, 0x24, 0x49, 0xf0, 0x88, 0xef, 0x61, 0x2a, 0xc1, 0x23, 0x46, 0xe0, 0xf9,
-	0x64, 0x6c, 0x9d, 0x09, 0x0e, 0x15, 0x41, 0x20, 0x40, 0x21, 0x87, 0x98, 0xe0, 0x51, 0x7b, 0x21,
-	0x45, 0xf0, 0x88, 0x8d, 0x23, 0x20, 0xbe, 0x65, 0xd8, 0xed, 0xaa, 0xd8, 0x5b, 0xc5, 0x17, 0xfa,
-	0x01, 0x34, 0x7d, 0x32, 0x22, 0xd6, 0x29, 0x91, 0xd2, 0xd5, 0xf8, 0x60, 0x1a, 0x21, 0x90, 0x73,
-	0x4f, 0x11, 0x3d, 0x6a, 0x43, 0x86, 0xe8, 0x11, 0x23, 0x12, 0x3c, 0x75, 0xc7, 0xa5, 0xd6, 0xf8,
-	0xbc, 0x5d, 0x17, 0x44, 0x02, 0xb8, 0xcf, 0x61, 0x4c, 0xce, 0x91, 0x31, 0x3a, 0x21, 0xba, 0xcf,
-	0x1c, 0x75, 0xbb, 0xc1, 0x49, 0x80, 0x83, 0xb8, 0xeb, 0x46, 0x77, 0x60, 0x31, 0x22, 0xe0, 0xca,
-	0xd2, 0x6e, 0x72, 0x9a, 0x66, 0x48, 0x23, 0x62, 0x93, 0x1b, 0x50, 0x27, 0x8e, 0xa9, 0xbb, 0x63,
-	0xdd, 0x34, 0xa8, 0xd1, 0x5e, 0xe4, 0x34, 0x35, 0xe2, 0x98, 0x07, 0xe3, 0x6d, 0x83, 0x1a, 0x68,
-	0x15, 0xe6, 0x89, 0xef, 0xbb, 0x7e, 0x7b, 0x89, 0x63, 0xc4, 0x07, 0xba, 0x0d, 0x52, 0x1a, 0xfd,
-	0xbb, 0x29, 0xf1, 0xcf, 0xdb, 0x2d, 0x8e, 0xac, 0x0b, 0xd8, 0x37, 0x0c, 0x24, 0x96, 0x22, 0x20,
-	0x54, 0x52, 0x2c, 0x0b, 0x01, 0x39, 0x88, 0x13, 0x68, 0xaf, 0xa0, 0x8c, 0xbd, 0x6f, 0x2d, 0xf4,
-	0x23, 0x28, 0x8f, 0x5c, 0x67, 0x2c, 0xb5, 0x55, 0xf5, 0x2c, 0x52, 0x07, 0x31, 0xc7, 0xa3, 0x7b,
-	0x30, 0x1f, 0x30, 0x4d, 0xe2, 0x5a, 0x52, 0x7f, 0xb8, 0x92, 0x24, 0xe4, 0x4a, 0x86, 0x05, 0x85,
-	0xb6, 0x09, 0x8b, 0x3b, 0x84, 0x32, 0xee, 0xa1, 0x4d, 0xc4, 0x11, 0x51, 0x41, 0x8d, 0x88, 0xb4,
-	0xc7, 0xb0, 0x14, 0x51, 0xca, 0x19, 0xd9, 0x84, 0x85, 0x80, 0xf8, 0xa7, 0xb9, 0xe1, 0x2c, 0x27,
-	0x0c, 0xd1, 0xda, 0x2f, 0xb9, 0x99, 0xab, 0xdd, 0xbc, 0x99, 0x57, 0xea, 0x40, 0xd5, 0xb6, 0xc6,
-	0x84, 0xab, 0x7e, 0x49, 0xa8, 0x7e, 0xf8, 0xad, 0x2d, 0xf3, 0x30, 0x52, 0x15, 0x4c, 0xeb, 0x86,
-	0x1e, 0xe0, 0xad, 0x7b, 0x8c, 0x03, 0xb9, 0x04, 0xe3, 0xf7, 0xc3, 0x3d, 0xe3, 0x52, 0x8c, 0x19,
-	0x13, 0x95, 0x5c, 0x32, 0x79, 0x10, 0x6d, 0x27, 0x97, 0xe3, 0xb2, 0x06, 0x2b, 0x09, 0x7a, 0xc9,
-	0xe6, 0x3d, 0x68, 0x71, 0xfd, 0xbd, 0x1c, 0x93, 0x15, 0x58, 0x56, 0xa8, 0x25, 0x8b, 0x0f, 0x61,
-	0x35, 0x8a, 0x60, 0x2e, 0xc7, 0x66, 0x03, 0xd6, 0x52, 0x2d, 0x24, 0xab, 0xdf, 0x16, 0xc2, 0xb1,
-	0xfe, 0x92, 0x1c, 0xf9, 0x46, 0xc8, 0xa9, 0x05, 0xa5, 0xa9, 0x6f, 0x4b, 0x2e, 0xec, 0x27, 0xd7,
-	0x76, 0x77, 0x4a, 0x09, 0xdf, 0xcc, 0x83, 0x76, 0xf1, 0x56, 0x89, 0x3b, 0x43, 0x06, 0x62, 0xdb,
-	0x79, 0xc0, 0x3a, 0x67, 0x3a, 0xc3, 0x62, 0x07, 0x11, 0x93, 0x87, 0x9f, 0xe8, 0x13, 0x58, 0x77,
-	0xc8, 0x19, 0x3d, 0x71, 0x3d, 0x9d, 0xfa, 0xd6, 0xf1, 0x31, 0xf1, 0x75, 0x71, 0xee, 0xe2, 0xfe,
-	0xad, 0x8a, 0x57, 0x25, 0x76, 0x28, 0x90, 0x42, 0x1c, 0xf4, 0x10, 0xd6, 0xd2, 0xad, 0x4c, 0x62,
-	0x1b, 0xe7, 0xd2, 0xe7, 0xad, 0x24, 0x1b, 0x6d, 0x33, 0x14, 0x9b, 0xf2, 0xc4, 0x60, 0xe4, 0x20,
-	0x97, 0xa0, 0xb9, 0x43, 0xe8, 0x0b, 0x7f, 0x1c, 0x46, 0x06, 0x1f, 0x73, 0xf3, 0xe1, 0x00, 0x69,
-	0x13, 0xb7, 0xa1, 0x7c, 0xea, 0x8f, 0x43, 0x83, 0x68, 0xc6, 0x06, 0xc1, 0x88, 0x38, 0x4a, 0xfb,
-	0x90, 0xef, 0xd0, 0x31, 0x17, 0x74, 0x13, 0x4a, 0xa7, 0x7e, 0x68, 0xd6, 0xa9, 0x26, 0x0c, 0x23,
-	0x77, 0x49, 0xa5, 0x1b, 0xed, 0xe3, 0x70, 0x97, 0x7c, 0x13, 0x36, 0xd1, 0xc6, 0xa8, 0x72, 0xea,
-	0xc2, 0xea, 0x0e, 0xa1, 0xdb, 0x64, 0x6c, 0x39, 0xc4, 0x3c, 0x24, 0x51, 0x28, 0x73, 0x4f, 0x06,
-	0x02, 0x22, 0x8c, 0x59, 0x8b, 0xd9, 0x49, 0x52, 0xb6, 0x58, 0x72, 0xd7, 0xef, 0xc2, 0x5a, 0x8a,
-	0x45, 0xe4, 0x20, 0xca, 0x01, 0xa1, 0xe1, 0x64, 0xac, 0x66, 0x78, 0x30, 0x5a, 0x4e, 0xa1, 0x7d,
-	0x09, 0xab, 0x5d, 0xd3, 0xcc, 0x4a, 0xf1, 0x23, 0x28, 0x31, 0xa7, 0x2d, 0xc6, 0x94, 0xcf, 0x80,
-	0x11, 0x30, 0xbd, 0x4c, 0xb5, 0x97, 0xc3, 0x3b, 0x84, 0x0d, 0x31, 0xe6, 0xb7, 0xe6, 0xcd, 0x74,
-	0xd8, 0xb0, 0x6d, 0xb9, 0xf5, 0xb3, 0x9f, 0x2c, 0x02, 0xcf, 0x32, 0x95, 0x1d, 0x3e, 0x81, 0x36,
-	0x26, 0x9e, 0x6d, 0x8c, 0xde, 0xbe, 0x47, 0x76, 0xb2, 0xc8, 0xe1, 0x21, 0x3b, 0x58, 0xe3, 0x99,
-	0x05, 0xee, 0xc5, 0x27, 0xc4, 0x89, 0x82, 0xd4, 0xaf, 0xf9, 0x3a, 0x2a, 0x60, 0xb9, 0x06, 0x1f,
-	0x03, 0x04, 0x21, 0x30, 0x5c, 0x09, 0x65, 0x47, 0x88, 0x1b, 0x28, 0x64, 0xda, 0x33, 0x7e, 0xec,
-	0x4c, 0xf7, 0x81, 0x3e, 0x82, 0x5a, 0x44, 0x24, 0x47, 0x91, 0xcb, 0x2a, 0xa6, 0xd2, 0xd6, 0xf9,
-	0xc2, 0x66, 0xc4, 0xd2, 0x7e, 0x3f, 0x3c, 0x84, 0xbe, 0x83, 0x4e, 0x72, 0x56, 0xe8, 0x4a, 0xb8,
-	0xec, 0xd9, 0x9e, 0x77, 0x61, 0x43, 0x4e, 0xee, 0xbb, 0x18, 0x5f, 0x27, 0x5a, 0xee, 0x6c, 0x4f,
-	0x08, 0x5a, 0x3b, 0x84, 0xca, 0x00, 0x59, 0x2e, 0x53, 0x17, 0x96, 0x15, 0x98, 0x5c, 0xa3, 0xf7,
-	0xa0, 0xea, 0x31, 0x88, 0x45, 0xc2, 0x15, 0x6a, 0x29, 0x21, 0xbf, 0xa0, 0x8d, 0x28, 0xb4, 0x33,
-	0x68, 0x75, 0x4d, 0x33, 0xc1, 0x16, 0x6d, 0x42, 0x85, 0xe3, 0xcf, 0xa5, 0xd8, 0xd9, 0xf6, 0x12,
-	0x8f, 0x3e, 0x83, 0x2b, 0x3e, 0x19, 0x33, 0xd7, 0x79, 0x66, 0x05, 0xd4, 0x72, 0x8e, 0x75, 0x45,
-	0x3d, 0xc4, 0x0c, 0x6e, 0x70, 0x82, 0x9e, 0xc4, 0x1f, 0xc6, 0x6a, 0xb1, 0x02, 0xcb, 0x4a, 0xcf,
-	0x72, 0x94, 0xbf, 0x2a, 0xc0, 0x8a, 0xcc, 0x79, 0xbc, 0xa5, 0x48, 0x1f, 0xc0, 0x8a, 0xc7, 0xc2,
-	0x1d, 0xff, 0x94, 0x64, 0x85, 0x41, 0x21, 0x2a, 0x96, 0x23, 0x5c, 0xef, 0x52, 0xbc, 0xde, 0xeb,
-	0xb0, 0x9a, 0x94, 0x41, 0x0a, 0xf7, 0x37, 0x05, 0x58, 0x95, 0xeb, 0xf3, 0xbf, 0x30, 0x61, 0xb3,
-	0x46, 0x56, 0x9a, 0x35, 0x32, 0x91, 0x29, 0x49, 0x88, 0x1b, 0x9d, 0xc5, 0x3b, 0x91, 0xde, 0x74,
-	0x83, 0xc0, 0x3a, 0x76, 0x54, 0xc5, 0xfd, 0x0c, 0xc0, 0x88, 0x80, 0x72, 0x44, 0x9d, 0xf4, 0x88,
-	0x94, 0x66, 0x0a, 0xb5, 0xf6, 0x0a, 0xae, 0xe6, 0x72, 0x96, 0xba, 0xf9, 0xdf, 0x61, 0xfd, 0x12,
-	0x3a, 0x91, 0xbe, 0xbc, 0x5b, 0xa1, 0xaf, 0xc3, 0xd5, 0x5c, 0xce, 0x72, 0xb6, 0x26, 0x70, 0x5d,
-	0x55, 0x87, 0x77, 0xda, 0x77, 0x8e, 0xb7, 0xb9, 0x05, 0x37, 0x66, 0x75, 0x27, 0x05, 0xfa, 0x3d,
-	0xb8, 0x91, 0x58, 0xd7, 0x77, 0x3b, 0x1b, 0xb7, 0xe1, 0xe6, 0x4c, 0xee, 0x09, 0x5f, 0x74, 0xc8,
-	0xe3, 0xf1, 0xd0, 0x17, 0x7d, 0xc1, 0x7d, 0x51, 0x08, 0x8b, 0xf6, 0xec, 0xca, 0xb1, 0xed, 0x1e,
-	0x19, 0x76, 0xd6, 0x30, 0x76, 0x38, 0x1c, 0x4b, 0xbc, 0xf6, 0x25, 0xa0, 0x43, 0x6a, 0xf8, 0x49,
-	0xa6, 0x6f, 0xd0, 0x7e, 0x0d, 0x56, 0x12, 0xed, 0xe3, 0x14, 0xcc, 0x21, 0x75, 0xbd, 0xa4, 0xa8,
-	0xab, 0xac, 0xaf, 0x18, 0x28, 0x49, 0xff, 0xb5, 0x04, 0xe5, 0x81, 0x4c, 0xc5, 0x3a, 0xb6, 0x6f,
-	0x85, 0x79, 0x63, 0xf6, 0x9b, 0x1d, 0x64, 0x3c, 0x83, 0x52, 0x5f, 0xc4, 0x98, 0x0d, 0x2c, 0xbf,
-	0xf8, 0xf2, 0x1d, 0x87, 0xc7, 0x08, 0xf6, 0x93, 0xb5, 0x3e, 0x22, 0x01, 0x95, 0x51, 0x24, 0xff,
-	0xcd, 0xc2, 0x54, 0x2b, 0xd0, 0xbf, 0xb7, 0xe8, 0x89, 0xe9, 0x1b, 0xdf, 0xf3, 0x58, 0xb1, 0x8a,
-	0xc1, 0x0a, 0x7e, 0x21, 0x21, 0xe8, 0x06, 0xc0, 0xa9, 0x61, 0x5b, 0xa6, 0xc8, 0x72, 0x55, 0x78,
-	0x52, 0x4a, 0x81, 0xa0, 0x0f, 0x61, 0xd5, 0x71, 0x75, 0x6b, 0xe2, 0x31, 0xaf, 0x4d, 0x63, 0x4e,
-	0x0b, 0xc2, 0xf6, 0x1d, 0xb7, 0x2f, 0x51, 0x11, 0xc7, 0xf8, 0xe4, 0x55, 0x4d, 0xe4, 0xa2, 0xaf,
-	0x03, 0x88, 0x74, 0x91, 0x6e, 0x04, 0x0e, 0x3f, 0x2c, 0x37, 0x71, 0x4d, 0x40, 0xba, 0x81, 0x83,
-	0xae, 0x82, 0xfc, 0xd0, 0x2d, 0x93, 0x9f, 0x92, 0x6b, 0xb8, 0x2a, 0x00, 0x7d, 0x53, 0x26, 0xc7,
-	0x28, 0xf1, 0x89, 0xc9, 0x0f, 0xc7, 0x55, 0x1c, 0x7d, 0xb3, 0x03, 0x6b, 0x40, 0x0d, 0x9b, 0xf0,
-	0x23, 0x71, 0x15, 0x8b, 0x0f, 0xb4, 0x09, 0x2d, 0x2b, 0xd0, 0xc7, 0xbe, 0x3b, 0xd1, 0xc9, 0x19,
-	0x25, 0xbe, 0x63, 0xd8, 0xfc, 0x3c, 0x5c, 0xc5, 0x8b, 0x56, 0xf0, 0xd4, 0x77, 0x27, 0x3d, 0x09,
-	0x65, 0x53, 0xe4, 0xc8, 0xec, 0x9d, 0x6e, 0x79, 0xfc, 0x40, 0x5c, 0xc3, 0x10, 0x82, 0xfa, 0x5e,
-	0x94, 0x20, 0x5f, 0x8a, 0x13, 0xe4, 0xe8, 0x3d, 0x40, 0x56, 0xa0, 0x87, 0x01, 0xb9, 0xe5, 0xf0,
-	0x19, 0xe3, 0xa7, 0xe2, 0x2a, 0x6e, 0x59, 0xc1, 0xbe, 0x40, 0xf4, 0x05, 0x5c, 0xfb, 0xab, 0x02,
-	0xd4, 0xb7, 0x09, 0xf3, 0xaa, 0x62, 0x52, 0xd9, 0x9a, 0xf2, 0x04, 0x83, 0x3c, 0x51, 0xc8, 0xaf,
-	0x38, 0x61, 0x56, 0xbc, 0x20, 0x61, 0x86, 0xee, 0xc2, 0x92, 0xed, 0x3a, 0xec, 0x00, 0x20, 0x9a,
-	0x91, 0xd0, 0x13, 0x2f, 0x0a, 0xf0, 0x40, 0x42, 0xd1, 0x3d, 0x68, 0x05, 0x27, 0xae, 0x4f, 0x55,
-	0x4a, 0xa1, 0x1c, 0x4b, 0x12, 0x1e, 0x92, 0x6a, 0x7f, 0x57, 0x80, 0x79, 0x9e, 0x2c, 0x62, 0xa7,
-	0x73, 0x25, 0x60, 0xce, 0xcb, 0xfb, 0x71, 0x7c, 0x74, 0x0f, 0x53, 0x8c, 0xef, 0x61, 0x66, 0x5e,
-	0x43, 0xfc, 0x3f, 0x68, 0x98, 0xf1, 0xf0, 0x99, 0x10, 0x6c, 0x78, 0x89, 0x60, 0x3c, 0xc2, 0xe2,
-	0x04, 0x29, 0x4f, 0xcf, 0xb8, 0x01, 0xd5, 0xe5, 0x2e, 0x27, 0x15, 0x98, 0x81, 0x84, 0x8f, 0xd0,
-	0x1e, 0xf1, 0xc3, 0xcc, 0x1b, 0x67, 0xc3, 0xb4, 0x4f, 0x45, 0xca, 0x80, 0xb5, 0x93, 0x2e, 0xe3,
-	0x92, 0x0d, 0x6d, 0x40, 0x2f, 0x84, 0x7d, 0x10, 0xa5, 0xd7, 0xcb, 0x4e, 0xdb, 0xac, 0x6b, 0xad,
-	0x58, 0x25, 0x4a, 0xaa, 0x4a, 0x30, 0xef, 0x92, 0xe8, 0x4d, 0xba, 0x8c, 0x7f, 0x60, 0x2e, 0x83,
-	0x10, 0x9f, 0x5b, 0x06, 0xe3, 0x10, 0xc6, 0x5c, 0x4d, 0x1c, 0x7d, 0xa3, 0x9f, 0x42, 0xc3, 0xf0,
-	0x3c, 0xfb, 0x3c, 0x9c, 0x3c, 0x91, 0x47, 0x51, 0xa6, 0xbd, 0xcb, 0xb0, 0x72, 0x87, 0xae, 0x1b,
-	0xf1, 0x47, 0x94, 0xa2, 0x29, 0xa5, 0x53, 0x34, 0xac, 0x4f, 0x25, 0x45, 0xf3, 0x18, 0x9a, 0xe4,
-	0xe8, 0xd8, 0xd3, 0x27, 0x53, 0x9b, 0x5a, 0x27, 0xae, 0x27, 0x2f, 0x9a, 0xd6, 0xe3, 0x06, 0xbd,
-	0xa3, 0x63, 0x6f, 0x4f, 0x62, 0x71, 0x83, 0x28, 0x5f, 0xa8, 0x0b, 0x4b, 0xe2, 0x08, 0xed, 0x93,
-	0xb1, 0x4d, 0x46, 0xd4, 0xf5, 0xf9, 0xf2, 0xd6, 0x1f, 0xb6, 0x95, 0xd9, 0x63, 0x04, 0x38, 0xc4,
-	0xe3, 0x45, 0x3f, 0xf1, 0x8d, 0xee, 0x42, 0xd9, 0x72, 0xc6, 0x2e, 0xf7, 0x5b, 0x89, 0x20, 0x97,
-	0xc9, 0x29, 0x32, 0x44, 0x9c, 0x80, 0xb9, 0x73, 0x6a, 0x4d, 0x88, 0x1f, 0x70, 0xc7, 0x95, 0x70,
-	0xe7, 0x43, 0x0e, 0xc7, 0x12, 0xcf, 0x82, 0x67, 0xea, 0x1b, 0x4e, 0xc0, 0x53, 0x29, 0xd5, 0x34,
-	0xdf, 0x61, 0x88, 0xc2, 0x31, 0x15, 0x9b, 0x67, 0x31, 0x10, 0x91, 0x27, 0xe2, 0xbe, 0x2d, 0x31,
-	0xcf, 0x7c, 0x14, 0xd2, 0xe9, 0x8b, 0xb4, 0x81, 0xf8, 0xd0, 0xfe, 0xa9, 0x00, 0x75, 0x65, 0x11,
-	0xd0, 0xa7, 0x50, 0xb3, 0x1c, 0x3d, 0x11, 0xd1, 0x5d, 0xb4, 0x79, 0x56, 0x2d, 0x47, 0x36, 0xfc,
-	0x19, 0x34, 0xc9, 0x19, 0x13, 0x26, 0xb9, 0xd6, 0x17, 0x35, 0x6e, 0x88, 0x06, 0x31, 0x03, 0x6b,
-	0xa2, 0x32, 0x28, 0xbd, 0x9e, 0x81, 0x68, 0x20, 0xed, 0xf0, 0x4f, 0xa0, 0x2e, 0xbc, 0xc9, 0xae,
-	0x35, 0xb1, 0x66, 0xe6, 0xdf, 0xd0, 0x6d, 0x68, 0x4c, 0x8c, 0xb3, 0xd8, 0x1f, 0x09, 0x2b, 0xa8,
-	0x4f, 0x8c, 0xb3, 0xc8, 0x6d, 0x7d, 0x02, 0xeb, 0x81, 0xbc, 0x18, 0xd2, 0xe9, 0x89, 0x4f, 0x82,
-	0x13, 0xd7, 0x36, 0x75, 0x6f, 0x44, 0xa5, 0x57, 0x59, 0x0d, 0xb1, 0xc3, 0x10, 0x39, 0x18, 0x51,
-	0xed, 0x5f, 0xca, 0x50, 0x0d, 0xb5, 0x13, 0xfd, 0x00, 0x9a, 0xc6, 0x94, 0x9e, 0xe8, 0x9e, 0x11,
-	0x04, 0xdf, 0xbb, 0xbe, 0x29, 0xfd, 0x6c, 0x83, 0x01, 0x07, 0x12, 0x86, 0x6e, 0x41, 0xdd, 0x24,
-	0xc1, 0xc8, 0xb7, 0x3c, 0xe5, 0x86, 0x47, 0x05, 0xa1, 0x2b, 0x50, 0xb5, 0xdd, 0x91, 0x61, 0xeb,
-	0x46, 0x10, 0x26, 0x71, 0xf8, 0x77, 0x97, 0xfb, 0xd6, 0x68, 0xd7, 0x08, 0x93, 0x4c, 0x65, 0xce,
-	0x61, 0x29, 0x84, 0x77, 0x65, 0x5e, 0x6e, 0x03, 0x16, 0x3c, 0x42, 0x7c, 0xc6, 0x44, 0xe4, 0x6a,
-	0x2a, 0xec, 0xb3, 0x1b, 0xb0, 0x1d, 0x91, 0x23, 0x8e, 0x7d, 0x77, 0xea, 0x71, 0x1d, 0xae, 0xe1,
-	0x1a, 0x83, 0xec, 0x30, 0x00, 0xdb, 0x11, 0x39, 0x9a, 0xfb, 0x15, 0x91, 0x97, 0xae, 0x32, 0x00,
-	0xbf, 0x2e, 0xba, 0x0f, 0xcb, 0x3e, 0x99, 0xb8, 0xa7, 0x44, 0xf7, 0x7c, 0xeb, 0xd4, 0xa0, 0x6c,
-	0x57, 0x95, 0x1b, 0xee, 0x92, 0x40, 0x0c, 0x04, 0xbc, 0x1b, 0xb0, 0xcd, 0x4a, 0xe8, 0xe7, 0xd8,
-	0x36, 0x3c, 0xdd, 0x34, 0x26, 0x9e, 0xe5, 0x1c, 0x73, 0x2d, 0xad, 0xe2, 0x16, 0xc7, 0x3c, 0xb5,
-	0x0d, 0x6f, 0x5b, 0xc0, 0xd1, 0x1d, 0x58, 0x0c, 0x88, 0x63, 0xea, 0xf2, 0xaa, 0x8b, 0x9e, 0xf3,
-	0xdd, 0xb8, 0x89, 0x9b, 0x0c, 0xba, 0x15, 0x02, 0x99, 0xf0, 0xf2, 0x36, 0x60, 0x64, 0x78, 0xed,
-	0x3a, 0x8f, 0x4d, 0x6a, 0x02, 0xb2, 0x65, 0x70, 0xe1, 0xc5, 0xd4, 0x31, 0x6c, 0x83, 0x63, 0xc5,
-	0x5c, 0x32, 0xe4, 0x22, 0x14, 0x2d, 0x93, 0x6f, 0xc7, 0x35, 0x5c, 0xb4, 0x4c, 0xf4, 0x19, 0x34,
-	0x65, 0x0e, 0xde, 0x66, 0xca, 0x13, 0xb4, 0x17, 0xd3, 0x1b, 0x84, 0xa2, 0x5a, 0xb8, 0xe1, 0xc5,
-	0x1f, 0x01, 0x5b, 0x6a, 0xb9, 0x46, 0x72, 0x15, 0x96, 0xc4, 0x52, 0x8b, 0x85, 0x92, 0x4b, 0xf0,
-	0x3e, 0xa0, 0x78, 0x8f, 0x77, 0x28, 0xf1, 0xc7, 0xc6, 0x88, 0xf0, 0xed, 0xba, 0x86, 0x97, 0xa3,
-	0xad, 0x3e, 0x44, 0xb0, 0xd8, 0xea, 0xd4, 0x1f, 0xf3, 0x14, 0x76, 0x4d, 0xe4, 0x9c, 0xbe, 0x86,
-	0x86, 0xea, 0xc9, 0x50, 0x1b, 0x16, 0x44, 0xce, 0x2e, 0x7c, 0x3a, 0x11, 0x7e, 0x72, 0x05, 0x97,
-	0x54, 0x3a, 0xa5, 0x76, 0xa4, 0xe0, 0x12, 0x36, 0xa4, 0xb6, 0xf6, 0x67, 0x05, 0x58, 0x4c, 0x3a,
-	0x36, 0xa6, 0xf3, 0x29, 0x5f, 0xa8, 0x8f, 0x6c, 0x2b, 0x0c, 0xa1, 0xab, 0x78, 0x35, 0xe9, 0xf8,
-	0xb6, 0x38, 0x0e, 0x3d, 0x86, 0x4e, 0xb6, 0xd5, 0x34, 0x60, 0x1b, 0x7e, 0x74, 0x17, 0xb7, 0x91,
-	0x6e, 0xc9, 0xf1, 0x7d, 0x53, 0xfb, 0xdb, 0x0a, 0xd4, 0x22, 0x37, 0xf9, 0x3f, 0x60, 0x31, 0x0f,
-	0xa0, 0x3a, 0x21, 0x41, 0x60, 0x1c, 0xcb, 0x28, 0x24, 0xb1, 0xaf, 0xec, 0x49, 0x0c, 0x8e, 0x68,
-	0x72, 0x2d, 0x6c, 0xfe, 0xb5, 0x16, 0x56, 0xb9, 0xc0, 0xc2, 0x16, 0x2e, 0xb4, 0xb0, 0x6a, 0xca,
-	0xc2, 0x36, 0xa1, 0xf2, 0xdd, 0x94, 0x4c, 0x49, 0x20, 0xfd, 0xb9, 0xb2, 0x65, 0x7c, 0xc3, 0xe1,
-	0x58, 0xe2, 0xf3, 0x6d, 0x11, 0xde, 0xc4, 0x16, 0xeb, 0x97, 0xb6, 0xc5, 0x46, 0x9e, 0x2d, 0xf2,
-	0x0b, 0xa4, 0x20, 0xb0, 0x5c, 0x47, 0x1c, 0xcf, 0xb9, 0x69, 0x35, 0x71, 0x43, 0x02, 0xc5, 0x0a,
-	0xff, 0x04, 0xd6, 0x83, 0xa9, 0xc7, 0x3c, 0x36, 0x31, 0x99, 0x55, 0x1a, 0x47, 0x96, 0x6d, 0x51,
-	0x16, 0x37, 0x2c, 0xf2, 0xe4, 0xf5, 0x5a, 0x84, 0xdd, 0x52, 0x90, 0x6c, 0x8e, 0xd8, 0x0e, 0x2f,
-	0xf8, 0x0a, 0xdb, 0xaa, 0x1e, 0x1d, 0x7b, 0x82, 0xe7, 0xcf, 0xa0, 0x6e, 0x98, 0x13, 0x2b, 0xec,
-	0xb6, 0xc5, 0x83, 0x9f, 0x1b, 0x39, 0xdb, 0xf0, 0x83, 0x2e, 0x23, 0x13, 0x3b, 0x32, 0x18, 0xd1,
-	0x6f, 0x16, 0xbe, 0x84, 0x57, 0x61, 0xdc, 0xdc, 0x9a, 0x38, 0xfa, 0x66, 0x38, 0x63, 0x34, 0x22,
-	0x1e, 0x25, 0x66, 0x1b, 0x09, 0x5c, 0xf8, 0xcd, 0x8e, 0x2d, 0x46, 0xfc, 0x7a, 0x69, 0x45, 0x5c,
-	0xda, 0xc5, 0x10, 0xb4, 0x02, 0xf3, 0xee, 0x94, 0xea, 0xdf, 0xb5, 0x57, 0xc5, 0x65, 0x88, 0x3b,
-	0xa5, 0xdf, 0xb0, 0x93, 0xc2, 0xd8, 0x76, 0xbd, 0xa0, 0xbd, 0xc6, 0x81, 0xe2, 0x43, 0xbb, 0x0f,
-	0x10, 0x0b, 0x87, 0x2a, 0x50, 0x7c, 0x3e, 0x10, 0xb7, 0xbc, 0xdb, 0x07, 0xbf, 0xd8, 0x6f, 0x15,
-	0x10, 0x40, 0x65, 0xf0, 0xf4, 0xa5, 0xbe, 0x35, 0x6c, 0x15, 0xb5, 0x3f, 0x84, 0x6a, 0xa8, 0xa9,
-	0xe8, 0x7d, 0x45, 0x74, 0xb1, 0x55, 0x2f, 0x67, 0xf4, 0x59, 0x19, 0xcd, 0x1d, 0x28, 0x07, 0xe1,
-	0xd5, 0x6b, 0x2e, 0x29, 0x47, 0x6b, 0xbf, 0x29, 0xc0, 0x82, 0x84, 0x20, 0x0d, 0x1a, 0xfb, 0x07,
-	0xc3, 0xfe, 0xd3, 0xfe, 0x56, 0x77, 0xd8, 0x3f, 0xd8, 0xe7, 0xbd, 0x94, 0x71, 0x02, 0xc6, 0xf6,
-	0xd9, 0xe7, 0x83, 0xed, 0xee, 0xb0, 0xc7, 0x19, 0x97, 0xb1, 0xfc, 0x62, 0xe1, 0xf9, 0xc1, 0xa0,
-	0xb7, 0x2f, 0x9f, 0x0b, 0xf0, 0xdf, 0xe8, 0x1a, 0xd4, 0xbe, 0xee, 0xf5, 0x06, 0xdd, 0xdd, 0xfe,
-	0x8b, 0x1e, 0x37, 0xc1, 0x32, 0x8e, 0x01, 0xcc, 0xa5, 0xe1, 0xde, 0x53, 0xdc, 0x3b, 0x7c, 0xc6,
-	0xcd, 0xac, 0x8c, 0xc3, 0x4f, 0xd6, 0x6e, 0xbb, 0x7f, 0xb8, 0xd5, 0xc5, 0xdb, 0xbd, 0x6d, 0x6e,
-	0x60, 0x65, 0x1c, 0x03, 0xd8, 0xac, 0x0e, 0x0f, 0x86, 0xdd, 0x5d, 0x6e, 0x5e, 0x65, 0x2c, 0x3e,
-	0xb4, 0x47, 0x50, 0x11, 0x56, 0xc2, 0xf0, 0x96, 0xe3, 0x4d, 0xa9, 0x0c, 0x04, 0xc4, 0x07, 0x93,
-	0xdb, 0x9d, 0x52, 0x06, 0x96, 0x71, 0xb0, 0xf8, 0xd2, 0x08, 0x54, 0x44, 0x40, 0x86, 0x1e, 0x40,
-	0x85, 0xc5, 0x98, 0xd6, 0xb1, 0x9c, 0xdd, 0xf5, 0x74, 0xc8, 0xb6, 0xc5, 0xb1, 0x58, 0x52, 0xa1,
-	0x1f, 0x27, 0xaf, 0x0b, 0xd7, 0xd2, 0xe4, 0x89, 0x0b, 0xc3, 0xdf, 0x14, 0xa0, 0xa1, 0x72, 0x61,
-	0x26, 0x34, 0x72, 0x1d, 0x87, 0x8c, 0xa8, 0xee, 0x13, 0xea, 0x9f, 0x87, 0x93, 0x2d, 0x81, 0x98,
-	0xc1, 0x98, 0x2d, 0xf0, 0x58, 0x24, 0xba, 0xbb, 0x2e, 0xe3, 0x2a, 0x03, 0x30, 0x4e, 0x6c, 0x8f,
-	0xf9, 0x96, 0x10, 0xcf, 0xb0, 0xad, 0x53, 0xa2, 0xa7, 0x9e, 0x6b, 0x2c, 0x47, 0x98, 0xbe, 0x44,
-	0xa0, 0x6d, 0xb8, 0x31, 0xb1, 0x1c, 0x6b, 0x32, 0x9d, 0xe8, 0x91, 0xde, 0xb2, 0xb0, 0x2a, 0x6e,
-	0x2a, 0x56, 0xe8, 0x9a, 0xa4, 0xea, 0xaa, 0x44, 0x21, 0x17, 0xed, 0xd7, 0x45, 0xa8, 0x2b, 0xc3,
-	0xfb, 0x3f, 0x3a, 0x0c, 0x9e, 0x65, 0x20, 0xc7, 0x2e, 0xb5, 0x0c, 0xe6, 0x9c, 0x62, 0xe1, 0x84,
-	0x22, 0xa2, 0x18, 0xf7, 0x2c, 0x14, 0x33, 0x7e, 0x5d, 0x20, 0x14, 0x32, 0xef, 0x75, 0x81, 0x50,
-	0xc8, 0xe8, 0x5b, 0xfb, 0xcf, 0x02, 0xd4, 0xa2, 0x00, 0x3e, 0x1b, 0x38, 0x14, 0x72, 0x02, 0x87,
-	0xeb, 0x00, 0x82, 0x48, 0xb9, 0x59, 0x15, 0x81, 0xcd, 0x40, 0xf2, 0x98, 0xd0, 0xa9, 0x6e, 0x5a,
-	0xc1, 0xc8, 0x3d, 0x25, 0xfe, 0xb9, 0x3c, 0x88, 0x37, 0x26, 0x74, 0xba, 0x1d, 0xc2, 0x58, 0x44,
-	0xc0, 0x76, 0x55, 0x36, 0x9f, 0x13, 0xd7, 0x0c, 0x6f, 0xf9, 0xea, 0x12, 0xb6, 0xe7, 0x9a, 0xec,
-	0xe8, 0xb9, 0x28, 0x83, 0xa9, 0xe4, 0x4e, 0xd7, 0x14, 0xd0, 0x6e, 0xfe, 0x0b, 0x8c, 0x4a, 0xf8,
-	0xda, 0x21, 0x7c, 0x81, 0xc1, 0x36, 0x42, 0x3a, 0xf2, 0xf4, 0x49, 0x10, 0xc8, 0x80, 0xb1, 0x42,
-	0x47, 0xde, 0x5e, 0x10, 0x68, 0x5f, 0x40, 0x5d, 0x39, 0x84, 0xa0, 0x07, 0xb0, 0xa2, 0x9e, 0x58,
-	0x92, 0xb1, 0xc6, 0xb2, 0x72, 0x42, 0x11, 0x81, 0x86, 0x36, 0x85, 0x8a, 0x88, 0xc0, 0x98, 0xee,
-	0x58, 0x9e, 0x9e, 0xc8, 0x5e, 0x54, 0x2d, 0x4f, 0x22, 0x7f, 0x04, 0x4b, 0x13, 0x23, 0xf8, 0x56,
-	0xb7, 0x89, 0x73, 0x4c, 0x4f, 0xf4, 0x89, 0xe5, 0xc8, 0x29, 0x6b, 0x32, 0xf0, 0x2e, 0x87, 0xee,
-	0x59, 0x4e, 0x86, 0xce, 0x38, 0x93, 0xc1, 0x82, 0x4a, 0x67, 0x9c, 0x69, 0x7f, 0x51, 0x00, 0x88,
-	0xaf, 0x8e, 0xde, 0xe0, 0x2e, 0x2f, 0x37, 0x3b, 0x81, 0xa0, 0x6c, 0x5b, 0x01, 0xe5, 0xaf, 0x91,
-	0x6a, 0x98, 0xff, 0xe6, 0x57, 0x16, 0x71, 0x6a, 0x24, 0x7d, 0x65, 0xc1, 0x31, 0x38, 0xa2, 0xd0,
-	0x76, 0xa0, 0xba, 0x67, 0xd0, 0xd1, 0x09, 0x13, 0xe6, 0x6e, 0x42, 0x18, 0xe5, 0x88, 0xc8, 0x29,
-	0x2e, 0x16, 0x45, 0x7b, 0x01, 0x8d, 0x6e, 0x30, 0x30, 0xe8, 0x89, 0x18, 0x2b, 0x7a, 0x90, 0x60,
-	0xa6, 0x1c, 0xba, 0x54, 0x2a, 0x85, 0xe7, 0x3a, 0x54, 0xc4, 0xdc, 0x85, 0xde, 0x53, 0x7c, 0x69,
-	0xff, 0x51, 0x06, 0xd8, 0x72, 0x1d, 0xd3, 0x12, 0xc9, 0x93, 0x8f, 0x40, 0x3e, 0x64, 0xd1, 0xe3,
-	0xfb, 0x3a, 0x94, 0x92, 0xf4, 0x90, 0x50, 0x5c, 0x13, 0x54, 0x6c, 0x58, 0x3f, 0x81, 0x46, 0x14,
-	0x75, 0xb1, 0x46, 0xc5, 0x99, 0x8d, 0xa2, 0xac, 0x19, 0x6b, 0xf6, 0x39, 0x2c, 0x1a, 0x81, 0xee,
-	0x19, 0xf4, 0x44, 0x2e, 0xaa, 0x3c, 0x3f, 0xae, 0xe7, 0x0f, 0x05, 0x37, 0x0c, 0x75, 0xf8, 0x0f,
-	0xa1, 0x1e, 0xb6, 0x66, 0x7d, 0x96, 0x67, 0x0b, 0x2a, 0x9a, 0xb1, 0x1e, 0x3f, 0x8d, 0x5e, 0xe8,
-	0xd1, 0x73, 0xde, 0x6a, 0x7e, 0x66, 0xab, 0x46, 0x44, 0xc8, 0x1a, 0x7e, 0x09, 0xcb, 0xe4, 0x8c,
-	0xea, 0xc9, 0xc6, 0x95, 0x99, 0x8d, 0x97, 0xc8, 0x19, 0xdd, 0x52, 0xdb, 0x33, 0x23, 0xf4, 0xbe,
-	0xb5, 0x74, 0x9f, 0x04, 0x53, 0x9b, 0x72, 0x3b, 0x9b, 0xc7, 0xe0, 0x8b, 0x57, 0x04, 0x53, 0x9b,
-	0xa2, 0x2f, 0x00, 0xe2, 0xa7, 0x01, 0x3c, 0xac, 0x4c, 0xc4, 0x44, 0xf1, 0xfa, 0x88, 0xbc, 0x00,
-	0x5f, 0xd6, 0x5a, 0xf4, 0x72, 0x00, 0x3d, 0x81, 0x15, 0xdb, 0xf0, 0x8f, 0x49, 0x4a, 0xc2, 0xda,
-	0x4c, 0x09, 0x97, 0x39, 0xb9, 0x2a, 0xa3, 0x76, 0x02, 0xb5, 0x88, 0x37, 0x5a, 0x81, 0x25, 0x7c,
-	0xf0, 0x7c, 0xd8, 0xd3, 0x87, 0xaf, 0x06, 0x3d, 0x7d, 0xff, 0x60, 0xbf, 0xd7, 0x9a, 0x43, 0x1b,
-	0xb0, 0xa2, 0x00, 0xfb, 0xfb, 0xc3, 0x1e, 0xde, 0xef, 0xee, 0xb6, 0x0a, 0x29, 0x44, 0xef, 0xa5,
-	0x44, 0x14, 0xd1, 0x2a, 0xb4, 0x14, 0xc4, 0xee, 0xc1, 0x56, 0x77, 0xb7, 0x55, 0xd2, 0xc6, 0xb0,
-	0x14, 0xf5, 0xdc, 0x15, 0x6f, 0x4d, 0x3f, 0x4a, 0x28, 0xf3, 0x75, 0x75, 0xe4, 0x09, 0x42, 0x45,
-	0x9f, 0x6f, 0x41, 0x3d, 0x1c, 0xad, 0x15, 0xbd, 0xa6, 0x50, 0x41, 0xda, 0x3e, 0xd4, 0xf6, 0x88,
-	0x29, 0x7b, 0xf8, 0x71, 0xa2, 0x87, 0x0d, 0x35, 0x96, 0x32, 0x33, 0xbc, 0x57, 0x61, 0xfe, 0xd4,
-	0xb0, 0xa7, 0xe1, 0x63, 0x33, 0xf1, 0xa1, 0xe9, 0xb0, 0xd4, 0x0d, 0x06, 0x3e, 0xf1, 0x88, 0x13,
-	0x72, 0x6d, 0x41, 0xc9, 0x08, 0x1c, 0x19, 0xa6, 0xb0, 0x9f, 0xcc, 0xcc, 0x18, 0x85, 0x11, 0x05,
-	0x29, 0xe2, 0x0b, 0x69, 0xd0, 0x9c, 0x06, 0x44, 0xb7, 0xc9, 0x98, 0xea, 0x13, 0x37, 0xa0, 0xd2,
-	0xed, 0xd7, 0xa7, 0x01, 0xd9, 0x25, 0x63, 0xba, 0xe7, 0xf2, 0x5b, 0x89, 0xa6, 0xcc, 0x02, 0x4b,
-	0xf6, 0x17, 0x3e, 0xdc, 0x09, 0x88, 0x3d, 0x96, 0x57, 0x31, 0xfc, 0xb7, 0x76, 0x17, 0x96, 0x76,
-	0xf9, 0x36, 0xe3, 0x93, 0xb1, 0x64, 0x10, 0x0d, 0x44, 0x06, 0x52, 0x62, 0x20, 0xff, 0x5c, 0x82,
-	0x05, 0x41, 0x10, 0xc4, 0x89, 0x28, 0x43, 0xbc, 0x29, 0xce, 0x38, 0x4a, 0xae, 0x14, 0x82, 0x5a,
-	0x26, 0xa2, 0x24, 0xef, 0x4f, 0xa1, 0x16, 0x9f, 0x31, 0x84, 0xcd, 0x5f, 0x99, 0xb9, 0x70, 0x38,
-	0xa6, 0x45, 0x77, 0xa0, 0x34, 0x21, 0xa6, 0xb4, 0xf6, 0x95, 0x9c, 0x95, 0xc0, 0x0c, 0x8f, 0x7e,
-	0x0a, 0xc0, 0x2c, 0x5c, 0xcc, 0xb7, 0x34, 0xf0, 0x2b, 0x09, 0xdf, 0xa0, 0x2e, 0x05, 0xb7, 0x73,
-	0x01, 0x40, 0x5f, 0x42, 0x33, 0x61, 0xae, 0xd2, 0xce, 0x2f, 0x90, 0xae, 0xa1, 0x5a, 0x2c, 0xfa,
-	0x08, 0x16, 0x64, 0x9a, 0x5e, 0x1a, 0xb9, 0xa2, 0x2e, 0x89, 0x05, 0xc2, 0x21, 0x1d, 0x13, 0x56,
-	0x6e, 0xfa, 0x3e, 0x19, 0xcb, 0x84, 0xa1, 0xd2, 0x5f, 0x6a, 0x5d, 0xc2, 0x78, 0xc0, 0x27, 0x63,
-	0xf4, 0x04, 0x96, 0x52, 0xb6, 0x2b, 0x53, 0x88, 0x17, 0x88, 0xbb, 0x98, 0x34, 0x5f, 0xed, 0x57,
-	0x05, 0xa8, 0x45, 0x57, 0xa9, 0xd1, 0xee, 0x51, 0x50, 0x36, 0xb2, 0x4f, 0x00, 0x46, 0x91, 0x13,
-	0x91, 0xab, 0xb5, 0x9a, 0xe7, 0x60, 0xb0, 0x42, 0x87, 0x7e, 0x0c, 0x0b, 0x42, 0x2d, 0x02, 0xb9,
-	0x5a, 0xca, 0x19, 0x44, 0x2a, 0x10, 0x0e, 0x29, 0xb4, 0x6f, 0xa0, 0x22, 0x13, 0x83, 0x79, 0x02,
-	0x24, 0x1f, 0x63, 0x14, 0x2f, 0xf7, 0x18, 0xe3, 0xdf, 0x0a, 0xd0, 0x4a, 0xe7, 0x10, 0xd1, 0x66,
-	0xc2, 0x92, 0x57, 0xd3, 0xd9, 0x46, 0xc5, 0x8c, 0xd5, 0x37, 0xc9, 0xc5, 0x4b, 0xbc, 0x49, 0xce,
-	0xa9, 0x13, 0x49, 0x3c, 0x50, 0x28, 0xbf, 0xee, 0x81, 0x02, 0xfa, 0x00, 0x16, 0x4c, 0x32, 0x36,
-	0x98, 0x93, 0x9f, 0xbf, 0xc8, 0x90, 0x42, 0x2a, 0xed, 0xcf, 0x0b, 0x50, 0xc2, 0xae, 0x81, 0x16,
-	0xa1, 0x68, 0x04, 0xd2, 0x4a, 0x8b, 0x46, 0xc0, 0xce, 0x4f, 0x62, 0x83, 0xb5, 0x49, 0x18, 0x10,
-	0xc5, 0x00, 0xe6, 0x64, 0x26, 0x06, 0x47, 0xc9, 0x4b, 0x13, 0xf1, 0xa5, 0xdc, 0x08, 0x94, 0x13,
-	0x97, 0x44, 0x61, 0x6e, 0x7e, 0xfe, 0xe2, 0xe7, 0x93, 0xda, 0x5d, 0x71, 0x31, 0xe2, 0x1a, 0xaf,
-	0x7b, 0x12, 0x29, 0x5e, 0x7f, 0x71, 0xc2, 0xf8, 0xf5, 0x97, 0xef, 0x1a, 0x39, 0xaf, 0xbf, 0x18,
-	0x11, 0x47, 0x69, 0x01, 0x94, 0x5e, 0xf8, 0xe3, 0x5c, 0xed, 0x58, 0x84, 0xa2, 0x2f, 0xb2, 0x4f,
-	0x0d, 0x5c, 0xf4, 0x4d, 0x1e, 0x32, 0x8a, 0xd4, 0xb2, 0x2f, 0x82, 0xaf, 0x06, 0xae, 0x0a, 0x00,
-	0xe6, 0x6f, 0xe2, 0x65, 0xe2, 0xda, 0xa7, 0x7c, 0x4d, 0x1a, 0xb8, 0x2a, 0x00, 0x98, 0xca, 0x3c,
-	0xa1, 0x48, 0x9a, 0x16, 0x2d, 0x53, 0xfb, 0x6d, 0x01, 0x2a, 0xe2, 0xf6, 0x35, 0x33, 0xc7, 0x57,
-	0x41, 0x6c, 0xa1, 0x4a, 0xe6, 0xab, 0x2a, 0x00, 0x7d, 0x93, 0x6d, 0xd9, 0x2c, 0xda, 0x23, 0x8e,
-	0x88, 0x9b, 0x4b, 0x62, 0xcb, 0x16, 0x20, 0x1e, 0x37, 0xdf, 0x83, 0x96, 0x24, 0x90, 0x3e, 0x59,
-	0x2a, 0x48, 0x0d, 0x2f, 0x09, 0x78, 0x37, 0x04, 0x27, 0x2e, 0x5c, 0xe6, 0x53, 0x17, 0x2e, 0xef,
-	0x01, 0x62, 0xfb, 0x02, 0xcf, 0xf5, 0x79, 0x36, 0xd1, 0xc5, 0x65, 0x5e, 0x45, 0x24, 0x77, 0xa6,
-	0x01, 0xd9, 0x93, 0x88, 0x01, 0x7f, 0xf8, 0xfe, 0x8f, 0xec, 0x38, 0x62, 0x1c, 0xd9, 0xa4, 0xef,
-	0x8c, 0xdd, 0xdf, 0xc9, 0xbd, 0xdb, 0x5d, 0x58, 0x72, 0xa6, 0x13, 0x5d, 0xb9, 0x50, 0x93, 0xa7,
-	0xb1, 0x45, 0x67, 0x3a, 0x51, 0x2f, 0x24, 0xaf, 0x40, 0x95, 0x11, 0xf2, 0x5a, 0x21, 0x79, 0xf8,
-	0x77, 0xa6, 0x13, 0x7e, 0x27, 0x7d, 0x1b, 0x1a, 0x0c, 0x15, 0x65, 0x62, 0xc4, 0x71, 0xab, 0xee,
-	0x4c, 0x27, 0x5d, 0x09, 0xd2, 0x3e, 0xe7, 0x17, 0xf0, 0xd8, 0x3a, 0x62, 0x03, 0x09, 0xb5, 0x2d,
-	0xbc, 0x9a, 0xc9, 0xbc, 0x3f, 0x8a, 0x86, 0x2c, 0xae, 0x66, 0xb4, 0x2f, 0x78, 0x91, 0x5a, 0xd4,
-	0x5a, 0xaa, 0xe0, 0x65, 0x9b, 0xdf, 0xdf, 0x82, 0x6a, 0x38, 0x43, 0x08, 0xa0, 0xb2, 0xb3, 0x7b,
-	0xf0, 0xa4, 0xbb, 0xdb, 0x9a, 0x43, 0x35, 0x98, 0x17, 0x31, 0x0a, 0xcf, 0xe0, 0x74, 0xb7, 0x7f,
-	0xae, 0xf7, 0xf7, 0x5b, 0x45, 0x54, 0x87, 0x05, 0xf6, 0xfb, 0xe0, 0xf9, 0xb0, 0x55, 0x42, 0x0b,
-	0x50, 0x7a, 0x81, 0x9f, 0xb6, 0xca, 0xf7, 0x29, 0xd4, 0x95, 0x33, 0x04, 0x4f, 0xf9, 0xe0, 0xde,
-	0xd3, 0xfe, 0xcb, 0xd6, 0x1c, 0x6a, 0x40, 0x75, 0xbf, 0xd7, 0xdf, 0x79, 0xf6, 0xe4, 0x00, 0xb7,
-	0x0a, 0xac, 0xc5, 0xb0, 0xbb, 0x23, 0xf9, 0x1c, 0xea, 0x83, 0xee, 0xf0, 0x59, 0xab, 0x84, 0x9a,
-	0x50, 0xdb, 0x3a, 0xd8, 0xdb, 0x7b, 0xbe, 0xdf, 0x1f, 0xbe, 0x6a, 0x95, 0xd1, 0x32, 0x34, 0x7b,
-	0x2f, 0x87, 0x7a, 0x0c, 0x9a, 0x67, 0x31, 0xd8, 0x6e, 0x17, 0xef, 0xf4, 0x14, 0x60, 0xe5, 0xfe,
-	0x3d, 0xa8, 0x45, 0x87, 0x05, 0xc6, 0xb9, 0xbb, 0xff, 0x4a, 0x94, 0xe8, 0x74, 0x77, 0xa5, 0xd8,
-	0xfd, 0xfd, 0x17, 0x3d, 0x3c, 0x6c, 0x15, 0xef, 0xdf, 0x87, 0x56, 0xfa, 0x28, 0x80, 0x2a, 0x50,
-	0xec, 0x7d, 0xd3, 0x9a, 0x63, 0x7f, 0x77, 0x7a, 0xad, 0x02, 0xfb, 0xbb, 0xdb, 0x6b, 0x15, 0xef,
-	0x7f, 0x20, 0xcf, 0x7a, 0x72, 0x6b, 0xaf, 0x42, 0x59, 0xc6, 0x7c, 0x6c, 0x1e, 0xb6, 0xb6, 0x7a,
-	0x83, 0xa1, 0x60, 0x8e, 0x7b, 0x3f, 0xef, 0x6d, 0x31, 0xe6, 0xcf, 0x61, 0x25, 0x27, 0x34, 0x63,
-	0xc3, 0x88, 0xa4, 0xd5, 0xbb, 0xdb, 0xdb, 0xad, 0x39, 0x16, 0x03, 0xc6, 0x20, 0xdc, 0xdb, 0x3b,
-	0x78, 0xc1, 0x3a, 0x5e, 0x83, 0x65, 0x15, 0x3a, 0xd8, 0xed, 0x6e, 0x31, 0x39, 0xde, 0x87, 0x66,
-	0x22, 0x1e, 0x63, 0x73, 0xb6, 0xd7, 0xdb, 0xd6, 0xf7, 0x0e, 0x18, 0xab, 0x25, 0xa8, 0xb3, 0x8f,
-	0x90, 0xbc, 0x70, 0xff, 0x3d, 0x80, 0xd8, 0xe9, 0x47, 0x05, 0x4b, 0x6c, 0x12, 0xf6, 0x06, 0x07,
-	0x58, 0xca, 0xdc, 0x7b, 0xc9, 0x7f, 0x17, 0x1f, 0xfe, 0xfb, 0x4d, 0xa8, 0xee, 0x30, 0x9d, 0xe8,
-	0x7a, 0x16, 0xda, 0x85, 0xba, 0xf2, 0x04, 0x03, 0x5d, 0x4b, 0x6c, 0x45, 0xa9, 0x97, 0x1d, 0x9d,
-	0xeb, 0x33, 0xb0, 0xf2, 0x66, 0x75, 0x0e, 0xf5, 0x01, 0xe2, 0x47, 0x1a, 0xe8, 0xaa, 0x4a, 0x9e,
-	0x7a, 0xcf, 0xd1, 0xb9, 0x96, 0x8f, 0x8c, 0x58, 0x3d, 0x85, 0x5a, 0xf4, 0x34, 0x05, 0x29, 0xc7,
-	0xba, 0xf4, 0x1b, 0x96, 0xce, 0xd5, 0x5c, 0x5c, 0xc4, 0x67, 0x17, 0xea, 0x4a, 0xfd, 0x9c, 0x3a,
-	0xc0, 0x6c, 0x41, 0x9e, 0x3a, 0xc0, 0xbc, 0xa2, 0xbb, 0x39, 0xf4, 0x1c, 0x16, 0x93, 0x95, 0x73,
-	0xe8, 0xa6, 0x7a, 0x96, 0xce, 0x29, 0xc8, 0xeb, 0xdc, 0x9a, 0x4d, 0xa0, 0x0a, 0xa9, 0xd4, 0x8a,
-	0xaa, 0x42, 0x66, 0x8b, 0x50, 0x55, 0x21, 0x73, 0x0a, 0x4c, 0xb5, 0x39, 0x84, 0xa1, 0x99, 0x28,
-	0x49, 0x43, 0x37, 0x12, 0x2e, 0x31, 0xcb, 0xf1, 0xe6, 0x4c, 0x7c, 0xc4, 0xf3, 0x0f, 0x60, 0x39,
-	0x53, 0xea, 0x86, 0xb4, 0xd7, 0x97, 0xdc, 0x75, 0x7e, 0x70, 0x21, 0x4d, 0xc4, 0xff, 0xff, 0x43,
-	0x2b, 0x5d, 0xd2, 0x86, 0x6e, 0x2b, 0x4d, 0xf3, 0x2b, 0xe9, 0x3a, 0xda, 0x45, 0x24, 0xea, 0xaa,
-	0x25, 0x0b, 0xdc, 0xd4, 0x55, 0xcb, 0xad, 0x96, 0x53, 0x57, 0x6d, 0x46, 0x6d, 0xdc, 0x1c, 0x7a,
-	0x09, 0x4b, 0xa9, 0x1a, 0x36, 0xa4, 0x2e, 0x76, 0x6e, 0xe1, 0x5c, 0xe7, 0xf6, 0x05, 0x14, 0x11,
-	0xe7, 0x2f, 0xa0, 0x22, 0x1c, 0x3b, 0xda, 0x48, 0x2c, 0x76, 0xfc, 0x6a, 0xa2, 0xd3, 0xce, 0x22,
-	0x54, 0x75, 0x52, 0x5e, 0x3e, 0xa8, 0xea, 0x94, 0x7d, 0x7e, 0xa1, 0xaa, 0x53, 0xde, 0x73, 0x89,
-	0x39, 0xf4, 0x15, 0x2c, 0xc8, 0x2a, 0x5d, 0xd4, 0x4e, 0xd8, 0x87, 0x52, 0x8d, 0xdb, 0xb9, 0x92,
-	0x83, 0x51, 0xdd, 0x42, 0x5c, 0x13, 0xab, 0xba, 0x85, 0x4c, 0x55, 0xaf, 0xea, 0x16, 0x72, 0xca,
-	0x68, 0xe7, 0xd0, 0x36, 0x40, 0x5c, 0xc5, 0xa5, 0xb2, 0xca, 0xd4, 0x76, 0x75, 0xf2, 0x1f, 0xc9,
-	0x68, 0x73, 0x1f, 0x16, 0xd0, 0xe3, 0xa8, 0x4a, 0x2d, 0xbe, 0xc6, 0x53, 0x36, 0xca, 0xa8, 0xf4,
-	0xba, 0x93, 0xaa, 0x9f, 0xe5, 0x8d, 0x9f, 0x42, 0x2d, 0x2a, 0x1b, 0x54, 0x3d, 0x53, 0xba, 0x68,
-	0x51, 0xf5, 0x4c, 0xd9, 0x3a, 0x43, 0x31, 0x2b, 0x51, 0x51, 0x61, 0x62, 0x56, 0xd2, 0xf5, 0x87,
-	0x89, 0x59, 0xc9, 0xd6, 0x21, 0xce, 0xa1, 0x67, 0x50, 0x8b, 0x0a, 0x01, 0x55, 0x91, 0xd2, 0xe5,
-	0x89, 0xaa, 0x48, 0xd9, 0xca, 0xc1, 0xb9, 0xcd, 0x02, 0xd3, 0x3c, 0x51, 0x8e, 0xa7, 0x6a, 0x5e,
-	0xa2, 0xf2, 0xaf, 0xd3, 0xce, 0x22, 0x54, 0xaf, 0x1d, 0x55, 0xde, 0xa9, 0x82, 0xa4, 0x0b, 0xfa,
-	0x3a, 0x57, 0x73, 0x71, 0xaa, 0xce, 0xc9, 0x5a, 0x23, 0x94, 0x52, 0xf4, 0xb8, 0x48, 0x45, 0xd5,
-	0xb9, 0x54, 0x61, 0x52, 0xa4, 0xb5, 0x69, 0x0e, 0xc9, 0x1a, 0xa4, 0x94, 0xd6, 0xa6, 0x38, 0x44,
-	0x5a, 0xcb, 0x99, 0x64, 0x04, 0x56, 0xf9, 0x5c, 0xcb, 0x47, 0xaa, 0xac, 0xe2, 0x32, 0x20, 0x94,
-	0xd1, 0x8b, 0x19, 0xac, 0x72, 0x2a, 0x87, 0xb8, 0x6d, 0x2b, 0xb5, 0x40, 0x28, 0xab, 0x19, 0x2a,
-	0xb3, 0xeb, 0x33, 0xb0, 0xea, 0x7a, 0x45, 0x95, 0x3c, 0xea, 0x7a, 0xa5, 0x0b, 0x82, 0xd4, 0xf5,
-	0xca, 0x96, 0xfe, 0xf0, 0x2d, 0x27, 0x51, 0x15, 0xa4, 0x6e, 0x39, 0x79, 0x05, 0x46, 0xea, 0x96,
-	0x93, 0x5f, 0x4e, 0x14, 0x39, 0x41, 0xd7, 0x48, 0x3b, 0xc1, 0xe8, 0x5c, 0x96, 0x76, 0x82, 0xf1,
-	0x39, 0x4c, 0x4c, 0x94, 0x52, 0xc1, 0x83, 0x32, 0xf3, 0xaa, 0x56, 0x29, 0xa9, 0x13, 0x95, 0x57,
-	0xf6, 0x33, 0x27, 0xed, 0x82, 0x9d, 0xdb, 0x92, 0x76, 0x11, 0x57, 0xdf, 0xa4, 0xec, 0x42, 0xad,
-	0xb0, 0x51, 0xec, 0x82, 0x71, 0xc8, 0xd8, 0x85, 0xc2, 0xe4, 0x6a, 0x2e, 0x2e, 0x35, 0x27, 0x29,
-	0x31, 0x12, 0x15, 0x49, 0xa9, 0x39, 0x49, 0x36, 0xc7, 0xfc, 0x60, 0xab, 0xdc, 0x0b, 0xdc, 0x48,
-	0x10, 0x67, 0xea, 0x55, 0xd4, 0x65, 0xca, 0x2d, 0xf0, 0x11, 0x3c, 0x13, 0x85, 0x37, 0x2a, 0xcf,
-	0xbc, 0x8a, 0x1e, 0x95, 0x67, 0x7e, 0xc5, 0x0e, 0x8f, 0x06, 0xd2, 0xe5, 0x35, 0x6a, 0x34, 0x30,
-	0xa3, 0x9e, 0x47, 0x8d, 0x06, 0x66, 0x56, 0xe7, 0xf0, 0x50, 0x26, 0x53, 0x5b, 0xa3, 0x86, 0x32,
-	0xb3, 0x8a, 0x77, 0xd4, 0x50, 0x66, 0x76, 0x71, 0xce, 0x1c, 0x3a, 0x80, 0x86, 0x5a, 0x87, 0x83,
-	0x92, 0xf1, 0x5a, 0xba, 0xe4, 0xa4, 0x73, 0x63, 0x16, 0x5a, 0x65, 0xa8, 0x56, 0xd0, 0xa0, 0x64,
-	0x94, 0x7a, 0x11, 0xc3, 0xdc, 0xc2, 0x1b, 0x11, 0xb8, 0x24, 0x6b, 0x63, 0x50, 0x26, 0x4a, 0xcd,
-	0xb0, 0xbd, 0x7d, 0x01, 0x85, 0xba, 0x70, 0xe9, 0x62, 0x18, 0x75, 0xe1, 0x66, 0x94, 0xdd, 0x74,
-	0xb4, 0x8b, 0x48, 0x52, 0x47, 0x02, 0x99, 0x5c, 0x4b, 0x1e, 0x09, 0x12, 0xa5, 0x1d, 0xa9, 0x23,
-	0x41, 0xaa, 0x8e, 0x82, 0xf3, 0x89, 0x4a, 0x07, 0x54, 0x3e, 0xe9, 0x9a, 0x1a, 0x95, 0x4f, 0xb6,
-	0xea, 0x85, 0xaf, 0x8b, 0xfa, 0xe8, 0x5f, 0x5d, 0x97, 0x9c, 0x72, 0x18, 0x75, 0x5d, 0x72, 0x2b,
-	0x55, 0x64, 0xe0, 0xae, 0xbc, 0xe2, 0x4f, 0x06, 0xee, 0xd9, 0x1a, 0x96, 0x64, 0xe0, 0x9e, 0x57,
-	0x34, 0x32, 0x87, 0x4c, 0x5e, 0x2c, 0x96, 0xc9, 0x1e, 0xfe, 0x30, 0x67, 0x8a, 0x32, 0x25, 0x09,
-	0x9d, 0x3b, 0xaf, 0xa1, 0x52, 0x7b, 0xc9, 0xa9, 0xc6, 0x50, 0x7b, 0x99, 0x5d, 0x06, 0xa2, 0xf6,
-	0x72, 0x51, 0x49, 0xc7, 0x1c, 0x9a, 0x84, 0x25, 0x63, 0x99, 0x8e, 0xee, 0xe6, 0xcf, 0x6d, 0xb6,
-	0xaf, 0xcd, 0xd7, 0x13, 0x46, 0xdd, 0x79, 0x51, 0x9d, 0x58, 0x36, 0xf9, 0x3a, 0x63, 0xe2, 0xb3,
-	0x1d, 0xde, 0xbb, 0x04, 0xa5, 0x1a, 0x27, 0xc4, 0x09, 0x1d, 0x74, 0x35, 0x1d, 0xe2, 0x2b, 0x49,
-	0xa2, 0xce, 0xb5, 0x7c, 0x64, 0xc8, 0xea, 0xa8, 0xc2, 0xff, 0xa3, 0xd1, 0xc7, 0xff, 0x15, 0x00,
-	0x00, 0xff, 0xff, 0xb0, 0xe5, 0xc8, 0x1b, 0xe0, 0x48, 0x00, 0x00,
+	// 8097 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7d, 0x4d, 0x73, 0x1c, 0x57,
+	0x92, 0x98, 0xfa, 0x03, 0x8d, 0xee, 0xec, 0x6e, 0x74, 0xe3, 0x01, 0x20, 0x9a, 0xc5, 0xef, 0x9a,
+	0x91, 0x48, 0x51, 0x12, 0x25, 0x51, 0x1a, 0x6a, 0x3d, 0x1a, 0xcd, 0x4c, 0x13, 0x68, 0x82, 0x98,
+	0xc1, 0x97, 0x8a, 0x20, 0x57, 0x5a, 0xaf, 0x5d, 0x5b, 0xe8, 0x7a, 0x0d, 0x94, 0xd4, 0x5d, 0x55,
+	0xac, 0xaa, 0x86, 0x48, 0x3b, 0xc2, 0x1b, 0x5e, 0x9f, 0x1c, 0x8e, 0x0d, 0x1f, 0x1c, 0xe1, 0xf0,
+	0xc1, 0x07, 0xdf, 0x1c, 0xde, 0x3f, 0x60, 0x87, 0x4f, 0xde, 0xc3, 0xae, 0x1d, 0xe1, 0x83, 0xfd,
+	0x0b, 0xec, 0x08, 0x1f, 0x7c, 0xd8, 0x08, 0xdf, 0xfc, 0x03, 0x1c, 0xf9, 0xbe, 0xea, 0xd5, 0x47,
+	0x83, 0xa4, 0x46, 0x33, 0xe3, 0xbd, 0x90, 0xfd, 0x32, 0xf3, 0xe5, 0xcb, 0xf7, 0x95, 0x2f, 0x5f,
+	0x66, 0xd6, 0x03, 0xb4, 0x4f, 0x83, 0x93, 0xd3, 0xf0, 0x5e, 0x18, 0x05, 0x49, 0x40, 0x9a, 0xac,
+	0xe0, 0x84, 0x9e, 0xf9, 0x4b, 0x20, 0x3b, 0x34, 0x39, 0xa0, 0xde, 0xe9, 0xd9, 0x49, 0x10, 0x59,
+	0xf4, 0xf9, 0x9c, 0xc6, 0x09, 0xb9, 0x0b, 0x7d, 0xea, 0x3b, 0x27, 0x53, 0x3a, 0x74, 0xcf, 0x69,
+	0x94, 0x78, 0x31, 0x75, 0x07, 0x95, 0x9b, 0x95, 0x3b, 0x4d, 0xab, 0x00, 0x37, 0x3f, 0x87, 0xb5,
+	0x0c, 0x87, 0x38, 0x0c, 0xfc, 0x98, 0x92, 0x1f, 0xc3, 0x52, 0x48, 0x69, 0x14, 0x0f, 0x2a, 0x37,
+	0x6b, 0x77, 0xda, 0xf7, 0x57, 0xee, 0xc9, 0x26, 0xef, 0x1d, 0x51, 0x1a, 0x59, 0x1c, 0x69, 0x9e,
+	0x42, 0x6b, 0x18, 0x9d, 0xce, 0x67, 0xd4, 0x4f, 0x62, 0x72, 0x0f, 0x9a, 0x11, 0x8d, 0x83, 0x79,
+	0x34, 0xa6, 0xac, 0xb5, 0x95, 0xfb, 0x24, 0xad, 0x65, 0x09, 0x8c, 0xa5, 0x68, 0xc8, 0x25, 0x68,
+	0x4c, 0x9c, 0x99, 0x37, 0x7d, 0x39, 0xa8, 0xde, 0xac, 0xdc, 0xe9, 0x5a, 0xa2, 0x44, 0x08, 0xd4,
+	0x7d, 0x67, 0x46, 0x07, 0xb5, 0x9b, 0x95, 0x3b, 0x2d, 0x8b, 0xfd, 0x36, 0xff, 0x21, 0xac, 0x0c,
+	0x5d, 0xf7, 0xc8, 0x49, 0xce, 0x64, 0x1f, 0xdf, 0xb4, 0xb5, 0x0d, 0x68, 0x9c, 0x47, 0x13, 0xdb,
+	0x73, 0x59, 0x6b, 0x2d, 0x6b, 0xe9, 0x3c, 0x9a, 0xec, 0xba, 0xc4, 0x84, 0x7a, 0xe8, 0x24, 0x67,
+	0xac, 0xb1, 0x6c, 0x37, 0xb1, 0x2d, 0x86, 0x33, 0xdf, 0x86, 0x9e, 0x6a, 0x5c, 0x0c, 0x0f, 0x81,
+	0xfa, 0x7c, 0xee, 0xf1, 0x51, 0xed, 0x58, 0xec, 0xb7, 0xf9, 0x17, 0x15, 0x58, 0xdd, 0xa6, 0x53,
+	0x9a, 0xd0, 0xdf, 0x82, 0x9c, 0xe9, 0x60, 0xd5, 0x32, 0x83, 0x25, 0xe5, 0xaf, 0x2f, 0x96, 0x5f,
+	0x09, 0xbb, 0xa4, 0x09, 0xbb, 0x0e, 0x44, 0x97, 0x95, 0x77, 0xcb, 0x7c, 0x06, 0x64, 0xe8, 0xba,
+	0xf9, 0xe5, 0x84, 0x6d, 0x50, 0x1a, 0x0d, 0x2a, 0x85, 0x36, 0x70, 0x29, 0x30, 0x1c, 0xb9, 0x0a,
+	0xad, 0xb1, 0xe3, 0xbb, 0x9e, 0xeb, 0x24, 0x94, 0x49, 0xde, 0xb4, 0x52, 0x80, 0xb9, 0x01, 0x6b,
+	0x19, 0xbe, 0xa2, 0xb9, 0xaf, 0x61, 0x83, 0x0b, 0xf1, 0xc3, 0xb7, 0x38, 0x80, 0x4b, 0x79, 0xd6,
+	0xaa, 0x8f, 0xeb, 0x16, 0x8d, 0x8b, 0x9b, 0x66, 0x00, 0xcb, 0x8e, 0xeb, 0x46, 0x34, 0x8e, 0x59,
+	0xb3, 0x2d, 0x4b, 0x16, 0xc9, 0x8f, 0xa1, 0x3b, 0x0e, 0x66, 0xb3, 0xb9, 0xef, 0x8d, 0x9d, 0xc4,
+	0x0b, 0x7c, 0x31, 0x33, 0x59, 0xa0, 0xb9, 0x09, 0x1b, 0x39, 0xbe, 0xa2, 0xc1, 0xff, 0x58, 0x81,
+	0xc1, 0x93, 0x60, 0x92, 0xbc, 0x61, 0xab, 0x4f, 0xa0, 0xe5, 0x7a, 0x11, 0x1d, 0xab, 0x16, 0x57,
+	0xee, 0xff, 0x24, 0x1d, 0x88, 0x45, 0x0c, 0x53, 0xc4, 0xb6, 0xac, 0x6c, 0xa5, 0x7c, 0xcc, 0x0f,
+	0x81, 0x14, 0x09, 0x48, 0x03, 0xaa, 0xbb, 0x07, 0xfd, 0xb7, 0xc8, 0x32, 0xd4, 0x0e, 0x9f, 0x1e,
+	0xf7, 0x2b, 0xa4, 0x09, 0xf5, 0x87, 0x87, 0xc7, 0x8f, 0xfb, 0x55, 0xf3, 0x0a, 0x5c, 0x2e, 0x69,
+	0x4a, 0xcd, 0xdf, 0xe6, 0x93, 0xb3, 0x79, 0xe2, 0x06, 0xdf, 0xf9, 0x3f, 0xf4, 0x68, 0x1a, 0x30,
+	0x28, 0xb2, 0x16, 0xcd, 0x7e, 0x0c, 0x1b, 0x23, 0xa6, 0xc6, 0x5e, 0xbb, 0x51, 0x5c, 0x0e, 0xf9,
+	0x2a, 0x82, 0xd9, 0x57, 0x70, 0x69, 0xdb, 0x8b, 0xdf, 0x88, 0xdb, 0x6b, 0x76, 0xe1, 0x32, 0x6c,
+	0x16, 0x38, 0x8b, 0x46, 0x4f, 0xa1, 0xcf, 0xc5, 0xd9, 0x8f, 0x12, 0xd9, 0xdc, 0x15, 0x68, 0xb9,
+	0xf3, 0x59, 0x68, 0x27, 0x2f, 0x43, 0xae, 0x29, 0x96, 0xac, 0x26, 0x02, 0x8e, 0x5f, 0x86, 0x94,
+	0x18, 0xd0, 0x9c, 0x78, 0x53, 0xca, 0xf4, 0x22, 0x6f, 0x4c, 0x95, 0x11, 0xe7, 0xf9, 0x09, 0x8d,
+	0xce, 0x9d, 0x29, 0x53, 0x0e, 0x75, 0x4b, 0x95, 0xcd, 0x35, 0x58, 0xd5, 0x1a, 0x12, 0xad, 0xaf,
+	0xc1, 0xaa, 0x10, 0x2c, 0x6d, 0x9e, 0x29, 0x04, 0x2f, 0xce, 0x93, 0xfe, 0x29, 0xf4, 0x77, 0xfd,
+	0x6f, 0xe8, 0x38, 0xd1, 0x04, 0xfd, 0x81, 0x34, 0x1a, 0x9e, 0x30, 0x4e, 0x72, 0x16, 0x0f, 0x6a,
+	0x85, 0x13, 0x06, 0x55, 0x12, 0x47, 0xa2, 0xac, 0x9a, 0x00, 0x42, 0xaa, 0xff, 0x50, 0x85, 0xee,
+	0xd0, 0x75, 0x1f, 0xce, 0xc2, 0x57, 0xcf, 0x15, 0x81, 0x7a, 0x18, 0x44, 0x89, 0x38, 0x63, 0xd8,
+	0x6f, 0xf2, 0x33, 0xa8, 0xb3, 0x51, 0xae, 0x31, 0xe9, 0xef, 0xa4, 0x2d, 0x67, 0x98, 0xde, 0xdb,
+	0x0f, 0x7c, 0x2f, 0x09, 0x22, 0xcf, 0x3f, 0x3d, 0x0a, 0xa6, 0xde, 0xf8, 0xa5, 0xc5, 0x6a, 0x91,
+	0x4d, 0x58, 0x9e, 0x06, 0x63, 0x3b, 0xf2, 0x4e, 0x98, 0xd6, 0x6d, 0x5a, 0x8d, 0x69, 0x30, 0xb6,
+	0xbc, 0x13, 0x72, 0x1d, 0xda, 0x8e, 0xfb, 0x0d, 0x22, 0xec, 0x60, 0x9e, 0x30, 0x75, 0xdb, 0xb4,
+	0x5a, 0x8e, 0xfb, 0x8d, 0xe5, 0x9d, 0x1c, 0xce, 0x13, 0xf2, 0x25, 0xf4, 0x35, 0x3c, 0x9f, 0xe8,
+	0xc6, 0x1b, 0x8a, 0xd0, 0x55, 0xec, 0x70, 0x5d, 0x98, 0x1f, 0x42, 0x3f, 0x4f, 0x82, 0xbb, 0xf8,
+	0xc8, 0x1a, 0xf5, 0xdf, 0xc2, 0x5d, 0x7c, 0x74, 0xf8, 0x24, 0xbb, 0x9f, 0xfb, 0xb0, 0x22, 0x5b,
+	0x10, 0x83, 0xf9, 0x4b, 0xe8, 0x73, 0x4d, 0xf9, 0x7d, 0x87, 0x93, 0xad, 0xa7, 0x94, 0x83, 0x60,
+	0xfb, 0xaf, 0x2a, 0xb0, 0x2a, 0x44, 0xb3, 0xbc, 0x13, 0xc9, 0xf8, 0x6d, 0x58, 0x4a, 0x70, 0x8d,
+	0x09, 0xcd, 0xde, 0x4b, 0xfb, 0x7d, 0x8c, 0x60, 0x8b, 0x63, 0xb1, 0xfd, 0xf1, 0x3c, 0x8a, 0xa8,
+	0x9f, 0x08, 0xcd, 0x2e, 0x8b, 0xe4, 0x06, 0xb4, 0x23, 0x1a, 0xcf, 0x67, 0xd4, 0x9e, 0x44, 0xc1,
+	0x4c, 0xac, 0x77, 0xe0, 0xa0, 0x47, 0x51, 0x30, 0x23, 0xb7, 0xa0, 0x23, 0x08, 0x68, 0x18, 0x8c,
+	0xf9, 0xc1, 0x58, 0xb7, 0x44, 0xa5, 0x11, 0x82, 0xcc, 0x11, 0x34, 0xad, 0xa3, 0x5f, 0xef, 0x6e,
+	0x05, 0xfe, 0xe4, 0x82, 0x9e, 0xb2, 0x96, 0x66, 0x41, 0x42, 0x6d, 0xd5, 0xe1, 0x96, 0x05, 0x1c,
+	0x74, 0x84, 0xdd, 0xfe, 0xd7, 0x75, 0x68, 0x21, 0x9f, 0x27, 0x89, 0x93, 0x30, 0x6b, 0x66, 0x1e,
+	0x26, 0xde, 0x8c, 0x77, 0xad, 0x66, 0x89, 0x12, 0xee, 0x4e, 0x54, 0x62, 0x0c, 0x53, 0x65, 0x18,
+	0x55, 0x26, 0x2b, 0x50, 0x9d, 0x87, 0xac, 0x0f, 0x4d, 0xab, 0x3a, 0x0f, 0x79, 0x93, 0xe3, 0x20,
+	0x72, 0x6d, 0x2f, 0x3c, 0xff, 0x94, 0x89, 0xde, 0xb5, 0x80, 0x83, 0x76, 0xc3, 0xf3, 0x4f, 0xb3,
+	0x04, 0x0f, 0x06, 0x4b, 0x39, 0x82, 0x07, 0x48, 0x10, 0x46, 0x74, 0xe2, 0xbd, 0xe0, 0x1c, 0x1a,
+	0x9c, 0x80, 0x83, 0x24, 0x87, 0x94, 0xe0, 0xc1, 0x60, 0x39, 0x47, 0xf0, 0x00, 0xfb, 0x11, 0xd3,
+	0xc8, 0x73, 0xa6, 0x83, 0x26, 0x37, 0x34, 0x78, 0x89, 0xfc, 0x08, 0xba, 0x11, 0x1d, 0x53, 0xef,
+	0x9c, 0x0a, 0xe9, 0x5a, 0xac, 0x33, 0x1d, 0x09, 0x64, 0xdc, 0x73, 0x44, 0x0f, 0x06, 0x50, 0x20,
+	0x7a, 0x80, 0x44, 0x9c, 0xa7, 0xed, 0x07, 0x89, 0x37, 0x79, 0x39, 0x68, 0x73, 0x22, 0x0e, 0x3c,
+	0x60, 0x30, 0x94, 0x73, 0xec, 0x8c, 0xcf, 0xa8, 0x1d, 0xd1, 0x98, 0x26, 0x83, 0x0e, 0x23, 0x01,
+	0x06, 0x62, 0x67, 0x11, 0x79, 0x1b, 0x56, 0x14, 0x01, 0x5b, 0x71, 0x83, 0x2e, 0xa3, 0xe9, 0x4a,
+	0x1a, 0x06, 0xc4, 0x3d, 0x49, 0x7d, 0xd7, 0x0e, 0x26, 0xb6, 0xeb, 0x24, 0xce, 0x60, 0x85, 0xd1,
+	0xb4, 0xa8, 0xef, 0x1e, 0x4e, 0xb6, 0x9d, 0xc4, 0x21, 0xeb, 0xb0, 0x44, 0xa3, 0x28, 0x88, 0x06,
+	0x3d, 0x86, 0xe1, 0x05, 0x5c, 0x44, 0x42, 0xc4, 0xe7, 0x73, 0x1a, 0xbd, 0x1c, 0xf4, 0x19, 0xb2,
+	0xcd, 0x61, 0x5f, 0x22, 0x48, 0x2c, 0x44, 0x9a, 0x08, 0x8a, 0x55, 0x2e, 0x20, 0x03, 0x31, 0x02,
+	0xf3, 0x6b, 0xa8, 0x5b, 0xe1, 0xb7, 0x1e, 0x79, 0x07, 0xea, 0xe3, 0xc0, 0x9f, 0x88, 0x15, 0xaf,
+	0xab, 0x4a, 0xb1, 0x06, 0x2d, 0x86, 0x27, 0xef, 0xc2, 0x52, 0x9c, 0x48, 0x5b, 0xa6, 0x7d, 0x7f,
+	0x2d, 0x4b, 0xc8, 0x16, 0x99, 0xc5, 0x29, 0xcc, 0x3b, 0xb0, 0xb2, 0x43, 0x13, 0xe4, 0x2e, 0xf7,
+	0x55, 0x6a, 0x1e, 0x56, 0x74, 0xf3, 0xd0, 0xfc, 0x1c, 0x7a, 0x8a, 0x52, 0x8c, 0xc8, 0x1d, 0x58,
+	0x8e, 0x69, 0x74, 0x5e, 0x6a, 0xdb, 0x33, 0x42, 0x89, 0x36, 0xff, 0x88, 0xe9, 0x0a, 0xbd, 0x99,
+	0x37, 0x53, 0xb3, 0x06, 0x34, 0xa7, 0xde, 0x84, 0xb2, 0xa5, 0x5f, 0xe3, 0x4b, 0x5f, 0x96, 0xcd,
+	0x55, 0xe8, 0x29, 0xde, 0x42, 0x63, 0x0c, 0xa5, 0x1a, 0xf9, 0xde, 0x2d, 0xa6, 0x56, 0x6d, 0x86,
+	0xf1, 0x07, 0xf2, 0x10, 0x7c, 0x2d, 0xc6, 0xc8, 0x44, 0x27, 0x17, 0x4c, 0xee, 0xa9, 0xf3, 0xf1,
+	0xf5, 0xb8, 0x6c, 0xc0, 0x5a, 0x86, 0x5e, 0xb0, 0x79, 0x1f, 0xfa, 0x6c, 0xfd, 0xbe, 0x1e, 0x93,
+	0x35, 0x58, 0xd5, 0xa8, 0x05, 0x8b, 0x8f, 0x60, 0x5d, 0x99, 0x64, 0xaf, 0xc7, 0x66, 0x13, 0x36,
+	0x72, 0x35, 0x04, 0xab, 0xff, 0x5a, 0x91, 0x7d, 0xfd, 0x23, 0x7a, 0x12, 0x39, 0x92, 0x53, 0x1f,
+	0x6a, 0xf3, 0x68, 0x2a, 0xb8, 0xe0, 0x4f, 0xb6, 0xda, 0x83, 0x79, 0x42, 0xd9, 0xa1, 0x15, 0x0f,
+	0xaa, 0x37, 0x6b, 0x4c, 0x19, 0x22, 0x08, 0xcf, 0xa1, 0x18, 0x1b, 0xc7, 0x35, 0x83, 0xc6, 0x10,
+	0xbf, 0xa0, 0xc8, 0x22, 0xf9, 0x14, 0x2e, 0xf9, 0xf4, 0x45, 0x72, 0x16, 0x84, 0x76, 0x12, 0x79,
+	0xa7, 0xa7, 0x34, 0xb2, 0xf9, 0x25, 0x54, 0x9c, 0x9e, 0xeb, 0x02, 0x7b, 0xcc, 0x91, 0x5c, 0x1c,
+	0x72, 0x1f, 0x36, 0xf2, 0xb5, 0x5c, 0x3a, 0x75, 0x5e, 0x0a, 0x9d, 0xb7, 0x96, 0xad, 0xb4, 0x8d,
+	0x28, 0x1c, 0xf2, 0x4c, 0x67, 0x44, 0x27, 0x7b, 0xd0, 0xdd, 0xa1, 0xc9, 0xb3, 0x68, 0x22, 0x4d,
+	0x9d, 0x4f, 0x60, 0x45, 0x02, 0xc4, 0x9e, 0xb8, 0x05, 0xf5, 0xf3, 0x68, 0x22, 0x37, 0x44, 0x37,
+	0xdd, 0x10, 0x48, 0xc4, 0x50, 0xe6, 0x47, 0xcc, 0xe4, 0x48, 0xb9, 0x90, 0x1b, 0x50, 0x3b, 0x8f,
+	0xe4, 0xb6, 0xce, 0x55, 0x41, 0x8c, 0x38, 0x6a, 0xb5, 0x66, 0xcc, 0x4f, 0xe4, 0x51, 0xfb, 0x26,
+	0x6c, 0xd4, 0xe9, 0xaa, 0x73, 0x1a, 0xc2, 0xfa, 0x0e, 0x4d, 0xb6, 0xe9, 0xc4, 0xf3, 0xa9, 0xfb,
+	0x84, 0x2a, 0xdb, 0xec, 0x5d, 0x61, 0xd9, 0x70, 0xbb, 0x6c, 0x23, 0x65, 0x27, 0x48, 0x71, 0xb2,
+	0xb8, 0x19, 0x63, 0x0e, 0x61, 0x23, 0xc7, 0x42, 0x29, 0x88, 0x7a, 0x4c, 0x13, 0x39, 0x18, 0xeb,
+	0x05, 0x1e, 0x48, 0xcb, 0x28, 0xcc, 0x3f, 0x86, 0xf5, 0xa1, 0xeb, 0x16, 0xa5, 0x78, 0x07, 0x6a,
+	0xa8, 0xb4, 0x79, 0x9f, 0xca, 0x19, 0x20, 0xc1, 0x2b, 0xae, 0x70, 0x9b, 0xb0, 0x91, 0xe3, 0x2e,
+	0x3a, 0xff, 0x1c, 0x36, 0xf9, 0x88, 0x7c, 0xff, 0x96, 0xfb, 0x50, 0x73, 0xa6, 0x53, 0xd1, 0x26,
+	0xfe, 0xcc, 0xca, 0x52, 0xcb, 0xcb, 0x62, 0xc0, 0xa0, 0xd8, 0xa4, 0x10, 0xe7, 0x4f, 0x60, 0x60,
+	0xd1, 0x70, 0xea, 0x8c, 0xe9, 0x6f, 0x6b, 0x24, 0xae, 0xc0, 0xe5, 0x92, 0x16, 0x44, 0xf3, 0x1b,
+	0xcc, 0x81, 0xc3, 0xce, 0x87, 0x19, 0xf5, 0x95, 0x3d, 0xff, 0x6b, 0x58, 0xcf, 0x82, 0xc5, 0xec,
+	0x7e, 0x02, 0x10, 0x4b, 0xa0, 0x9c, 0x63, 0xed, 0xac, 0x49, 0x2b, 0x68, 0x64, 0xe6, 0x63, 0x76,
+	0x7f, 0xcf, 0xb7, 0x41, 0x3e, 0x86, 0x96, 0x22, 0x12, 0x7d, 0x2c, 0x65, 0x95, 0x52, 0x99, 0x97,
+	0xd8, 0x92, 0x29, 0x88, 0x65, 0xfe, 0x3d, 0x79, 0x5f, 0xff, 0x01, 0x1a, 0x29, 0xce, 0x2e, 0xbb,
+	0x8b, 0xe5, 0xd9, 0x8b, 0x96, 0xf7, 0x60, 0x53, 0x0c, 0xee, 0x0f, 0xd1, 0x3f, 0x43, 0x2d, 0x86,
+	0x62, 0x4b, 0x04, 0xfa, 0x3b, 0x34, 0x11, 0x86, 0xbc, 0x98, 0xa6, 0x21, 0xac, 0x6a, 0x30, 0x31,
+	0x47, 0xef, 0x43, 0x33, 0x44, 0x88, 0x47, 0xe5, 0x0c, 0xf5, 0xb5, 0xdb, 0x11, 0xa7, 0x55, 0x14,
+	0xe6, 0xbf, 0xac, 0x40, 0x1f, 0xfd, 0x53, 0x3a, 0x5f, 0x72, 0x07, 0x1a, 0x8c, 0xe0, 0xa5, 0x90,
+	0xbb, 0xc8, 0x40, 0xe0, 0xc9, 0x4f, 0xe1, 0x72, 0x44, 0x27, 0xa8, 0x95, 0x5f, 0x78, 0x71, 0xe2,
+	0xf9, 0xa7, 0xb6, 0xb6, 0x3e, 0xf8, 0x10, 0x6e, 0x32, 0x82, 0x91, 0xc0, 0xab, 0x8e, 0xc5, 0xaf,
+	0xd8, 0x34, 0x6b, 0xb0, 0xaa, 0xc9, 0x25, 0x06, 0xe1, 0xdf, 0x54, 0x60, 0x4d, 0x78, 0x9e, 0xbe,
+	0xa7, 0xc0, 0x1f, 0xc2, 0x5a, 0x18, 0x51, 0x66, 0xa4, 0x14, 0x45, 0x25, 0x12, 0xa5, 0x49, 0x29,
+	0x96, 0x43, 0x6d, 0xc1, 0x66, 0xaf, 0xe7, 0xe5, 0xbe, 0x04, 0xeb, 0x59, 0x09, 0xd3, 0xd3, 0x72,
+	0x5d, 0x4c, 0xee, 0xef, 0x63, 0xb0, 0x17, 0xf4, 0xbb, 0xb6, 0xb0, 0xdf, 0x17, 0xf7, 0x92, 0xf9,
+	0xab, 0x32, 0x9d, 0x51, 0x1e, 0x11, 0x43, 0x2d, 0xc9, 0x61, 0x1c, 0x7b, 0xa7, 0xbe, 0xbe, 0x27,
+	0x7e, 0x0a, 0xe0, 0x28, 0xa0, 0xe8, 0xaf, 0x91, 0xef, 0xaf, 0x56, 0x4d, 0xa3, 0x36, 0xbf, 0x86,
+	0x2b, 0xa5, 0x9c, 0xc5, 0xb2, 0xff, 0x4d, 0x58, 0x9f, 0x83, 0xa1, 0xd6, 0xda, 0x0f, 0x2a, 0xf4,
+	0x2b, 0x54, 0xf3, 0x35, 0xb8, 0x52, 0xda, 0xae, 0x18, 0xcb, 0x7f, 0x56, 0x81, 0x6b, 0xfa, 0x5a,
+	0xfa, 0x61, 0x45, 0x7b, 0xd3, 0x53, 0xec, 0x26, 0x5c, 0x5f, 0x24, 0x8c, 0x90, 0xf7, 0x1f, 0xc0,
+	0xf5, 0xcc, 0xa2, 0xf8, 0x5d, 0x0e, 0xe5, 0x2d, 0xb8, 0xb1, 0xb0, 0xed, 0x8c, 0x06, 0x7d, 0xc2,
+	0xee, 0x27, 0x52, 0x83, 0x7e, 0x01, 0xab, 0x1a, 0x4c, 0xd9, 0x30, 0x8d, 0xd3, 0x69, 0x70, 0xe2,
+	0x4c, 0x8b, 0x3b, 0x72, 0x87, 0xc1, 0x2d, 0x81, 0x37, 0x7f, 0x0e, 0xe4, 0x49, 0xe2, 0x44, 0x59,
+	0xa6, 0x6f, 0x50, 0x7f, 0x03, 0xd6, 0x32, 0xf5, 0x53, 0x1f, 0xdb, 0x93, 0x24, 0x08, 0xb3, 0xa2,
+	0xae, 0x03, 0xd1, 0x81, 0x82, 0xf4, 0xdf, 0xd5, 0xa1, 0x7e, 0x24, 0xfc, 0xf4, 0xfe, 0x34, 0xf2,
+	0x64, 0x50, 0x01, 0x7f, 0xe3, 0xc5, 0x2e, 0x74, 0x92, 0x24, 0xe2, 0x36, 0x77, 0xc7, 0x12, 0x25,
+	0x36, 0xf5, 0xa7, 0xf2, 0x5a, 0x85, 0x3f, 0xb1, 0xf6, 0x09, 0x8d, 0x13, 0xb1, 0xd1, 0xd9, 0x6f,
+	0x34, 0xdb, 0xbd, 0xd8, 0xfe, 0xce, 0x4b, 0xce, 0xdc, 0xc8, 0xf9, 0x4e, 0x78, 0xa4, 0xc0, 0x8b,
+	0xff, 0x50, 0x40, 0xc8, 0x75, 0x80, 0x73, 0x67, 0x8a, 0xe3, 0x8f, 0x96, 0x7b, 0x83, 0x79, 0x1d,
+	0x35, 0x08, 0xf9, 0x08, 0xd6, 0xfd, 0xc0, 0xf6, 0x66, 0x21, 0x9e, 0x35, 0x49, 0xca, 0x69, 0x99,
+	0x2b, 0x1d, 0x3f, 0xd8, 0x15, 0x28, 0xc5, 0x31, 0xbd, 0x89, 0x36, 0x33, 0x81, 0x8a, 0x6b, 0x00,
+	0xdc, 0x1f, 0x68, 0x3b, 0xb1, 0xcf, 0x9c, 0x07, 0x5d, 0xab, 0xc5, 0x21, 0xc3, 0xd8, 0x47, 0xef,
+	0xa7, 0x40, 0x7b, 0x2e, 0xf3, 0x1a, 0xb4, 0xac, 0x26, 0x07, 0xec, 0xba, 0xc2, 0xfb, 0x99, 0xd0,
+	0x88, 0xba, 0xcc, 0x59, 0xd0, 0xb4, 0x54, 0x19, 0x2f, 0xf0, 0x71, 0xe2, 0x4c, 0x29, 0x73, 0x11,
+	0x34, 0x2d, 0x5e, 0x20, 0x77, 0xa0, 0xef, 0xc5, 0xcc, 0x45, 0x64, 0xd3, 0x17, 0x09, 0x8d, 0x7c,
+	0x67, 0xca, 0xfc, 0x03, 0x4d, 0x6b, 0xc5, 0x8b, 0xd1, 0x4f, 0x34, 0x12, 0x50, 0x1c, 0x22, 0x5f,
+	0xb8, 0x67, 0x6d, 0x2f, 0x64, 0x0e, 0x82, 0x96, 0x05, 0x12, 0xb4, 0x1b, 0xaa, 0xe8, 0x49, 0x2f,
+	0x8d, 0x9e, 0x90, 0xf7, 0x81, 0x78, 0xb1, 0x2d, 0x2f, 0x28, 0x9e, 0xcf, 0x46, 0x8c, 0x79, 0x09,
+	0x9a, 0x56, 0xdf, 0x8b, 0x0f, 0x38, 0x62, 0x97, 0xc3, 0x71, 0x90, 0x3d, 0x97, 0xfa, 0x89, 0x37,
+	0xf1, 0x68, 0xc4, 0x3c, 0x05, 0x5d, 0x4b, 0x83, 0x90, 0x77, 0xa1, 0x3f, 0x0d, 0xc6, 0xce, 0xd4,
+	0xd6, 0xa8, 0x08, 0xa3, 0xea, 0x31, 0xf8, 0xae, 0x02, 0x9b, 0xff, 0xa7, 0x02, 0xed, 0x6d, 0x8a,
+	0x27, 0x03, 0x9f, 0x1f, 0x5c, 0x1e, 0xcc, 0x77, 0x23, 0x2e, 0x6b, 0xa2, 0x94, 0x3a, 0x57, 0xab,
+	0x17, 0x38, 0x57, 0xc9, 0x6d, 0xe8, 0x4d, 0x03, 0x1f, 0xef, 0x56, 0xbc, 0x1a, 0x95, 0xa7, 0xc9,
+	0x0a, 0x07, 0x1f, 0x09, 0x28, 0x4a, 0x18, 0x9f, 0x05, 0x51, 0xa2, 0x53, 0xf2, 0x75, 0xd6, 0x13,
+	0x70, 0x45, 0x6a, 0x40, 0x33, 0xc6, 0xe5, 0xee, 0x8f, 0x29, 0x5b, 0x6f, 0x75, 0x4b, 0x95, 0x19,
+	0xce, 0x77, 0xc2, 0xf8, 0x2c, 0x48, 0xd8, 0x5a, 0x6b, 0x5a, 0xaa, 0x8c, 0xf3, 0xc8, 0x1d, 0x76,
+	0xcb, 0xac, 0x12, 0x2f, 0x98, 0xff, 0xbe, 0x02, 0x4b, 0xcc, 0x33, 0x88, 0x6e, 0x14, 0xed, 0x66,
+	0x53, 0xe6, 0x71, 0x66, 0x78, 0x15, 0x3d, 0xac, 0xa6, 0xd1, 0xc3, 0x85, 0xc1, 0xb3, 0xbf, 0x03,
+	0x1d, 0x37, 0x1d, 0x4c, 0xec, 0x12, 0x0e, 0x56, 0xe6, 0xd6, 0xa4, 0xb0, 0x56, 0x86, 0x94, 0xf9,
+	0xd1, 0x82, 0x38, 0xb1, 0xc5, 0xb9, 0x2f, 0x76, 0x16, 0x82, 0xb8, 0xf2, 0x32, 0x1f, 0xb0, 0x5b,
+	0xe7, 0x1b, 0xbb, 0x3e, 0xcd, 0xcf, 0x60, 0x45, 0xd6, 0x13, 0xba, 0xec, 0x35, 0x2b, 0x4e, 0x81,
+	0x3c, 0xe3, 0x1b, 0x97, 0x6a, 0xad, 0xbe, 0xee, 0xb0, 0x2d, 0x0a, 0xc6, 0xa6, 0x0b, 0xac, 0xa6,
+	0x2f, 0x30, 0x54, 0x7b, 0x99, 0xd6, 0x84, 0x2e, 0xfb, 0xdf, 0xa8, 0xcb, 0x28, 0x8d, 0xd8, 0x96,
+	0x45, 0x0e, 0xd2, 0x84, 0xed, 0x5a, 0xaa, 0x4c, 0xfe, 0x00, 0x3a, 0x4e, 0x18, 0x4e, 0x5f, 0xca,
+	0xc1, 0xe3, 0x0e, 0x2f, 0x6d, 0xd8, 0x87, 0x88, 0x15, 0x56, 0x49, 0xdb, 0x49, 0x0b, 0xca, 0x97,
+	0x56, 0xcb, 0xfb, 0xd2, 0xb0, 0x4d, 0xcd, 0x97, 0xf6, 0x39, 0x74, 0xe9, 0xc9, 0x69, 0x68, 0xcf,
+	0xe6, 0xd3, 0xc4, 0x3b, 0x0b, 0x42, 0x11, 0x1e, 0xbd, 0x94, 0x56, 0x18, 0x9d, 0x9c, 0x86, 0xfb,
+	0x02, 0x6b, 0x75, 0xa8, 0x56, 0x22, 0x43, 0xe8, 0x71, 0x5f, 0x47, 0x44, 0x27, 0x53, 0x3a, 0x4e,
+	0x82, 0x88, 0x4d, 0x6f, 0xfb, 0xfe, 0x40, 0x1b, 0x3d, 0x24, 0xb0, 0x24, 0xde, 0x5a, 0x89, 0x32,
+	0x65, 0x72, 0x1b, 0xea, 0x9e, 0x3f, 0x09, 0x06, 0x8d, 0xfc, 0x9d, 0x01, 0xe5, 0xe4, 0xae, 0x3c,
+	0x46, 0x80, 0xe7, 0x4c, 0xe2, 0xcd, 0xd0, 0x17, 0xb7, 0x9c, 0x3f, 0x67, 0x8e, 0x19, 0xdc, 0x12,
+	0x78, 0xbc, 0x8b, 0x24, 0x91, 0xe3, 0xc7, 0xcc, 0xe7, 0xd5, 0xcc, 0xf3, 0x3d, 0x96, 0x28, 0x2b,
+	0xa5, 0xc2, 0x71, 0xe6, 0x1d, 0xe1, 0x0e, 0xbd, 0x41, 0x2b, 0x3f, 0xce, 0xac, 0x17, 0xe2, 0x34,
+	0x6a, 0x47, 0x69, 0x81, 0xfc, 0x02, 0x7a, 0x4e, 0x6c, 0xa3, 0x92, 0xb0, 0x83, 0x90, 0xef, 0x0d,
+	0x60, 0x95, 0x37, 0xb5, 0x49, 0x8a, 0x51, 0x95, 0x1c, 0x72, 0xb4, 0xd5, 0x75, 0xf4, 0x22, 0xf9,
+	0x39, 0xac, 0x30, 0x4f, 0xaa, 0x7d, 0xe6, 0xf8, 0xee, 0xd4, 0xf3, 0x4f, 0x07, 0xed, 0x7c, 0xfd,
+	0x11, 0xe2, 0x1f, 0x0b, 0xb4, 0xd5, 0xa5, 0x7a, 0x11, 0xbd, 0x22, 0x27, 0x13, 0x77, 0xd0, 0xc9,
+	0x7b, 0x45, 0x1e, 0x4e, 0x5c, 0x0b, 0x31, 0xe6, 0x7f, 0xa9, 0x40, 0x5b, 0x5b, 0x26, 0xe4, 0x33,
+	0x68, 0x79, 0xbe, 0x9d, 0xb1, 0xc2, 0x2f, 0xb2, 0x4a, 0x9a, 0x9e, 0x2f, 0x2a, 0xfe, 0x02, 0xba,
+	0xf4, 0x05, 0x0e, 0x57, 0x76, 0x35, 0x5e, 0x54, 0xb9, 0xc3, 0x2b, 0xa4, 0x0c, 0xbc, 0x99, 0xce,
+	0xa0, 0xf6, 0x6a, 0x06, 0xbc, 0x82, 0xd0, 0x14, 0xff, 0x08, 0xda, 0x5c, 0x7b, 0xee, 0x79, 0x33,
+	0x6f, 0xa1, 0x2b, 0x17, 0x7d, 0xd2, 0x33, 0xe7, 0x45, 0xaa, 0x7f, 0xf9, 0x3e, 0x6d, 0xcf, 0x9c,
+	0x17, 0x4a, 0xf7, 0x7e, 0x0a, 0x97, 0x62, 0x11, 0x34, 0xb5, 0x93, 0xb3, 0x88, 0xc6, 0x67, 0xc1,
+	0xd4, 0xb5, 0xc3, 0x71, 0x22, 0xf4, 0xde, 0xba, 0xc4, 0x1e, 0x4b, 0xe4, 0xd1, 0x38, 0x31, 0xff,
+	0x69, 0x03, 0x9a, 0x72, 0xff, 0xa0, 0x73, 0xde, 0x99, 0x27, 0x67, 0x76, 0xe8, 0xc4, 0xf1, 0x77,
+	0x41, 0xe4, 0x8a, 0x73, 0xa5, 0x83, 0xc0, 0x23, 0x01, 0x23, 0x37, 0xa1, 0xed, 0xd2, 0x78, 0x1c,
+	0x79, 0xa1, 0x16, 0xfd, 0xd4, 0x41, 0xe4, 0x32, 0x34, 0xf9, 0x91, 0xe6, 0xc4, 0xd2, 0x1f, 0xc8,
+	0xca, 0x43, 0x76, 0x96, 0xa8, 0x03, 0x57, 0xfa, 0x2b, 0xeb, 0x8c, 0x43, 0x4f, 0xc2, 0x87, 0x1c,
+	0x8c, 0x91, 0xb6, 0x90, 0xd2, 0x08, 0x99, 0x70, 0xb7, 0x5f, 0x03, 0x8b, 0xc3, 0x18, 0x8d, 0x09,
+	0x86, 0x38, 0x8d, 0x82, 0x79, 0xc8, 0x76, 0x59, 0xcb, 0x6a, 0x21, 0x64, 0x07, 0x01, 0x68, 0x4c,
+	0x30, 0x34, 0xd3, 0x7c, 0x3c, 0xc4, 0xd1, 0x44, 0x00, 0x0b, 0xa5, 0xde, 0x85, 0x55, 0x0c, 0xe2,
+	0x9c, 0x53, 0x3b, 0x8c, 0xbc, 0x73, 0x27, 0x41, 0x83, 0x44, 0xd8, 0x2a, 0x3d, 0x8e, 0x38, 0xe2,
+	0xf0, 0x61, 0x8c, 0xe7, 0x3c, 0xdf, 0x41, 0x93, 0xa9, 0x13, 0xda, 0xae, 0x33, 0x0b, 0x71, 0x29,
+	0xb7, 0xf8, 0x39, 0xcf, 0x30, 0x8f, 0xa6, 0x4e, 0xb8, 0xcd, 0xe1, 0x18, 0x92, 0x88, 0x31, 0xd8,
+	0x20, 0xc2, 0xc0, 0xc9, 0x4b, 0xb6, 0x69, 0xba, 0x56, 0x17, 0xa1, 0x5b, 0x12, 0x88, 0xc2, 0x8b,
+	0xc0, 0xd2, 0xd8, 0x09, 0x07, 0x6d, 0x66, 0xd6, 0xb5, 0x38, 0x64, 0xcb, 0x61, 0xc2, 0xf3, 0xa1,
+	0x43, 0x6c, 0x87, 0x61, 0xf9, 0x58, 0x22, 0x72, 0x05, 0xaa, 0x9e, 0xcb, 0x2c, 0x99, 0x96, 0x55,
+	0xf5, 0x5c, 0xf2, 0x53, 0xe8, 0x8a, 0x70, 0xce, 0x14, 0x17, 0x4f, 0x3c, 0x58, 0xc9, 0x1f, 0x61,
+	0xda, 0xd2, 0xb2, 0x3a, 0x61, 0x5a, 0x88, 0x71, 0xaa, 0xc5, 0x1c, 0x89, 0x59, 0xe8, 0xf1, 0xa9,
+	0xe6, 0x13, 0x25, 0xa6, 0xe0, 0x03, 0x20, 0xa9, 0x79, 0xe4, 0x27, 0x34, 0x9a, 0x38, 0x63, 0xca,
+	0x2c, 0x9d, 0x96, 0xb5, 0xaa, 0xac, 0x24, 0x89, 0x20, 0x7d, 0xee, 0xcd, 0x5c, 0x65, 0x78, 0xfc,
+	0x49, 0x76, 0x81, 0xb8, 0x74, 0xe2, 0xcc, 0xa7, 0x89, 0x1d, 0x44, 0xde, 0x29, 0x1e, 0xa0, 0x34,
+	0x1e, 0x90, 0x9b, 0xb5, 0xec, 0x1e, 0xd9, 0xe6, 0x34, 0x87, 0x92, 0xc4, 0x5a, 0x75, 0x73, 0x90,
+	0x98, 0x38, 0x60, 0x8c, 0x03, 0xdf, 0xf5, 0x70, 0x85, 0x31, 0xb1, 0x45, 0x12, 0x13, 0xbf, 0x07,
+	0xaf, 0x31, 0x96, 0x66, 0xca, 0x72, 0x2b, 0xa5, 0x1d, 0xea, 0xa4, 0xd6, 0xe5, 0xf1, 0x02, 0x4c,
+	0x6c, 0xfe, 0x1a, 0x3a, 0xfa, 0xc9, 0x80, 0x6e, 0x6d, 0xee, 0xac, 0x96, 0x09, 0x54, 0xb2, 0xc8,
+	0xb6, 0xa3, 0xa0, 0xb2, 0x93, 0x64, 0xaa, 0xb6, 0xa3, 0x80, 0x1d, 0x27, 0x53, 0xf3, 0x9f, 0x54,
+	0x60, 0x25, 0x7b, 0x50, 0xe0, 0x0e, 0xcd, 0x9d, 0x2d, 0xf6, 0x78, 0xea, 0xc9, 0x9b, 0x54, 0xd3,
+	0x5a, 0xcf, 0x1e, 0x24, 0x5b, 0x0c, 0x47, 0x3e, 0x07, 0xa3, 0x58, 0x6b, 0x1e, 0xa3, 0x39, 0xa6,
+	0xa2, 0xea, 0x9b, 0xf9, 0x9a, 0x0c, 0xbf, 0xeb, 0x9a, 0x7f, 0xd9, 0x84, 0x96, 0x3a, 0x76, 0x7e,
+	0x07, 0xfb, 0xfb, 0x1e, 0x34, 0x67, 0x34, 0x8e, 0x9d, 0x53, 0x61, 0x23, 0x66, 0xce, 0xe9, 0x7d,
+	0x81, 0xb1, 0x14, 0x4d, 0xa9, 0x3e, 0x58, 0x7a, 0xa5, 0x3e, 0x68, 0x5c, 0xa0, 0x0f, 0x96, 0x2f,
+	0xd4, 0x07, 0xcd, 0x9c, 0x3e, 0xb8, 0x03, 0x8d, 0xe7, 0x73, 0x3a, 0xa7, 0xf1, 0xa0, 0x95, 0x3f,
+	0x82, 0xbf, 0x64, 0x70, 0x4b, 0xe0, 0xcb, 0x35, 0x07, 0xbc, 0x89, 0xe6, 0x68, 0xbf, 0xb6, 0xe6,
+	0xe8, 0x94, 0x69, 0x0e, 0x16, 0x39, 0x8d, 0x31, 0xaa, 0xc2, 0x1d, 0x40, 0x4c, 0x11, 0x74, 0xad,
+	0x8e, 0x00, 0xf2, 0x19, 0xfe, 0x09, 0x5c, 0x8a, 0xe7, 0x21, 0x9e, 0x2f, 0xd4, 0x45, 0x1d, 0xe2,
+	0x9c, 0x78, 0x53, 0x2f, 0xf1, 0x28, 0xd7, 0x0d, 0x2d, 0x6b, 0x43, 0x61, 0xb7, 0x34, 0x24, 0x8e,
+	0x11, 0x5a, 0x4c, 0x9c, 0x2f, 0xd7, 0x04, 0xcd, 0x93, 0xd3, 0x90, 0xf3, 0xfc, 0x05, 0x66, 0x36,
+	0xcc, 0x3c, 0xd9, 0x6c, 0x9f, 0x19, 0x93, 0xd7, 0x4b, 0xcc, 0x9a, 0x7b, 0x43, 0x24, 0x63, 0x3f,
+	0x2d, 0x70, 0xd4, 0x6f, 0x34, 0x07, 0x65, 0x0c, 0x58, 0x5c, 0x80, 0x54, 0x19, 0x71, 0xce, 0x78,
+	0x4c, 0xc3, 0x84, 0xba, 0xe2, 0xda, 0xa3, 0xca, 0x78, 0x75, 0x72, 0xd2, 0x1c, 0xc6, 0x35, 0x86,
+	0xd5, 0x20, 0x64, 0x0d, 0x96, 0x30, 0x95, 0xe2, 0xf9, 0x60, 0x9d, 0xa1, 0xea, 0xc1, 0x3c, 0xf9,
+	0x12, 0xaf, 0x12, 0x93, 0x69, 0x10, 0xc6, 0x83, 0x0d, 0x06, 0xe4, 0x05, 0x74, 0xbd, 0xa1, 0x8d,
+	0xe1, 0xd3, 0x60, 0x1e, 0xdb, 0xf3, 0xd0, 0xc5, 0xf9, 0x53, 0x0b, 0xf5, 0x12, 0xa3, 0xdc, 0x54,
+	0x04, 0x4f, 0x19, 0x5e, 0xae, 0x56, 0x72, 0x0f, 0xd6, 0xe4, 0xc0, 0xf3, 0xa0, 0xef, 0x38, 0x98,
+	0xfb, 0xc9, 0x60, 0x93, 0xd5, 0x5a, 0x15, 0x28, 0x16, 0x5e, 0xdb, 0x42, 0x04, 0xf9, 0x04, 0x2e,
+	0x39, 0x13, 0xcf, 0x8e, 0xf1, 0x1f, 0x97, 0x47, 0x01, 0x45, 0x95, 0x01, 0x0f, 0x5f, 0x39, 0x13,
+	0xef, 0x89, 0x33, 0xf1, 0x44, 0x84, 0x90, 0x57, 0xfa, 0x09, 0x6c, 0x26, 0x11, 0x75, 0x12, 0xdb,
+	0x49, 0xaf, 0xec, 0xa2, 0xd6, 0x65, 0x7e, 0x7c, 0x33, 0xf4, 0x50, 0xdd, 0xde, 0x79, 0xb5, 0x07,
+	0xb0, 0xe9, 0x24, 0x49, 0xe4, 0x9d, 0xe0, 0x6a, 0x73, 0xbd, 0x78, 0xec, 0x44, 0xae, 0xa8, 0x66,
+	0xb0, 0x6a, 0x1b, 0x0a, 0xbd, 0xcd, 0xb1, 0xac, 0x9e, 0x79, 0x17, 0x20, 0x9d, 0x2c, 0x4c, 0x01,
+	0x7b, 0x7a, 0xc4, 0x73, 0x46, 0xb6, 0x0f, 0xff, 0xf0, 0xa0, 0x5f, 0x21, 0x00, 0x8d, 0xa3, 0x47,
+	0x5f, 0xd9, 0x5b, 0xc7, 0xfd, 0xaa, 0xf9, 0x27, 0xd0, 0x54, 0x63, 0xf1, 0x81, 0x36, 0x95, 0xdc,
+	0xd0, 0x5a, 0x2d, 0xec, 0x6f, 0x6d, 0x76, 0xdf, 0xc6, 0x68, 0x92, 0xc8, 0xe3, 0x28, 0x25, 0x65,
+	0x68, 0xf3, 0xaf, 0x2a, 0xb0, 0x2c, 0x20, 0xc4, 0x84, 0xce, 0xc1, 0xe1, 0xf1, 0xee, 0xa3, 0xdd,
+	0xad, 0xe1, 0xf1, 0xee, 0xe1, 0x01, 0x6b, 0xa5, 0x6e, 0x65, 0x60, 0x68, 0x25, 0x3d, 0x3d, 0xda,
+	0x1e, 0x1e, 0x8f, 0x18, 0xe3, 0xba, 0x25, 0x4a, 0x78, 0xfd, 0x3b, 0x3c, 0x1a, 0x1d, 0x88, 0xc4,
+	0x10, 0xf6, 0x1b, 0xdd, 0x4e, 0xbf, 0x1e, 0x8d, 0x8e, 0x86, 0x7b, 0xbb, 0xcf, 0x46, 0x22, 0x1f,
+	0x24, 0x05, 0xa0, 0x8a, 0xb7, 0x46, 0x8f, 0xac, 0xd1, 0x93, 0xc7, 0xe2, 0xbe, 0x2a, 0x8b, 0x58,
+	0x6f, 0x7b, 0xf7, 0xc9, 0xd6, 0xd0, 0xda, 0x1e, 0x6d, 0x33, 0x85, 0x53, 0xb7, 0x52, 0x00, 0xae,
+	0xb2, 0xe3, 0xc3, 0xe3, 0xe1, 0x9e, 0xbc, 0xb0, 0xb2, 0x82, 0xf9, 0x00, 0x1a, 0x5c, 0x6b, 0x20,
+	0xde, 0xf3, 0xc3, 0x79, 0x22, 0xcc, 0x38, 0x5e, 0x40, 0xb9, 0x83, 0x79, 0x82, 0x60, 0x71, 0xcf,
+	0xe2, 0x25, 0x93, 0x42, 0x83, 0x1b, 0xfc, 0xe4, 0x1e, 0x34, 0xf0, 0x0e, 0xe3, 0x9d, 0x0e, 0x2a,
+	0xf9, 0x4b, 0x0b, 0xa7, 0xd8, 0x62, 0x58, 0x4b, 0x50, 0x91, 0xf7, 0xb2, 0x79, 0x03, 0x1b, 0x79,
+	0xf2, 0x4c, 0xe6, 0xc0, 0x5f, 0x55, 0xa0, 0xa3, 0x73, 0x41, 0x95, 0x32, 0x0e, 0x7c, 0x9f, 0x8e,
+	0x13, 0x3b, 0xa2, 0x49, 0xf4, 0x52, 0x0e, 0xb6, 0x00, 0x5a, 0x08, 0x43, 0xdd, 0xc0, 0x2c, 0x49,
+	0x95, 0xc4, 0x52, 0xb7, 0x9a, 0x08, 0x40, 0x4e, 0x68, 0x21, 0x7c, 0x4b, 0x69, 0xe8, 0x4c, 0xbd,
+	0x73, 0x6a, 0xe7, 0x12, 0xd1, 0x56, 0x15, 0x66, 0x57, 0x20, 0xc8, 0x36, 0x5c, 0x9f, 0x79, 0xbe,
+	0x37, 0x9b, 0xcf, 0xb2, 0x07, 0x78, 0x5a, 0x95, 0xcf, 0xd0, 0x55, 0x41, 0x95, 0x39, 0xa0, 0x25,
+	0x17, 0xf3, 0x2f, 0xaa, 0xd0, 0xd6, 0xba, 0xf7, 0xb7, 0xb4, 0x1b, 0xcc, 0xbd, 0x46, 0x4f, 0x83,
+	0xc4, 0x73, 0x50, 0x59, 0xa7, 0xc2, 0xf1, 0x85, 0x48, 0x52, 0xdc, 0x63, 0x29, 0x66, 0x9a, 0x66,
+	0xc4, 0x17, 0x64, 0x59, 0x9a, 0x11, 0x5f, 0x90, 0xaa, 0x6c, 0xfe, 0x75, 0x15, 0x5a, 0xea, 0x82,
+	0x58, 0x34, 0xfb, 0x2a, 0x25, 0x66, 0xdf, 0x35, 0x00, 0x4e, 0xa4, 0xa5, 0x58, 0x70, 0xb3, 0xf4,
+	0x48, 0xf0, 0x98, 0x25, 0x73, 0xa6, 0x6d, 0x82, 0x73, 0x4c, 0x7f, 0xe1, 0x6e, 0xa3, 0xce, 0x2c,
+	0x99, 0x6f, 0x4b, 0x18, 0x5a, 0x48, 0x68, 0x65, 0xe0, 0x78, 0xce, 0x02, 0x57, 0x46, 0x20, 0xda,
+	0x02, 0xb6, 0x1f, 0xb8, 0xe8, 0xda, 0x58, 0x11, 0xa6, 0x70, 0xf6, 0xe4, 0xef, 0x72, 0xe8, 0xb0,
+	0x3c, 0x15, 0xab, 0x21, 0xd3, 0x9e, 0x64, 0x2a, 0x16, 0x1a, 0x06, 0xc9, 0x38, 0xb4, 0x67, 0x71,
+	0x2c, 0xcc, 0xfd, 0x46, 0x32, 0x0e, 0xf7, 0xe3, 0x18, 0x65, 0x48, 0x92, 0xa9, 0x1d, 0xd3, 0xf1,
+	0x3c, 0xc2, 0x63, 0xb5, 0xc9, 0x65, 0x48, 0x92, 0xe9, 0x13, 0x01, 0x42, 0x93, 0x15, 0xed, 0x37,
+	0xee, 0x91, 0xc4, 0x9f, 0xc8, 0x0d, 0xcf, 0x3a, 0x84, 0xf2, 0xd3, 0xbd, 0x31, 0xf3, 0x7c, 0x34,
+	0xe8, 0xbe, 0x80, 0xb6, 0x76, 0x65, 0xc6, 0x53, 0x41, 0xbf, 0x5f, 0x67, 0x2d, 0xb9, 0x55, 0xed,
+	0x3e, 0xcd, 0xcd, 0x38, 0x73, 0x0e, 0x0d, 0x6e, 0x8d, 0xe3, 0x4a, 0xf4, 0x42, 0x3b, 0xe3, 0xb9,
+	0x6b, 0x7a, 0xa1, 0x40, 0xbe, 0x03, 0xbd, 0x99, 0x13, 0x7f, 0x6b, 0x4f, 0xa9, 0x7f, 0x9a, 0x9c,
+	0xd9, 0x33, 0xcf, 0x17, 0x13, 0xd0, 0x45, 0xf0, 0x1e, 0x83, 0xee, 0x7b, 0x7e, 0x81, 0xce, 0x79,
+	0x31, 0xa8, 0x15, 0xe8, 0x9c, 0x17, 0xe6, 0x9f, 0x57, 0x00, 0xd2, 0xb8, 0xf1, 0x1b, 0xa4, 0x08,
+	0x94, 0xfa, 0xd2, 0x08, 0xd4, 0xa7, 0x5e, 0x9c, 0xb0, 0xac, 0xcd, 0x96, 0xc5, 0x7e, 0xb3, 0x78,
+	0x65, 0xea, 0x16, 0xcc, 0xc7, 0x2b, 0x19, 0xc6, 0x52, 0x14, 0xe6, 0x0e, 0x34, 0xf7, 0x9d, 0x64,
+	0x7c, 0x86, 0xc2, 0xdc, 0xce, 0x08, 0xa3, 0x39, 0x34, 0x18, 0xc5, 0xc5, 0xa2, 0x98, 0xcf, 0xa0,
+	0xc3, 0x9d, 0x10, 0xbc, 0xaf, 0xe4, 0x5e, 0x86, 0x99, 0x91, 0x77, 0x55, 0x70, 0x2a, 0x8d, 0xe7,
+	0x25, 0x68, 0xf0, 0xb1, 0x93, 0xba, 0x98, 0x97, 0xcc, 0xbf, 0x69, 0x00, 0xa8, 0xcb, 0x03, 0x7a,
+	0x5e, 0x44, 0x7e, 0x9c, 0x9d, 0x86, 0xf2, 0x49, 0x4e, 0x52, 0x0c, 0xc8, 0xb7, 0x38, 0x15, 0x76,
+	0xeb, 0x27, 0xd0, 0x51, 0x36, 0x2d, 0x56, 0xaa, 0x2e, 0xac, 0xa4, 0x9c, 0xcf, 0x58, 0xed, 0x67,
+	0xb0, 0x22, 0xdd, 0x2e, 0x42, 0xb0, 0x5a, 0xfe, 0x08, 0xd0, 0xbb, 0x62, 0x75, 0x1c, 0xbd, 0xfb,
+	0xf7, 0xa1, 0x2d, 0x6b, 0x63, 0x9b, 0xf5, 0xc5, 0x82, 0xf2, 0x6a, 0xd8, 0xe2, 0x67, 0x2a, 0x93,
+	0x39, 0x79, 0xc9, 0x6a, 0x2d, 0x2d, 0xac, 0xd5, 0x51, 0x84, 0x58, 0xf1, 0xe7, 0xb0, 0x4a, 0x5f,
+	0x24, 0x76, 0xb6, 0x72, 0x63, 0x61, 0xe5, 0x1e, 0x7d, 0x91, 0x6c, 0xe9, 0xf5, 0x71, 0x4b, 0x87,
+	0xdf, 0x7a, 0x68, 0x4e, 0xcd, 0xa7, 0x09, 0xdb, 0xb5, 0x4b, 0x16, 0x44, 0x3c, 0x39, 0x69, 0x3e,
+	0x4d, 0xc8, 0x17, 0x00, 0x69, 0xc6, 0xd1, 0xa0, 0x99, 0xb7, 0x38, 0xd3, 0xf9, 0xe1, 0x5e, 0x2c,
+	0x36, 0xad, 0x2d, 0x95, 0x90, 0x44, 0x1e, 0xc2, 0xda, 0xd4, 0x89, 0x4e, 0x69, 0x4e, 0xc2, 0xd6,
+	0x42, 0x09, 0x57, 0x19, 0x79, 0x46, 0xc6, 0x0d, 0x68, 0xcc, 0xa8, 0x6b, 0xd3, 0xe7, 0x42, 0x0d,
+	0x2c, 0xcd, 0xa8, 0x3b, 0x7a, 0x4e, 0xee, 0x43, 0x8b, 0xdf, 0x64, 0x11, 0xd3, 0xce, 0xef, 0x22,
+	0x26, 0x0d, 0xbf, 0xb4, 0x5a, 0x4d, 0x4e, 0x37, 0x7a, 0x4e, 0x6e, 0xe3, 0x25, 0xe7, 0x45, 0x62,
+	0xf3, 0x70, 0x81, 0xcd, 0x76, 0x50, 0x87, 0xed, 0xa0, 0x2e, 0xc2, 0x1f, 0x63, 0xb0, 0x60, 0x0f,
+	0xb7, 0x12, 0xe6, 0x10, 0x4b, 0xcb, 0xd1, 0xf3, 0x07, 0x5d, 0xe6, 0x3a, 0x6d, 0x09, 0x73, 0x71,
+	0xd7, 0x27, 0xa6, 0xd4, 0xde, 0xb8, 0xd8, 0x50, 0x80, 0x15, 0x7e, 0xef, 0xe4, 0xba, 0x39, 0xa2,
+	0x93, 0xd1, 0x73, 0x74, 0x60, 0xa6, 0xbd, 0xe6, 0x96, 0x60, 0x2f, 0xef, 0xc0, 0x54, 0x1d, 0x65,
+	0xc6, 0xa0, 0xb5, 0x32, 0xce, 0x94, 0xcd, 0x33, 0x68, 0xa9, 0x61, 0x25, 0x6b, 0xd0, 0xb3, 0x0e,
+	0x9f, 0x1e, 0x8f, 0xec, 0xe3, 0xaf, 0x8f, 0x46, 0xf6, 0xc1, 0xe1, 0x01, 0x26, 0x17, 0x6f, 0xc2,
+	0x9a, 0x06, 0xdc, 0x3d, 0x38, 0x1e, 0x59, 0x07, 0xc3, 0xbd, 0x7e, 0x25, 0x87, 0x18, 0x7d, 0x25,
+	0x10, 0x55, 0xb2, 0x0e, 0x7d, 0x0d, 0xb1, 0x77, 0xb8, 0x35, 0xdc, 0xeb, 0xd7, 0xcc, 0x09, 0xf4,
+	0x94, 0x2c, 0x43, 0xfe, 0x39, 0xc2, 0xc7, 0x99, 0x7d, 0x7c, 0xad, 0x44, 0x68, 0x4e, 0xa8, 0x6d,
+	0xe5, 0x9b, 0xd0, 0x96, 0x3d, 0xf0, 0x54, 0x7e, 0x9a, 0x0e, 0x32, 0x0f, 0xa0, 0xb5, 0x4f, 0x5d,
+	0xd1, 0xc2, 0x7b, 0x99, 0x16, 0x36, 0x75, 0xa3, 0xd4, 0x2d, 0xf0, 0x5e, 0x87, 0xa5, 0x73, 0x67,
+	0x3a, 0x97, 0xe9, 0xbb, 0xbc, 0x60, 0xda, 0xd0, 0x1b, 0xc6, 0x47, 0x11, 0x0d, 0xa9, 0x2f, 0xb9,
+	0x62, 0x4c, 0x2e, 0xf6, 0x85, 0xbd, 0x87, 0x3f, 0x51, 0xc3, 0x20, 0x85, 0xa3, 0xac, 0x3d, 0x5e,
+	0xc2, 0x59, 0x9c, 0xc7, 0xd4, 0x9e, 0xd2, 0x49, 0x62, 0xcf, 0x82, 0x38, 0x11, 0xe7, 0x67, 0x7b,
+	0x1e, 0xd3, 0x3d, 0x3a, 0x49, 0xf6, 0x03, 0x16, 0xd7, 0xec, 0x8a, 0x38, 0x92, 0x60, 0x7f, 0x61,
+	0x2a, 0x64, 0x4c, 0xa7, 0x13, 0x11, 0x52, 0x65, 0xbf, 0xcd, 0xdb, 0xd0, 0xdb, 0x93, 0x6b, 0x42,
+	0x30, 0x50, 0x1d, 0x11, 0x16, 0x29, 0xef, 0xc8, 0xbf, 0xad, 0xc3, 0x32, 0x27, 0x88, 0x53, 0x8f,
+	0xb1, 0xc3, 0x00, 0xc5, 0x33, 0x82, 0x2d, 0x0a, 0x4e, 0x2d, 0x3c, 0xc6, 0x82, 0xf7, 0x67, 0xd0,
+	0x4a, 0x2f, 0xaf, 0x5c, 0xdd, 0x5d, 0x5e, 0x38, 0x71, 0x56, 0x4a, 0x4b, 0xde, 0x86, 0xda, 0x8c,
+	0xba, 0x42, 0xd1, 0xad, 0x95, 0xcc, 0x84, 0x85, 0x78, 0xf2, 0x07, 0x18, 0x76, 0xb6, 0x43, 0x3e,
+	0xde, 0x83, 0x7a, 0xbe, 0x81, 0xdc, 0x54, 0x30, 0x15, 0xc7, 0x01, 0xe4, 0xe7, 0xd0, 0xcd, 0x68,
+	0xaa, 0xc1, 0x52, 0xbe, 0x72, 0x5e, 0xba, 0x8e, 0xae, 0xac, 0xc8, 0xc7, 0xb0, 0x2c, 0x02, 0x7d,
+	0x42, 0xbf, 0x69, 0xcb, 0x25, 0x33, 0x41, 0x96, 0xa4, 0x43, 0x61, 0xd3, 0x4d, 0x3a, 0x58, 0xce,
+	0xb7, 0x97, 0x9b, 0x17, 0x69, 0x58, 0x45, 0x74, 0x42, 0x1e, 0x42, 0x2f, 0xa7, 0xb6, 0x06, 0xcd,
+	0x7c, 0xf5, 0xbc, 0xb8, 0x2b, 0x59, 0xcd, 0x85, 0xf9, 0x7b, 0x8e, 0x77, 0x1a, 0x0e, 0x5a, 0xf9,
+	0xa4, 0xb3, 0xa1, 0x77, 0x2a, 0x45, 0x65, 0x14, 0xe4, 0x03, 0x68, 0x70, 0x0d, 0x35, 0x80, 0xd2,
+	0x89, 0x16, 0x6a, 0x4c, 0x10, 0x99, 0x7f, 0x56, 0x81, 0x96, 0x4a, 0x2f, 0x51, 0x27, 0x72, 0x45,
+	0x33, 0x0e, 0x3e, 0x05, 0x50, 0xbe, 0xb5, 0x78, 0x50, 0xcd, 0x0b, 0x90, 0x2a, 0x6d, 0x4b, 0xa3,
+	0x23, 0xef, 0xc1, 0x32, 0x5f, 0x6f, 0xf1, 0xa0, 0x96, 0xbf, 0x25, 0x8a, 0x95, 0x69, 0x49, 0x0a,
+	0xf3, 0x4b, 0x68, 0x08, 0xc7, 0x7b, 0x99, 0x00, 0xd9, 0xec, 0xb6, 0xea, 0xeb, 0x65, 0xb7, 0xfd,
+	0xcf, 0x0a, 0xf4, 0xf3, 0x3e, 0x7a, 0x1c, 0x45, 0x4d, 0x45, 0xac, 0xe7, 0xbd, 0xf9, 0x9a, 0x7e,
+	0xd0, 0xbf, 0x87, 0xa9, 0xbe, 0xc6, 0xf7, 0x30, 0x25, 0xdf, 0x37, 0x66, 0x32, 0xbe, 0xea, 0xaf,
+	0xca, 0xf8, 0x22, 0x1f, 0xc2, 0xb2, 0xf0, 0x8e, 0x0e, 0x96, 0x4a, 0x27, 0x4e, 0x2e, 0x48, 0x41,
+	0x85, 0x69, 0x28, 0x35, 0x2b, 0x70, 0xd0, 0x7d, 0xec, 0xc4, 0x62, 0xfb, 0x57, 0x1d, 0x96, 0x21,
+	0xc4, 0x8d, 0x96, 0x29, 0x95, 0x46, 0x66, 0x0a, 0x40, 0xed, 0x35, 0x73, 0x18, 0x4a, 0x84, 0x4d,
+	0x67, 0x8e, 0x84, 0x73, 0x22, 0xe1, 0xb7, 0x17, 0x25, 0x15, 0x9d, 0x5b, 0xba, 0x38, 0xd3, 0xdd,
+	0xbc, 0xcd, 0x43, 0xa3, 0x81, 0xf3, 0xaa, 0xec, 0x75, 0x9e, 0xa8, 0xcb, 0x08, 0xd3, 0x44, 0xdd,
+	0x28, 0x70, 0x4a, 0x12, 0x75, 0x91, 0x88, 0xa1, 0xcc, 0x18, 0x6a, 0xcf, 0xa2, 0x49, 0xe9, 0xea,
+	0x58, 0x81, 0x6a, 0xc4, 0xfd, 0xa5, 0x1d, 0xab, 0x1a, 0xb9, 0xcc, 0x0c, 0xe7, 0xa1, 0x9b, 0x88,
+	0x1b, 0xb4, 0x1d, 0xab, 0xc9, 0x01, 0x16, 0xfb, 0x1e, 0x4b, 0x04, 0x86, 0xa2, 0x84, 0xcd, 0x49,
+	0xc7, 0x6a, 0x72, 0x80, 0x95, 0x08, 0x3f, 0x3c, 0x0f, 0x4a, 0x54, 0x3d, 0xd7, 0xfc, 0xbf, 0x15,
+	0x68, 0xf0, 0xc4, 0x90, 0xc2, 0x18, 0x5f, 0x01, 0x6e, 0x96, 0x68, 0xbe, 0xda, 0x26, 0x07, 0xec,
+	0xba, 0x68, 0x06, 0xa1, 0x2d, 0x40, 0x7d, 0x7e, 0xb3, 0xa9, 0x71, 0x33, 0x88, 0x83, 0xd8, 0xcd,
+	0x06, 0x73, 0x03, 0x38, 0x81, 0x50, 0xf6, 0x62, 0x81, 0xb4, 0xac, 0x1e, 0x87, 0x0f, 0x25, 0x38,
+	0x13, 0x72, 0x5d, 0xca, 0x85, 0x5c, 0xdf, 0x07, 0x82, 0x07, 0x0e, 0xf3, 0x4e, 0x87, 0x53, 0x6a,
+	0xf3, 0xe4, 0x00, 0x1e, 0x83, 0xef, 0xcf, 0x63, 0xba, 0x2f, 0x10, 0x47, 0x32, 0x2f, 0x00, 0x55,
+	0x27, 0xa6, 0xa7, 0x45, 0x34, 0x4e, 0x9c, 0x08, 0x0d, 0x34, 0x6c, 0x73, 0x45, 0x80, 0x2d, 0x0e,
+	0x35, 0xff, 0xba, 0x02, 0x2d, 0x16, 0x84, 0xde, 0xc5, 0x60, 0xe6, 0x6f, 0x23, 0x44, 0x7f, 0x1b,
+	0x7a, 0xfe, 0x7c, 0x66, 0x6b, 0xb1, 0x77, 0x71, 0xb1, 0x5e, 0xf1, 0xe7, 0x33, 0x3d, 0x13, 0xe2,
+	0x32, 0x34, 0x91, 0x10, 0x3b, 0x26, 0xfd, 0x38, 0xfe, 0x7c, 0x86, 0xfd, 0xc1, 0x4b, 0x20, 0xa2,
+	0x94, 0x93, 0x91, 0xdf, 0x9c, 0xdb, 0xfe, 0x7c, 0x36, 0x14, 0x20, 0xf3, 0x67, 0x2c, 0x89, 0xc8,
+	0xf2, 0x4e, 0xb0, 0x23, 0x72, 0x59, 0xca, 0x28, 0x6e, 0x21, 0xf3, 0x53, 0x75, 0x99, 0x47, 0x71,
+	0xcd, 0x2f, 0x80, 0xe8, 0xb5, 0xc5, 0x5a, 0x7d, 0xed, 0xea, 0xff, 0xb9, 0xce, 0x3d, 0xf4, 0xdc,
+	0x59, 0xfd, 0xdb, 0x89, 0x9c, 0xbf, 0x97, 0x89, 0x9c, 0x6f, 0x66, 0x5d, 0xb7, 0xac, 0xe1, 0xff,
+	0x8f, 0xc2, 0xe7, 0x69, 0x54, 0xbc, 0xf1, 0x26, 0x51, 0xf1, 0xe5, 0xef, 0x15, 0x15, 0x6f, 0xfe,
+	0x26, 0x51, 0xf1, 0xd6, 0x6f, 0x18, 0x15, 0x87, 0xef, 0x13, 0x15, 0x6f, 0x2f, 0x8c, 0x8a, 0xff,
+	0xf7, 0x2a, 0x74, 0x33, 0x13, 0xfa, 0x3b, 0x88, 0xf7, 0x68, 0x41, 0x99, 0x7a, 0x26, 0x28, 0xf3,
+	0x0e, 0xf4, 0xd2, 0xa0, 0x8c, 0xcd, 0x76, 0xbc, 0xf0, 0xee, 0xa8, 0xc8, 0xcc, 0x01, 0x6e, 0xfd,
+	0x4c, 0x74, 0xa6, 0xf1, 0x3a, 0xd1, 0xda, 0xe5, 0x37, 0x89, 0xb9, 0x34, 0x5f, 0x3b, 0xe6, 0xd2,
+	0x2a, 0x89, 0xb9, 0x98, 0xa7, 0x2c, 0xf5, 0x5d, 0x0d, 0xaa, 0xd4, 0x0d, 0xf7, 0x33, 0x11, 0xa7,
+	0x4a, 0x59, 0x9e, 0x07, 0xa7, 0xd7, 0xc2, 0x50, 0x17, 0xa7, 0x3f, 0xf2, 0xcc, 0x78, 0xad, 0x21,
+	0x91, 0x52, 0xf3, 0x8d, 0xcc, 0x8c, 0xff, 0x1d, 0xc8, 0xa0, 0xd2, 0xe4, 0x8b, 0x62, 0xfc, 0x8b,
+	0x0a, 0x5c, 0xe2, 0x51, 0x91, 0x1f, 0x44, 0x8e, 0xdb, 0xd0, 0x77, 0x03, 0x3b, 0x0e, 0x26, 0x89,
+	0x88, 0xa8, 0x08, 0x2f, 0x57, 0xd3, 0xea, 0xba, 0x81, 0xfa, 0x58, 0x69, 0xd7, 0x7f, 0x45, 0x46,
+	0xeb, 0x63, 0xd8, 0x2c, 0x08, 0x25, 0xd4, 0xef, 0x07, 0xb0, 0xe6, 0x53, 0xea, 0xc6, 0xb9, 0x46,
+	0xc4, 0x3b, 0x18, 0x0c, 0xa5, 0xb5, 0x63, 0x3e, 0x86, 0xde, 0xf6, 0x4b, 0xdf, 0x99, 0x79, 0x63,
+	0xf9, 0xb5, 0xf6, 0xc2, 0xe4, 0xba, 0x6c, 0xb4, 0xb1, 0x9a, 0x8b, 0x36, 0x9a, 0x7f, 0x0a, 0x97,
+	0xf1, 0xbb, 0x95, 0x2c, 0x33, 0x39, 0x56, 0xdb, 0xd0, 0x77, 0x39, 0xc6, 0x96, 0x9e, 0x9f, 0x41,
+	0x25, 0x6f, 0xe1, 0xe7, 0xeb, 0xf6, 0xdc, 0x9c, 0x64, 0x17, 0xcf, 0xe2, 0x55, 0x96, 0x0b, 0x5d,
+	0x10, 0x40, 0x4c, 0xe4, 0x9f, 0x55, 0xe0, 0xaa, 0xf8, 0x96, 0xe5, 0xf7, 0x27, 0xe2, 0x0d, 0x99,
+	0x16, 0xbd, 0x48, 0xca, 0x7f, 0x5c, 0x81, 0x0e, 0xee, 0x54, 0xea, 0x53, 0xf6, 0xf2, 0x86, 0x7a,
+	0xe8, 0xa2, 0x72, 0xc1, 0x43, 0x17, 0x03, 0x54, 0x45, 0xbe, 0x33, 0x4d, 0x64, 0x16, 0x9b, 0x2c,
+	0xf2, 0x90, 0xa0, 0x13, 0x4a, 0xe5, 0xc5, 0x0b, 0x3c, 0x13, 0x03, 0xed, 0x22, 0xe6, 0x36, 0xaf,
+	0xf3, 0x6f, 0x43, 0x19, 0x04, 0x8f, 0x19, 0xf3, 0x57, 0x70, 0x09, 0xbf, 0x90, 0xd2, 0xa4, 0x78,
+	0xf5, 0x57, 0x89, 0x0b, 0xf2, 0xe8, 0xcc, 0x1d, 0xd8, 0x2c, 0xf0, 0x52, 0x5f, 0x7b, 0x88, 0x5c,
+	0x4d, 0x6e, 0xd4, 0x6a, 0xa7, 0x6c, 0x86, 0x9c, 0x13, 0x99, 0x5f, 0x43, 0x37, 0x73, 0xc6, 0x90,
+	0x9b, 0xd0, 0x71, 0xa6, 0xd3, 0xe0, 0x3b, 0x1b, 0xb3, 0x7e, 0x94, 0xe5, 0x09, 0x0c, 0x76, 0xf8,
+	0x9d, 0xcf, 0x15, 0x71, 0xc4, 0x13, 0xab, 0x6d, 0xa9, 0xa9, 0xc5, 0x56, 0x13, 0xe0, 0x23, 0xa6,
+	0xb0, 0xcd, 0xcf, 0xa1, 0x9b, 0x39, 0x7e, 0x50, 0xf9, 0x16, 0x22, 0x92, 0x62, 0x03, 0xf5, 0x72,
+	0xb1, 0x48, 0xf3, 0xa7, 0x00, 0xe9, 0xfd, 0x32, 0xeb, 0x6a, 0xa8, 0x0b, 0x57, 0x03, 0x77, 0x87,
+	0xa0, 0xce, 0x16, 0xed, 0x8b, 0x92, 0xf9, 0x3f, 0x2a, 0xd0, 0x7a, 0x38, 0x71, 0x45, 0x48, 0x6a,
+	0x71, 0xce, 0x85, 0x01, 0x4d, 0x65, 0x92, 0x70, 0x0e, 0xaa, 0x8c, 0xd1, 0x53, 0x97, 0xc6, 0x5e,
+	0x44, 0x5d, 0x9b, 0x39, 0xef, 0x5f, 0x64, 0x83, 0x38, 0x5d, 0x6b, 0x5d, 0xa0, 0xf7, 0x3d, 0xff,
+	0xf8, 0x85, 0x8a, 0xc0, 0x7c, 0x06, 0x83, 0x88, 0x3e, 0x9f, 0xab, 0x7a, 0xd1, 0x8b, 0x6c, 0x04,
+	0xa7, 0x6b, 0x6d, 0x48, 0xfc, 0xbe, 0xe7, 0x5b, 0x69, 0xc5, 0xf7, 0x60, 0xd5, 0xa5, 0x09, 0x06,
+	0x9c, 0x84, 0x51, 0xed, 0xd1, 0x48, 0x5c, 0x08, 0xfa, 0x1c, 0xb1, 0xaf, 0xe0, 0xe6, 0x9f, 0x57,
+	0xa1, 0xf9, 0x70, 0xe2, 0xaa, 0x58, 0x55, 0x36, 0x8a, 0x2f, 0x8e, 0xe4, 0x4c, 0x14, 0xff, 0x3a,
+	0x80, 0xeb, 0x39, 0xa7, 0x7e, 0x10, 0x27, 0xde, 0x58, 0x7e, 0x7c, 0x9e, 0x42, 0xf0, 0x63, 0x10,
+	0x7e, 0x20, 0x63, 0x0c, 0x26, 0xf2, 0x66, 0x68, 0x06, 0x07, 0x91, 0xe8, 0x2a, 0x61, 0xa8, 0x6d,
+	0x1d, 0x43, 0x3e, 0x86, 0x75, 0x11, 0x43, 0xc9, 0xd6, 0xe0, 0x9d, 0x5c, 0xe3, 0xb8, 0x6c, 0x95,
+	0xb7, 0x61, 0x85, 0xf7, 0x04, 0x45, 0x55, 0x71, 0xa9, 0xae, 0xd5, 0x55, 0xd0, 0x92, 0x90, 0x54,
+	0xfa, 0xe5, 0xfb, 0x65, 0x68, 0xce, 0x43, 0xe1, 0x7f, 0xe4, 0x27, 0xf6, 0xf2, 0x3c, 0xe4, 0xee,
+	0xc5, 0x3f, 0x86, 0xda, 0xc3, 0x89, 0x4b, 0xde, 0xcb, 0x85, 0x3a, 0xd7, 0x32, 0x26, 0x4d, 0x2e,
+	0xce, 0x79, 0x27, 0x1b, 0xe7, 0x24, 0x19, 0xda, 0x4c, 0x90, 0x73, 0xca, 0xdc, 0xf7, 0x13, 0xef,
+	0x74, 0xdb, 0x9b, 0xb0, 0x8b, 0xa0, 0xba, 0x95, 0xb4, 0x2e, 0xb8, 0x81, 0x0c, 0x60, 0x39, 0x9a,
+	0xfb, 0x3e, 0x9a, 0x0c, 0xfc, 0x66, 0x2e, 0x8b, 0xc5, 0xef, 0x68, 0x5a, 0xb9, 0x33, 0x73, 0x87,
+	0x26, 0x5b, 0xb2, 0x8c, 0x6d, 0xca, 0x7c, 0xff, 0x47, 0x30, 0x28, 0xa2, 0xc4, 0xae, 0xbf, 0x0b,
+	0x4b, 0xae, 0x37, 0x99, 0x94, 0x7c, 0x66, 0x99, 0xca, 0x6e, 0x71, 0x12, 0x7c, 0xbd, 0x04, 0x0d,
+	0x12, 0x2f, 0x65, 0x25, 0x5b, 0x78, 0x17, 0x36, 0x0b, 0x18, 0xd1, 0x00, 0xbf, 0xa2, 0x56, 0xd4,
+	0x15, 0x95, 0x3f, 0x47, 0xc2, 0xe2, 0xff, 0x79, 0x2e, 0xf8, 0x75, 0x63, 0x01, 0x25, 0x14, 0xf1,
+	0x7d, 0xe8, 0x70, 0x81, 0x78, 0x3b, 0x79, 0xb6, 0x6c, 0x78, 0xd3, 0xb7, 0x0d, 0xd8, 0x6f, 0x39,
+	0x24, 0xac, 0xc2, 0x63, 0x2f, 0x4e, 0x82, 0x48, 0x7d, 0xef, 0xb6, 0x07, 0x83, 0x22, 0x4a, 0x48,
+	0xfc, 0x11, 0x2c, 0x8f, 0x19, 0xa2, 0x44, 0x15, 0xea, 0x32, 0x58, 0x92, 0xcc, 0xbc, 0x0d, 0x1b,
+	0x56, 0x30, 0x9d, 0x9e, 0x38, 0xe3, 0x6f, 0xc5, 0x6a, 0x11, 0x0a, 0x3a, 0xdf, 0xf9, 0x3b, 0x70,
+	0x29, 0x4f, 0xb8, 0x60, 0x98, 0xee, 0xb2, 0x4f, 0x4c, 0xb2, 0xdc, 0x50, 0xa9, 0x07, 0xd1, 0xcc,
+	0x49, 0xa4, 0x21, 0xc0, 0x4b, 0xe6, 0x7b, 0xb0, 0xaa, 0xd1, 0x0a, 0x86, 0x97, 0x32, 0x8b, 0xba,
+	0x25, 0xd7, 0xaf, 0xf9, 0x97, 0x15, 0x58, 0x17, 0x0f, 0x62, 0x8c, 0xce, 0xa9, 0x9f, 0xc4, 0x92,
+	0xfb, 0x3a, 0x2c, 0xf1, 0xaf, 0xaa, 0x2b, 0xec, 0x8e, 0xcd, 0x0b, 0x99, 0x6b, 0x60, 0x35, 0x77,
+	0x0d, 0xbc, 0x0a, 0x2d, 0x79, 0x32, 0xc7, 0x22, 0x10, 0x97, 0x02, 0x98, 0x79, 0x92, 0xc6, 0xab,
+	0xc4, 0x7a, 0x4d, 0x63, 0x53, 0x39, 0x57, 0xf9, 0x52, 0xc1, 0x55, 0xae, 0xbf, 0xbe, 0xd1, 0xc8,
+	0xbc, 0xbe, 0x61, 0xfe, 0xaf, 0x1a, 0x2c, 0x31, 0xe1, 0x33, 0x69, 0xfe, 0x95, 0x5c, 0x9a, 0xbf,
+	0xdc, 0x71, 0x55, 0x6d, 0xc7, 0x5d, 0x85, 0x16, 0x2e, 0x8d, 0x38, 0x71, 0x66, 0xa1, 0xf8, 0x6a,
+	0x25, 0x05, 0x20, 0x37, 0x65, 0x6a, 0x70, 0x81, 0x55, 0x79, 0x71, 0x12, 0x68, 0x7a, 0xd4, 0x36,
+	0xc4, 0xac, 0xe4, 0x53, 0xd6, 0x97, 0x33, 0x66, 0xdb, 0x75, 0x00, 0x79, 0x88, 0x89, 0xd7, 0x2d,
+	0x9a, 0x96, 0x06, 0xc1, 0x6e, 0x4b, 0x47, 0x6f, 0x8b, 0x2b, 0x00, 0x51, 0x44, 0x11, 0xc4, 0xc5,
+	0x4f, 0x7c, 0x9a, 0xd2, 0xe0, 0xf7, 0x3a, 0x6c, 0x4a, 0x38, 0x50, 0xdb, 0x1c, 0xce, 0x4b, 0x58,
+	0xe1, 0xcc, 0x89, 0x6d, 0x74, 0x6c, 0xf3, 0xcf, 0x52, 0x1a, 0x67, 0x4e, 0xbc, 0x4f, 0x5d, 0x0c,
+	0x11, 0x20, 0x90, 0xe7, 0x6d, 0xe1, 0x4f, 0x2d, 0xd2, 0x8e, 0xbe, 0xe2, 0x15, 0x3d, 0xd2, 0x8e,
+	0x0e, 0xe1, 0xdc, 0x6c, 0xf5, 0x8a, 0xb3, 0xb5, 0x0e, 0x4b, 0x69, 0x56, 0x56, 0x4b, 0xe8, 0x40,
+	0x74, 0x2c, 0xe9, 0x19, 0x5b, 0x3c, 0x21, 0x53, 0xcf, 0xc8, 0x1a, 0xc0, 0xb2, 0x1b, 0x05, 0x61,
+	0x28, 0x92, 0xae, 0xea, 0x96, 0x2c, 0x9a, 0xcf, 0x60, 0x25, 0x1b, 0x1d, 0x7a, 0xe3, 0xc0, 0xea,
+	0x3a, 0x2c, 0x71, 0xb5, 0xcf, 0x6d, 0x20, 0x5e, 0x30, 0x67, 0xf8, 0xf5, 0x7b, 0x36, 0xa7, 0xf3,
+	0xa2, 0x64, 0x67, 0x7e, 0x95, 0xd3, 0x1c, 0x1d, 0x2d, 0x71, 0x1b, 0x17, 0x1e, 0x8d, 0xeb, 0x00,
+	0x2a, 0xa1, 0xd4, 0x15, 0x37, 0x03, 0x0d, 0x62, 0xfe, 0x4d, 0x05, 0x06, 0x8b, 0x52, 0x40, 0xd1,
+	0xad, 0xa6, 0xd2, 0x36, 0xf4, 0xac, 0xf1, 0x96, 0xd5, 0x53, 0x70, 0xd1, 0xce, 0x1d, 0xe8, 0xb3,
+	0x8f, 0x35, 0x6d, 0x6d, 0x53, 0x71, 0x71, 0x56, 0x18, 0xfc, 0x48, 0xed, 0xac, 0x0f, 0xf1, 0x63,
+	0x29, 0xdf, 0x2e, 0x50, 0xd7, 0x44, 0xb6, 0x6c, 0xe0, 0x8f, 0xb2, 0x15, 0x78, 0xd6, 0x0a, 0x97,
+	0xd0, 0x9e, 0x51, 0xf9, 0xed, 0x56, 0x47, 0x01, 0xf7, 0x39, 0x51, 0x12, 0x39, 0xe3, 0x6f, 0xa9,
+	0x2b, 0xbc, 0x76, 0x7c, 0x17, 0x74, 0x04, 0x90, 0x79, 0xec, 0xcc, 0xff, 0x56, 0x81, 0xfe, 0x63,
+	0xea, 0x4c, 0x93, 0xb3, 0xad, 0x33, 0x3a, 0xfe, 0x96, 0xb9, 0x30, 0x16, 0xde, 0x5f, 0xd0, 0x55,
+	0x26, 0x82, 0x91, 0x83, 0x6a, 0xba, 0xd2, 0x1f, 0x07, 0xa1, 0x5c, 0x9f, 0xb5, 0x74, 0x7d, 0xe6,
+	0x16, 0x60, 0xbd, 0xb8, 0x00, 0x6f, 0xe1, 0x57, 0x34, 0xa7, 0x91, 0xe3, 0xa2, 0x09, 0x45, 0xa5,
+	0x57, 0xb4, 0x2d, 0x61, 0xb8, 0xec, 0x3f, 0x86, 0x75, 0x45, 0xa2, 0x73, 0x6b, 0x30, 0x6e, 0x6b,
+	0x12, 0xb7, 0x95, 0xa2, 0xcc, 0xff, 0x54, 0x83, 0xb6, 0xd6, 0xa3, 0x52, 0x7f, 0xae, 0xae, 0x68,
+	0xba, 0x62, 0xed, 0x69, 0xa6, 0x79, 0x2d, 0x6b, 0x9a, 0x8b, 0x57, 0x2d, 0xea, 0xe9, 0xab, 0x16,
+	0xb7, 0xa1, 0x47, 0x5f, 0x84, 0x74, 0x9c, 0x50, 0x97, 0xed, 0x93, 0xb9, 0x1c, 0xdc, 0x15, 0x09,
+	0x7e, 0xc2, 0xa0, 0x4c, 0x23, 0x06, 0xb3, 0x99, 0xe3, 0xbb, 0x42, 0xd7, 0xc8, 0x62, 0xe6, 0xf1,
+	0x2d, 0x91, 0x69, 0x2e, 0xcb, 0x58, 0x0b, 0x55, 0x1c, 0xbe, 0x05, 0xc5, 0x93, 0x4e, 0x65, 0x11,
+	0x05, 0x8f, 0xbc, 0x98, 0x0a, 0x8f, 0x03, 0xfb, 0x8d, 0xb0, 0x09, 0x7e, 0xcd, 0xc9, 0x63, 0xcd,
+	0xec, 0xb7, 0x78, 0x20, 0xa8, 0xad, 0x1e, 0x08, 0xba, 0x0a, 0xad, 0x78, 0x3e, 0x1e, 0x73, 0x37,
+	0x70, 0x47, 0x7c, 0x43, 0x27, 0x01, 0xfc, 0xc8, 0xf0, 0xa6, 0xf3, 0x88, 0xc6, 0x42, 0xc3, 0xa8,
+	0x32, 0x53, 0x33, 0x4e, 0x9c, 0xd8, 0xfc, 0xb1, 0x1b, 0xfe, 0x95, 0x5b, 0x0b, 0x21, 0xcc, 0xe2,
+	0x67, 0x7e, 0x68, 0x44, 0x8f, 0xcf, 0x1c, 0xff, 0x94, 0x8a, 0xc7, 0x70, 0x58, 0x8d, 0x2d, 0x06,
+	0x21, 0xf7, 0xa1, 0xc1, 0x36, 0x5f, 0x3c, 0xe8, 0xe7, 0x53, 0xb7, 0xf3, 0xeb, 0xce, 0x12, 0x94,
+	0xf8, 0x85, 0xf1, 0x0e, 0x4d, 0x74, 0xb4, 0x30, 0x02, 0x8e, 0xe1, 0x52, 0x1e, 0xa1, 0x3e, 0x01,
+	0xee, 0x9e, 0x31, 0xb0, 0x3d, 0x46, 0xb8, 0x34, 0x04, 0x36, 0xca, 0x5b, 0xeb, 0x9c, 0xa5, 0x85,
+	0x58, 0x3c, 0x84, 0x90, 0xf7, 0x4e, 0x98, 0x7b, 0xb0, 0x9e, 0x05, 0x8b, 0xa6, 0x3e, 0x85, 0x76,
+	0x7a, 0x8b, 0x2f, 0x79, 0x09, 0x21, 0xad, 0x01, 0xea, 0x6e, 0x1f, 0xdf, 0xdd, 0x82, 0xa6, 0xf4,
+	0x66, 0x63, 0x86, 0xe4, 0xce, 0xde, 0xe1, 0xc3, 0xe1, 0x5e, 0xff, 0x2d, 0xd2, 0x82, 0x25, 0x1e,
+	0xd1, 0x66, 0x89, 0x93, 0xc3, 0xed, 0x5f, 0xd9, 0xbb, 0x07, 0xfd, 0x2a, 0x69, 0xc3, 0x32, 0xfe,
+	0xc6, 0x57, 0xf5, 0x6a, 0xf8, 0x30, 0xd7, 0x33, 0xeb, 0x51, 0xbf, 0x7e, 0x37, 0x81, 0xb6, 0x96,
+	0x6c, 0x83, 0x15, 0x8e, 0xac, 0xd1, 0xa3, 0xdd, 0xaf, 0xfa, 0x6f, 0x91, 0x0e, 0x34, 0x0f, 0x46,
+	0xbb, 0x3b, 0x8f, 0x1f, 0x1e, 0x5a, 0xfd, 0x0a, 0xd6, 0x38, 0x1e, 0xee, 0x08, 0x3e, 0x4f, 0xec,
+	0xa3, 0xe1, 0xf1, 0xe3, 0x7e, 0x8d, 0x74, 0xa1, 0xb5, 0x75, 0xb8, 0xbf, 0xff, 0xf4, 0x60, 0xf7,
+	0xf8, 0xeb, 0x7e, 0x9d, 0xac, 0x42, 0x77, 0xf4, 0xd5, 0xb1, 0x9d, 0x82, 0x96, 0x30, 0x62, 0xbf,
+	0x37, 0xb4, 0x76, 0x46, 0x1a, 0xb0, 0x71, 0xf7, 0x5d, 0x68, 0xa9, 0xac, 0x1a, 0xe4, 0x3c, 0x3c,
+	0xf8, 0x9a, 0xbf, 0xf9, 0x37, 0xdc, 0x13, 0x62, 0xef, 0x1e, 0x3c, 0x1b, 0x59, 0xc7, 0xfd, 0xea,
+	0xdd, 0xbb, 0xd0, 0xcf, 0xab, 0x76, 0xcc, 0x10, 0x1d, 0x7d, 0xd9, 0x7f, 0x0b, 0xff, 0xdf, 0x19,
+	0xf5, 0x2b, 0xf8, 0xff, 0xde, 0xa8, 0x5f, 0xbd, 0xfb, 0xa1, 0x48, 0x8a, 0x12, 0x37, 0xbf, 0x26,
+	0xd4, 0x45, 0x86, 0x00, 0x8e, 0xc3, 0xd6, 0xd6, 0xe8, 0xe8, 0x98, 0x33, 0xb7, 0x46, 0xbf, 0x1a,
+	0x61, 0x32, 0xe9, 0xdd, 0xa7, 0xb0, 0x56, 0x12, 0xc8, 0xc7, 0x6e, 0x28, 0x69, 0xed, 0xe1, 0xf6,
+	0x76, 0xff, 0x2d, 0xcc, 0x18, 0x48, 0x41, 0xd6, 0x68, 0xff, 0xf0, 0x19, 0x36, 0xbc, 0x01, 0xab,
+	0x3a, 0xf4, 0x68, 0x6f, 0xb8, 0x85, 0x72, 0x7c, 0x00, 0xdd, 0x4c, 0xf4, 0x1e, 0xc7, 0x6c, 0x7f,
+	0xb4, 0x6d, 0xef, 0x1f, 0x22, 0xab, 0x1e, 0xb4, 0xb1, 0x20, 0xc9, 0x2b, 0x77, 0xdf, 0x07, 0x48,
+	0x23, 0x79, 0xea, 0x05, 0x44, 0x1c, 0x84, 0xfd, 0xa3, 0x43, 0x4b, 0xc8, 0x3c, 0xfa, 0x8a, 0xfd,
+	0x46, 0x99, 0xdb, 0x5a, 0x44, 0x14, 0xb9, 0x1d, 0x5a, 0xbb, 0x3b, 0xbb, 0x07, 0x32, 0x1b, 0x62,
+	0x05, 0x40, 0x00, 0x76, 0x77, 0x8e, 0xfa, 0x15, 0xad, 0x3c, 0xda, 0x39, 0xea, 0x57, 0x51, 0x66,
+	0x89, 0x3f, 0xd8, 0x3a, 0xdc, 0x3f, 0xda, 0x1b, 0x1d, 0x8f, 0xfa, 0xb5, 0xfb, 0xff, 0xfc, 0x2e,
+	0x34, 0x77, 0x70, 0xc1, 0x0d, 0x43, 0x8f, 0xec, 0x41, 0x5b, 0xfb, 0x92, 0x98, 0x5c, 0xcd, 0x84,
+	0x2d, 0x73, 0x1f, 0x28, 0x1b, 0xd7, 0x16, 0x60, 0x85, 0xd5, 0xfe, 0x16, 0xd9, 0x05, 0x48, 0xbf,
+	0x35, 0x26, 0x57, 0x74, 0xf2, 0xdc, 0x67, 0xc9, 0xc6, 0xd5, 0x72, 0xa4, 0x62, 0xf5, 0x08, 0x5a,
+	0xea, 0x0b, 0x6b, 0xa2, 0x6d, 0xfc, 0xfc, 0xa7, 0xd8, 0xc6, 0x95, 0x52, 0x9c, 0xe2, 0xb3, 0x07,
+	0x6d, 0xed, 0x15, 0x50, 0xbd, 0x83, 0xc5, 0x47, 0x47, 0x8d, 0x6b, 0x0b, 0xb0, 0x8a, 0xdb, 0x53,
+	0x58, 0xc9, 0xbe, 0xf0, 0x49, 0x6e, 0xe8, 0xb9, 0x6c, 0x25, 0xcf, 0x8a, 0x1a, 0x37, 0x17, 0x13,
+	0xe8, 0x42, 0x6a, 0xef, 0xe1, 0xea, 0x42, 0x16, 0x1f, 0xda, 0x35, 0xae, 0x2d, 0xc0, 0x2a, 0x6e,
+	0x16, 0x74, 0x33, 0x4f, 0x67, 0x92, 0xeb, 0x99, 0xa8, 0x58, 0x91, 0xe3, 0x8d, 0x85, 0x78, 0xc5,
+	0xf3, 0xef, 0xc3, 0x6a, 0xe1, 0x49, 0x4e, 0x62, 0xbe, 0xfa, 0x69, 0x50, 0xe3, 0x47, 0x17, 0xd2,
+	0x28, 0xfe, 0x7f, 0x17, 0xfa, 0xf9, 0xa7, 0x37, 0xc9, 0x2d, 0xad, 0x6a, 0xf9, 0x8b, 0x9f, 0x86,
+	0x79, 0x11, 0x89, 0x3e, 0x6b, 0xd9, 0x87, 0x38, 0xf5, 0x59, 0x2b, 0x7d, 0xd5, 0xd3, 0xb8, 0xb9,
+	0x98, 0x40, 0xb1, 0xfd, 0x0a, 0x7a, 0xb9, 0xb7, 0x36, 0x89, 0x3e, 0xd9, 0xa5, 0x0f, 0x7c, 0x1a,
+	0xb7, 0x2e, 0xa0, 0x50, 0x9c, 0xbf, 0x80, 0x06, 0x8f, 0xed, 0x91, 0xcd, 0xcc, 0x64, 0xa7, 0xdf,
+	0xd8, 0x1a, 0x83, 0x22, 0x42, 0x5f, 0x4e, 0xda, 0x77, 0xb2, 0xfa, 0x72, 0x2a, 0x7e, 0xac, 0x6b,
+	0x5c, 0x5b, 0x80, 0x55, 0xdc, 0x7e, 0x09, 0xcb, 0xe2, 0x25, 0x62, 0x32, 0xc8, 0xec, 0x0f, 0xcd,
+	0x39, 0x69, 0x5c, 0x2e, 0xc1, 0xe8, 0x6a, 0x21, 0x7d, 0xf7, 0x57, 0x57, 0x0b, 0x85, 0x97, 0x8b,
+	0x8d, 0xab, 0xe5, 0x48, 0xc5, 0x6a, 0x1b, 0x20, 0x7d, 0xe0, 0x51, 0x67, 0x55, 0x78, 0xf6, 0xd1,
+	0x28, 0xff, 0xa4, 0xda, 0x7c, 0xeb, 0xa3, 0x0a, 0xf9, 0x5c, 0xbd, 0x60, 0x99, 0x7e, 0xa4, 0xa4,
+	0x9d, 0xc2, 0xea, 0x79, 0x69, 0x23, 0xf7, 0x0a, 0x30, 0xab, 0xfc, 0x08, 0x5a, 0xea, 0x79, 0x53,
+	0x5d, 0x33, 0xe5, 0x1f, 0x57, 0x35, 0xae, 0x94, 0xe2, 0x32, 0xa3, 0xa2, 0x1e, 0x3f, 0xcd, 0x8c,
+	0x4a, 0xfe, 0x9d, 0x54, 0xe3, 0x6a, 0x39, 0x52, 0xb1, 0x7a, 0x0c, 0x2d, 0xf5, 0x60, 0xa9, 0x2e,
+	0x52, 0xfe, 0x19, 0x55, 0xe3, 0x4a, 0x29, 0x4e, 0xf2, 0xb9, 0x53, 0xc1, 0x95, 0xc7, 0x9f, 0xea,
+	0xd4, 0x57, 0x5e, 0xe6, 0x79, 0x50, 0x63, 0x50, 0x44, 0xe8, 0x5a, 0x5b, 0xbd, 0xca, 0x49, 0x8c,
+	0xfc, 0x5c, 0x6a, 0x4c, 0xae, 0x94, 0xe2, 0xf4, 0x35, 0x27, 0x9e, 0x10, 0x24, 0xb9, 0x85, 0x9e,
+	0xbe, 0x3d, 0x67, 0x5c, 0x2e, 0xc1, 0xe4, 0x56, 0x6d, 0x9e, 0x43, 0xf6, 0x69, 0x41, 0xe3, 0x72,
+	0x09, 0xa6, 0xb8, 0x6a, 0x19, 0x93, 0x82, 0xc0, 0x3a, 0x9f, 0xab, 0xe5, 0x48, 0x9d, 0x55, 0xfa,
+	0xba, 0x1f, 0x29, 0xac, 0x8b, 0x05, 0xac, 0x4a, 0x1e, 0x04, 0x64, 0x7b, 0x5b, 0x7b, 0xe2, 0x8f,
+	0x14, 0x57, 0x86, 0xce, 0xec, 0xda, 0x02, 0xac, 0x3e, 0x5f, 0xea, 0x81, 0x3e, 0x7d, 0xbe, 0xf2,
+	0xef, 0xfc, 0x19, 0x57, 0x4a, 0x71, 0xfa, 0x91, 0x93, 0x79, 0xec, 0x4f, 0x3f, 0x72, 0xca, 0xde,
+	0x0d, 0x34, 0x6e, 0x2c, 0xc4, 0xe7, 0x95, 0x60, 0xe0, 0xe4, 0x95, 0x60, 0xe0, 0x94, 0x2c, 0xc5,
+	0x6c, 0xce, 0x0e, 0x1f, 0x28, 0xed, 0x61, 0x3e, 0x52, 0x18, 0x57, 0xfd, 0xf1, 0x41, 0xe3, 0xda,
+	0x02, 0xac, 0x2e, 0x0c, 0x7f, 0x57, 0x2f, 0xb7, 0x2f, 0xd2, 0x47, 0xf5, 0x8c, 0x41, 0x11, 0x51,
+	0xdc, 0x17, 0xc8, 0xa1, 0xb0, 0x2f, 0x34, 0x26, 0x57, 0x4a, 0x71, 0xb9, 0x31, 0xc9, 0x89, 0x91,
+	0x79, 0x68, 0xd0, 0x18, 0x14, 0x11, 0xfa, 0x34, 0x65, 0x9e, 0xdf, 0xd3, 0xa7, 0xa9, 0xec, 0x69,
+	0x3f, 0xe3, 0xc6, 0x42, 0xbc, 0xce, 0x33, 0xf3, 0x62, 0x9e, 0xce, 0xb3, 0xec, 0xa1, 0x3e, 0xe3,
+	0xc6, 0x42, 0xbc, 0x6e, 0x0d, 0xe4, 0x5f, 0xbe, 0xd3, 0xad, 0x81, 0x05, 0x0f, 0xf1, 0x19, 0xe6,
+	0x45, 0x24, 0xba, 0x29, 0x53, 0x78, 0xd8, 0x4e, 0x37, 0x65, 0x16, 0xbd, 0xab, 0x67, 0xfc, 0xe8,
+	0x42, 0x1a, 0xc5, 0xff, 0x10, 0x3a, 0xfa, 0x23, 0x78, 0x24, 0x6b, 0xaf, 0xe5, 0xdf, 0x7b, 0x33,
+	0xae, 0x2f, 0x42, 0xeb, 0x0c, 0xf5, 0xe7, 0xeb, 0x48, 0xd6, 0x4a, 0xbd, 0x88, 0x61, 0xe9, 0xab,
+	0x77, 0xdc, 0x70, 0xc9, 0x3e, 0x4c, 0x47, 0x0a, 0x56, 0x6a, 0x81, 0xed, 0xad, 0x0b, 0x28, 0xf4,
+	0x89, 0xcb, 0xbf, 0x44, 0xa7, 0x4f, 0xdc, 0x82, 0x37, 0xef, 0x0c, 0xf3, 0x22, 0x92, 0xdc, 0x95,
+	0x40, 0x78, 0xc8, 0xb2, 0x57, 0x82, 0xcc, 0xd3, 0x68, 0xc6, 0x95, 0x52, 0x9c, 0xce, 0x47, 0x3d,
+	0x9f, 0xa5, 0xf3, 0xc9, 0xbf, 0x67, 0x67, 0x5c, 0x29, 0xc5, 0xe9, 0xf3, 0xa2, 0xbf, 0x6c, 0xa5,
+	0xcf, 0x4b, 0xc9, 0x63, 0x73, 0xc6, 0xf5, 0x45, 0xe8, 0xac, 0xe1, 0xae, 0x3d, 0x46, 0x95, 0x35,
+	0xdc, 0x8b, 0x6f, 0xc0, 0x19, 0x37, 0x16, 0xe2, 0x15, 0x4f, 0x97, 0x3b, 0x28, 0xf2, 0x99, 0xa6,
+	0x3f, 0x2e, 0x19, 0xa2, 0xc2, 0xbb, 0x5b, 0xc6, 0xdb, 0xaf, 0xa0, 0xd2, 0x5b, 0x29, 0x79, 0x91,
+	0x4c, 0x6f, 0x65, 0xf1, 0x43, 0x69, 0xc6, 0xdb, 0xaf, 0xa0, 0x52, 0xad, 0xcc, 0x54, 0x56, 0x4a,
+	0xbe, 0xa1, 0xdb, 0xe5, 0x63, 0x5b, 0x6c, 0xeb, 0xce, 0xab, 0x09, 0x55, 0x73, 0xa1, 0x7a, 0xa4,
+	0xb1, 0xd0, 0xde, 0x9d, 0x05, 0x03, 0x5f, 0x6c, 0xf0, 0xdd, 0xd7, 0xa0, 0xd4, 0xed, 0x84, 0x34,
+	0xa7, 0x8f, 0x5c, 0xc9, 0x9b, 0xf8, 0x5a, 0x9e, 0xa0, 0x71, 0xb5, 0x1c, 0x99, 0x53, 0x1a, 0x69,
+	0x86, 0x5f, 0x56, 0x69, 0xe4, 0x1d, 0x56, 0xc6, 0xf5, 0x45, 0xe8, 0xa2, 0xd2, 0x48, 0x79, 0x16,
+	0x94, 0x46, 0x81, 0xed, 0xad, 0x0b, 0x28, 0x74, 0xce, 0xb9, 0x7c, 0x1a, 0x9d, 0x73, 0x79, 0xfe,
+	0x8f, 0x71, 0xeb, 0x02, 0x0a, 0xc5, 0xd9, 0x61, 0x7f, 0x5a, 0x24, 0x9f, 0x62, 0xf3, 0xa3, 0xec,
+	0x01, 0x54, 0x9a, 0x90, 0x62, 0xfc, 0xf8, 0x62, 0x22, 0xd5, 0xc4, 0x37, 0xf2, 0xcf, 0x89, 0xe4,
+	0x5b, 0x79, 0xa7, 0x70, 0x18, 0x95, 0x37, 0x74, 0xfb, 0x95, 0x74, 0xfa, 0x40, 0xe5, 0xf2, 0x39,
+	0xf4, 0x81, 0x2a, 0x4f, 0x1b, 0x31, 0x6e, 0x5d, 0x40, 0xa1, 0xeb, 0xed, 0x7c, 0xd0, 0x98, 0x64,
+	0x2b, 0x96, 0xc5, 0x9a, 0x0d, 0xf3, 0x22, 0x12, 0x5d, 0xec, 0x5c, 0xbc, 0x58, 0x17, 0xbb, 0x3c,
+	0xc8, 0x6c, 0xdc, 0xba, 0x80, 0x22, 0x63, 0x27, 0xe4, 0x62, 0xc8, 0x24, 0x7b, 0xc1, 0x2e, 0x0b,
+	0x3d, 0x1b, 0xe6, 0x45, 0x24, 0xf9, 0x31, 0xd1, 0xa3, 0xc6, 0xf9, 0x31, 0x29, 0x09, 0x36, 0x1b,
+	0xe6, 0x45, 0x24, 0xba, 0x4b, 0x22, 0x1b, 0x1b, 0xd6, 0x5d, 0x12, 0xa5, 0xe1, 0x65, 0xe3, 0xe6,
+	0x62, 0x82, 0xdc, 0x11, 0x29, 0x38, 0x1a, 0x39, 0x49, 0x74, 0x66, 0x57, 0x4a, 0x71, 0xda, 0x35,
+	0xbb, 0x9b, 0x09, 0x1b, 0xeb, 0x27, 0x51, 0x59, 0x3c, 0xd9, 0xd0, 0x1e, 0x08, 0x63, 0x08, 0x76,
+	0x53, 0x7e, 0xca, 0x52, 0xe9, 0xf5, 0x80, 0x4a, 0xd6, 0x9e, 0x2c, 0x7a, 0xe9, 0x8d, 0x9b, 0x8b,
+	0x09, 0x72, 0x06, 0x56, 0xa9, 0x6a, 0x2b, 0xf1, 0xc5, 0x1b, 0xd7, 0x17, 0xa1, 0x25, 0xc3, 0x93,
+	0x06, 0xfb, 0x0b, 0x57, 0x9f, 0xfc, 0xbf, 0x01, 0x00, 0x1b, 0x75, 0x62, 0x6e, 0xf0, 0x6a, 0x00,
+	0x00,
 }
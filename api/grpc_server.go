@@ -16,9 +16,11 @@
 package gobgpapi
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"net"
+	"os"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -35,27 +37,81 @@ import (
 	"google.golang.org/grpc"
 )
 
+const UNIX_SOCKET_PREFIX = "unix://"
+
 type Server struct {
 	bgpServer  *server.BgpServer
 	grpcServer *grpc.Server
 	hosts      string
+	socketMode os.FileMode
+	socketUid  int
+	socketGid  int
 }
 
 func NewGrpcServer(b *server.BgpServer, hosts string) *Server {
 	return NewServer(b, grpc.NewServer(), hosts)
 }
 
+// NewGrpcServerWithAuth is like NewGrpcServer, but the API is protected
+// as specified in c.
+func NewGrpcServerWithAuth(b *server.BgpServer, hosts string, c *AuthConfig) (*Server, error) {
+	opts, err := c.serverOptions()
+	if err != nil {
+		return nil, err
+	}
+	return NewServer(b, grpc.NewServer(opts...), hosts), nil
+}
+
 func NewServer(b *server.BgpServer, g *grpc.Server, hosts string) *Server {
 	grpc.EnableTracing = false
 	server := &Server{
 		bgpServer:  b,
 		grpcServer: g,
 		hosts:      hosts,
+		socketUid:  -1,
+		socketGid:  -1,
 	}
 	RegisterGobgpApiServer(g, server)
 	return server
 }
 
+// SetUnixSocketPermission sets the mode and the owner of the unix domain
+// sockets specified as "unix://<path>" in the hosts. The owner isn't
+// changed if uid or gid is -1.
+func (s *Server) SetUnixSocketPermission(mode os.FileMode, uid, gid int) {
+	s.socketMode = mode
+	s.socketUid = uid
+	s.socketGid = gid
+}
+
+func (s *Server) listen(host string) (net.Listener, error) {
+	if !strings.HasPrefix(host, UNIX_SOCKET_PREFIX) {
+		return net.Listen("tcp", host)
+	}
+	path := strings.TrimPrefix(host, UNIX_SOCKET_PREFIX)
+	// remove the socket left by the previous gobgpd.
+	if fi, err := os.Lstat(path); err == nil && fi.Mode()&os.ModeSocket != 0 {
+		os.Remove(path)
+	}
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if s.socketMode != 0 {
+		if err := os.Chmod(path, s.socketMode); err != nil {
+			lis.Close()
+			return nil, err
+		}
+	}
+	if s.socketUid != -1 || s.socketGid != -1 {
+		if err := os.Chown(path, s.socketUid, s.socketGid); err != nil {
+			lis.Close()
+			return nil, err
+		}
+	}
+	return lis, nil
+}
+
 func (s *Server) Serve() error {
 	var wg sync.WaitGroup
 	l := strings.Split(s.hosts, ",")
@@ -64,7 +120,7 @@ func (s *Server) Serve() error {
 	serve := func(host string) {
 		for {
 			defer wg.Done()
-			lis, err := net.Listen("tcp", fmt.Sprintf(host))
+			lis, err := s.listen(host)
 			if err != nil {
 				log.WithFields(log.Fields{
 					"Topic": "grpc",
@@ -88,38 +144,48 @@ func (s *Server) Serve() error {
 	return nil
 }
 
-func NewPeerFromConfigStruct(pconf *config.Neighbor) *Peer {
+func newFamiliesFromConfigStruct(afiSafis []config.AfiSafi) []uint32 {
 	var families []uint32
-	for _, f := range pconf.AfiSafis {
+	for _, f := range afiSafis {
 		if family, ok := bgp.AddressFamilyValueMap[string(f.Config.AfiSafiName)]; ok {
 			families = append(families, uint32(family))
 		}
 	}
+	return families
+}
+
+func newApplyPolicyFromConfigStruct(c *config.ApplyPolicy) *ApplyPolicy {
 	applyPolicy := &ApplyPolicy{}
-	if len(pconf.ApplyPolicy.Config.ImportPolicyList) != 0 {
+	if len(c.Config.ImportPolicyList) != 0 {
 		applyPolicy.ImportPolicy = &PolicyAssignment{
 			Type: PolicyType_IMPORT,
 		}
-		for _, pname := range pconf.ApplyPolicy.Config.ImportPolicyList {
+		for _, pname := range c.Config.ImportPolicyList {
 			applyPolicy.ImportPolicy.Policies = append(applyPolicy.ImportPolicy.Policies, &Policy{Name: pname})
 		}
 	}
-	if len(pconf.ApplyPolicy.Config.ExportPolicyList) != 0 {
+	if len(c.Config.ExportPolicyList) != 0 {
 		applyPolicy.ExportPolicy = &PolicyAssignment{
 			Type: PolicyType_EXPORT,
 		}
-		for _, pname := range pconf.ApplyPolicy.Config.ExportPolicyList {
+		for _, pname := range c.Config.ExportPolicyList {
 			applyPolicy.ExportPolicy.Policies = append(applyPolicy.ExportPolicy.Policies, &Policy{Name: pname})
 		}
 	}
-	if len(pconf.ApplyPolicy.Config.InPolicyList) != 0 {
+	if len(c.Config.InPolicyList) != 0 {
 		applyPolicy.InPolicy = &PolicyAssignment{
 			Type: PolicyType_IN,
 		}
-		for _, pname := range pconf.ApplyPolicy.Config.InPolicyList {
+		for _, pname := range c.Config.InPolicyList {
 			applyPolicy.InPolicy.Policies = append(applyPolicy.InPolicy.Policies, &Policy{Name: pname})
 		}
 	}
+	return applyPolicy
+}
+
+func NewPeerFromConfigStruct(pconf *config.Neighbor) *Peer {
+	families := newFamiliesFromConfigStruct(pconf.AfiSafis)
+	applyPolicy := newApplyPolicyFromConfigStruct(&pconf.ApplyPolicy)
 	prefixLimits := make([]*PrefixLimit, 0, len(pconf.AfiSafis))
 	for _, family := range pconf.AfiSafis {
 		if c := family.PrefixLimit.Config; c.MaxPrefixes > 0 {
@@ -131,6 +197,27 @@ func NewPeerFromConfigStruct(pconf *config.Neighbor) *Peer {
 			})
 		}
 	}
+	defaultOriginates := make([]*DefaultOriginate, 0)
+	for _, family := range pconf.AfiSafis {
+		if d := family.DefaultOriginate; d.Config.Enabled {
+			k, _ := bgp.GetRouteFamily(string(family.Config.AfiSafiName))
+			defaultOriginates = append(defaultOriginates, &DefaultOriginate{
+				Family:      uint32(k),
+				RoutePolicy: d.Config.RoutePolicy,
+				Originated:  d.State.Originated,
+			})
+		}
+	}
+	conditionalAdvertisements := make([]*ConditionalAdvertisement, 0, len(pconf.ConditionalAdvertisements))
+	for _, c := range pconf.ConditionalAdvertisements {
+		conditionalAdvertisements = append(conditionalAdvertisements, &ConditionalAdvertisement{
+			AdvertisePolicy:   c.Config.AdvertisePolicy,
+			ExistPrefixSet:    c.Config.ExistPrefixSet,
+			NonExistPrefixSet: c.Config.NonExistPrefixSet,
+			ConditionMet:      c.State.ConditionMet,
+			TrackedPaths:      c.State.TrackedPaths,
+		})
+	}
 
 	timer := pconf.Timers
 	s := pconf.State
@@ -151,23 +238,25 @@ func NewPeerFromConfigStruct(pconf *config.Neighbor) *Peer {
 		Families:    families,
 		ApplyPolicy: applyPolicy,
 		Conf: &PeerConf{
-			NeighborAddress:   pconf.Config.NeighborAddress,
-			Id:                s.RemoteRouterId,
-			PeerAs:            pconf.Config.PeerAs,
-			LocalAs:           pconf.Config.LocalAs,
-			PeerType:          uint32(pconf.Config.PeerType.ToInt()),
-			AuthPassword:      pconf.Config.AuthPassword,
-			RemovePrivateAs:   uint32(pconf.Config.RemovePrivateAs.ToInt()),
-			RouteFlapDamping:  pconf.Config.RouteFlapDamping,
-			SendCommunity:     uint32(pconf.Config.SendCommunity.ToInt()),
-			Description:       pconf.Config.Description,
-			PeerGroup:         pconf.Config.PeerGroup,
-			RemoteCap:         remoteCap,
-			LocalCap:          localCap,
-			PrefixLimits:      prefixLimits,
-			LocalAddress:      localAddress,
-			NeighborInterface: pconf.Config.NeighborInterface,
-			Vrf:               pconf.Config.Vrf,
+			NeighborAddress:           pconf.Config.NeighborAddress,
+			Id:                        s.RemoteRouterId,
+			PeerAs:                    pconf.Config.PeerAs,
+			LocalAs:                   pconf.Config.LocalAs,
+			PeerType:                  uint32(pconf.Config.PeerType.ToInt()),
+			AuthPassword:              pconf.Config.AuthPassword,
+			RemovePrivateAs:           uint32(pconf.Config.RemovePrivateAs.ToInt()),
+			RouteFlapDamping:          pconf.Config.RouteFlapDamping,
+			SendCommunity:             uint32(pconf.Config.SendCommunity.ToInt()),
+			Description:               pconf.Config.Description,
+			PeerGroup:                 pconf.Config.PeerGroup,
+			RemoteCap:                 remoteCap,
+			LocalCap:                  localCap,
+			PrefixLimits:              prefixLimits,
+			LocalAddress:              localAddress,
+			NeighborInterface:         pconf.Config.NeighborInterface,
+			Vrf:                       pconf.Config.Vrf,
+			DefaultOriginates:         defaultOriginates,
+			ConditionalAdvertisements: conditionalAdvertisements,
 		},
 		Info: &PeerState{
 			BgpState:   string(s.SessionState),
@@ -192,9 +281,14 @@ func NewPeerFromConfigStruct(pconf *config.Neighbor) *Peer {
 					TOTAL:        s.Messages.Sent.Total,
 				},
 			},
-			Received:   s.AdjTable.Received,
-			Accepted:   s.AdjTable.Accepted,
-			Advertised: s.AdjTable.Advertised,
+			Received:                s.AdjTable.Received,
+			Accepted:                s.AdjTable.Accepted,
+			Advertised:              s.AdjTable.Advertised,
+			ErroneousUpdateMessages: pconf.ErrorHandling.State.ErroneousUpdateMessages,
+			SessionResetCount:       pconf.ErrorHandling.State.SessionResetCount,
+			AfiSafiDisableCount:     pconf.ErrorHandling.State.AfiSafiDisableCount,
+			TreatAsWithdrawCount:    pconf.ErrorHandling.State.TreatAsWithdrawCount,
+			AttributeDiscardCount:   pconf.ErrorHandling.State.AttributeDiscardCount,
 		},
 		Timers: &Timers{
 			Config: &TimersConfig{
@@ -216,9 +310,37 @@ func NewPeerFromConfigStruct(pconf *config.Neighbor) *Peer {
 		RouteServer: &RouteServer{
 			RouteServerClient: pconf.RouteServer.Config.RouteServerClient,
 		},
+		AsPathOptions: &AsPathOptions{
+			AllowOwnAs:    uint32(pconf.AsPathOptions.Config.AllowOwnAs),
+			ReplacePeerAs: pconf.AsPathOptions.Config.ReplacePeerAs,
+		},
+		ErrorHandling: &ErrorHandling{
+			TreatAsWithdraw: pconf.ErrorHandling.Config.TreatAsWithdraw,
+		},
+		Bfd: &Bfd{
+			Config: &BfdConfig{
+				Enabled:               pconf.Bfd.Config.Enabled,
+				Multihop:              pconf.Bfd.Config.Multihop,
+				DesiredMinTxInterval:  pconf.Bfd.Config.DesiredMinTxInterval,
+				RequiredMinRxInterval: pconf.Bfd.Config.RequiredMinRxInterval,
+				DetectMultiplier:      uint32(pconf.Bfd.Config.DetectMultiplier),
+			},
+			State: &BfdState{
+				SessionState:        pconf.Bfd.State.SessionState,
+				Diagnostic:          pconf.Bfd.State.Diagnostic,
+				LocalDiscriminator:  pconf.Bfd.State.LocalDiscriminator,
+				RemoteDiscriminator: pconf.Bfd.State.RemoteDiscriminator,
+				DetectionTime:       pconf.Bfd.State.DetectionTime,
+				Uptime:              pconf.Bfd.State.Uptime,
+				UpCount:             pconf.Bfd.State.UpCount,
+			},
+		},
 		Transport: &Transport{
 			RemotePort:   uint32(pconf.Transport.Config.RemotePort),
 			LocalAddress: pconf.Transport.Config.LocalAddress,
+			TtlSecurity:  pconf.Transport.Config.TtlSecurity,
+			Ttl:          uint32(pconf.Transport.State.Ttl),
+			MinTtl:       uint32(pconf.Transport.State.MinTtl),
 		},
 	}
 }
@@ -259,6 +381,8 @@ func ToPathApi(path *table.Path) *Path {
 		NoImplicitWithdraw: path.NoImplicitWithdraw(),
 		Uuid:               path.UUID().Bytes(),
 		IsNexthopInvalid:   path.IsNexthopInvalid,
+		Identifier:         nlri.PathIdentifier(),
+		LocalIdentifier:    path.GetLocalIdentifier(),
 	}
 	if s := path.GetSource(); s != nil {
 		p.SourceAsn = s.AS
@@ -349,8 +473,14 @@ func (s *Server) MonitorRib(arg *MonitorRibRequest, stream GobgpApi_MonitorRibSe
 	w, err := func() (*server.Watcher, error) {
 		switch t.Type {
 		case Resource_GLOBAL:
+			if arg.ResumeFrom != 0 || arg.ResumeEpoch != 0 {
+				return s.bgpServer.Watch(server.WatchBestPathFrom(arg.ResumeEpoch, arg.ResumeFrom)), nil
+			}
 			return s.bgpServer.Watch(server.WatchBestPath(arg.Current)), nil
 		case Resource_ADJ_IN:
+			if arg.ResumeFrom != 0 || arg.ResumeEpoch != 0 {
+				return nil, fmt.Errorf("resume is supported only for the global rib")
+			}
 			if t.PostPolicy {
 				return s.bgpServer.Watch(server.WatchPostUpdate(arg.Current)), nil
 			}
@@ -360,13 +490,13 @@ func (s *Server) MonitorRib(arg *MonitorRibRequest, stream GobgpApi_MonitorRibSe
 		}
 	}()
 	if err != nil {
-		return nil
+		return err
 	}
 
 	return func() error {
 		defer func() { w.Stop() }()
 
-		sendPath := func(pathList []*table.Path) error {
+		sendPath := func(pathList []*table.Path, epoch, seq uint64, snapshot bool) error {
 			dsts := make(map[string]*Destination)
 			for _, path := range pathList {
 				if path == nil || (t.Family != 0 && bgp.RouteFamily(t.Family) != path.GetRouteFamily()) {
@@ -376,8 +506,11 @@ func (s *Server) MonitorRib(arg *MonitorRibRequest, stream GobgpApi_MonitorRibSe
 					dst.Paths = append(dst.Paths, ToPathApi(path))
 				} else {
 					dsts[path.GetNlri().String()] = &Destination{
-						Prefix: path.GetNlri().String(),
-						Paths:  []*Path{ToPathApi(path)},
+						Prefix:   path.GetNlri().String(),
+						Paths:    []*Path{ToPathApi(path)},
+						Sequence: seq,
+						Snapshot: snapshot,
+						Epoch:    epoch,
 					}
 				}
 			}
@@ -403,11 +536,11 @@ func (s *Server) MonitorRib(arg *MonitorRibRequest, stream GobgpApi_MonitorRibSe
 						} else {
 							return msg.PathList
 						}
-					}()); err != nil {
+					}(), msg.Epoch, msg.Sequence, msg.Snapshot); err != nil {
 						return err
 					}
 				case *server.WatchEventUpdate:
-					if err := sendPath(msg.PathList); err != nil {
+					if err := sendPath(msg.PathList, 0, 0, false); err != nil {
 						return err
 					}
 				}
@@ -460,6 +593,70 @@ func (s *Server) MonitorPeerState(arg *Arguments, stream GobgpApi_MonitorPeerSta
 	}()
 }
 
+func toEventApi(e *server.Event) *Event {
+	ev := &Event{
+		Sequence:    e.Sequence,
+		Type:        string(e.Type),
+		Timestamp:   e.Timestamp.UnixNano(),
+		Neighbor:    e.Neighbor,
+		PeerAs:      e.PeerAs,
+		Family:      e.Family,
+		Prefix:      e.Prefix,
+		Withdrawal:  e.Withdrawal,
+		Nexthop:     e.Nexthop,
+		AsPath:      e.AsPath,
+		Origin:      e.Origin,
+		LocalPref:   e.LocalPref,
+		Communities: e.Communities,
+		State:       string(e.State),
+		AdminState:  string(e.AdminState),
+		Dropped:     e.Dropped,
+	}
+	if e.Med != nil {
+		ev.HasMed = true
+		ev.Med = *e.Med
+	}
+	return ev
+}
+
+func (s *Server) MonitorEvents(arg *MonitorEventsRequest, stream GobgpApi_MonitorEventsServer) error {
+	if arg == nil {
+		return fmt.Errorf("invalid request")
+	}
+	f := &server.EventFilter{
+		Types:       make([]server.EventType, 0, len(arg.Types)),
+		Families:    make([]bgp.RouteFamily, 0, len(arg.Families)),
+		Neighbors:   arg.Neighbors,
+		PrefixSet:   arg.PrefixSet,
+		Communities: arg.Communities,
+	}
+	for _, t := range arg.Types {
+		f.Types = append(f.Types, server.EventType(t))
+	}
+	for _, rf := range arg.Families {
+		f.Families = append(f.Families, bgp.RouteFamily(rf))
+	}
+	w, err := s.bgpServer.WatchEvent(f, arg.Current)
+	if err != nil {
+		return err
+	}
+	defer w.Stop()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case e, ok := <-w.Event():
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(toEventApi(e)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func (s *Server) ResetNeighbor(ctx context.Context, arg *ResetNeighborRequest) (*ResetNeighborResponse, error) {
 	return &ResetNeighborResponse{}, s.bgpServer.ResetNeighbor(arg.Address, arg.Communication)
 }
@@ -646,11 +843,20 @@ func (s *Server) AddBmp(ctx context.Context, arg *AddBmpRequest) (*AddBmpRespons
 	if !ok {
 		return nil, fmt.Errorf("invalid bmp route monitoring policy: %d", arg.Type)
 	}
-	return &AddBmpResponse{}, s.bgpServer.AddBmp(&config.BmpServerConfig{
-		Address: arg.Address,
-		Port:    arg.Port,
+	c := &config.BmpServerConfig{
+		Address:               arg.Address,
+		Port:                  arg.Port,
 		RouteMonitoringPolicy: t,
-	})
+		RouteMonitoringLocRib: arg.LocRib,
+	}
+	if arg.AdjRibOut {
+		t, ok := config.IntToBmpRouteMonitoringPolicyTypeMap[int(arg.AdjRibOutType)]
+		if !ok {
+			return nil, fmt.Errorf("invalid bmp adj-rib-out monitoring policy: %d", arg.AdjRibOutType)
+		}
+		c.RouteMonitoringAdjRibOut = t
+	}
+	return &AddBmpResponse{}, s.bgpServer.AddBmp(c)
 }
 
 func (s *Server) DeleteBmp(ctx context.Context, arg *DeleteBmpRequest) (*DeleteBmpResponse, error) {
@@ -742,6 +948,43 @@ func (s *Server) GetRpki(ctx context.Context, arg *GetRpkiRequest) (*GetRpkiResp
 	return &GetRpkiResponse{Servers: l}, nil
 }
 
+func (s *Server) GetHealthCheck(ctx context.Context, arg *GetHealthCheckRequest) (*GetHealthCheckResponse, error) {
+	checks := s.bgpServer.GetHealthCheck()
+	l := make([]*HealthCheck, 0, len(checks))
+	for _, h := range checks {
+		routes := make([]*HealthCheckRoute, 0, len(h.Routes))
+		for _, r := range h.Routes {
+			routes = append(routes, &HealthCheckRoute{
+				Prefix:              r.Config.Prefix,
+				NextHop:             r.Config.NextHop,
+				Med:                 r.Config.Med,
+				Communities:         r.Config.CommunityList,
+				DegradedMed:         r.Config.DegradedMed,
+				DegradedCommunities: r.Config.DegradedCommunityList,
+			})
+		}
+		l = append(l, &HealthCheck{
+			Name:           h.Config.Name,
+			Type:           uint32(h.Config.Type.ToInt()),
+			Address:        h.Config.Address,
+			Url:            h.Config.Url,
+			ExpectedStatus: uint32(h.Config.ExpectedStatus),
+			Command:        h.Config.Command,
+			Interval:       h.Config.Interval,
+			Timeout:        h.Config.Timeout,
+			Rise:           h.Config.Rise,
+			Fall:           h.Config.Fall,
+			Up:             h.State.Up,
+			Successes:      h.State.Successes,
+			Failures:       h.State.Failures,
+			LastError:      h.State.LastError,
+			LastChange:     h.State.LastChange,
+			Routes:         routes,
+		})
+	}
+	return &GetHealthCheckResponse{HealthChecks: l}, nil
+}
+
 func (s *Server) GetRoa(ctx context.Context, arg *GetRoaRequest) (*GetRoaResponse, error) {
 	roas, err := s.bgpServer.GetRoa(bgp.RouteFamily(arg.Family))
 	if err != nil {
@@ -774,7 +1017,7 @@ func (s *Server) EnableZebra(ctx context.Context, arg *EnableZebraRequest) (*Ena
 		}
 	}
 	return &EnableZebraResponse{}, s.bgpServer.StartZebraClient(&config.ZebraConfig{
-		Url: arg.Url,
+		Url:                       arg.Url,
 		RedistributeRouteTypeList: l,
 		Version:                   uint8(arg.Version),
 		NexthopTriggerEnable:      arg.NexthopTriggerEnable,
@@ -843,6 +1086,41 @@ func (s *Server) DeleteVrf(ctx context.Context, arg *DeleteVrfRequest) (*DeleteV
 	return &DeleteVrfResponse{}, s.bgpServer.DeleteVrf(arg.Vrf.Name)
 }
 
+func newAfiSafisFromAPIStruct(families []uint32) []config.AfiSafi {
+	var afiSafis []config.AfiSafi
+	for _, f := range families {
+		family := bgp.RouteFamily(f)
+		afiSafis = append(afiSafis, config.AfiSafi{
+			Config: config.AfiSafiConfig{
+				AfiSafiName: config.AfiSafiType(family.String()),
+				Enabled:     true,
+			},
+		})
+	}
+	return afiSafis
+}
+
+func readApplyPolicyFromAPIStruct(c *config.ApplyPolicy, a *ApplyPolicy) {
+	if a.ImportPolicy != nil {
+		c.Config.DefaultImportPolicy = config.DefaultPolicyType(a.ImportPolicy.Default)
+		for _, p := range a.ImportPolicy.Policies {
+			c.Config.ImportPolicyList = append(c.Config.ImportPolicyList, p.Name)
+		}
+	}
+	if a.ExportPolicy != nil {
+		c.Config.DefaultExportPolicy = config.DefaultPolicyType(a.ExportPolicy.Default)
+		for _, p := range a.ExportPolicy.Policies {
+			c.Config.ExportPolicyList = append(c.Config.ExportPolicyList, p.Name)
+		}
+	}
+	if a.InPolicy != nil {
+		c.Config.DefaultInPolicy = config.DefaultPolicyType(a.InPolicy.Default)
+		for _, p := range a.InPolicy.Policies {
+			c.Config.InPolicyList = append(c.Config.InPolicyList, p.Name)
+		}
+	}
+}
+
 func NewNeighborFromAPIStruct(a *Peer) (*config.Neighbor, error) {
 	pconf := &config.Neighbor{}
 	if a.Conf != nil {
@@ -850,9 +1128,10 @@ func NewNeighborFromAPIStruct(a *Peer) (*config.Neighbor, error) {
 		pconf.Config.PeerAs = a.Conf.PeerAs
 		pconf.Config.LocalAs = a.Conf.LocalAs
 		pconf.Config.AuthPassword = a.Conf.AuthPassword
-		pconf.Config.RemovePrivateAs = config.RemovePrivateAsOption(a.Conf.RemovePrivateAs)
+		pconf.Config.RemovePrivateAs = config.IntToRemovePrivateAsOptionMap[int(a.Conf.RemovePrivateAs)]
 		pconf.Config.RouteFlapDamping = a.Conf.RouteFlapDamping
-		pconf.Config.SendCommunity = config.CommunityType(a.Conf.SendCommunity)
+		pconf.Config.SendCommunity = config.IntToCommunityTypeMap[int(a.Conf.SendCommunity)]
+		pconf.Config.PeerType = config.IntToPeerTypeMap[int(a.Conf.PeerType)]
 		pconf.Config.Description = a.Conf.Description
 		pconf.Config.PeerGroup = a.Conf.PeerGroup
 		pconf.Config.NeighborAddress = a.Conf.NeighborAddress
@@ -884,15 +1163,7 @@ func NewNeighborFromAPIStruct(a *Peer) (*config.Neighbor, error) {
 
 		pconf.State.RemoteRouterId = a.Conf.Id
 
-		for _, f := range a.Families {
-			family := bgp.RouteFamily(f)
-			pconf.AfiSafis = append(pconf.AfiSafis, config.AfiSafi{
-				Config: config.AfiSafiConfig{
-					AfiSafiName: config.AfiSafiType(family.String()),
-					Enabled:     true,
-				},
-			})
-		}
+		pconf.AfiSafis = newAfiSafisFromAPIStruct(a.Families)
 
 		for _, pl := range a.Conf.PrefixLimits {
 			for _, f := range pconf.AfiSafis {
@@ -902,6 +1173,30 @@ func NewNeighborFromAPIStruct(a *Peer) (*config.Neighbor, error) {
 				}
 			}
 		}
+
+		for _, d := range a.Conf.DefaultOriginates {
+			for i, f := range pconf.AfiSafis {
+				if f.Config.AfiSafiName == config.AfiSafiType(bgp.RouteFamily(d.Family).String()) {
+					pconf.AfiSafis[i].DefaultOriginate.Config.Enabled = true
+					pconf.AfiSafis[i].DefaultOriginate.Config.RoutePolicy = d.RoutePolicy
+					pconf.AfiSafis[i].DefaultOriginate.State.Originated = d.Originated
+				}
+			}
+		}
+
+		for _, c := range a.Conf.ConditionalAdvertisements {
+			pconf.ConditionalAdvertisements = append(pconf.ConditionalAdvertisements, config.ConditionalAdvertisement{
+				Config: config.ConditionalAdvertisementConfig{
+					AdvertisePolicy:   c.AdvertisePolicy,
+					ExistPrefixSet:    c.ExistPrefixSet,
+					NonExistPrefixSet: c.NonExistPrefixSet,
+				},
+				State: config.ConditionalAdvertisementState{
+					ConditionMet: c.ConditionMet,
+					TrackedPaths: c.TrackedPaths,
+				},
+			})
+		}
 	}
 
 	if a.Timers != nil {
@@ -926,34 +1221,46 @@ func NewNeighborFromAPIStruct(a *Peer) (*config.Neighbor, error) {
 		pconf.RouteServer.Config.RouteServerClient = a.RouteServer.RouteServerClient
 	}
 	if a.ApplyPolicy != nil {
-		if a.ApplyPolicy.ImportPolicy != nil {
-			pconf.ApplyPolicy.Config.DefaultImportPolicy = config.DefaultPolicyType(a.ApplyPolicy.ImportPolicy.Default)
-			for _, p := range a.ApplyPolicy.ImportPolicy.Policies {
-				pconf.ApplyPolicy.Config.ImportPolicyList = append(pconf.ApplyPolicy.Config.ImportPolicyList, p.Name)
-			}
-		}
-		if a.ApplyPolicy.ExportPolicy != nil {
-			pconf.ApplyPolicy.Config.DefaultExportPolicy = config.DefaultPolicyType(a.ApplyPolicy.ExportPolicy.Default)
-			for _, p := range a.ApplyPolicy.ExportPolicy.Policies {
-				pconf.ApplyPolicy.Config.ExportPolicyList = append(pconf.ApplyPolicy.Config.ExportPolicyList, p.Name)
-			}
-		}
-		if a.ApplyPolicy.InPolicy != nil {
-			pconf.ApplyPolicy.Config.DefaultInPolicy = config.DefaultPolicyType(a.ApplyPolicy.InPolicy.Default)
-			for _, p := range a.ApplyPolicy.InPolicy.Policies {
-				pconf.ApplyPolicy.Config.InPolicyList = append(pconf.ApplyPolicy.Config.InPolicyList, p.Name)
-			}
-		}
+		readApplyPolicyFromAPIStruct(&pconf.ApplyPolicy, a.ApplyPolicy)
 	}
 	if a.Transport != nil {
 		pconf.Transport.Config.LocalAddress = a.Transport.LocalAddress
 		pconf.Transport.Config.PassiveMode = a.Transport.PassiveMode
 		pconf.Transport.Config.RemotePort = uint16(a.Transport.RemotePort)
+		pconf.Transport.Config.TtlSecurity = a.Transport.TtlSecurity
+		pconf.Transport.State.TtlSecurity = a.Transport.TtlSecurity
+		pconf.Transport.State.Ttl = uint8(a.Transport.Ttl)
+		pconf.Transport.State.MinTtl = uint8(a.Transport.MinTtl)
 	}
 	if a.EbgpMultihop != nil {
 		pconf.EbgpMultihop.Config.Enabled = a.EbgpMultihop.Enabled
 		pconf.EbgpMultihop.Config.MultihopTtl = uint8(a.EbgpMultihop.MultihopTtl)
 	}
+	if a.AsPathOptions != nil {
+		pconf.AsPathOptions.Config.AllowOwnAs = uint8(a.AsPathOptions.AllowOwnAs)
+		pconf.AsPathOptions.Config.ReplacePeerAs = a.AsPathOptions.ReplacePeerAs
+	}
+	if a.ErrorHandling != nil {
+		pconf.ErrorHandling.Config.TreatAsWithdraw = a.ErrorHandling.TreatAsWithdraw
+	}
+	if a.Bfd != nil {
+		if c := a.Bfd.Config; c != nil {
+			pconf.Bfd.Config.Enabled = c.Enabled
+			pconf.Bfd.Config.Multihop = c.Multihop
+			pconf.Bfd.Config.DesiredMinTxInterval = c.DesiredMinTxInterval
+			pconf.Bfd.Config.RequiredMinRxInterval = c.RequiredMinRxInterval
+			pconf.Bfd.Config.DetectMultiplier = uint8(c.DetectMultiplier)
+		}
+		if st := a.Bfd.State; st != nil {
+			pconf.Bfd.State.SessionState = st.SessionState
+			pconf.Bfd.State.Diagnostic = st.Diagnostic
+			pconf.Bfd.State.LocalDiscriminator = st.LocalDiscriminator
+			pconf.Bfd.State.RemoteDiscriminator = st.RemoteDiscriminator
+			pconf.Bfd.State.DetectionTime = st.DetectionTime
+			pconf.Bfd.State.Uptime = st.Uptime
+			pconf.Bfd.State.UpCount = st.UpCount
+		}
+	}
 	if a.Info != nil {
 		pconf.State.SessionState = config.SessionState(a.Info.BgpState)
 		pconf.State.AdminState = config.IntToAdminStateMap[int(a.Info.AdminState)]
@@ -962,6 +1269,12 @@ func NewNeighborFromAPIStruct(a *Peer) (*config.Neighbor, error) {
 		pconf.State.AdjTable.Accepted = a.Info.Accepted
 		pconf.State.AdjTable.Advertised = a.Info.Advertised
 
+		pconf.ErrorHandling.State.ErroneousUpdateMessages = a.Info.ErroneousUpdateMessages
+		pconf.ErrorHandling.State.SessionResetCount = a.Info.SessionResetCount
+		pconf.ErrorHandling.State.AfiSafiDisableCount = a.Info.AfiSafiDisableCount
+		pconf.ErrorHandling.State.TreatAsWithdrawCount = a.Info.TreatAsWithdrawCount
+		pconf.ErrorHandling.State.AttributeDiscardCount = a.Info.AttributeDiscardCount
+
 		if a.Info.Messages != nil {
 			if a.Info.Messages.Sent != nil {
 				pconf.State.Messages.Sent.Update = a.Info.Messages.Sent.UPDATE
@@ -990,14 +1303,264 @@ func (s *Server) AddNeighbor(ctx context.Context, arg *AddNeighborRequest) (*Add
 	if err != nil {
 		return nil, err
 	}
+	if arg.Candidate {
+		return &AddNeighborResponse{}, s.bgpServer.EditCandidate(func(candidate *server.Candidate) error {
+			return candidate.AddNeighbor(c)
+		})
+	}
 	return &AddNeighborResponse{}, s.bgpServer.AddNeighbor(c)
 }
 
 func (s *Server) DeleteNeighbor(ctx context.Context, arg *DeleteNeighborRequest) (*DeleteNeighborResponse, error) {
-	return &DeleteNeighborResponse{}, s.bgpServer.DeleteNeighbor(&config.Neighbor{Config: config.NeighborConfig{
+	c := &config.Neighbor{Config: config.NeighborConfig{
 		NeighborAddress:   arg.Peer.Conf.NeighborAddress,
 		NeighborInterface: arg.Peer.Conf.NeighborInterface,
-	}})
+	}}
+	if arg.Candidate {
+		return &DeleteNeighborResponse{}, s.bgpServer.EditCandidate(func(candidate *server.Candidate) error {
+			return candidate.DeleteNeighbor(c)
+		})
+	}
+	return &DeleteNeighborResponse{}, s.bgpServer.DeleteNeighbor(c)
+}
+
+func NewPeerGroupFromConfigStruct(pconf *config.PeerGroup) *PeerGroup {
+	timer := pconf.Timers
+	return &PeerGroup{
+		Families:    newFamiliesFromConfigStruct(pconf.AfiSafis),
+		ApplyPolicy: newApplyPolicyFromConfigStruct(&pconf.ApplyPolicy),
+		Conf: &PeerGroupConf{
+			PeerAs:           pconf.Config.PeerAs,
+			LocalAs:          pconf.Config.LocalAs,
+			PeerType:         uint32(pconf.Config.PeerType.ToInt()),
+			AuthPassword:     pconf.Config.AuthPassword,
+			RemovePrivateAs:  uint32(pconf.Config.RemovePrivateAs.ToInt()),
+			RouteFlapDamping: pconf.Config.RouteFlapDamping,
+			SendCommunity:    uint32(pconf.Config.SendCommunity.ToInt()),
+			Description:      pconf.Config.Description,
+			PeerGroupName:    pconf.Config.PeerGroupName,
+		},
+		EbgpMultihop: &EbgpMultihop{
+			Enabled:     pconf.EbgpMultihop.Config.Enabled,
+			MultihopTtl: uint32(pconf.EbgpMultihop.Config.MultihopTtl),
+		},
+		Timers: &Timers{
+			Config: &TimersConfig{
+				ConnectRetry:                 uint64(timer.Config.ConnectRetry),
+				HoldTime:                     uint64(timer.Config.HoldTime),
+				KeepaliveInterval:            uint64(timer.Config.KeepaliveInterval),
+				MinimumAdvertisementInterval: uint64(timer.Config.MinimumAdvertisementInterval),
+			},
+		},
+		RouteReflector: &RouteReflector{
+			RouteReflectorClient:    pconf.RouteReflector.Config.RouteReflectorClient,
+			RouteReflectorClusterId: string(pconf.RouteReflector.Config.RouteReflectorClusterId),
+		},
+		RouteServer: &RouteServer{
+			RouteServerClient: pconf.RouteServer.Config.RouteServerClient,
+		},
+		AsPathOptions: &AsPathOptions{
+			AllowOwnAs:    uint32(pconf.AsPathOptions.Config.AllowOwnAs),
+			ReplacePeerAs: pconf.AsPathOptions.Config.ReplacePeerAs,
+		},
+		ErrorHandling: &ErrorHandling{
+			TreatAsWithdraw: pconf.ErrorHandling.Config.TreatAsWithdraw,
+		},
+		Bfd: &Bfd{
+			Config: &BfdConfig{
+				Enabled:               pconf.Bfd.Config.Enabled,
+				Multihop:              pconf.Bfd.Config.Multihop,
+				DesiredMinTxInterval:  pconf.Bfd.Config.DesiredMinTxInterval,
+				RequiredMinRxInterval: pconf.Bfd.Config.RequiredMinRxInterval,
+				DetectMultiplier:      uint32(pconf.Bfd.Config.DetectMultiplier),
+			},
+		},
+		Transport: &Transport{
+			RemotePort:   uint32(pconf.Transport.Config.RemotePort),
+			LocalAddress: pconf.Transport.Config.LocalAddress,
+			PassiveMode:  pconf.Transport.Config.PassiveMode,
+			TtlSecurity:  pconf.Transport.Config.TtlSecurity,
+		},
+	}
+}
+
+func NewPeerGroupFromAPIStruct(a *PeerGroup) (*config.PeerGroup, error) {
+	pconf := &config.PeerGroup{}
+	if a.Conf == nil {
+		return nil, fmt.Errorf("peer-group config is not specified")
+	}
+	pconf.Config.PeerAs = a.Conf.PeerAs
+	pconf.Config.LocalAs = a.Conf.LocalAs
+	pconf.Config.AuthPassword = a.Conf.AuthPassword
+	pconf.Config.RemovePrivateAs = config.IntToRemovePrivateAsOptionMap[int(a.Conf.RemovePrivateAs)]
+	pconf.Config.RouteFlapDamping = a.Conf.RouteFlapDamping
+	pconf.Config.SendCommunity = config.IntToCommunityTypeMap[int(a.Conf.SendCommunity)]
+	pconf.Config.Description = a.Conf.Description
+	pconf.Config.PeerGroupName = a.Conf.PeerGroupName
+	pconf.AfiSafis = newAfiSafisFromAPIStruct(a.Families)
+
+	if a.Timers != nil && a.Timers.Config != nil {
+		pconf.Timers.Config.ConnectRetry = float64(a.Timers.Config.ConnectRetry)
+		pconf.Timers.Config.HoldTime = float64(a.Timers.Config.HoldTime)
+		pconf.Timers.Config.KeepaliveInterval = float64(a.Timers.Config.KeepaliveInterval)
+		pconf.Timers.Config.MinimumAdvertisementInterval = float64(a.Timers.Config.MinimumAdvertisementInterval)
+	}
+	if a.RouteReflector != nil {
+		pconf.RouteReflector.Config.RouteReflectorClusterId = config.RrClusterIdType(a.RouteReflector.RouteReflectorClusterId)
+		pconf.RouteReflector.Config.RouteReflectorClient = a.RouteReflector.RouteReflectorClient
+	}
+	if a.RouteServer != nil {
+		pconf.RouteServer.Config.RouteServerClient = a.RouteServer.RouteServerClient
+	}
+	if a.ApplyPolicy != nil {
+		readApplyPolicyFromAPIStruct(&pconf.ApplyPolicy, a.ApplyPolicy)
+	}
+	if a.Transport != nil {
+		pconf.Transport.Config.LocalAddress = a.Transport.LocalAddress
+		pconf.Transport.Config.PassiveMode = a.Transport.PassiveMode
+		pconf.Transport.Config.RemotePort = uint16(a.Transport.RemotePort)
+		pconf.Transport.Config.TtlSecurity = a.Transport.TtlSecurity
+	}
+	if a.EbgpMultihop != nil {
+		pconf.EbgpMultihop.Config.Enabled = a.EbgpMultihop.Enabled
+		pconf.EbgpMultihop.Config.MultihopTtl = uint8(a.EbgpMultihop.MultihopTtl)
+	}
+	if a.AsPathOptions != nil {
+		pconf.AsPathOptions.Config.AllowOwnAs = uint8(a.AsPathOptions.AllowOwnAs)
+		pconf.AsPathOptions.Config.ReplacePeerAs = a.AsPathOptions.ReplacePeerAs
+	}
+	if a.ErrorHandling != nil {
+		pconf.ErrorHandling.Config.TreatAsWithdraw = a.ErrorHandling.TreatAsWithdraw
+	}
+	if a.Bfd != nil && a.Bfd.Config != nil {
+		pconf.Bfd.Config.Enabled = a.Bfd.Config.Enabled
+		pconf.Bfd.Config.Multihop = a.Bfd.Config.Multihop
+		pconf.Bfd.Config.DesiredMinTxInterval = a.Bfd.Config.DesiredMinTxInterval
+		pconf.Bfd.Config.RequiredMinRxInterval = a.Bfd.Config.RequiredMinRxInterval
+		pconf.Bfd.Config.DetectMultiplier = uint8(a.Bfd.Config.DetectMultiplier)
+	}
+	return pconf, nil
+}
+
+func (s *Server) AddPeerGroup(ctx context.Context, arg *AddPeerGroupRequest) (*AddPeerGroupResponse, error) {
+	c, err := NewPeerGroupFromAPIStruct(arg.PeerGroup)
+	if err != nil {
+		return nil, err
+	}
+	if arg.Candidate {
+		return &AddPeerGroupResponse{}, s.bgpServer.EditCandidate(func(candidate *server.Candidate) error {
+			return candidate.AddPeerGroup(c)
+		})
+	}
+	return &AddPeerGroupResponse{}, s.bgpServer.AddPeerGroup(c)
+}
+
+func (s *Server) DeletePeerGroup(ctx context.Context, arg *DeletePeerGroupRequest) (*DeletePeerGroupResponse, error) {
+	c, err := NewPeerGroupFromAPIStruct(arg.PeerGroup)
+	if err != nil {
+		return nil, err
+	}
+	if arg.Candidate {
+		return &DeletePeerGroupResponse{}, s.bgpServer.EditCandidate(func(candidate *server.Candidate) error {
+			return candidate.DeletePeerGroup(c)
+		})
+	}
+	return &DeletePeerGroupResponse{}, s.bgpServer.DeletePeerGroup(c)
+}
+
+func (s *Server) UpdatePeerGroup(ctx context.Context, arg *UpdatePeerGroupRequest) (*UpdatePeerGroupResponse, error) {
+	c, err := NewPeerGroupFromAPIStruct(arg.PeerGroup)
+	if err != nil {
+		return nil, err
+	}
+	// only the names of the families are given by the API, so the
+	// settings of the families kept in the peer group are kept.
+	for _, pg := range s.bgpServer.GetPeerGroup() {
+		if pg.Config.PeerGroupName != c.Config.PeerGroupName {
+			continue
+		}
+		for i, a := range c.AfiSafis {
+			for _, b := range pg.AfiSafis {
+				if a.Config.AfiSafiName == b.Config.AfiSafiName {
+					c.AfiSafis[i] = b
+				}
+			}
+		}
+	}
+	if arg.Candidate {
+		return &UpdatePeerGroupResponse{}, s.bgpServer.EditCandidate(func(candidate *server.Candidate) error {
+			return candidate.UpdatePeerGroup(c)
+		})
+	}
+	needsSoftResetIn, err := s.bgpServer.UpdatePeerGroup(c)
+	if err != nil {
+		return nil, err
+	}
+	if arg.DoSoftResetIn && needsSoftResetIn {
+		return &UpdatePeerGroupResponse{}, s.bgpServer.SoftResetIn("", bgp.RouteFamily(0))
+	}
+	return &UpdatePeerGroupResponse{NeedsSoftResetIn: needsSoftResetIn}, nil
+}
+
+func (s *Server) GetPeerGroup(ctx context.Context, arg *GetPeerGroupRequest) (*GetPeerGroupResponse, error) {
+	groups := s.bgpServer.GetPeerGroup()
+	l := make([]*PeerGroup, 0, len(groups))
+	for _, pg := range groups {
+		l = append(l, NewPeerGroupFromConfigStruct(pg))
+	}
+	return &GetPeerGroupResponse{PeerGroups: l}, nil
+}
+
+func NewDynamicNeighborFromAPIStruct(a *DynamicNeighbor) *config.DynamicNeighbor {
+	return &config.DynamicNeighbor{
+		Config: config.DynamicNeighborConfig{
+			Prefix:    a.Prefix,
+			PeerGroup: a.PeerGroup,
+		},
+	}
+}
+
+func (s *Server) AddDynamicNeighbor(ctx context.Context, arg *AddDynamicNeighborRequest) (*AddDynamicNeighborResponse, error) {
+	if arg.DynamicNeighbor == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+	c := NewDynamicNeighborFromAPIStruct(arg.DynamicNeighbor)
+	if arg.Candidate {
+		return &AddDynamicNeighborResponse{}, s.bgpServer.EditCandidate(func(candidate *server.Candidate) error {
+			return candidate.AddDynamicNeighbor(c)
+		})
+	}
+	return &AddDynamicNeighborResponse{}, s.bgpServer.AddDynamicNeighbor(c)
+}
+
+func (s *Server) DeleteDynamicNeighbor(ctx context.Context, arg *DeleteDynamicNeighborRequest) (*DeleteDynamicNeighborResponse, error) {
+	if arg.DynamicNeighbor == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+	c := NewDynamicNeighborFromAPIStruct(arg.DynamicNeighbor)
+	if arg.Candidate {
+		return &DeleteDynamicNeighborResponse{}, s.bgpServer.EditCandidate(func(candidate *server.Candidate) error {
+			return candidate.DeleteDynamicNeighbor(c)
+		})
+	}
+	return &DeleteDynamicNeighborResponse{}, s.bgpServer.DeleteDynamicNeighbor(c)
+}
+
+func (s *Server) GetDampenedPath(ctx context.Context, arg *GetDampenedPathRequest) (*GetDampenedPathResponse, error) {
+	l, err := s.bgpServer.GetDampenedPath(arg.Address, bgp.RouteFamily(arg.Family))
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]*DampenedPath, 0, len(l))
+	for _, d := range l {
+		paths = append(paths, &DampenedPath{
+			Path:      ToPathApi(d.Path),
+			Penalty:   uint32(d.Penalty),
+			Flaps:     uint32(d.Flaps),
+			ReuseTime: int64(d.ReuseTime.Seconds()),
+		})
+	}
+	return &GetDampenedPathResponse{Paths: paths}, nil
 }
 
 func NewPrefixFromApiStruct(a *Prefix) (*table.Prefix, error) {
@@ -1209,6 +1772,11 @@ func (s *Server) AddDefinedSet(ctx context.Context, arg *AddDefinedSetRequest) (
 	if err != nil {
 		return nil, err
 	}
+	if arg.Candidate {
+		return &AddDefinedSetResponse{}, s.bgpServer.EditCandidate(func(candidate *server.Candidate) error {
+			return candidate.AddDefinedSet(set)
+		})
+	}
 	return &AddDefinedSetResponse{}, s.bgpServer.AddDefinedSet(set)
 }
 
@@ -1220,6 +1788,11 @@ func (s *Server) DeleteDefinedSet(ctx context.Context, arg *DeleteDefinedSetRequ
 	if err != nil {
 		return nil, err
 	}
+	if arg.Candidate {
+		return &DeleteDefinedSetResponse{}, s.bgpServer.EditCandidate(func(candidate *server.Candidate) error {
+			return candidate.DeleteDefinedSet(set, arg.All)
+		})
+	}
 	return &DeleteDefinedSetResponse{}, s.bgpServer.DeleteDefinedSet(set, arg.All)
 }
 
@@ -1231,6 +1804,11 @@ func (s *Server) ReplaceDefinedSet(ctx context.Context, arg *ReplaceDefinedSetRe
 	if err != nil {
 		return nil, err
 	}
+	if arg.Candidate {
+		return &ReplaceDefinedSetResponse{}, s.bgpServer.EditCandidate(func(candidate *server.Candidate) error {
+			return candidate.ReplaceDefinedSet(set)
+		})
+	}
 	return &ReplaceDefinedSetResponse{}, s.bgpServer.ReplaceDefinedSet(set)
 }
 
@@ -1288,6 +1866,25 @@ func toStatementApi(s *config.Statement) *Statement {
 		cs.RouteType = Conditions_RouteType(s.Conditions.BgpConditions.RouteType.ToInt())
 	}
 	cs.RpkiResult = int32(s.Conditions.BgpConditions.RpkiValidationResult.ToInt())
+	cs.MedEq = s.Conditions.BgpConditions.MedEq
+	if s.Conditions.BgpConditions.OriginEq != "" {
+		cs.OriginEq = RouteOrigin(s.Conditions.BgpConditions.OriginEq.ToInt() + 1)
+	}
+	cs.NextHopInList = s.Conditions.BgpConditions.NextHopInList
+	for _, afiSafi := range s.Conditions.BgpConditions.AfiSafiInList {
+		if rf, err := bgp.GetRouteFamily(string(afiSafi)); err == nil {
+			cs.AfiSafiIn = append(cs.AfiSafiIn, uint32(rf))
+		}
+	}
+	cs.LocalPrefEq = s.Conditions.BgpConditions.LocalPrefEq
+	if s.Conditions.BgpConditions.CommunityCount.Operator != "" {
+		cs.CommunityCount = &CommunityCount{
+			Count: s.Conditions.BgpConditions.CommunityCount.Value,
+			// "eq", "ge" and "le" are the same as "attribute-eq",
+			// "attribute-ge" and "attribute-le".
+			Type: AsPathLengthType(s.Conditions.BgpConditions.CommunityCount.Operator.ToInt() % 3),
+		}
+	}
 	as := &Actions{
 		RouteAction: func() RouteAction {
 			switch s.Actions.RouteDisposition {
@@ -1378,6 +1975,22 @@ func toStatementApi(s *config.Statement) *Statement {
 			}
 			return &LocalPrefAction{Value: s.Actions.BgpActions.SetLocalPref}
 		}(),
+		Aigp: func() *AigpAction {
+			switch s.Actions.BgpActions.SetAigp {
+			case "":
+				return nil
+			case "remove":
+				return &AigpAction{Remove: true}
+			}
+			value, _ := strconv.ParseUint(s.Actions.BgpActions.SetAigp, 10, 64)
+			return &AigpAction{Value: value}
+		}(),
+		Origin: func() RouteOrigin {
+			if s.Actions.BgpActions.SetRouteOrigin == "" {
+				return RouteOrigin_ORIGIN_NONE
+			}
+			return RouteOrigin(s.Actions.BgpActions.SetRouteOrigin.ToInt() + 1)
+		}(),
 	}
 	return &Statement{
 		Name:       s.Name,
@@ -1454,6 +2067,55 @@ func NewAsPathLengthConditionFromApiStruct(a *AsPathLength) (*table.AsPathLength
 	})
 }
 
+func NewMedConditionFromApiStruct(a uint32) (*table.MedCondition, error) {
+	return table.NewMedCondition(a)
+}
+
+func toConfigOrigin(a RouteOrigin) (config.BgpOriginAttrType, error) {
+	if a == RouteOrigin_ORIGIN_NONE {
+		return "", nil
+	}
+	typ, ok := config.IntToBgpOriginAttrTypeMap[int(a)-1]
+	if !ok {
+		return "", fmt.Errorf("invalid origin: %d", a)
+	}
+	return typ, nil
+}
+
+func NewOriginConditionFromApiStruct(a RouteOrigin) (*table.OriginCondition, error) {
+	typ, err := toConfigOrigin(a)
+	if err != nil {
+		return nil, err
+	}
+	return table.NewOriginCondition(typ)
+}
+
+func NewNextHopConditionFromApiStruct(a []string) (*table.NextHopCondition, error) {
+	return table.NewNextHopCondition(a)
+}
+
+func NewAfiSafiInConditionFromApiStruct(a []uint32) (*table.AfiSafiInCondition, error) {
+	l := make([]config.AfiSafiType, 0, len(a))
+	for _, rf := range a {
+		l = append(l, config.AfiSafiType(bgp.RouteFamily(rf).String()))
+	}
+	return table.NewAfiSafiInCondition(l)
+}
+
+func NewLocalPrefConditionFromApiStruct(a uint32) (*table.LocalPrefCondition, error) {
+	return table.NewLocalPrefCondition(a)
+}
+
+func NewCommunityCountConditionFromApiStruct(a *CommunityCount) (*table.CommunityCountCondition, error) {
+	if a == nil {
+		return nil, nil
+	}
+	return table.NewCommunityCountCondition(config.CommunityCount{
+		Operator: config.IntToAttributeComparisonMap[int(a.Type)],
+		Value:    a.Count,
+	})
+}
+
 func NewAsPathConditionFromApiStruct(a *MatchSet) (*table.AsPathCondition, error) {
 	if a == nil {
 		return nil, nil
@@ -1595,6 +2257,24 @@ func NewLocalPrefActionFromApiStruct(a *LocalPrefAction) (*table.LocalPrefAction
 	return table.NewLocalPrefAction(a.Value)
 }
 
+func NewAigpActionFromApiStruct(a *AigpAction) (*table.AigpAction, error) {
+	if a == nil {
+		return nil, nil
+	}
+	if a.Remove {
+		return table.NewAigpAction("remove")
+	}
+	return table.NewAigpAction(fmt.Sprintf("%d", a.Value))
+}
+
+func NewOriginActionFromApiStruct(a RouteOrigin) (*table.OriginAction, error) {
+	typ, err := toConfigOrigin(a)
+	if err != nil {
+		return nil, err
+	}
+	return table.NewOriginAction(typ)
+}
+
 func NewAsPathPrependActionFromApiStruct(a *AsPrependAction) (*table.AsPathPrependAction, error) {
 	if a == nil {
 		return nil, nil
@@ -1661,6 +2341,24 @@ func NewStatementFromApiStruct(a *Statement) (*table.Statement, error) {
 			func() (table.Condition, error) {
 				return NewLargeCommunityConditionFromApiStruct(a.Conditions.LargeCommunitySet)
 			},
+			func() (table.Condition, error) {
+				return NewMedConditionFromApiStruct(a.Conditions.MedEq)
+			},
+			func() (table.Condition, error) {
+				return NewOriginConditionFromApiStruct(a.Conditions.OriginEq)
+			},
+			func() (table.Condition, error) {
+				return NewNextHopConditionFromApiStruct(a.Conditions.NextHopInList)
+			},
+			func() (table.Condition, error) {
+				return NewAfiSafiInConditionFromApiStruct(a.Conditions.AfiSafiIn)
+			},
+			func() (table.Condition, error) {
+				return NewLocalPrefConditionFromApiStruct(a.Conditions.LocalPrefEq)
+			},
+			func() (table.Condition, error) {
+				return NewCommunityCountConditionFromApiStruct(a.Conditions.CommunityCount)
+			},
 		}
 		cs = make([]table.Condition, 0, len(cfs))
 		for _, f := range cfs {
@@ -1700,6 +2398,12 @@ func NewStatementFromApiStruct(a *Statement) (*table.Statement, error) {
 			func() (table.Action, error) {
 				return NewNexthopActionFromApiStruct(a.Actions.Nexthop)
 			},
+			func() (table.Action, error) {
+				return NewAigpActionFromApiStruct(a.Actions.Aigp)
+			},
+			func() (table.Action, error) {
+				return NewOriginActionFromApiStruct(a.Actions.Origin)
+			},
 		}
 		as = make([]table.Action, 0, len(afs))
 		for _, f := range afs {
@@ -1855,6 +2559,11 @@ func (s *Server) AddPolicy(ctx context.Context, arg *AddPolicyRequest) (*AddPoli
 	if err != nil {
 		return nil, err
 	}
+	if arg.Candidate {
+		return &AddPolicyResponse{}, s.bgpServer.EditCandidate(func(candidate *server.Candidate) error {
+			return candidate.AddPolicy(x.ToConfig(), arg.ReferExistingStatements)
+		})
+	}
 	return &AddPolicyResponse{}, s.bgpServer.AddPolicy(x, arg.ReferExistingStatements)
 }
 
@@ -1866,6 +2575,11 @@ func (s *Server) DeletePolicy(ctx context.Context, arg *DeletePolicyRequest) (*D
 	if err != nil {
 		return nil, err
 	}
+	if arg.Candidate {
+		return &DeletePolicyResponse{}, s.bgpServer.EditCandidate(func(candidate *server.Candidate) error {
+			return candidate.DeletePolicy(x.ToConfig(), arg.All)
+		})
+	}
 	return &DeletePolicyResponse{}, s.bgpServer.DeletePolicy(x, arg.All, arg.PreserveStatements)
 }
 
@@ -1877,6 +2591,11 @@ func (s *Server) ReplacePolicy(ctx context.Context, arg *ReplacePolicyRequest) (
 	if err != nil {
 		return nil, err
 	}
+	if arg.Candidate {
+		return &ReplacePolicyResponse{}, s.bgpServer.EditCandidate(func(candidate *server.Candidate) error {
+			return candidate.ReplacePolicy(x.ToConfig(), arg.ReferExistingStatements)
+		})
+	}
 	return &ReplacePolicyResponse{}, s.bgpServer.ReplacePolicy(x, arg.ReferExistingStatements, arg.PreserveStatements)
 }
 
@@ -1964,6 +2683,11 @@ func (s *Server) AddPolicyAssignment(ctx context.Context, arg *AddPolicyAssignme
 	if err != nil {
 		return nil, err
 	}
+	if arg.Candidate {
+		return &AddPolicyAssignmentResponse{}, s.bgpServer.EditCandidate(func(candidate *server.Candidate) error {
+			return candidate.AddPolicyAssignment(name, dir, toPolicyDefinition(arg.Assignment.Policies), defaultRouteType(arg.Assignment.Default))
+		})
+	}
 	return &AddPolicyAssignmentResponse{}, s.bgpServer.AddPolicyAssignment(name, dir, toPolicyDefinition(arg.Assignment.Policies), defaultRouteType(arg.Assignment.Default))
 }
 
@@ -1975,6 +2699,11 @@ func (s *Server) DeletePolicyAssignment(ctx context.Context, arg *DeletePolicyAs
 	if err != nil {
 		return nil, err
 	}
+	if arg.Candidate {
+		return &DeletePolicyAssignmentResponse{}, s.bgpServer.EditCandidate(func(candidate *server.Candidate) error {
+			return candidate.DeletePolicyAssignment(name, dir, toPolicyDefinition(arg.Assignment.Policies), arg.All)
+		})
+	}
 	return &DeletePolicyAssignmentResponse{}, s.bgpServer.DeletePolicyAssignment(name, dir, toPolicyDefinition(arg.Assignment.Policies), arg.All)
 }
 
@@ -1986,9 +2715,82 @@ func (s *Server) ReplacePolicyAssignment(ctx context.Context, arg *ReplacePolicy
 	if err != nil {
 		return nil, err
 	}
+	if arg.Candidate {
+		return &ReplacePolicyAssignmentResponse{}, s.bgpServer.EditCandidate(func(candidate *server.Candidate) error {
+			return candidate.ReplacePolicyAssignment(name, dir, toPolicyDefinition(arg.Assignment.Policies), defaultRouteType(arg.Assignment.Default))
+		})
+	}
 	return &ReplacePolicyAssignmentResponse{}, s.bgpServer.ReplacePolicyAssignment(name, dir, toPolicyDefinition(arg.Assignment.Policies), defaultRouteType(arg.Assignment.Default))
 }
 
+func (s *Server) GetCandidateDiff(ctx context.Context, arg *GetCandidateDiffRequest) (*GetCandidateDiffResponse, error) {
+	l, err := s.bgpServer.GetCandidateDiff()
+	if err != nil {
+		return nil, err
+	}
+	diffs := make([]*ConfigDiff, 0, len(l))
+	for _, d := range l {
+		diff := &ConfigDiff{
+			Type: d.Type,
+			Name: d.Name,
+		}
+		if d.Running != nil {
+			j, _ := json.Marshal(d.Running)
+			diff.Running = string(j)
+		}
+		if d.Candidate != nil {
+			j, _ := json.Marshal(d.Candidate)
+			diff.Candidate = string(j)
+		}
+		diffs = append(diffs, diff)
+	}
+	return &GetCandidateDiffResponse{Diffs: diffs}, nil
+}
+
+func (s *Server) CommitCandidate(ctx context.Context, arg *CommitCandidateRequest) (*CommitCandidateResponse, error) {
+	id, err := s.bgpServer.CommitCandidate()
+	if err != nil {
+		return nil, err
+	}
+	return &CommitCandidateResponse{Id: id}, nil
+}
+
+func (s *Server) DiscardCandidate(ctx context.Context, arg *DiscardCandidateRequest) (*DiscardCandidateResponse, error) {
+	return &DiscardCandidateResponse{}, s.bgpServer.DiscardCandidate()
+}
+
+func (s *Server) GetCommitHistory(ctx context.Context, arg *GetCommitHistoryRequest) (*GetCommitHistoryResponse, error) {
+	l := s.bgpServer.GetCommitHistory()
+	commits := make([]*ConfigCommit, 0, len(l))
+	for _, c := range l {
+		commits = append(commits, &ConfigCommit{
+			Id:   c.Id,
+			Time: c.Time.Unix(),
+		})
+	}
+	return &GetCommitHistoryResponse{Commits: commits}, nil
+}
+
+func (s *Server) RollbackConfig(ctx context.Context, arg *RollbackConfigRequest) (*RollbackConfigResponse, error) {
+	id, err := s.bgpServer.Rollback(arg.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &RollbackConfigResponse{Id: id}, nil
+}
+
+func (s *Server) GetConfig(ctx context.Context, arg *GetConfigRequest) (*GetConfigResponse, error) {
+	format := arg.Format
+	if format == "" {
+		format = "toml"
+	}
+	b, err := config.MarshalConfig(s.bgpServer.GetConfig(), format)
+	if err != nil {
+		return nil, err
+	}
+	return &GetConfigResponse{Config: string(b)}, nil
+}
+
 func (s *Server) GetServer(ctx context.Context, arg *GetServerRequest) (*GetServerResponse, error) {
 	g := s.bgpServer.GetServer()
 	return &GetServerResponse{
@@ -1998,6 +2800,7 @@ func (s *Server) GetServer(ctx context.Context, arg *GetServerRequest) (*GetServ
 			ListenPort:       g.Config.Port,
 			ListenAddresses:  g.Config.LocalAddressList,
 			UseMultiplePaths: g.UseMultiplePaths.Config.Enabled,
+			PendingRestart:   g.State.PendingRestartList,
 		},
 	}, nil
 }
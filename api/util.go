@@ -22,6 +22,7 @@ import (
 
 	"github.com/citizen-insane/gobgp/config"
 	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/citizen-insane/gobgp/server"
 	"github.com/citizen-insane/gobgp/table"
 )
 
@@ -123,3 +124,42 @@ func (p *Path) ToNativePath(option ...ToNativeOption) (*table.Path, error) {
 	path.IsNexthopInvalid = p.IsNexthopInvalid
 	return path, nil
 }
+
+func (d *DampenedPath) ToNativeDampenedPath() (*table.DampenedPath, error) {
+	path, err := d.Path.ToNativePath()
+	if err != nil {
+		return nil, err
+	}
+	return &table.DampenedPath{
+		Path:      path,
+		Penalty:   int(d.Penalty),
+		Flaps:     int(d.Flaps),
+		ReuseTime: time.Duration(d.ReuseTime) * time.Second,
+	}, nil
+}
+
+func (e *Event) ToNativeEvent() *server.Event {
+	ev := &server.Event{
+		Sequence:    e.Sequence,
+		Type:        server.EventType(e.Type),
+		Timestamp:   time.Unix(0, e.Timestamp),
+		Neighbor:    e.Neighbor,
+		PeerAs:      e.PeerAs,
+		Family:      e.Family,
+		Prefix:      e.Prefix,
+		Withdrawal:  e.Withdrawal,
+		Nexthop:     e.Nexthop,
+		AsPath:      e.AsPath,
+		Origin:      e.Origin,
+		LocalPref:   e.LocalPref,
+		Communities: e.Communities,
+		State:       config.SessionState(e.State),
+		AdminState:  config.AdminState(e.AdminState),
+		Dropped:     e.Dropped,
+	}
+	if e.HasMed {
+		med := e.Med
+		ev.Med = &med
+	}
+	return ev
+}
@@ -17,17 +17,23 @@
 package client
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"strconv"
+	"strings"
 	"time"
 
 	api "github.com/citizen-insane/gobgp/api"
 	"github.com/citizen-insane/gobgp/config"
 	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/citizen-insane/gobgp/server"
 	"github.com/citizen-insane/gobgp/table"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 type Client struct {
@@ -39,6 +45,53 @@ func defaultGRPCOptions() []grpc.DialOption {
 	return []grpc.DialOption{grpc.WithTimeout(time.Second), grpc.WithBlock(), grpc.WithInsecure()}
 }
 
+type tokenCredentials struct {
+	token  string
+	secure bool
+}
+
+func (c *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + c.token}, nil
+}
+
+func (c *tokenCredentials) RequireTransportSecurity() bool {
+	return c.secure
+}
+
+// WithToken returns the option to send the bearer token with every
+// request. secure should be true if the connection is over TLS.
+func WithToken(token string, secure bool) grpc.DialOption {
+	return grpc.WithPerRPCCredentials(&tokenCredentials{token: token, secure: secure})
+}
+
+// WithTLS returns the option to connect over TLS. The server certificate
+// is verified with caFile, or with the system roots if caFile is empty.
+// certFile and keyFile are the client certificate, which is needed if
+// gobgpd verifies the clients.
+func WithTLS(caFile, certFile, keyFile, serverName string) (grpc.DialOption, error) {
+	c := &tls.Config{
+		ServerName: serverName,
+	}
+	if caFile != "" {
+		b, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		c.RootCAs = x509.NewCertPool()
+		if !c.RootCAs.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("no certificate in %s", caFile)
+		}
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		c.Certificates = []tls.Certificate{cert}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(c)), nil
+}
+
 // New returns a new Client using the given target and options for dialing
 // to the grpc server. If an error occurs during dialing it will be returned and
 // Client will be nil.
@@ -55,6 +108,12 @@ func NewWith(ctx context.Context, target string, opts ...grpc.DialOption) (*Clie
 	if len(opts) == 0 {
 		opts = defaultGRPCOptions()
 	}
+	if strings.HasPrefix(target, api.UNIX_SOCKET_PREFIX) {
+		path := strings.TrimPrefix(target, api.UNIX_SOCKET_PREFIX)
+		opts = append(opts, grpc.WithDialer(func(_ string, timeout time.Duration) (net.Conn, error) {
+			return net.DialTimeout("unix", path, timeout)
+		}))
+	}
 	conn, err := grpc.DialContext(ctx, target, opts...)
 	if err != nil {
 		return nil, err
@@ -110,6 +169,9 @@ func (cli *Client) GetServer() (*config.Global, error) {
 				Enabled: ret.Global.UseMultiplePaths,
 			},
 		},
+		State: config.GlobalState{
+			PendingRestartList: ret.Global.PendingRestart,
+		},
 	}, nil
 }
 
@@ -195,6 +257,63 @@ func (cli *Client) DeleteNeighbor(c *config.Neighbor) error {
 //func (cli *Client) UpdateNeighbor(c *config.Neighbor) (bool, error) {
 //}
 
+func (cli *Client) AddPeerGroup(c *config.PeerGroup) error {
+	pg := api.NewPeerGroupFromConfigStruct(c)
+	_, err := cli.cli.AddPeerGroup(context.Background(), &api.AddPeerGroupRequest{PeerGroup: pg})
+	return err
+}
+
+func (cli *Client) DeletePeerGroup(c *config.PeerGroup) error {
+	pg := api.NewPeerGroupFromConfigStruct(c)
+	_, err := cli.cli.DeletePeerGroup(context.Background(), &api.DeletePeerGroupRequest{PeerGroup: pg})
+	return err
+}
+
+func (cli *Client) GetPeerGroup() ([]*config.PeerGroup, error) {
+	rsp, err := cli.cli.GetPeerGroup(context.Background(), &api.GetPeerGroupRequest{})
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]*config.PeerGroup, 0, len(rsp.PeerGroups))
+	for _, p := range rsp.PeerGroups {
+		pg, err := api.NewPeerGroupFromAPIStruct(p)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, pg)
+	}
+	return groups, nil
+}
+
+func (cli *Client) UpdatePeerGroup(c *config.PeerGroup, doSoftResetIn bool) (bool, error) {
+	pg := api.NewPeerGroupFromConfigStruct(c)
+	res, err := cli.cli.UpdatePeerGroup(context.Background(), &api.UpdatePeerGroupRequest{PeerGroup: pg, DoSoftResetIn: doSoftResetIn})
+	if err != nil {
+		return false, err
+	}
+	return res.NeedsSoftResetIn, nil
+}
+
+func (cli *Client) AddDynamicNeighbor(c *config.DynamicNeighbor) error {
+	_, err := cli.cli.AddDynamicNeighbor(context.Background(), &api.AddDynamicNeighborRequest{
+		DynamicNeighbor: &api.DynamicNeighbor{
+			Prefix:    c.Config.Prefix,
+			PeerGroup: c.Config.PeerGroup,
+		},
+	})
+	return err
+}
+
+func (cli *Client) DeleteDynamicNeighbor(c *config.DynamicNeighbor) error {
+	_, err := cli.cli.DeleteDynamicNeighbor(context.Background(), &api.DeleteDynamicNeighborRequest{
+		DynamicNeighbor: &api.DynamicNeighbor{
+			Prefix:    c.Config.Prefix,
+			PeerGroup: c.Config.PeerGroup,
+		},
+	})
+	return err
+}
+
 func (cli *Client) ShutdownNeighbor(addr, communication string) error {
 	_, err := cli.cli.ShutdownNeighbor(context.Background(), &api.ShutdownNeighborRequest{Address: addr, Communication: communication})
 	return err
@@ -286,6 +405,25 @@ func (cli *Client) GetVRFRIB(name string, family bgp.RouteFamily, prefixes []*ta
 	return cli.getRIB(api.Resource_VRF, name, family, prefixes)
 }
 
+func (cli *Client) GetDampenedPath(name string, family bgp.RouteFamily) ([]*table.DampenedPath, error) {
+	res, err := cli.cli.GetDampenedPath(context.Background(), &api.GetDampenedPathRequest{
+		Address: name,
+		Family:  uint32(family),
+	})
+	if err != nil {
+		return nil, err
+	}
+	l := make([]*table.DampenedPath, 0, len(res.Paths))
+	for _, p := range res.Paths {
+		d, err := p.ToNativeDampenedPath()
+		if err != nil {
+			return nil, err
+		}
+		l = append(l, d)
+	}
+	return l, nil
+}
+
 func (cli *Client) getRIBInfo(resource api.Resource, name string, family bgp.RouteFamily) (*table.TableInfo, error) {
 	res, err := cli.cli.GetRibInfo(context.Background(), &api.GetRibInfoRequest{
 		Info: &api.TableInfo{
@@ -756,6 +894,51 @@ func (cli *Client) ReplacePolicyAssignment(assignment *table.PolicyAssignment) e
 	return err
 }
 
+func (cli *Client) GetCandidateDiff() ([]*api.ConfigDiff, error) {
+	rsp, err := cli.cli.GetCandidateDiff(context.Background(), &api.GetCandidateDiffRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Diffs, nil
+}
+
+func (cli *Client) CommitCandidate() (uint32, error) {
+	rsp, err := cli.cli.CommitCandidate(context.Background(), &api.CommitCandidateRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return rsp.Id, nil
+}
+
+func (cli *Client) DiscardCandidate() error {
+	_, err := cli.cli.DiscardCandidate(context.Background(), &api.DiscardCandidateRequest{})
+	return err
+}
+
+func (cli *Client) GetCommitHistory() ([]*api.ConfigCommit, error) {
+	rsp, err := cli.cli.GetCommitHistory(context.Background(), &api.GetCommitHistoryRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Commits, nil
+}
+
+func (cli *Client) RollbackConfig(id uint32) (uint32, error) {
+	rsp, err := cli.cli.RollbackConfig(context.Background(), &api.RollbackConfigRequest{Id: id})
+	if err != nil {
+		return 0, err
+	}
+	return rsp.Id, nil
+}
+
+func (cli *Client) GetConfig(format string) (string, error) {
+	rsp, err := cli.cli.GetConfig(context.Background(), &api.GetConfigRequest{Format: format})
+	if err != nil {
+		return "", err
+	}
+	return rsp.Config, nil
+}
+
 //func (cli *Client) EnableMrt(c *config.MrtConfig) error {
 //}
 //
@@ -830,6 +1013,52 @@ func (cli *Client) GetROA(family bgp.RouteFamily) ([]*table.ROA, error) {
 	return roas, nil
 }
 
+func (cli *Client) GetHealthCheck() ([]*config.HealthCheck, error) {
+	rsp, err := cli.cli.GetHealthCheck(context.Background(), &api.GetHealthCheckRequest{})
+	if err != nil {
+		return nil, err
+	}
+	checks := make([]*config.HealthCheck, 0, len(rsp.HealthChecks))
+	for _, h := range rsp.HealthChecks {
+		routes := make([]config.HealthCheckRoute, 0, len(h.Routes))
+		for _, r := range h.Routes {
+			routes = append(routes, config.HealthCheckRoute{
+				Config: config.HealthCheckRouteConfig{
+					Prefix:                r.Prefix,
+					NextHop:               r.NextHop,
+					Med:                   r.Med,
+					CommunityList:         r.Communities,
+					DegradedMed:           r.DegradedMed,
+					DegradedCommunityList: r.DegradedCommunities,
+				},
+			})
+		}
+		checks = append(checks, &config.HealthCheck{
+			Config: config.HealthCheckConfig{
+				Name:           h.Name,
+				Type:           config.IntToHealthCheckTypeMap[int(h.Type)],
+				Address:        h.Address,
+				Url:            h.Url,
+				ExpectedStatus: uint16(h.ExpectedStatus),
+				Command:        h.Command,
+				Interval:       h.Interval,
+				Timeout:        h.Timeout,
+				Rise:           h.Rise,
+				Fall:           h.Fall,
+			},
+			State: config.HealthCheckState{
+				Up:         h.Up,
+				Successes:  h.Successes,
+				Failures:   h.Failures,
+				LastError:  h.LastError,
+				LastChange: h.LastChange,
+			},
+			Routes: routes,
+		})
+	}
+	return checks, nil
+}
+
 func (cli *Client) AddRPKIServer(address string, port, lifetime int) error {
 	_, err := cli.cli.AddRpki(context.Background(), &api.AddRpkiRequest{
 		Address:  address,
@@ -886,11 +1115,17 @@ func (cli *Client) ValidateRIBWithRPKI(prefixes ...string) error {
 }
 
 func (cli *Client) AddBMP(c *config.BmpServerConfig) error {
-	_, err := cli.cli.AddBmp(context.Background(), &api.AddBmpRequest{
+	req := &api.AddBmpRequest{
 		Address: c.Address,
 		Port:    c.Port,
 		Type:    api.AddBmpRequest_MonitoringPolicy(c.RouteMonitoringPolicy.ToInt()),
-	})
+		LocRib:  c.RouteMonitoringLocRib,
+	}
+	if c.RouteMonitoringAdjRibOut != "" {
+		req.AdjRibOut = true
+		req.AdjRibOutType = api.AddBmpRequest_MonitoringPolicy(c.RouteMonitoringAdjRibOut.ToInt())
+	}
+	_, err := cli.cli.AddBmp(context.Background(), req)
 	return err
 }
 
@@ -903,7 +1138,10 @@ func (cli *Client) DeleteBMP(c *config.BmpServerConfig) error {
 }
 
 type MonitorRIBClient struct {
-	stream api.GobgpApi_MonitorRibClient
+	stream   api.GobgpApi_MonitorRibClient
+	epoch    uint64
+	sequence uint64
+	snapshot bool
 }
 
 func (c *MonitorRIBClient) Recv() (*table.Destination, error) {
@@ -911,9 +1149,32 @@ func (c *MonitorRIBClient) Recv() (*table.Destination, error) {
 	if err != nil {
 		return nil, err
 	}
+	c.epoch = d.Epoch
+	c.sequence = d.Sequence
+	c.snapshot = d.Snapshot
 	return d.ToNativeDestination()
 }
 
+// Sequence returns the sequence number of the best path change of the
+// destination received last. Pass it to ResumeMonitorRIB with Epoch()
+// to receive the changes after it.
+func (c *MonitorRIBClient) Sequence() uint64 {
+	return c.sequence
+}
+
+// Epoch returns the epoch of the sequence number, which differs every
+// time gobgpd starts.
+func (c *MonitorRIBClient) Epoch() uint64 {
+	return c.epoch
+}
+
+// Snapshot returns true if the destination received last is a part of
+// the current best paths instead of a change. The destinations not in
+// the snapshot are no longer in the rib.
+func (c *MonitorRIBClient) Snapshot() bool {
+	return c.snapshot
+}
+
 func (cli *Client) MonitorRIB(family bgp.RouteFamily, current bool) (*MonitorRIBClient, error) {
 	stream, err := cli.cli.MonitorRib(context.Background(), &api.MonitorRibRequest{
 		Table: &api.Table{
@@ -925,7 +1186,26 @@ func (cli *Client) MonitorRIB(family bgp.RouteFamily, current bool) (*MonitorRIB
 	if err != nil {
 		return nil, err
 	}
-	return &MonitorRIBClient{stream}, nil
+	return &MonitorRIBClient{stream: stream}, nil
+}
+
+// ResumeMonitorRIB monitors the global rib from the best path change
+// after the sequence number of the epoch. If gobgpd no longer keeps some
+// of the changes or has restarted since, the current best paths are
+// sent as a snapshot instead.
+func (cli *Client) ResumeMonitorRIB(family bgp.RouteFamily, epoch, seq uint64) (*MonitorRIBClient, error) {
+	stream, err := cli.cli.MonitorRib(context.Background(), &api.MonitorRibRequest{
+		Table: &api.Table{
+			Type:   api.Resource_GLOBAL,
+			Family: uint32(family),
+		},
+		ResumeFrom:  seq,
+		ResumeEpoch: epoch,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &MonitorRIBClient{stream: stream}, nil
 }
 
 func (cli *Client) MonitorAdjRIBIn(name string, family bgp.RouteFamily, current bool) (*MonitorRIBClient, error) {
@@ -940,7 +1220,41 @@ func (cli *Client) MonitorAdjRIBIn(name string, family bgp.RouteFamily, current
 	if err != nil {
 		return nil, err
 	}
-	return &MonitorRIBClient{stream}, nil
+	return &MonitorRIBClient{stream: stream}, nil
+}
+
+type MonitorEventsClient struct {
+	stream api.GobgpApi_MonitorEventsClient
+}
+
+func (c *MonitorEventsClient) Recv() (*server.Event, error) {
+	e, err := c.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return e.ToNativeEvent(), nil
+}
+
+func (cli *Client) MonitorEvents(f *server.EventFilter, current bool) (*MonitorEventsClient, error) {
+	req := &api.MonitorEventsRequest{
+		Types:       make([]string, 0, len(f.Types)),
+		Families:    make([]uint32, 0, len(f.Families)),
+		Neighbors:   f.Neighbors,
+		PrefixSet:   f.PrefixSet,
+		Communities: f.Communities,
+		Current:     current,
+	}
+	for _, t := range f.Types {
+		req.Types = append(req.Types, string(t))
+	}
+	for _, rf := range f.Families {
+		req.Families = append(req.Families, uint32(rf))
+	}
+	stream, err := cli.cli.MonitorEvents(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	return &MonitorEventsClient{stream}, nil
 }
 
 type MonitorNeighborStateClient struct {
@@ -16,6 +16,17 @@
 package client
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -23,6 +34,7 @@ import (
 	"github.com/citizen-insane/gobgp/config"
 	"github.com/citizen-insane/gobgp/server"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 )
 
 func TestGetNeighbor(test *testing.T) {
@@ -54,3 +66,200 @@ func TestGetNeighbor(test *testing.T) {
 	_, err = cli.GetNeighbor("10.0.0.2")
 	assert.Equal(err.Error(), "not found neighbor 10.0.0.2")
 }
+
+func TestGetPeerGroup(test *testing.T) {
+	assert := assert.New(test)
+	s := server.NewBgpServer()
+	go s.Serve()
+	g := api.NewGrpcServer(s, ":50053")
+	go g.Serve()
+	time.Sleep(time.Second)
+	cli, err := New(":50053")
+	assert.Nil(err)
+	err = cli.StartServer(&config.Global{
+		Config: config.GlobalConfig{
+			As:       1,
+			RouterId: "1.1.1.1",
+			Port:     -1,
+		},
+	})
+	assert.Nil(err)
+	err = s.AddPeerGroup(&config.PeerGroup{
+		Config: config.PeerGroupConfig{
+			PeerGroupName: "g",
+			PeerAs:        2,
+		},
+		Timers: config.Timers{
+			Config: config.TimersConfig{HoldTime: 30},
+		},
+		AfiSafis: []config.AfiSafi{{
+			Config:   config.AfiSafiConfig{AfiSafiName: config.AFI_SAFI_TYPE_IPV4_UNICAST},
+			AddPaths: config.AddPaths{Config: config.AddPathsConfig{Receive: true}},
+		}},
+	})
+	assert.Nil(err)
+
+	groups, err := cli.GetPeerGroup()
+	assert.Nil(err)
+	assert.Equal(1, len(groups))
+	assert.Equal("g", groups[0].Config.PeerGroupName)
+	assert.Equal(float64(30), groups[0].Timers.Config.HoldTime)
+
+	// the settings not given by the API are kept.
+	groups[0].Config.PeerAs = 3
+	_, err = cli.UpdatePeerGroup(groups[0], false)
+	assert.Nil(err)
+	pg := s.GetPeerGroup()[0]
+	assert.Equal(uint32(3), pg.Config.PeerAs)
+	assert.Equal(float64(30), pg.Timers.Config.HoldTime)
+	assert.Equal(1, len(pg.AfiSafis))
+	assert.True(pg.AfiSafis[0].AddPaths.Config.Receive)
+}
+
+// writeTestCert writes a self-signed certificate for 127.0.0.1 and its
+// key to dir.
+func writeTestCert(dir, name string) (string, string, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return "", "", err
+	}
+	b, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return "", "", err
+	}
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	if err := ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		return "", "", err
+	}
+	if err := ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: b}), 0600); err != nil {
+		return "", "", err
+	}
+	return certFile, keyFile, nil
+}
+
+func TestAuth(test *testing.T) {
+	assert := assert.New(test)
+	dir, err := ioutil.TempDir("", "gobgp")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+	serverCert, serverKey, err := writeTestCert(dir, "server")
+	assert.Nil(err)
+	clientCert, clientKey, err := writeTestCert(dir, "client")
+	assert.Nil(err)
+
+	s := server.NewBgpServer()
+	go s.Serve()
+	g, err := api.NewGrpcServerWithAuth(s, "127.0.0.1:50052", &api.AuthConfig{
+		CertFile:     serverCert,
+		KeyFile:      serverKey,
+		ClientCAFile: clientCert,
+		Tokens: map[string]api.Role{
+			"ro": api.ROLE_READ_ONLY,
+			"rw": api.ROLE_READ_WRITE,
+		},
+	})
+	assert.Nil(err)
+	go g.Serve()
+	time.Sleep(time.Second)
+
+	dial := func(certFile, keyFile, token string) (*Client, error) {
+		opt, err := WithTLS(serverCert, certFile, keyFile, "")
+		if err != nil {
+			return nil, err
+		}
+		opts := []grpc.DialOption{grpc.WithTimeout(time.Second), grpc.WithBlock(), opt}
+		if token != "" {
+			opts = append(opts, WithToken(token, true))
+		}
+		return New("127.0.0.1:50052", opts...)
+	}
+
+	rw, err := dial(clientCert, clientKey, "rw")
+	assert.Nil(err)
+	defer rw.Close()
+	err = rw.StartServer(&config.Global{
+		Config: config.GlobalConfig{
+			As:       1,
+			RouterId: "1.1.1.1",
+			Port:     -1,
+		},
+	})
+	assert.Nil(err)
+
+	ro, err := dial(clientCert, clientKey, "ro")
+	assert.Nil(err)
+	defer ro.Close()
+	_, err = ro.GetServer()
+	assert.Nil(err)
+	_, err = ro.GetHealthCheck()
+	assert.Nil(err)
+	_, err = ro.GetPeerGroup()
+	assert.Nil(err)
+	err = ro.AddNeighbor(&config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: "10.0.0.1",
+			PeerAs:          2,
+		},
+	})
+	assert.NotNil(err)
+
+	anonymous, err := dial(clientCert, clientKey, "")
+	assert.Nil(err)
+	defer anonymous.Close()
+	_, err = anonymous.GetServer()
+	assert.NotNil(err)
+
+	// the client certificate is required.
+	_, err = dial("", "", "rw")
+	assert.NotNil(err)
+}
+
+func TestUnixSocket(test *testing.T) {
+	assert := assert.New(test)
+	dir, err := ioutil.TempDir("", "gobgp")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "gobgp.sock")
+
+	s := server.NewBgpServer()
+	go s.Serve()
+	g := api.NewGrpcServer(s, "unix://"+path)
+	g.SetUnixSocketPermission(0600, -1, -1)
+	go g.Serve()
+	time.Sleep(time.Second)
+
+	fi, err := os.Stat(path)
+	assert.Nil(err)
+	assert.Equal(os.FileMode(0600), fi.Mode().Perm())
+
+	cli, err := New("unix://" + path)
+	assert.Nil(err)
+	defer cli.Close()
+	err = cli.StartServer(&config.Global{
+		Config: config.GlobalConfig{
+			As:       1,
+			RouterId: "1.1.1.1",
+			Port:     -1,
+		},
+	})
+	assert.Nil(err)
+	g2, err := cli.GetServer()
+	assert.Nil(err)
+	assert.Equal(uint32(1), g2.Config.As)
+}
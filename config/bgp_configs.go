@@ -125,13 +125,15 @@ type BgpStdCommunityType string
 type PeerType string
 
 const (
-	PEER_TYPE_INTERNAL PeerType = "internal"
-	PEER_TYPE_EXTERNAL PeerType = "external"
+	PEER_TYPE_INTERNAL        PeerType = "internal"
+	PEER_TYPE_EXTERNAL        PeerType = "external"
+	PEER_TYPE_CONFED_EXTERNAL PeerType = "confed-external"
 )
 
 var PeerTypeToIntMap = map[PeerType]int{
-	PEER_TYPE_INTERNAL: 0,
-	PEER_TYPE_EXTERNAL: 1,
+	PEER_TYPE_INTERNAL:        0,
+	PEER_TYPE_EXTERNAL:        1,
+	PEER_TYPE_CONFED_EXTERNAL: 2,
 }
 
 func (v PeerType) ToInt() int {
@@ -145,6 +147,7 @@ func (v PeerType) ToInt() int {
 var IntToPeerTypeMap = map[int]PeerType{
 	0: PEER_TYPE_INTERNAL,
 	1: PEER_TYPE_EXTERNAL,
+	2: PEER_TYPE_CONFED_EXTERNAL,
 }
 
 func (v PeerType) Validate() error {
@@ -920,6 +923,78 @@ func (v MrtType) Validate() error {
 	return nil
 }
 
+// typedef for identity gobgp:collector-type
+type CollectorType string
+
+const (
+	COLLECTOR_TYPE_INFLUXDB CollectorType = "influxdb"
+	COLLECTOR_TYPE_JSON     CollectorType = "json"
+	COLLECTOR_TYPE_HTTP     CollectorType = "http"
+)
+
+var CollectorTypeToIntMap = map[CollectorType]int{
+	COLLECTOR_TYPE_INFLUXDB: 0,
+	COLLECTOR_TYPE_JSON:     1,
+	COLLECTOR_TYPE_HTTP:     2,
+}
+
+func (v CollectorType) ToInt() int {
+	i, ok := CollectorTypeToIntMap[v]
+	if !ok {
+		return -1
+	}
+	return i
+}
+
+var IntToCollectorTypeMap = map[int]CollectorType{
+	0: COLLECTOR_TYPE_INFLUXDB,
+	1: COLLECTOR_TYPE_JSON,
+	2: COLLECTOR_TYPE_HTTP,
+}
+
+func (v CollectorType) Validate() error {
+	if _, ok := CollectorTypeToIntMap[v]; !ok {
+		return fmt.Errorf("invalid CollectorType: %s", v)
+	}
+	return nil
+}
+
+// typedef for identity gobgp:health-check-type
+type HealthCheckType string
+
+const (
+	HEALTH_CHECK_TYPE_TCP  HealthCheckType = "tcp"
+	HEALTH_CHECK_TYPE_HTTP HealthCheckType = "http"
+	HEALTH_CHECK_TYPE_EXEC HealthCheckType = "exec"
+)
+
+var HealthCheckTypeToIntMap = map[HealthCheckType]int{
+	HEALTH_CHECK_TYPE_TCP:  0,
+	HEALTH_CHECK_TYPE_HTTP: 1,
+	HEALTH_CHECK_TYPE_EXEC: 2,
+}
+
+func (v HealthCheckType) ToInt() int {
+	i, ok := HealthCheckTypeToIntMap[v]
+	if !ok {
+		return -1
+	}
+	return i
+}
+
+var IntToHealthCheckTypeMap = map[int]HealthCheckType{
+	0: HEALTH_CHECK_TYPE_TCP,
+	1: HEALTH_CHECK_TYPE_HTTP,
+	2: HEALTH_CHECK_TYPE_EXEC,
+}
+
+func (v HealthCheckType) Validate() error {
+	if _, ok := HealthCheckTypeToIntMap[v]; !ok {
+		return fmt.Errorf("invalid HealthCheckType: %s", v)
+	}
+	return nil
+}
+
 // typedef for identity gobgp:rpki-validation-result-type
 type RpkiValidationResultType string
 
@@ -959,6 +1034,54 @@ func (v RpkiValidationResultType) Validate() error {
 	return nil
 }
 
+//struct for container gobgp:state
+type DynamicNeighborState struct {
+	// original -> gobgp:prefix
+	Prefix string `mapstructure:"prefix" json:"prefix,omitempty"`
+	// original -> gobgp:peer-group
+	PeerGroup string `mapstructure:"peer-group" json:"peer-group,omitempty"`
+}
+
+//struct for container gobgp:config
+type DynamicNeighborConfig struct {
+	// original -> gobgp:prefix
+	Prefix string `mapstructure:"prefix" json:"prefix,omitempty"`
+	// original -> gobgp:peer-group
+	PeerGroup string `mapstructure:"peer-group" json:"peer-group,omitempty"`
+}
+
+func (lhs *DynamicNeighborConfig) Equal(rhs *DynamicNeighborConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Prefix != rhs.Prefix {
+		return false
+	}
+	if lhs.PeerGroup != rhs.PeerGroup {
+		return false
+	}
+	return true
+}
+
+//struct for container gobgp:dynamic-neighbor
+type DynamicNeighbor struct {
+	// original -> gobgp:prefix
+	// original -> gobgp:dynamic-neighbor-config
+	Config DynamicNeighborConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:dynamic-neighbor-state
+	State DynamicNeighborState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *DynamicNeighbor) Equal(rhs *DynamicNeighbor) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
 //struct for container gobgp:state
 type CollectorState struct {
 	// original -> gobgp:url
@@ -967,6 +1090,12 @@ type CollectorState struct {
 	DbName string `mapstructure:"db-name" json:"db-name,omitempty"`
 	// original -> gobgp:table-dump-interval
 	TableDumpInterval uint64 `mapstructure:"table-dump-interval" json:"table-dump-interval,omitempty"`
+	// original -> gobgp:type
+	Type CollectorType `mapstructure:"type" json:"type,omitempty"`
+	// original -> gobgp:batch-size
+	BatchSize uint32 `mapstructure:"batch-size" json:"batch-size,omitempty"`
+	// original -> gobgp:flush-interval
+	FlushInterval uint32 `mapstructure:"flush-interval" json:"flush-interval,omitempty"`
 }
 
 //struct for container gobgp:config
@@ -977,6 +1106,12 @@ type CollectorConfig struct {
 	DbName string `mapstructure:"db-name" json:"db-name,omitempty"`
 	// original -> gobgp:table-dump-interval
 	TableDumpInterval uint64 `mapstructure:"table-dump-interval" json:"table-dump-interval,omitempty"`
+	// original -> gobgp:type
+	Type CollectorType `mapstructure:"type" json:"type,omitempty"`
+	// original -> gobgp:batch-size
+	BatchSize uint32 `mapstructure:"batch-size" json:"batch-size,omitempty"`
+	// original -> gobgp:flush-interval
+	FlushInterval uint32 `mapstructure:"flush-interval" json:"flush-interval,omitempty"`
 }
 
 func (lhs *CollectorConfig) Equal(rhs *CollectorConfig) bool {
@@ -992,6 +1127,15 @@ func (lhs *CollectorConfig) Equal(rhs *CollectorConfig) bool {
 	if lhs.TableDumpInterval != rhs.TableDumpInterval {
 		return false
 	}
+	if lhs.Type != rhs.Type {
+		return false
+	}
+	if lhs.BatchSize != rhs.BatchSize {
+		return false
+	}
+	if lhs.FlushInterval != rhs.FlushInterval {
+		return false
+	}
 	return true
 }
 
@@ -1133,6 +1277,329 @@ func (lhs *MrtConfig) Equal(rhs *MrtConfig) bool {
 	return true
 }
 
+//struct for container gobgp:config
+type VrfConfig struct {
+	// original -> gobgp:name
+	Name string `mapstructure:"name" json:"name,omitempty"`
+	// original -> gobgp:id
+	Id uint32 `mapstructure:"id" json:"id,omitempty"`
+	// original -> gobgp:rd
+	Rd string `mapstructure:"rd" json:"rd,omitempty"`
+	// original -> gobgp:import-rt
+	ImportRtList []string `mapstructure:"import-rt-list" json:"import-rt-list,omitempty"`
+	// original -> gobgp:export-rt
+	ExportRtList []string `mapstructure:"export-rt-list" json:"export-rt-list,omitempty"`
+	// original -> gobgp:both-rt
+	BothRtList []string `mapstructure:"both-rt-list" json:"both-rt-list,omitempty"`
+}
+
+func (lhs *VrfConfig) Equal(rhs *VrfConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Name != rhs.Name {
+		return false
+	}
+	if lhs.Id != rhs.Id {
+		return false
+	}
+	if lhs.Rd != rhs.Rd {
+		return false
+	}
+	if len(lhs.ImportRtList) != len(rhs.ImportRtList) {
+		return false
+	}
+	for idx, l := range lhs.ImportRtList {
+		if l != rhs.ImportRtList[idx] {
+			return false
+		}
+	}
+	if len(lhs.ExportRtList) != len(rhs.ExportRtList) {
+		return false
+	}
+	for idx, l := range lhs.ExportRtList {
+		if l != rhs.ExportRtList[idx] {
+			return false
+		}
+	}
+	if len(lhs.BothRtList) != len(rhs.BothRtList) {
+		return false
+	}
+	for idx, l := range lhs.BothRtList {
+		if l != rhs.BothRtList[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+//struct for container gobgp:vrf
+type Vrf struct {
+	// original -> gobgp:name
+	// original -> gobgp:vrf-config
+	Config VrfConfig `mapstructure:"config" json:"config,omitempty"`
+}
+
+func (lhs *Vrf) Equal(rhs *Vrf) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
+//struct for container gobgp:state
+type AggregateState struct {
+	// original -> gobgp:originated
+	//gobgp:originated's original type is boolean
+	Originated bool `mapstructure:"originated" json:"originated,omitempty"`
+	// original -> gobgp:contributors
+	Contributors uint32 `mapstructure:"contributors" json:"contributors,omitempty"`
+}
+
+//struct for container gobgp:config
+type AggregateConfig struct {
+	// original -> gobgp:prefix
+	//gobgp:prefix's original type is inet:ip-prefix
+	Prefix string `mapstructure:"prefix" json:"prefix,omitempty"`
+	// original -> gobgp:vrf
+	Vrf string `mapstructure:"vrf" json:"vrf,omitempty"`
+	// original -> gobgp:summary-only
+	//gobgp:summary-only's original type is boolean
+	SummaryOnly bool `mapstructure:"summary-only" json:"summary-only,omitempty"`
+	// original -> gobgp:as-set
+	//gobgp:as-set's original type is boolean
+	AsSet bool `mapstructure:"as-set" json:"as-set,omitempty"`
+	// original -> gobgp:contributor-policy
+	ContributorPolicy string `mapstructure:"contributor-policy" json:"contributor-policy,omitempty"`
+}
+
+func (lhs *AggregateConfig) Equal(rhs *AggregateConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Prefix != rhs.Prefix {
+		return false
+	}
+	if lhs.Vrf != rhs.Vrf {
+		return false
+	}
+	if lhs.SummaryOnly != rhs.SummaryOnly {
+		return false
+	}
+	if lhs.AsSet != rhs.AsSet {
+		return false
+	}
+	if lhs.ContributorPolicy != rhs.ContributorPolicy {
+		return false
+	}
+	return true
+}
+
+//struct for container gobgp:aggregate
+type Aggregate struct {
+	// original -> gobgp:prefix
+	// original -> gobgp:aggregate-config
+	Config AggregateConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:aggregate-state
+	State AggregateState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *Aggregate) Equal(rhs *Aggregate) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
+//struct for container gobgp:state
+type HealthCheckState struct {
+	// original -> gobgp:up
+	//gobgp:up's original type is boolean
+	Up bool `mapstructure:"up" json:"up,omitempty"`
+	// original -> gobgp:successes
+	Successes uint32 `mapstructure:"successes" json:"successes,omitempty"`
+	// original -> gobgp:failures
+	Failures uint32 `mapstructure:"failures" json:"failures,omitempty"`
+	// original -> gobgp:last-error
+	LastError string `mapstructure:"last-error" json:"last-error,omitempty"`
+	// original -> gobgp:last-change
+	LastChange int64 `mapstructure:"last-change" json:"last-change,omitempty"`
+}
+
+//struct for container gobgp:config
+type HealthCheckConfig struct {
+	// original -> gobgp:name
+	Name string `mapstructure:"name" json:"name,omitempty"`
+	// original -> gobgp:type
+	Type HealthCheckType `mapstructure:"type" json:"type,omitempty"`
+	// original -> gobgp:address
+	Address string `mapstructure:"address" json:"address,omitempty"`
+	// original -> gobgp:url
+	Url string `mapstructure:"url" json:"url,omitempty"`
+	// original -> gobgp:expected-status
+	ExpectedStatus uint16 `mapstructure:"expected-status" json:"expected-status,omitempty"`
+	// original -> gobgp:command
+	Command string `mapstructure:"command" json:"command,omitempty"`
+	// original -> gobgp:interval
+	Interval uint32 `mapstructure:"interval" json:"interval,omitempty"`
+	// original -> gobgp:timeout
+	Timeout uint32 `mapstructure:"timeout" json:"timeout,omitempty"`
+	// original -> gobgp:rise
+	Rise uint32 `mapstructure:"rise" json:"rise,omitempty"`
+	// original -> gobgp:fall
+	Fall uint32 `mapstructure:"fall" json:"fall,omitempty"`
+}
+
+func (lhs *HealthCheckConfig) Equal(rhs *HealthCheckConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Name != rhs.Name {
+		return false
+	}
+	if lhs.Type != rhs.Type {
+		return false
+	}
+	if lhs.Address != rhs.Address {
+		return false
+	}
+	if lhs.Url != rhs.Url {
+		return false
+	}
+	if lhs.ExpectedStatus != rhs.ExpectedStatus {
+		return false
+	}
+	if lhs.Command != rhs.Command {
+		return false
+	}
+	if lhs.Interval != rhs.Interval {
+		return false
+	}
+	if lhs.Timeout != rhs.Timeout {
+		return false
+	}
+	if lhs.Rise != rhs.Rise {
+		return false
+	}
+	if lhs.Fall != rhs.Fall {
+		return false
+	}
+	return true
+}
+
+//struct for container gobgp:config
+type HealthCheckRouteConfig struct {
+	// original -> gobgp:prefix
+	//gobgp:prefix's original type is inet:ip-prefix
+	Prefix string `mapstructure:"prefix" json:"prefix,omitempty"`
+	// original -> gobgp:next-hop
+	//gobgp:next-hop's original type is inet:ip-address
+	NextHop string `mapstructure:"next-hop" json:"next-hop,omitempty"`
+	// original -> gobgp:med
+	Med uint32 `mapstructure:"med" json:"med,omitempty"`
+	// original -> gobgp:community
+	CommunityList []string `mapstructure:"community-list" json:"community-list,omitempty"`
+	// original -> gobgp:degraded-med
+	DegradedMed uint32 `mapstructure:"degraded-med" json:"degraded-med,omitempty"`
+	// original -> gobgp:degraded-community
+	DegradedCommunityList []string `mapstructure:"degraded-community-list" json:"degraded-community-list,omitempty"`
+}
+
+func (lhs *HealthCheckRouteConfig) Equal(rhs *HealthCheckRouteConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Prefix != rhs.Prefix {
+		return false
+	}
+	if lhs.NextHop != rhs.NextHop {
+		return false
+	}
+	if lhs.Med != rhs.Med {
+		return false
+	}
+	if len(lhs.CommunityList) != len(rhs.CommunityList) {
+		return false
+	}
+	for idx, l := range lhs.CommunityList {
+		if l != rhs.CommunityList[idx] {
+			return false
+		}
+	}
+	if lhs.DegradedMed != rhs.DegradedMed {
+		return false
+	}
+	if len(lhs.DegradedCommunityList) != len(rhs.DegradedCommunityList) {
+		return false
+	}
+	for idx, l := range lhs.DegradedCommunityList {
+		if l != rhs.DegradedCommunityList[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+//struct for container gobgp:route
+type HealthCheckRoute struct {
+	// original -> gobgp:prefix
+	// original -> gobgp:health-check-route-config
+	Config HealthCheckRouteConfig `mapstructure:"config" json:"config,omitempty"`
+}
+
+func (lhs *HealthCheckRoute) Equal(rhs *HealthCheckRoute) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
+//struct for container gobgp:health-check
+type HealthCheck struct {
+	// original -> gobgp:name
+	// original -> gobgp:health-check-config
+	Config HealthCheckConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:health-check-state
+	State HealthCheckState `mapstructure:"state" json:"state,omitempty"`
+	// original -> gobgp:routes
+	Routes []HealthCheckRoute `mapstructure:"routes" json:"routes,omitempty"`
+}
+
+func (lhs *HealthCheck) Equal(rhs *HealthCheck) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	if len(lhs.Routes) != len(rhs.Routes) {
+		return false
+	}
+	{
+		lmap := make(map[string]*HealthCheckRoute)
+		for i, l := range lhs.Routes {
+			lmap[mapkey(i, string(l.Config.Prefix))] = &lhs.Routes[i]
+		}
+		for i, r := range rhs.Routes {
+			if l, y := lmap[mapkey(i, string(r.Config.Prefix))]; !y {
+				return false
+			} else if !r.Equal(l) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 //struct for container gobgp:mrt
 type Mrt struct {
 	// original -> gobgp:file-name
@@ -1163,6 +1630,11 @@ type BmpServerConfig struct {
 	Port uint32 `mapstructure:"port" json:"port,omitempty"`
 	// original -> gobgp:route-monitoring-policy
 	RouteMonitoringPolicy BmpRouteMonitoringPolicyType `mapstructure:"route-monitoring-policy" json:"route-monitoring-policy,omitempty"`
+	// original -> gobgp:route-monitoring-loc-rib
+	//gobgp:route-monitoring-loc-rib's original type is boolean
+	RouteMonitoringLocRib bool `mapstructure:"route-monitoring-loc-rib" json:"route-monitoring-loc-rib,omitempty"`
+	// original -> gobgp:route-monitoring-adj-rib-out
+	RouteMonitoringAdjRibOut BmpRouteMonitoringPolicyType `mapstructure:"route-monitoring-adj-rib-out" json:"route-monitoring-adj-rib-out,omitempty"`
 }
 
 func (lhs *BmpServerConfig) Equal(rhs *BmpServerConfig) bool {
@@ -1178,6 +1650,12 @@ func (lhs *BmpServerConfig) Equal(rhs *BmpServerConfig) bool {
 	if lhs.RouteMonitoringPolicy != rhs.RouteMonitoringPolicy {
 		return false
 	}
+	if lhs.RouteMonitoringLocRib != rhs.RouteMonitoringLocRib {
+		return false
+	}
+	if lhs.RouteMonitoringAdjRibOut != rhs.RouteMonitoringAdjRibOut {
+		return false
+	}
 	return true
 }
 
@@ -1500,6 +1978,10 @@ type PeerGroup struct {
 	UseMultiplePaths UseMultiplePaths `mapstructure:"use-multiple-paths" json:"use-multiple-paths,omitempty"`
 	// original -> gobgp:route-server
 	RouteServer RouteServer `mapstructure:"route-server" json:"route-server,omitempty"`
+	// original -> gobgp:bfd
+	Bfd Bfd `mapstructure:"bfd" json:"bfd,omitempty"`
+	// original -> gobgp:route-flap-damping
+	RouteFlapDamping RouteFlapDamping `mapstructure:"route-flap-damping" json:"route-flap-damping,omitempty"`
 }
 
 func (lhs *PeerGroup) Equal(rhs *PeerGroup) bool {
@@ -1561,6 +2043,12 @@ func (lhs *PeerGroup) Equal(rhs *PeerGroup) bool {
 	if !lhs.RouteServer.Equal(&(rhs.RouteServer)) {
 		return false
 	}
+	if !lhs.Bfd.Equal(&(rhs.Bfd)) {
+		return false
+	}
+	if !lhs.RouteFlapDamping.Equal(&(rhs.RouteFlapDamping)) {
+		return false
+	}
 	return true
 }
 
@@ -1588,6 +2076,141 @@ func (lhs *RouteServerConfig) Equal(rhs *RouteServerConfig) bool {
 	return true
 }
 
+//struct for container gobgp:state
+type BfdState struct {
+	// original -> gobgp:session-state
+	SessionState string `mapstructure:"session-state" json:"session-state,omitempty"`
+	// original -> gobgp:diagnostic
+	Diagnostic string `mapstructure:"diagnostic" json:"diagnostic,omitempty"`
+	// original -> gobgp:local-discriminator
+	LocalDiscriminator uint32 `mapstructure:"local-discriminator" json:"local-discriminator,omitempty"`
+	// original -> gobgp:remote-discriminator
+	RemoteDiscriminator uint32 `mapstructure:"remote-discriminator" json:"remote-discriminator,omitempty"`
+	// original -> gobgp:detection-time
+	DetectionTime uint32 `mapstructure:"detection-time" json:"detection-time,omitempty"`
+	// original -> gobgp:uptime
+	Uptime int64 `mapstructure:"uptime" json:"uptime,omitempty"`
+	// original -> gobgp:up-count
+	UpCount uint32 `mapstructure:"up-count" json:"up-count,omitempty"`
+}
+
+//struct for container gobgp:config
+type BfdConfig struct {
+	// original -> gobgp:enabled
+	//gobgp:enabled's original type is boolean
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
+	// original -> gobgp:multihop
+	//gobgp:multihop's original type is boolean
+	Multihop bool `mapstructure:"multihop" json:"multihop,omitempty"`
+	// original -> gobgp:desired-min-tx-interval
+	DesiredMinTxInterval uint32 `mapstructure:"desired-min-tx-interval" json:"desired-min-tx-interval,omitempty"`
+	// original -> gobgp:required-min-rx-interval
+	RequiredMinRxInterval uint32 `mapstructure:"required-min-rx-interval" json:"required-min-rx-interval,omitempty"`
+	// original -> gobgp:detect-multiplier
+	DetectMultiplier uint8 `mapstructure:"detect-multiplier" json:"detect-multiplier,omitempty"`
+}
+
+func (lhs *BfdConfig) Equal(rhs *BfdConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Enabled != rhs.Enabled {
+		return false
+	}
+	if lhs.Multihop != rhs.Multihop {
+		return false
+	}
+	if lhs.DesiredMinTxInterval != rhs.DesiredMinTxInterval {
+		return false
+	}
+	if lhs.RequiredMinRxInterval != rhs.RequiredMinRxInterval {
+		return false
+	}
+	if lhs.DetectMultiplier != rhs.DetectMultiplier {
+		return false
+	}
+	return true
+}
+
+//struct for container gobgp:bfd
+type Bfd struct {
+	// original -> gobgp:bfd-config
+	Config BfdConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:bfd-state
+	State BfdState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *Bfd) Equal(rhs *Bfd) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
+//struct for container gobgp:state
+type RouteFlapDampingState struct {
+	// original -> gobgp:half-life
+	HalfLife uint32 `mapstructure:"half-life" json:"half-life,omitempty"`
+	// original -> gobgp:reuse-threshold
+	ReuseThreshold uint32 `mapstructure:"reuse-threshold" json:"reuse-threshold,omitempty"`
+	// original -> gobgp:suppress-threshold
+	SuppressThreshold uint32 `mapstructure:"suppress-threshold" json:"suppress-threshold,omitempty"`
+	// original -> gobgp:max-suppress-time
+	MaxSuppressTime uint32 `mapstructure:"max-suppress-time" json:"max-suppress-time,omitempty"`
+}
+
+//struct for container gobgp:config
+type RouteFlapDampingConfig struct {
+	// original -> gobgp:half-life
+	HalfLife uint32 `mapstructure:"half-life" json:"half-life,omitempty"`
+	// original -> gobgp:reuse-threshold
+	ReuseThreshold uint32 `mapstructure:"reuse-threshold" json:"reuse-threshold,omitempty"`
+	// original -> gobgp:suppress-threshold
+	SuppressThreshold uint32 `mapstructure:"suppress-threshold" json:"suppress-threshold,omitempty"`
+	// original -> gobgp:max-suppress-time
+	MaxSuppressTime uint32 `mapstructure:"max-suppress-time" json:"max-suppress-time,omitempty"`
+}
+
+func (lhs *RouteFlapDampingConfig) Equal(rhs *RouteFlapDampingConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.HalfLife != rhs.HalfLife {
+		return false
+	}
+	if lhs.ReuseThreshold != rhs.ReuseThreshold {
+		return false
+	}
+	if lhs.SuppressThreshold != rhs.SuppressThreshold {
+		return false
+	}
+	if lhs.MaxSuppressTime != rhs.MaxSuppressTime {
+		return false
+	}
+	return true
+}
+
+//struct for container gobgp:route-flap-damping
+type RouteFlapDamping struct {
+	// original -> gobgp:route-flap-damping-config
+	Config RouteFlapDampingConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:route-flap-damping-state
+	State RouteFlapDampingState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *RouteFlapDamping) Equal(rhs *RouteFlapDamping) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
 //struct for container gobgp:route-server
 type RouteServer struct {
 	// original -> gobgp:route-server-config
@@ -1877,6 +2500,14 @@ type ErrorHandlingState struct {
 	TreatAsWithdraw bool `mapstructure:"treat-as-withdraw" json:"treat-as-withdraw,omitempty"`
 	// original -> bgp-op:erroneous-update-messages
 	ErroneousUpdateMessages uint32 `mapstructure:"erroneous-update-messages" json:"erroneous-update-messages,omitempty"`
+	// original -> gobgp:session-reset-count
+	SessionResetCount uint32 `mapstructure:"session-reset-count" json:"session-reset-count,omitempty"`
+	// original -> gobgp:afi-safi-disable-count
+	AfiSafiDisableCount uint32 `mapstructure:"afi-safi-disable-count" json:"afi-safi-disable-count,omitempty"`
+	// original -> gobgp:treat-as-withdraw-count
+	TreatAsWithdrawCount uint32 `mapstructure:"treat-as-withdraw-count" json:"treat-as-withdraw-count,omitempty"`
+	// original -> gobgp:attribute-discard-count
+	AttributeDiscardCount uint32 `mapstructure:"attribute-discard-count" json:"attribute-discard-count,omitempty"`
 }
 
 //struct for container bgp:config
@@ -1936,6 +2567,13 @@ type TransportState struct {
 	// original -> bgp-op:remote-port
 	//bgp-op:remote-port's original type is inet:port-number
 	RemotePort uint16 `mapstructure:"remote-port" json:"remote-port,omitempty"`
+	// original -> gobgp:ttl-security
+	//gobgp:ttl-security's original type is boolean
+	TtlSecurity bool `mapstructure:"ttl-security" json:"ttl-security,omitempty"`
+	// original -> gobgp:ttl
+	Ttl uint8 `mapstructure:"ttl" json:"ttl,omitempty"`
+	// original -> gobgp:min-ttl
+	MinTtl uint8 `mapstructure:"min-ttl" json:"min-ttl,omitempty"`
 }
 
 //struct for container bgp:config
@@ -1954,6 +2592,9 @@ type TransportConfig struct {
 	// original -> gobgp:remote-port
 	//gobgp:remote-port's original type is inet:port-number
 	RemotePort uint16 `mapstructure:"remote-port" json:"remote-port,omitempty"`
+	// original -> gobgp:ttl-security
+	//gobgp:ttl-security's original type is boolean
+	TtlSecurity bool `mapstructure:"ttl-security" json:"ttl-security,omitempty"`
 }
 
 func (lhs *TransportConfig) Equal(rhs *TransportConfig) bool {
@@ -1975,6 +2616,9 @@ func (lhs *TransportConfig) Equal(rhs *TransportConfig) bool {
 	if lhs.RemotePort != rhs.RemotePort {
 		return false
 	}
+	if lhs.TtlSecurity != rhs.TtlSecurity {
+		return false
+	}
 	return true
 }
 
@@ -2398,6 +3042,60 @@ func (lhs *NeighborConfig) Equal(rhs *NeighborConfig) bool {
 	return true
 }
 
+//struct for container gobgp:state
+type ConditionalAdvertisementState struct {
+	// original -> gobgp:condition-met
+	//gobgp:condition-met's original type is boolean
+	ConditionMet bool `mapstructure:"condition-met" json:"condition-met,omitempty"`
+	// original -> gobgp:tracked-paths
+	TrackedPaths uint32 `mapstructure:"tracked-paths" json:"tracked-paths,omitempty"`
+}
+
+//struct for container gobgp:config
+type ConditionalAdvertisementConfig struct {
+	// original -> gobgp:advertise-policy
+	AdvertisePolicy string `mapstructure:"advertise-policy" json:"advertise-policy,omitempty"`
+	// original -> gobgp:exist-prefix-set
+	ExistPrefixSet string `mapstructure:"exist-prefix-set" json:"exist-prefix-set,omitempty"`
+	// original -> gobgp:non-exist-prefix-set
+	NonExistPrefixSet string `mapstructure:"non-exist-prefix-set" json:"non-exist-prefix-set,omitempty"`
+}
+
+func (lhs *ConditionalAdvertisementConfig) Equal(rhs *ConditionalAdvertisementConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.AdvertisePolicy != rhs.AdvertisePolicy {
+		return false
+	}
+	if lhs.ExistPrefixSet != rhs.ExistPrefixSet {
+		return false
+	}
+	if lhs.NonExistPrefixSet != rhs.NonExistPrefixSet {
+		return false
+	}
+	return true
+}
+
+//struct for container gobgp:conditional-advertisement
+type ConditionalAdvertisement struct {
+	// original -> gobgp:advertise-policy
+	// original -> gobgp:conditional-advertisement-config
+	Config ConditionalAdvertisementConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:conditional-advertisement-state
+	State ConditionalAdvertisementState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *ConditionalAdvertisement) Equal(rhs *ConditionalAdvertisement) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
 //struct for container bgp:neighbor
 type Neighbor struct {
 	// original -> bgp:neighbor-address
@@ -2431,6 +3129,12 @@ type Neighbor struct {
 	UseMultiplePaths UseMultiplePaths `mapstructure:"use-multiple-paths" json:"use-multiple-paths,omitempty"`
 	// original -> gobgp:route-server
 	RouteServer RouteServer `mapstructure:"route-server" json:"route-server,omitempty"`
+	// original -> gobgp:bfd
+	Bfd Bfd `mapstructure:"bfd" json:"bfd,omitempty"`
+	// original -> gobgp:route-flap-damping
+	RouteFlapDamping RouteFlapDamping `mapstructure:"route-flap-damping" json:"route-flap-damping,omitempty"`
+	// original -> gobgp:conditional-advertisements
+	ConditionalAdvertisements []ConditionalAdvertisement `mapstructure:"conditional-advertisements" json:"conditional-advertisements,omitempty"`
 }
 
 func (lhs *Neighbor) Equal(rhs *Neighbor) bool {
@@ -2492,6 +3196,28 @@ func (lhs *Neighbor) Equal(rhs *Neighbor) bool {
 	if !lhs.RouteServer.Equal(&(rhs.RouteServer)) {
 		return false
 	}
+	if !lhs.Bfd.Equal(&(rhs.Bfd)) {
+		return false
+	}
+	if !lhs.RouteFlapDamping.Equal(&(rhs.RouteFlapDamping)) {
+		return false
+	}
+	if len(lhs.ConditionalAdvertisements) != len(rhs.ConditionalAdvertisements) {
+		return false
+	}
+	{
+		lmap := make(map[string]*ConditionalAdvertisement)
+		for i, l := range lhs.ConditionalAdvertisements {
+			lmap[mapkey(i, string(l.Config.AdvertisePolicy))] = &lhs.ConditionalAdvertisements[i]
+		}
+		for i, r := range rhs.ConditionalAdvertisements {
+			if l, y := lmap[mapkey(i, string(r.Config.AdvertisePolicy))]; !y {
+				return false
+			} else if !r.Equal(l) {
+				return false
+			}
+		}
+	}
 	return true
 }
 
@@ -3050,6 +3776,53 @@ func (lhs *MpGracefulRestart) Equal(rhs *MpGracefulRestart) bool {
 	return true
 }
 
+//struct for container gobgp:state
+type DefaultOriginateState struct {
+	// original -> gobgp:originated
+	//gobgp:originated's original type is boolean
+	Originated bool `mapstructure:"originated" json:"originated,omitempty"`
+}
+
+//struct for container gobgp:config
+type DefaultOriginateConfig struct {
+	// original -> gobgp:enabled
+	//gobgp:enabled's original type is boolean
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
+	// original -> gobgp:route-policy
+	RoutePolicy string `mapstructure:"route-policy" json:"route-policy,omitempty"`
+}
+
+func (lhs *DefaultOriginateConfig) Equal(rhs *DefaultOriginateConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Enabled != rhs.Enabled {
+		return false
+	}
+	if lhs.RoutePolicy != rhs.RoutePolicy {
+		return false
+	}
+	return true
+}
+
+//struct for container gobgp:default-originate
+type DefaultOriginate struct {
+	// original -> gobgp:default-originate-config
+	Config DefaultOriginateConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:default-originate-state
+	State DefaultOriginateState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *DefaultOriginate) Equal(rhs *DefaultOriginate) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
 //struct for container bgp-mp:afi-safi
 type AfiSafi struct {
 	// original -> bgp-mp:afi-safi-name
@@ -3091,6 +3864,10 @@ type AfiSafi struct {
 	RouteTargetMembership RouteTargetMembership `mapstructure:"route-target-membership" json:"route-target-membership,omitempty"`
 	// original -> gobgp:long-lived-graceful-restart
 	LongLivedGracefulRestart LongLivedGracefulRestart `mapstructure:"long-lived-graceful-restart" json:"long-lived-graceful-restart,omitempty"`
+	// original -> gobgp:add-paths
+	AddPaths AddPaths `mapstructure:"add-paths" json:"add-paths,omitempty"`
+	// original -> gobgp:default-originate
+	DefaultOriginate DefaultOriginate `mapstructure:"default-originate" json:"default-originate,omitempty"`
 }
 
 func (lhs *AfiSafi) Equal(rhs *AfiSafi) bool {
@@ -3151,6 +3928,12 @@ func (lhs *AfiSafi) Equal(rhs *AfiSafi) bool {
 	if !lhs.LongLivedGracefulRestart.Equal(&(rhs.LongLivedGracefulRestart)) {
 		return false
 	}
+	if !lhs.AddPaths.Equal(&(rhs.AddPaths)) {
+		return false
+	}
+	if !lhs.DefaultOriginate.Equal(&(rhs.DefaultOriginate)) {
+		return false
+	}
 	return true
 }
 
@@ -3612,6 +4395,8 @@ type GlobalState struct {
 	Port int32 `mapstructure:"port" json:"port,omitempty"`
 	// original -> gobgp:local-address
 	LocalAddressList []string `mapstructure:"local-address-list" json:"local-address-list,omitempty"`
+	// original -> gobgp:pending-restart
+	PendingRestartList []string `mapstructure:"pending-restart-list" json:"pending-restart-list,omitempty"`
 }
 
 //struct for container bgp:config
@@ -3626,6 +4411,8 @@ type GlobalConfig struct {
 	Port int32 `mapstructure:"port" json:"port,omitempty"`
 	// original -> gobgp:local-address
 	LocalAddressList []string `mapstructure:"local-address-list" json:"local-address-list,omitempty"`
+	// original -> gobgp:best-path-history-size
+	BestPathHistorySize uint32 `mapstructure:"best-path-history-size" json:"best-path-history-size,omitempty"`
 }
 
 func (lhs *GlobalConfig) Equal(rhs *GlobalConfig) bool {
@@ -3649,6 +4436,9 @@ func (lhs *GlobalConfig) Equal(rhs *GlobalConfig) bool {
 			return false
 		}
 	}
+	if lhs.BestPathHistorySize != rhs.BestPathHistorySize {
+		return false
+	}
 	return true
 }
 
@@ -3726,6 +4516,8 @@ type Bgp struct {
 	Neighbors []Neighbor `mapstructure:"neighbors" json:"neighbors,omitempty"`
 	// original -> bgp:peer-groups
 	PeerGroups []PeerGroup `mapstructure:"peer-groups" json:"peer-groups,omitempty"`
+	// original -> gobgp:dynamic-neighbors
+	DynamicNeighbors []DynamicNeighbor `mapstructure:"dynamic-neighbors" json:"dynamic-neighbors,omitempty"`
 	// original -> gobgp:rpki-servers
 	RpkiServers []RpkiServer `mapstructure:"rpki-servers" json:"rpki-servers,omitempty"`
 	// original -> gobgp:bmp-servers
@@ -3736,6 +4528,12 @@ type Bgp struct {
 	Zebra Zebra `mapstructure:"zebra" json:"zebra,omitempty"`
 	// original -> gobgp:collector
 	Collector Collector `mapstructure:"collector" json:"collector,omitempty"`
+	// original -> gobgp:vrfs
+	Vrfs []Vrf `mapstructure:"vrfs" json:"vrfs,omitempty"`
+	// original -> gobgp:aggregates
+	Aggregates []Aggregate `mapstructure:"aggregates" json:"aggregates,omitempty"`
+	// original -> gobgp:health-checks
+	HealthChecks []HealthCheck `mapstructure:"health-checks" json:"health-checks,omitempty"`
 }
 
 func (lhs *Bgp) Equal(rhs *Bgp) bool {
@@ -3777,6 +4575,22 @@ func (lhs *Bgp) Equal(rhs *Bgp) bool {
 			}
 		}
 	}
+	if len(lhs.DynamicNeighbors) != len(rhs.DynamicNeighbors) {
+		return false
+	}
+	{
+		lmap := make(map[string]*DynamicNeighbor)
+		for i, l := range lhs.DynamicNeighbors {
+			lmap[mapkey(i, string(l.Config.Prefix))] = &lhs.DynamicNeighbors[i]
+		}
+		for i, r := range rhs.DynamicNeighbors {
+			if l, y := lmap[mapkey(i, string(r.Config.Prefix))]; !y {
+				return false
+			} else if !r.Equal(l) {
+				return false
+			}
+		}
+	}
 	if len(lhs.RpkiServers) != len(rhs.RpkiServers) {
 		return false
 	}
@@ -3831,6 +4645,54 @@ func (lhs *Bgp) Equal(rhs *Bgp) bool {
 	if !lhs.Collector.Equal(&(rhs.Collector)) {
 		return false
 	}
+	if len(lhs.Vrfs) != len(rhs.Vrfs) {
+		return false
+	}
+	{
+		lmap := make(map[string]*Vrf)
+		for i, l := range lhs.Vrfs {
+			lmap[mapkey(i, string(l.Config.Name))] = &lhs.Vrfs[i]
+		}
+		for i, r := range rhs.Vrfs {
+			if l, y := lmap[mapkey(i, string(r.Config.Name))]; !y {
+				return false
+			} else if !r.Equal(l) {
+				return false
+			}
+		}
+	}
+	if len(lhs.Aggregates) != len(rhs.Aggregates) {
+		return false
+	}
+	{
+		lmap := make(map[string]*Aggregate)
+		for i, l := range lhs.Aggregates {
+			lmap[mapkey(i, string(l.Config.Prefix))] = &lhs.Aggregates[i]
+		}
+		for i, r := range rhs.Aggregates {
+			if l, y := lmap[mapkey(i, string(r.Config.Prefix))]; !y {
+				return false
+			} else if !r.Equal(l) {
+				return false
+			}
+		}
+	}
+	if len(lhs.HealthChecks) != len(rhs.HealthChecks) {
+		return false
+	}
+	{
+		lmap := make(map[string]*HealthCheck)
+		for i, l := range lhs.HealthChecks {
+			lmap[mapkey(i, string(l.Config.Name))] = &lhs.HealthChecks[i]
+		}
+		for i, r := range rhs.HealthChecks {
+			if l, y := lmap[mapkey(i, string(r.Config.Name))]; !y {
+				return false
+			} else if !r.Equal(l) {
+				return false
+			}
+		}
+	}
 	return true
 }
 
@@ -4014,6 +4876,8 @@ type BgpActions struct {
 	SetMed BgpSetMedType `mapstructure:"set-med" json:"set-med,omitempty"`
 	// original -> gobgp:set-large-community
 	SetLargeCommunity SetLargeCommunity `mapstructure:"set-large-community" json:"set-large-community,omitempty"`
+	// original -> gobgp:set-aigp
+	SetAigp string `mapstructure:"set-aigp" json:"set-aigp,omitempty"`
 }
 
 func (lhs *BgpActions) Equal(rhs *BgpActions) bool {
@@ -4044,6 +4908,9 @@ func (lhs *BgpActions) Equal(rhs *BgpActions) bool {
 	if !lhs.SetLargeCommunity.Equal(&(rhs.SetLargeCommunity)) {
 		return false
 	}
+	if lhs.SetAigp != rhs.SetAigp {
+		return false
+	}
 	return true
 }
 
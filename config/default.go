@@ -7,12 +7,21 @@ import (
 	"github.com/citizen-insane/gobgp/packet/rtr"
 	"github.com/spf13/viper"
 	"net"
+	"reflect"
+	"strconv"
+	"strings"
 )
 
 const (
-	DEFAULT_HOLDTIME                  = 90
-	DEFAULT_IDLE_HOLDTIME_AFTER_RESET = 30
-	DEFAULT_CONNECT_RETRY             = 120
+	DEFAULT_HOLDTIME                   = 90
+	DEFAULT_IDLE_HOLDTIME_AFTER_RESET  = 30
+	DEFAULT_CONNECT_RETRY              = 120
+	DEFAULT_BFD_MIN_INTERVAL           = 300
+	DEFAULT_BFD_DETECT_MULTIPLIER      = 3
+	DEFAULT_DAMPING_HALF_LIFE          = 900
+	DEFAULT_DAMPING_REUSE_THRESHOLD    = 750
+	DEFAULT_DAMPING_SUPPRESS_THRESHOLD = 2000
+	DEFAULT_DAMPING_MAX_SUPPRESS_TIME  = 3600
 )
 
 func defaultAfiSafi(typ AfiSafiType, enable bool) AfiSafi {
@@ -86,20 +95,27 @@ func isLocalLinkLocalAddress(ifindex int, addr net.IP) (bool, error) {
 	return false, nil
 }
 
-func SetDefaultNeighborConfigValues(n *Neighbor, asn uint32) error {
-	return setDefaultNeighborConfigValuesWithViper(nil, n, asn)
+func SetDefaultNeighborConfigValues(n *Neighbor, g *Global) error {
+	return setDefaultNeighborConfigValuesWithViper(nil, n, g)
 }
 
-func setDefaultNeighborConfigValuesWithViper(v *viper.Viper, n *Neighbor, asn uint32) error {
+func setDefaultNeighborConfigValuesWithViper(v *viper.Viper, n *Neighbor, g *Global) error {
 	if v == nil {
 		v = viper.New()
 	}
 
 	if n.Config.LocalAs == 0 {
-		n.Config.LocalAs = asn
+		n.Config.LocalAs = g.Config.As
+		// the peers outside of the confederation see the confederation
+		// identifier as the local AS (RFC 5065)
+		if g.Confederation.Config.Enabled && !IsConfederationMember(g, n) && n.Config.PeerAs != g.Config.As {
+			n.Config.LocalAs = g.Confederation.Config.Identifier
+		}
 	}
 
-	if n.Config.PeerAs != n.Config.LocalAs {
+	if IsConfederationMember(g, n) {
+		n.Config.PeerType = PEER_TYPE_CONFED_EXTERNAL
+	} else if n.Config.PeerAs != n.Config.LocalAs {
 		n.Config.PeerType = PEER_TYPE_EXTERNAL
 	} else {
 		n.Config.PeerType = PEER_TYPE_INTERNAL
@@ -155,6 +171,11 @@ func setDefaultNeighborConfigValuesWithViper(v *viper.Viper, n *Neighbor, asn ui
 		} else {
 			n.AfiSafis = []AfiSafi{defaultAfiSafi(AFI_SAFI_TYPE_IPV6_UNICAST, true)}
 		}
+		for i := range n.AfiSafis {
+			n.AfiSafis[i].AddPaths.Config = n.AddPaths.Config
+			n.AfiSafis[i].AddPaths.State.Receive = n.AddPaths.Config.Receive
+			n.AfiSafis[i].AddPaths.State.SendMax = n.AddPaths.Config.SendMax
+		}
 	} else {
 		afs, err := extractArray(v.Get("neighbor.afi-safis"))
 		if err != nil {
@@ -169,6 +190,11 @@ func setDefaultNeighborConfigValuesWithViper(v *viper.Viper, n *Neighbor, asn ui
 			if !vv.IsSet("afi-safi.config") {
 				af.Config.Enabled = true
 			}
+			if !vv.IsSet("afi-safi.add-paths.config") {
+				af.AddPaths.Config = n.AddPaths.Config
+			}
+			af.AddPaths.State.Receive = af.AddPaths.Config.Receive
+			af.AddPaths.State.SendMax = af.AddPaths.Config.SendMax
 			n.AfiSafis[i] = af
 		}
 	}
@@ -176,6 +202,37 @@ func setDefaultNeighborConfigValuesWithViper(v *viper.Viper, n *Neighbor, asn ui
 	n.State.Description = n.Config.Description
 	n.State.AdminDown = n.Config.AdminDown
 
+	if n.Bfd.Config.Enabled {
+		if n.Bfd.Config.DesiredMinTxInterval == 0 {
+			n.Bfd.Config.DesiredMinTxInterval = DEFAULT_BFD_MIN_INTERVAL
+		}
+		if n.Bfd.Config.RequiredMinRxInterval == 0 {
+			n.Bfd.Config.RequiredMinRxInterval = DEFAULT_BFD_MIN_INTERVAL
+		}
+		if n.Bfd.Config.DetectMultiplier == 0 {
+			n.Bfd.Config.DetectMultiplier = DEFAULT_BFD_DETECT_MULTIPLIER
+		}
+	}
+
+	if n.Config.RouteFlapDamping {
+		d := &n.RouteFlapDamping.Config
+		if d.HalfLife == 0 {
+			d.HalfLife = DEFAULT_DAMPING_HALF_LIFE
+		}
+		if d.ReuseThreshold == 0 {
+			d.ReuseThreshold = DEFAULT_DAMPING_REUSE_THRESHOLD
+		}
+		if d.SuppressThreshold == 0 {
+			d.SuppressThreshold = DEFAULT_DAMPING_SUPPRESS_THRESHOLD
+		}
+		if d.MaxSuppressTime == 0 {
+			d.MaxSuppressTime = DEFAULT_DAMPING_MAX_SUPPRESS_TIME
+		}
+		if d.ReuseThreshold >= d.SuppressThreshold {
+			return fmt.Errorf("reuse threshold of route flap damping must be less than suppress threshold")
+		}
+	}
+
 	if n.GracefulRestart.Config.Enabled {
 		if !v.IsSet("neighbor.graceful-restart.config.restart-time") && n.GracefulRestart.Config.RestartTime == 0 {
 			// RFC 4724 4. Operation
@@ -194,6 +251,112 @@ func setDefaultNeighborConfigValuesWithViper(v *viper.Viper, n *Neighbor, asn ui
 	return nil
 }
 
+// configKeys records the keys of the value decoded from the configuration
+// file into keys. The keys are joined with dots and the elements of a list
+// are keyed by their index.
+func configKeys(prefix string, intf interface{}, keys map[string]bool) {
+	add := func(k string, e interface{}) {
+		if prefix != "" {
+			k = prefix + "." + k
+		}
+		keys[k] = true
+		configKeys(k, e, keys)
+	}
+	switch m := intf.(type) {
+	case map[string]interface{}:
+		for k, e := range m {
+			add(strings.ToLower(k), e)
+		}
+	case map[interface{}]interface{}:
+		for k, e := range m {
+			add(strings.ToLower(fmt.Sprint(k)), e)
+		}
+	default:
+		if list, err := extractArray(intf); err == nil {
+			for i, e := range list {
+				add(strconv.Itoa(i), e)
+			}
+		}
+	}
+}
+
+// overwriteConfig copies every field of pg into the field of c that has the
+// same name and type, unless the field of c is already set, either with a
+// non-zero value or explicitly in the configuration file. Nested structs
+// are merged recursively by field name and State containers are left
+// untouched.
+func overwriteConfig(c, pg reflect.Value, prefix string, keys map[string]bool) {
+	for i := 0; i < pg.NumField(); i++ {
+		name := pg.Type().Field(i).Name
+		if name == "State" {
+			continue
+		}
+		f, ok := c.Type().FieldByName(name)
+		if !ok {
+			continue
+		}
+		src := pg.Field(i)
+		dst := c.FieldByName(name)
+		key := prefix + f.Tag.Get("mapstructure")
+		if dst.Kind() == reflect.Struct && src.Kind() == reflect.Struct {
+			overwriteConfig(dst, src, key+".", keys)
+			continue
+		}
+		if dst.Type() != src.Type() {
+			continue
+		}
+		if afiSafis, ok := dst.Addr().Interface().(*[]AfiSafi); ok {
+			overwriteAfiSafis(afiSafis, src.Interface().([]AfiSafi), key, keys)
+			continue
+		}
+		if keys[key] {
+			continue
+		}
+		switch dst.Kind() {
+		case reflect.Slice:
+			if dst.Len() == 0 && src.Len() > 0 {
+				dst.Set(reflect.AppendSlice(reflect.MakeSlice(src.Type(), 0, src.Len()), src))
+			}
+		default:
+			if reflect.DeepEqual(dst.Interface(), reflect.Zero(dst.Type()).Interface()) {
+				dst.Set(src)
+			}
+		}
+	}
+}
+
+// overwriteAfiSafis merges the settings of each address family of the peer
+// group into the one of the same AfiSafiName in c. The address families
+// configured only in the peer group are appended to c.
+func overwriteAfiSafis(c *[]AfiSafi, pg []AfiSafi, prefix string, keys map[string]bool) {
+	for _, p := range pg {
+		found := false
+		for i := range *c {
+			if (*c)[i].Config.AfiSafiName == p.Config.AfiSafiName {
+				overwriteConfig(reflect.ValueOf(&(*c)[i]).Elem(), reflect.ValueOf(p), fmt.Sprintf("%s.%d.", prefix, i), keys)
+				found = true
+				break
+			}
+		}
+		if !found {
+			*c = append(*c, p)
+		}
+	}
+}
+
+// OverwriteNeighborConfigWithPeerGroup fills the settings left unspecified
+// in the neighbor configuration with the ones of the peer group it belongs
+// to. Settings explicitly given to the neighbor take precedence. The
+// settings given with the zero value are distinguished only by keys, the
+// keys given to the neighbor (see BgpConfigSet.NeighborKeys).
+func OverwriteNeighborConfigWithPeerGroup(c *Neighbor, pg *PeerGroup, keys map[string]bool) error {
+	if c.Config.PeerGroup != pg.Config.PeerGroupName {
+		return fmt.Errorf("neighbor %s doesn't belong to peer group %s", c.Config.NeighborAddress, pg.Config.PeerGroupName)
+	}
+	overwriteConfig(reflect.ValueOf(c).Elem(), reflect.ValueOf(pg).Elem(), "", keys)
+	return nil
+}
+
 func SetDefaultGlobalConfigValues(g *Global) error {
 	if len(g.AfiSafis) == 0 {
 		g.AfiSafis = []AfiSafi{}
@@ -209,6 +372,55 @@ func SetDefaultGlobalConfigValues(g *Global) error {
 	if len(g.Config.LocalAddressList) == 0 {
 		g.Config.LocalAddressList = []string{"0.0.0.0", "::"}
 	}
+
+	if c := g.Confederation.Config; c.Enabled && c.Identifier == 0 {
+		return fmt.Errorf("confederation identifier is not specified")
+	}
+	return nil
+}
+
+func SetDefaultHealthCheckConfigValues(c *HealthCheckConfig) error {
+	if c.Name == "" {
+		return fmt.Errorf("name of health check is not specified")
+	}
+	if c.Type == "" {
+		c.Type = HEALTH_CHECK_TYPE_TCP
+	}
+	if err := c.Type.Validate(); err != nil {
+		return err
+	}
+	switch c.Type {
+	case HEALTH_CHECK_TYPE_TCP:
+		if _, _, err := net.SplitHostPort(c.Address); err != nil {
+			return fmt.Errorf("invalid address of health check %s: %s", c.Name, err)
+		}
+	case HEALTH_CHECK_TYPE_HTTP:
+		if c.Url == "" {
+			return fmt.Errorf("url of health check %s is not specified", c.Name)
+		}
+		if c.ExpectedStatus == 0 {
+			c.ExpectedStatus = 200
+		}
+	case HEALTH_CHECK_TYPE_EXEC:
+		if c.Command == "" {
+			return fmt.Errorf("command of health check %s is not specified", c.Name)
+		}
+	}
+	if c.Interval == 0 {
+		c.Interval = 5
+	}
+	if c.Timeout == 0 {
+		c.Timeout = 2
+	}
+	if c.Timeout > c.Interval {
+		c.Timeout = c.Interval
+	}
+	if c.Rise == 0 {
+		c.Rise = 2
+	}
+	if c.Fall == 0 {
+		c.Fall = 3
+	}
 	return nil
 }
 
@@ -234,6 +446,7 @@ func setDefaultPolicyConfigValuesWithViper(v *viper.Viper, p *PolicyDefinition)
 }
 
 func setDefaultConfigValuesWithViper(v *viper.Viper, b *BgpConfigSet) error {
+	fromFile := v != nil
 	if v == nil {
 		v = viper.New()
 	}
@@ -259,17 +472,32 @@ func setDefaultConfigValuesWithViper(v *viper.Viper, b *BgpConfigSet) error {
 		b.Zebra.Config.NexthopTriggerDelay = 5
 	}
 
+	if b.Collector.Config.Url != "" && b.Collector.Config.Type == "" {
+		b.Collector.Config.Type = COLLECTOR_TYPE_INFLUXDB
+	}
+
 	list, err := extractArray(v.Get("neighbors"))
 	if err != nil {
 		return err
 	}
 
+	keys := make(map[string]map[string]bool)
 	for idx, n := range b.Neighbors {
+		if n.Config.PeerGroup != "" {
+			// defaults are applied by BgpServer after the settings of
+			// the peer group are merged into the neighbor.
+			if len(list) > idx {
+				m := make(map[string]bool)
+				configKeys("", list[idx], m)
+				keys[n.Config.NeighborAddress] = m
+			}
+			continue
+		}
 		vv := viper.New()
 		if len(list) > idx {
 			vv.Set("neighbor", list[idx])
 		}
-		if err := setDefaultNeighborConfigValuesWithViper(vv, &n, b.Global.Config.As); err != nil {
+		if err := setDefaultNeighborConfigValuesWithViper(vv, &n, &b.Global); err != nil {
 			return err
 		}
 		b.Neighbors[idx] = n
@@ -281,6 +509,18 @@ func setDefaultConfigValuesWithViper(v *viper.Viper, b *BgpConfigSet) error {
 		}
 	}
 
+	for idx := range b.HealthChecks {
+		if err := SetDefaultHealthCheckConfigValues(&b.HealthChecks[idx].Config); err != nil {
+			return err
+		}
+	}
+
+	for _, vrf := range b.Vrfs {
+		if _, _, _, err := ParseVrfConfig(&vrf.Config); err != nil {
+			return err
+		}
+	}
+
 	list, err = extractArray(v.Get("policy-definitions"))
 	if err != nil {
 		return err
@@ -297,5 +537,8 @@ func setDefaultConfigValuesWithViper(v *viper.Viper, b *BgpConfigSet) error {
 		b.PolicyDefinitions[idx] = p
 	}
 
+	if fromFile {
+		b.NeighborKeys = keys
+	}
 	return nil
 }
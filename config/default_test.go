@@ -0,0 +1,148 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func readConfig(t *testing.T, s string) *BgpConfigSet {
+	v := viper.New()
+	v.SetConfigType("toml")
+	if err := v.ReadConfig(strings.NewReader(s)); err != nil {
+		t.Fatal(err)
+	}
+	b := &BgpConfigSet{}
+	if err := v.UnmarshalExact(b); err != nil {
+		t.Fatal(err)
+	}
+	if err := setDefaultConfigValuesWithViper(v, b); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestOverwriteNeighborConfigWithPeerGroup(t *testing.T) {
+	assert := assert.New(t)
+	b := readConfig(t, `
+[global.config]
+  as = 1
+  router-id = "1.1.1.1"
+
+[[peer-groups]]
+  [peer-groups.config]
+    peer-group-name = "g"
+    peer-as = 2
+  [peer-groups.timers.config]
+    hold-time = 30
+  [peer-groups.transport.config]
+    passive-mode = true
+  [peer-groups.route-reflector.config]
+    route-reflector-client = true
+
+[[neighbors]]
+  [neighbors.config]
+    neighbor-address = "10.0.0.1"
+    peer-group = "g"
+  [neighbors.transport.config]
+    passive-mode = false
+
+[[neighbors]]
+  [neighbors.config]
+    neighbor-address = "10.0.0.2"
+    peer-group = "g"
+`)
+	pg := &b.PeerGroups[0]
+
+	n := b.Neighbors[0]
+	assert.Nil(OverwriteNeighborConfigWithPeerGroup(&n, pg, b.NeighborKeys["10.0.0.1"]))
+	assert.False(n.Transport.Config.PassiveMode)
+	assert.True(n.RouteReflector.Config.RouteReflectorClient)
+	assert.Equal(uint32(2), n.Config.PeerAs)
+	assert.Equal(float64(30), n.Timers.Config.HoldTime)
+
+	n = b.Neighbors[1]
+	assert.Nil(OverwriteNeighborConfigWithPeerGroup(&n, pg, b.NeighborKeys["10.0.0.2"]))
+	assert.True(n.Transport.Config.PassiveMode)
+
+	// without the keys, zero values are taken as unset.
+	n = b.Neighbors[0]
+	assert.Nil(OverwriteNeighborConfigWithPeerGroup(&n, pg, nil))
+	assert.True(n.Transport.Config.PassiveMode)
+
+	// the keys aren't shared with another configuration.
+	other := readConfig(t, `
+[global.config]
+  as = 1
+  router-id = "1.1.1.1"
+`)
+	assert.Empty(other.NeighborKeys)
+	assert.True(b.NeighborKeys["10.0.0.1"]["transport.config.passive-mode"])
+
+	n.Config.PeerGroup = "h"
+	assert.NotNil(OverwriteNeighborConfigWithPeerGroup(&n, pg, nil))
+}
+
+func TestOverwriteNeighborAfiSafisWithPeerGroup(t *testing.T) {
+	assert := assert.New(t)
+	b := readConfig(t, `
+[global.config]
+  as = 1
+  router-id = "1.1.1.1"
+
+[[peer-groups]]
+  [peer-groups.config]
+    peer-group-name = "g"
+    peer-as = 2
+  [[peer-groups.afi-safis]]
+    [peer-groups.afi-safis.config]
+      afi-safi-name = "ipv4-unicast"
+      enabled = true
+    [peer-groups.afi-safis.add-paths.config]
+      receive = true
+      send-max = 4
+  [[peer-groups.afi-safis]]
+    [peer-groups.afi-safis.config]
+      afi-safi-name = "ipv6-unicast"
+      enabled = true
+
+[[neighbors]]
+  [neighbors.config]
+    neighbor-address = "10.0.0.1"
+    peer-group = "g"
+  [[neighbors.afi-safis]]
+    [neighbors.afi-safis.config]
+      afi-safi-name = "ipv4-unicast"
+      enabled = true
+    [neighbors.afi-safis.add-paths.config]
+      send-max = 0
+`)
+	n := b.Neighbors[0]
+	assert.Nil(OverwriteNeighborConfigWithPeerGroup(&n, &b.PeerGroups[0], b.NeighborKeys["10.0.0.1"]))
+	assert.Len(n.AfiSafis, 2)
+	v4 := n.AfiSafis[0]
+	assert.Equal(AFI_SAFI_TYPE_IPV4_UNICAST, v4.Config.AfiSafiName)
+	assert.True(v4.AddPaths.Config.Receive)
+	assert.Equal(uint8(0), v4.AddPaths.Config.SendMax)
+	assert.Equal(AFI_SAFI_TYPE_IPV6_UNICAST, n.AfiSafis[1].Config.AfiSafiName)
+	// the peer group itself isn't changed.
+	assert.Len(b.PeerGroups[0].AfiSafis, 2)
+	assert.Equal(uint8(4), b.PeerGroups[0].AfiSafis[0].AddPaths.Config.SendMax)
+}
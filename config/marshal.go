@@ -0,0 +1,208 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v2"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// configToMap converts the configuration into the tree of the maps
+// keyed by the names in the configuration file. The states and the
+// zero values are omitted. The false values are kept only in the
+// containers having other values because some of them mean that the
+// defaults aren't applied (e.g. afi-safi.config.enabled).
+func configToMap(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Struct:
+		m := make(map[string]interface{})
+		falses := make([]string, 0)
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			key := t.Field(i).Tag.Get("mapstructure")
+			if key == "" || key == "-" || key == "state" {
+				continue
+			}
+			f := v.Field(i)
+			if f.Kind() == reflect.Bool && !f.Bool() {
+				falses = append(falses, key)
+			} else if x := configToMap(f); x != nil {
+				m[key] = x
+			}
+		}
+		if len(m) == 0 {
+			return nil
+		}
+		for _, key := range falses {
+			m[key] = false
+		}
+		return m
+	case reflect.Slice:
+		if v.Len() == 0 {
+			return nil
+		}
+		if v.Type().Elem().Kind() == reflect.Struct {
+			l := make([]map[string]interface{}, 0, v.Len())
+			for i := 0; i < v.Len(); i++ {
+				m, _ := configToMap(v.Index(i)).(map[string]interface{})
+				if m == nil {
+					m = make(map[string]interface{})
+				}
+				l = append(l, m)
+			}
+			return l
+		}
+		l := make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			if x := configToMap(v.Index(i)); x != nil {
+				l = append(l, x)
+			}
+		}
+		return l
+	case reflect.String:
+		if v.String() != "" {
+			return v.String()
+		}
+	case reflect.Bool:
+		if v.Bool() {
+			return true
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Int() != 0 {
+			return v.Int()
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.Uint() != 0 {
+			return v.Uint()
+		}
+	case reflect.Float32, reflect.Float64:
+		if v.Float() != 0 {
+			return v.Float()
+		}
+	}
+	return nil
+}
+
+// zeroValue returns the zero value of v in the form of configToMap.
+func zeroValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Slice:
+		return []interface{}{}
+	case reflect.String:
+		return ""
+	case reflect.Bool:
+		return false
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int64(0)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return uint64(0)
+	case reflect.Float32, reflect.Float64:
+		return float64(0)
+	}
+	return nil
+}
+
+// addKeyValue adds the value of the key to m converted from v by
+// configToMap if it's omitted for the zero value. The key is split into
+// path.
+func addKeyValue(m map[string]interface{}, v reflect.Value, path []string) {
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		if t.Field(i).Tag.Get("mapstructure") != path[0] {
+			continue
+		}
+		f := v.Field(i)
+		switch {
+		case f.Kind() == reflect.Struct:
+			if len(path) == 1 {
+				return
+			}
+			x, _ := m[path[0]].(map[string]interface{})
+			if x == nil {
+				x = make(map[string]interface{})
+				m[path[0]] = x
+			}
+			addKeyValue(x, f, path[1:])
+		case f.Kind() == reflect.Slice && f.Type().Elem().Kind() == reflect.Struct && len(path) > 1:
+			l, _ := m[path[0]].([]map[string]interface{})
+			idx, err := strconv.Atoi(path[1])
+			if err != nil || idx >= len(l) || idx >= f.Len() || len(path) == 2 {
+				return
+			}
+			addKeyValue(l[idx], f.Index(idx), path[2:])
+		case len(path) == 1:
+			if _, ok := m[path[0]]; !ok {
+				m[path[0]] = zeroValue(f)
+			}
+		}
+		return
+	}
+}
+
+// removeFalses removes the false values from m unless the keys are
+// given. The maps left empty are removed too.
+func removeFalses(m map[string]interface{}, prefix string, keys map[string]bool) {
+	for k, x := range m {
+		key := prefix + k
+		switch x := x.(type) {
+		case bool:
+			if !x && !keys[key] {
+				delete(m, k)
+			}
+		case map[string]interface{}:
+			removeFalses(x, key+".", keys)
+			if len(x) == 0 {
+				delete(m, k)
+			}
+		case []map[string]interface{}:
+			for i, e := range x {
+				removeFalses(e, fmt.Sprintf("%s.%d.", key, i), keys)
+			}
+		}
+	}
+}
+
+// MarshalConfig encodes the configuration in the format of the
+// configuration file, "toml", "yaml" or "json". The zero values given
+// explicitly to the neighbors are kept (see BgpConfigSet.NeighborKeys).
+func MarshalConfig(c *BgpConfigSet, format string) ([]byte, error) {
+	m, _ := configToMap(reflect.ValueOf(*c)).(map[string]interface{})
+	if m == nil {
+		m = make(map[string]interface{})
+	}
+	if l, ok := m["neighbors"].([]map[string]interface{}); ok {
+		for i, n := range c.Neighbors {
+			if n.Config.PeerGroup == "" {
+				continue
+			}
+			// the zero values of the neighbors belonging to a peer
+			// group are taken from the peer group unless given.
+			keys := c.NeighborKeys[n.Config.NeighborAddress]
+			removeFalses(l[i], "", keys)
+			for key := range keys {
+				addKeyValue(l[i], reflect.ValueOf(n), strings.Split(key, "."))
+			}
+		}
+	}
+	switch format {
+	case "toml":
+		var buf bytes.Buffer
+		if err := toml.NewEncoder(&buf).Encode(m); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case "yaml", "yml":
+		return yaml.Marshal(m)
+	case "json":
+		b, err := json.MarshalIndent(m, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(b, '\n'), nil
+	}
+	return nil, fmt.Errorf("unsupported format: %s", format)
+}
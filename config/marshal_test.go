@@ -0,0 +1,160 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+const marshalTestConfig = `
+[global.config]
+  as = 65001
+  router-id = "10.0.0.1"
+  [global.apply-policy.config]
+    export-policy-list = ["p1"]
+    default-export-policy = "reject-route"
+
+[[neighbors]]
+  [neighbors.config]
+    neighbor-address = "10.0.0.2"
+    peer-as = 65002
+  [[neighbors.afi-safis]]
+    [neighbors.afi-safis.config]
+      afi-safi-name = "ipv4-unicast"
+      enabled = true
+  [[neighbors.afi-safis]]
+    [neighbors.afi-safis.config]
+      afi-safi-name = "ipv6-unicast"
+      enabled = false
+
+[[neighbors]]
+  [neighbors.config]
+    neighbor-address = "10.0.0.3"
+    peer-group = "pg1"
+  [neighbors.transport.config]
+    passive-mode = false
+
+[[peer-groups]]
+  [peer-groups.config]
+    peer-group-name = "pg1"
+    peer-as = 65003
+  [peer-groups.transport.config]
+    passive-mode = true
+
+[[dynamic-neighbors]]
+  [dynamic-neighbors.config]
+    prefix = "20.0.0.0/24"
+    peer-group = "pg1"
+
+[[rpki-servers]]
+  [rpki-servers.config]
+    address = "10.0.0.4"
+
+[[bmp-servers]]
+  [bmp-servers.config]
+    address = "10.0.0.5"
+    route-monitoring-policy = "both"
+
+[[mrt-dump]]
+  [mrt-dump.config]
+    dump-type = "updates"
+    file-name = "/tmp/log/20060102.1504.dump"
+    rotation-interval = 180
+
+[zebra.config]
+  enabled = true
+  version = 3
+  redistribute-route-type-list = ["connect"]
+
+[[vrfs]]
+  [vrfs.config]
+    name = "red"
+    id = 1
+    rd = "100:100"
+    import-rt-list = ["100:100"]
+    export-rt-list = ["100:100"]
+
+[[defined-sets.prefix-sets]]
+  prefix-set-name = "ps1"
+  [[defined-sets.prefix-sets.prefix-list]]
+    ip-prefix = "10.0.0.0/8"
+    masklength-range = "8..32"
+
+[[defined-sets.bgp-defined-sets.community-sets]]
+  community-set-name = "cs1"
+  community-list = ["65100:10"]
+
+[[policy-definitions]]
+  name = "p1"
+  [[policy-definitions.statements]]
+    [policy-definitions.statements.conditions.match-prefix-set]
+      prefix-set = "ps1"
+    [policy-definitions.statements.actions]
+      route-disposition = "accept-route"
+      [policy-definitions.statements.actions.bgp-actions]
+        set-med = "+100"
+  [[policy-definitions.statements]]
+    [policy-definitions.statements.conditions.bgp-conditions]
+      [policy-definitions.statements.conditions.bgp-conditions.match-community-set]
+        community-set = "cs1"
+`
+
+func readConfigForTest(b []byte, format string) (*BgpConfigSet, error) {
+	c := &BgpConfigSet{}
+	v := viper.New()
+	v.SetConfigType(format)
+	if err := v.ReadConfig(bytes.NewBuffer(b)); err != nil {
+		return nil, err
+	}
+	if err := v.UnmarshalExact(c); err != nil {
+		return nil, err
+	}
+	if err := setDefaultConfigValuesWithViper(v, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func TestMarshalConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	c, err := readConfigForTest([]byte(marshalTestConfig), "toml")
+	assert.Nil(err)
+	assert.True(c.Neighbors[0].AfiSafis[0].Config.Enabled)
+	assert.False(c.Neighbors[0].AfiSafis[1].Config.Enabled)
+
+	for _, format := range []string{"toml", "yaml", "json"} {
+		b, err := MarshalConfig(c, format)
+		assert.Nil(err)
+		x, err := readConfigForTest(b, format)
+		assert.Nil(err, format)
+		if assert.NotNil(x, format) {
+			assert.Equal(UpdateConfig(c, x), UpdateConfig(c, c), format)
+			assert.Equal(c.NeighborKeys, x.NeighborKeys, format)
+			assert.True(x.NeighborKeys["10.0.0.3"]["transport.config.passive-mode"], format)
+			y, err := MarshalConfig(x, format)
+			assert.Nil(err)
+			assert.Equal(b, y, format)
+		}
+	}
+
+	_, err = MarshalConfig(c, "xml")
+	assert.NotNil(err)
+}
@@ -12,13 +12,97 @@ type BgpConfigSet struct {
 	Global            Global             `mapstructure:"global"`
 	Neighbors         []Neighbor         `mapstructure:"neighbors"`
 	PeerGroups        []PeerGroup        `mapstructure:"peer-groups"`
+	DynamicNeighbors  []DynamicNeighbor  `mapstructure:"dynamic-neighbors"`
 	RpkiServers       []RpkiServer       `mapstructure:"rpki-servers"`
 	BmpServers        []BmpServer        `mapstructure:"bmp-servers"`
 	MrtDump           []Mrt              `mapstructure:"mrt-dump"`
 	Zebra             Zebra              `mapstructure:"zebra"`
 	Collector         Collector          `mapstructure:"collector"`
+	Vrfs              []Vrf              `mapstructure:"vrfs"`
+	Aggregates        []Aggregate        `mapstructure:"aggregates"`
+	HealthChecks      []HealthCheck      `mapstructure:"health-checks"`
 	DefinedSets       DefinedSets        `mapstructure:"defined-sets"`
 	PolicyDefinitions []PolicyDefinition `mapstructure:"policy-definitions"`
+	// NeighborKeys holds the keys given to the neighbors belonging to a
+	// peer group in the configuration file, by neighbor address. The
+	// settings of these keys aren't overwritten with the ones of the peer
+	// group even if the value is zero.
+	NeighborKeys map[string]map[string]bool `mapstructure:"-"`
+}
+
+type NeighborChanges struct {
+	Added   []Neighbor
+	Deleted []Neighbor
+	Updated []Neighbor
+}
+
+type PeerGroupChanges struct {
+	Added   []PeerGroup
+	Deleted []PeerGroup
+	Updated []PeerGroup
+}
+
+type DynamicNeighborChanges struct {
+	Added   []DynamicNeighbor
+	Deleted []DynamicNeighbor
+}
+
+type RpkiServerChanges struct {
+	Added   []RpkiServer
+	Deleted []RpkiServer
+	Updated []RpkiServer
+}
+
+type BmpServerChanges struct {
+	Added   []BmpServer
+	Deleted []BmpServer
+	Updated []BmpServer
+}
+
+type MrtChanges struct {
+	Added   []Mrt
+	Deleted []Mrt
+	Updated []Mrt
+}
+
+type VrfChanges struct {
+	Added   []Vrf
+	Deleted []Vrf
+	Updated []Vrf
+}
+
+type AggregateChanges struct {
+	Added   []Aggregate
+	Deleted []Aggregate
+	Updated []Aggregate
+}
+
+type HealthCheckChanges struct {
+	Added   []HealthCheck
+	Deleted []HealthCheck
+	Updated []HealthCheck
+}
+
+// BgpConfigSetChanges is the difference between two config sets for every
+// top-level section. The sections which aren't lists hold the new
+// configuration, or nil if they aren't changed.
+type BgpConfigSetChanges struct {
+	Global           *Global
+	Neighbors        NeighborChanges
+	PeerGroups       PeerGroupChanges
+	DynamicNeighbors DynamicNeighborChanges
+	RpkiServers      RpkiServerChanges
+	BmpServers       BmpServerChanges
+	MrtDump          MrtChanges
+	Zebra            *Zebra
+	Collector        *Collector
+	Vrfs             VrfChanges
+	Aggregates       AggregateChanges
+	HealthChecks     HealthCheckChanges
+	Policy           *RoutingPolicy
+	// the keys given to the added or updated neighbors, see
+	// BgpConfigSet.NeighborKeys.
+	NeighborKeys map[string]map[string]bool
 }
 
 func ReadConfigfileServe(path, format string, configCh chan *BgpConfigSet) {
@@ -83,6 +167,69 @@ func inSlice(n Neighbor, b []Neighbor) int {
 	return -1
 }
 
+func peerGroupInSlice(pg PeerGroup, b []PeerGroup) int {
+	for i, g := range b {
+		if g.Config.PeerGroupName == pg.Config.PeerGroupName {
+			return i
+		}
+	}
+	return -1
+}
+
+func rpkiServerInSlice(n RpkiServer, b []RpkiServer) int {
+	for i, r := range b {
+		if r.Config.Address == n.Config.Address {
+			return i
+		}
+	}
+	return -1
+}
+
+func bmpServerInSlice(n BmpServer, b []BmpServer) int {
+	for i, r := range b {
+		if r.Config.Address == n.Config.Address && r.Config.Port == n.Config.Port {
+			return i
+		}
+	}
+	return -1
+}
+
+func mrtInSlice(n Mrt, b []Mrt) int {
+	for i, m := range b {
+		if m.Config.FileName == n.Config.FileName {
+			return i
+		}
+	}
+	return -1
+}
+
+func vrfInSlice(n Vrf, b []Vrf) int {
+	for i, v := range b {
+		if v.Config.Name == n.Config.Name {
+			return i
+		}
+	}
+	return -1
+}
+
+func aggregateInSlice(n Aggregate, b []Aggregate) int {
+	for i, a := range b {
+		if a.Config.Prefix == n.Config.Prefix && a.Config.Vrf == n.Config.Vrf {
+			return i
+		}
+	}
+	return -1
+}
+
+func healthCheckInSlice(n HealthCheck, b []HealthCheck) int {
+	for i, h := range b {
+		if h.Config.Name == n.Config.Name {
+			return i
+		}
+	}
+	return -1
+}
+
 func ConfigSetToRoutingPolicy(c *BgpConfigSet) *RoutingPolicy {
 	return &RoutingPolicy{
 		DefinedSets:       c.DefinedSets,
@@ -90,16 +237,17 @@ func ConfigSetToRoutingPolicy(c *BgpConfigSet) *RoutingPolicy {
 	}
 }
 
-func UpdateConfig(curC, newC *BgpConfigSet) ([]Neighbor, []Neighbor, []Neighbor, bool) {
+func updateNeighborConfig(curC, newC *BgpConfigSet) ([]Neighbor, []Neighbor, []Neighbor) {
 
 	added := []Neighbor{}
 	deleted := []Neighbor{}
 	updated := []Neighbor{}
 
 	for _, n := range newC.Neighbors {
+		addr := n.Config.NeighborAddress
 		if idx := inSlice(n, curC.Neighbors); idx < 0 {
 			added = append(added, n)
-		} else if !n.Equal(&curC.Neighbors[idx]) {
+		} else if !n.Equal(&curC.Neighbors[idx]) || !EqualKeys(curC.NeighborKeys[addr], newC.NeighborKeys[addr]) {
 			log.WithFields(log.Fields{
 				"Topic": "Config",
 			}).Debugf("Current neighbor config:%s", curC.Neighbors[idx])
@@ -116,7 +264,123 @@ func UpdateConfig(curC, newC *BgpConfigSet) ([]Neighbor, []Neighbor, []Neighbor,
 		}
 	}
 
-	return added, deleted, updated, CheckPolicyDifference(ConfigSetToRoutingPolicy(curC), ConfigSetToRoutingPolicy(newC))
+	return added, deleted, updated
+}
+
+// EqualKeys returns true if the same keys are given.
+func EqualKeys(a, b map[string]bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k := range a {
+		if !b[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// UpdateConfig returns the changes from curC to newC.
+func UpdateConfig(curC, newC *BgpConfigSet) *BgpConfigSetChanges {
+	c := &BgpConfigSetChanges{}
+
+	if !curC.Global.Equal(&newC.Global) {
+		c.Global = &newC.Global
+	}
+	c.Neighbors.Added, c.Neighbors.Deleted, c.Neighbors.Updated = updateNeighborConfig(curC, newC)
+	c.NeighborKeys = newC.NeighborKeys
+	c.PeerGroups.Added, c.PeerGroups.Deleted, c.PeerGroups.Updated = UpdatePeerGroupConfig(curC, newC)
+	c.DynamicNeighbors.Added, c.DynamicNeighbors.Deleted = UpdateDynamicNeighborConfig(curC, newC)
+
+	for _, n := range newC.RpkiServers {
+		if idx := rpkiServerInSlice(n, curC.RpkiServers); idx < 0 {
+			c.RpkiServers.Added = append(c.RpkiServers.Added, n)
+		} else if !n.Equal(&curC.RpkiServers[idx]) {
+			c.RpkiServers.Updated = append(c.RpkiServers.Updated, n)
+		}
+	}
+	for _, n := range curC.RpkiServers {
+		if rpkiServerInSlice(n, newC.RpkiServers) < 0 {
+			c.RpkiServers.Deleted = append(c.RpkiServers.Deleted, n)
+		}
+	}
+
+	for _, n := range newC.BmpServers {
+		if idx := bmpServerInSlice(n, curC.BmpServers); idx < 0 {
+			c.BmpServers.Added = append(c.BmpServers.Added, n)
+		} else if !n.Equal(&curC.BmpServers[idx]) {
+			c.BmpServers.Updated = append(c.BmpServers.Updated, n)
+		}
+	}
+	for _, n := range curC.BmpServers {
+		if bmpServerInSlice(n, newC.BmpServers) < 0 {
+			c.BmpServers.Deleted = append(c.BmpServers.Deleted, n)
+		}
+	}
+
+	for _, n := range newC.MrtDump {
+		if idx := mrtInSlice(n, curC.MrtDump); idx < 0 {
+			c.MrtDump.Added = append(c.MrtDump.Added, n)
+		} else if !n.Equal(&curC.MrtDump[idx]) {
+			c.MrtDump.Updated = append(c.MrtDump.Updated, n)
+		}
+	}
+	for _, n := range curC.MrtDump {
+		if mrtInSlice(n, newC.MrtDump) < 0 {
+			c.MrtDump.Deleted = append(c.MrtDump.Deleted, n)
+		}
+	}
+
+	if !curC.Zebra.Equal(&newC.Zebra) {
+		c.Zebra = &newC.Zebra
+	}
+	if !curC.Collector.Equal(&newC.Collector) {
+		c.Collector = &newC.Collector
+	}
+
+	for _, n := range newC.Vrfs {
+		if idx := vrfInSlice(n, curC.Vrfs); idx < 0 {
+			c.Vrfs.Added = append(c.Vrfs.Added, n)
+		} else if !n.Equal(&curC.Vrfs[idx]) {
+			c.Vrfs.Updated = append(c.Vrfs.Updated, n)
+		}
+	}
+	for _, n := range curC.Vrfs {
+		if vrfInSlice(n, newC.Vrfs) < 0 {
+			c.Vrfs.Deleted = append(c.Vrfs.Deleted, n)
+		}
+	}
+
+	for _, n := range newC.Aggregates {
+		if idx := aggregateInSlice(n, curC.Aggregates); idx < 0 {
+			c.Aggregates.Added = append(c.Aggregates.Added, n)
+		} else if !n.Equal(&curC.Aggregates[idx]) {
+			c.Aggregates.Updated = append(c.Aggregates.Updated, n)
+		}
+	}
+	for _, n := range curC.Aggregates {
+		if aggregateInSlice(n, newC.Aggregates) < 0 {
+			c.Aggregates.Deleted = append(c.Aggregates.Deleted, n)
+		}
+	}
+
+	for _, n := range newC.HealthChecks {
+		if idx := healthCheckInSlice(n, curC.HealthChecks); idx < 0 {
+			c.HealthChecks.Added = append(c.HealthChecks.Added, n)
+		} else if !n.Equal(&curC.HealthChecks[idx]) {
+			c.HealthChecks.Updated = append(c.HealthChecks.Updated, n)
+		}
+	}
+	for _, n := range curC.HealthChecks {
+		if healthCheckInSlice(n, newC.HealthChecks) < 0 {
+			c.HealthChecks.Deleted = append(c.HealthChecks.Deleted, n)
+		}
+	}
+
+	if CheckPolicyDifference(ConfigSetToRoutingPolicy(curC), ConfigSetToRoutingPolicy(newC)) {
+		c.Policy = ConfigSetToRoutingPolicy(newC)
+	}
+	return c
 }
 
 func CheckPolicyDifference(currentPolicy *RoutingPolicy, newPolicy *RoutingPolicy) bool {
@@ -141,3 +405,55 @@ func CheckPolicyDifference(currentPolicy *RoutingPolicy, newPolicy *RoutingPolic
 	}
 	return result
 }
+
+func UpdatePeerGroupConfig(curC, newC *BgpConfigSet) ([]PeerGroup, []PeerGroup, []PeerGroup) {
+	added := []PeerGroup{}
+	deleted := []PeerGroup{}
+	updated := []PeerGroup{}
+
+	for _, pg := range newC.PeerGroups {
+		if idx := peerGroupInSlice(pg, curC.PeerGroups); idx < 0 {
+			added = append(added, pg)
+		} else if !pg.Equal(&curC.PeerGroups[idx]) {
+			log.WithFields(log.Fields{
+				"Topic": "Config",
+			}).Debugf("Current peer-group config:%v", curC.PeerGroups[idx])
+			log.WithFields(log.Fields{
+				"Topic": "Config",
+			}).Debugf("New peer-group config:%v", pg)
+			updated = append(updated, pg)
+		}
+	}
+
+	for _, pg := range curC.PeerGroups {
+		if peerGroupInSlice(pg, newC.PeerGroups) < 0 {
+			deleted = append(deleted, pg)
+		}
+	}
+	return added, deleted, updated
+}
+
+func UpdateDynamicNeighborConfig(curC, newC *BgpConfigSet) ([]DynamicNeighbor, []DynamicNeighbor) {
+	added := []DynamicNeighbor{}
+	deleted := []DynamicNeighbor{}
+
+	inSlice := func(n DynamicNeighbor, b []DynamicNeighbor) bool {
+		for _, d := range b {
+			if d.Equal(&n) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, n := range newC.DynamicNeighbors {
+		if !inSlice(n, curC.DynamicNeighbors) {
+			added = append(added, n)
+		}
+	}
+	for _, n := range curC.DynamicNeighbors {
+		if !inSlice(n, newC.DynamicNeighbors) {
+			deleted = append(deleted, n)
+		}
+	}
+	return added, deleted
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	cur := &BgpConfigSet{
+		Global: Global{Config: GlobalConfig{As: 1, RouterId: "1.1.1.1"}},
+		RpkiServers: []RpkiServer{
+			{Config: RpkiServerConfig{Address: "10.0.0.1", Port: 323}},
+			{Config: RpkiServerConfig{Address: "10.0.0.2", Port: 323}},
+		},
+		BmpServers: []BmpServer{
+			{Config: BmpServerConfig{Address: "10.0.0.1", Port: 11019}},
+		},
+		Vrfs: []Vrf{
+			{Config: VrfConfig{Name: "red", Id: 1, Rd: "100:100"}},
+		},
+		Aggregates: []Aggregate{
+			{Config: AggregateConfig{Prefix: "10.0.0.0/8"}},
+			{Config: AggregateConfig{Prefix: "10.0.0.0/8", Vrf: "red"}},
+		},
+		HealthChecks: []HealthCheck{
+			{
+				Config: HealthCheckConfig{Name: "web", Type: HEALTH_CHECK_TYPE_TCP, Address: "127.0.0.1:80"},
+				Routes: []HealthCheckRoute{{Config: HealthCheckRouteConfig{Prefix: "192.0.2.1/32"}}},
+			},
+		},
+	}
+	new := &BgpConfigSet{
+		Global: Global{Config: GlobalConfig{As: 2, RouterId: "1.1.1.1"}},
+		RpkiServers: []RpkiServer{
+			{Config: RpkiServerConfig{Address: "10.0.0.1", Port: 324}},
+		},
+		BmpServers: []BmpServer{
+			{Config: BmpServerConfig{Address: "10.0.0.1", Port: 11019}},
+			{Config: BmpServerConfig{Address: "10.0.0.1", Port: 11020}},
+		},
+		Vrfs: []Vrf{
+			{Config: VrfConfig{Name: "red", Id: 1, Rd: "100:100"}},
+		},
+		Aggregates: []Aggregate{
+			{Config: AggregateConfig{Prefix: "10.0.0.0/8", SummaryOnly: true}},
+			{Config: AggregateConfig{Prefix: "20.0.0.0/8"}},
+		},
+		HealthChecks: []HealthCheck{
+			{
+				Config: HealthCheckConfig{Name: "web", Type: HEALTH_CHECK_TYPE_TCP, Address: "127.0.0.1:80"},
+				Routes: []HealthCheckRoute{{Config: HealthCheckRouteConfig{Prefix: "192.0.2.1/32", DegradedMed: 100}}},
+			},
+		},
+		Zebra: Zebra{Config: ZebraConfig{Enabled: true, Url: "unix:/var/run/quagga/zserv.api"}},
+	}
+
+	c := UpdateConfig(cur, new)
+	assert.NotNil(c.Global)
+	assert.Equal(uint32(2), c.Global.Config.As)
+
+	assert.Len(c.RpkiServers.Added, 0)
+	assert.Len(c.RpkiServers.Updated, 1)
+	assert.Len(c.RpkiServers.Deleted, 1)
+	assert.Equal("10.0.0.2", c.RpkiServers.Deleted[0].Config.Address)
+
+	assert.Len(c.BmpServers.Added, 1)
+	assert.Equal(uint32(11020), c.BmpServers.Added[0].Config.Port)
+	assert.Len(c.BmpServers.Updated, 0)
+	assert.Len(c.BmpServers.Deleted, 0)
+
+	assert.NotNil(c.Zebra)
+	assert.Nil(c.Collector)
+	assert.Len(c.Vrfs.Added, 0)
+	assert.Len(c.Vrfs.Updated, 0)
+	assert.Len(c.Vrfs.Deleted, 0)
+	assert.Len(c.Aggregates.Added, 1)
+	assert.Equal("20.0.0.0/8", c.Aggregates.Added[0].Config.Prefix)
+	assert.Len(c.Aggregates.Updated, 1)
+	assert.True(c.Aggregates.Updated[0].Config.SummaryOnly)
+	assert.Len(c.Aggregates.Deleted, 1)
+	assert.Equal("red", c.Aggregates.Deleted[0].Config.Vrf)
+	// the routes bound to the health check are compared too.
+	assert.Len(c.HealthChecks.Updated, 1)
+	assert.Len(c.HealthChecks.Added, 0)
+	assert.Len(c.HealthChecks.Deleted, 0)
+	assert.Nil(c.Policy)
+
+	// nothing changes when the same config is loaded again.
+	c = UpdateConfig(new, new)
+	assert.Nil(c.Global)
+	assert.Nil(c.Zebra)
+	assert.Len(c.RpkiServers.Updated, 0)
+	assert.Len(c.BmpServers.Added, 0)
+	assert.Len(c.Aggregates.Updated, 0)
+	assert.Len(c.HealthChecks.Updated, 0)
+}
@@ -41,7 +41,7 @@ func detectConfigFileType(path, def string) string {
 }
 
 func IsConfederationMember(g *Global, p *Neighbor) bool {
-	if p.Config.PeerAs != g.Config.As {
+	if g.Confederation.Config.Enabled && p.Config.PeerAs != g.Config.As {
 		for _, member := range g.Confederation.Config.MemberAsList {
 			if member == p.Config.PeerAs {
 				return true
@@ -87,6 +87,15 @@ func GetAfiSafi(p *Neighbor, family bgp.RouteFamily) *AfiSafi {
 	return nil
 }
 
+// IsAigpEnabled reports whether AIGP is enabled for the given family of
+// the neighbor.
+func IsAigpEnabled(p *Neighbor, family bgp.RouteFamily) bool {
+	if a := GetAfiSafi(p, family); a != nil {
+		return a.RouteSelectionOptions.Config.EnableAigp
+	}
+	return false
+}
+
 func CheckAfiSafisChange(x, y []AfiSafi) bool {
 	if len(x) != len(y) {
 		return true
@@ -140,3 +149,37 @@ func ParseMaskLength(prefix, mask string) (int, int, error) {
 	}
 	return min, max, nil
 }
+
+// ParseVrfConfig returns the route distinguisher and the import and export
+// route targets of the VRF.
+func ParseVrfConfig(c *VrfConfig) (bgp.RouteDistinguisherInterface, []bgp.ExtendedCommunityInterface, []bgp.ExtendedCommunityInterface, error) {
+	if c.Name == "" {
+		return nil, nil, nil, fmt.Errorf("vrf name is empty")
+	}
+	rd, err := bgp.ParseRouteDistinguisher(c.Rd)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid rd of vrf %s: %s", c.Name, c.Rd)
+	}
+	parse := func(list ...[]string) ([]bgp.ExtendedCommunityInterface, error) {
+		rts := make([]bgp.ExtendedCommunityInterface, 0)
+		for _, l := range list {
+			for _, s := range l {
+				rt, err := bgp.ParseRouteTarget(s)
+				if err != nil {
+					return nil, fmt.Errorf("invalid rt of vrf %s: %s", c.Name, s)
+				}
+				rts = append(rts, rt)
+			}
+		}
+		return rts, nil
+	}
+	im, err := parse(c.ImportRtList, c.BothRtList)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ex, err := parse(c.ExportRtList, c.BothRtList)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return rd, im, ex, nil
+}
@@ -24,9 +24,21 @@ import (
 	"strconv"
 )
 
+func parseBmpPolicyType(s string) (config.BmpRouteMonitoringPolicyType, error) {
+	switch s {
+	case "pre":
+		return config.BMP_ROUTE_MONITORING_POLICY_TYPE_PRE_POLICY, nil
+	case "post":
+		return config.BMP_ROUTE_MONITORING_POLICY_TYPE_POST_POLICY, nil
+	case "both":
+		return config.BMP_ROUTE_MONITORING_POLICY_TYPE_BOTH, nil
+	}
+	return "", fmt.Errorf("invalid bmp policy type. valid type is {pre|post|both}")
+}
+
 func modBmpServer(cmdType string, args []string) error {
 	if len(args) < 1 {
-		return fmt.Errorf("usage: gobgp bmp %s <addr>[:<port>] [{pre|post|both}]", cmdType)
+		return fmt.Errorf("usage: gobgp bmp %s <addr>[:<port>] [{pre|post|both}] [--loc-rib] [--adj-rib-out {pre|post|both}]", cmdType)
 	}
 
 	var address string
@@ -48,19 +60,22 @@ func modBmpServer(cmdType string, args []string) error {
 	case CMD_ADD:
 		policyType := config.BMP_ROUTE_MONITORING_POLICY_TYPE_PRE_POLICY
 		if len(args) > 1 {
-			switch args[1] {
-			case "post":
-				policyType = config.BMP_ROUTE_MONITORING_POLICY_TYPE_POST_POLICY
-			case "both":
-				policyType = config.BMP_ROUTE_MONITORING_POLICY_TYPE_BOTH
-			default:
-				return fmt.Errorf("invalid bmp policy type. valid type is {pre|post|both}")
+			if policyType, err = parseBmpPolicyType(args[1]); err != nil {
+				return err
+			}
+		}
+		var adjRibOut config.BmpRouteMonitoringPolicyType
+		if bmpOpts.AdjRibOut != "" {
+			if adjRibOut, err = parseBmpPolicyType(bmpOpts.AdjRibOut); err != nil {
+				return err
 			}
 		}
 		err = client.AddBMP(&config.BmpServerConfig{
-			Address: address,
-			Port:    port,
-			RouteMonitoringPolicy: policyType,
+			Address:                  address,
+			Port:                     port,
+			RouteMonitoringPolicy:    policyType,
+			RouteMonitoringLocRib:    bmpOpts.LocRib,
+			RouteMonitoringAdjRibOut: adjRibOut,
 		})
 	case CMD_DEL:
 		err = client.DeleteBMP(&config.BmpServerConfig{
@@ -87,6 +102,10 @@ func NewBmpCmd() *cobra.Command {
 				}
 			},
 		}
+		if w == CMD_ADD {
+			subcmd.Flags().BoolVarP(&bmpOpts.LocRib, "loc-rib", "", false, "send the Loc-RIB")
+			subcmd.Flags().StringVarP(&bmpOpts.AdjRibOut, "adj-rib-out", "", "", "send the Adj-RIB-Out (pre|post|both)")
+		}
 		bmpCmd.AddCommand(subcmd)
 	}
 
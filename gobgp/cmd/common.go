@@ -24,57 +24,66 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	cli "github.com/citizen-insane/gobgp/client"
 	"github.com/citizen-insane/gobgp/config"
 	"github.com/citizen-insane/gobgp/packet/bgp"
 	"github.com/citizen-insane/gobgp/table"
+	"google.golang.org/grpc"
 )
 
 const (
-	CMD_GLOBAL         = "global"
-	CMD_NEIGHBOR       = "neighbor"
-	CMD_POLICY         = "policy"
-	CMD_RIB            = "rib"
-	CMD_ADD            = "add"
-	CMD_DEL            = "del"
-	CMD_ALL            = "all"
-	CMD_SET            = "set"
-	CMD_LOCAL          = "local"
-	CMD_ADJ_IN         = "adj-in"
-	CMD_ADJ_OUT        = "adj-out"
-	CMD_RESET          = "reset"
-	CMD_SOFT_RESET     = "softreset"
-	CMD_SOFT_RESET_IN  = "softresetin"
-	CMD_SOFT_RESET_OUT = "softresetout"
-	CMD_SHUTDOWN       = "shutdown"
-	CMD_ENABLE         = "enable"
-	CMD_DISABLE        = "disable"
-	CMD_PREFIX         = "prefix"
-	CMD_ASPATH         = "as-path"
-	CMD_COMMUNITY      = "community"
-	CMD_EXTCOMMUNITY   = "ext-community"
-	CMD_IMPORT         = "import"
-	CMD_EXPORT         = "export"
-	CMD_IN             = "in"
-	CMD_MONITOR        = "monitor"
-	CMD_MRT            = "mrt"
-	CMD_DUMP           = "dump"
-	CMD_INJECT         = "inject"
-	CMD_RPKI           = "rpki"
-	CMD_RPKI_TABLE     = "table"
-	CMD_RPKI_SERVER    = "server"
-	CMD_VRF            = "vrf"
-	CMD_ACCEPTED       = "accepted"
-	CMD_REJECTED       = "rejected"
-	CMD_STATEMENT      = "statement"
-	CMD_CONDITION      = "condition"
-	CMD_ACTION         = "action"
-	CMD_UPDATE         = "update"
-	CMD_ROTATE         = "rotate"
-	CMD_BMP            = "bmp"
-	CMD_LARGECOMMUNITY = "large-community"
-	CMD_SUMMARY        = "summary"
+	CMD_GLOBAL           = "global"
+	CMD_NEIGHBOR         = "neighbor"
+	CMD_POLICY           = "policy"
+	CMD_RIB              = "rib"
+	CMD_ADD              = "add"
+	CMD_DEL              = "del"
+	CMD_ALL              = "all"
+	CMD_SET              = "set"
+	CMD_LOCAL            = "local"
+	CMD_ADJ_IN           = "adj-in"
+	CMD_ADJ_OUT          = "adj-out"
+	CMD_RESET            = "reset"
+	CMD_SOFT_RESET       = "softreset"
+	CMD_SOFT_RESET_IN    = "softresetin"
+	CMD_SOFT_RESET_OUT   = "softresetout"
+	CMD_SHUTDOWN         = "shutdown"
+	CMD_ENABLE           = "enable"
+	CMD_DISABLE          = "disable"
+	CMD_PREFIX           = "prefix"
+	CMD_ASPATH           = "as-path"
+	CMD_COMMUNITY        = "community"
+	CMD_EXTCOMMUNITY     = "ext-community"
+	CMD_IMPORT           = "import"
+	CMD_EXPORT           = "export"
+	CMD_IN               = "in"
+	CMD_MONITOR          = "monitor"
+	CMD_MRT              = "mrt"
+	CMD_DUMP             = "dump"
+	CMD_INJECT           = "inject"
+	CMD_RPKI             = "rpki"
+	CMD_RPKI_TABLE       = "table"
+	CMD_RPKI_SERVER      = "server"
+	CMD_VRF              = "vrf"
+	CMD_ACCEPTED         = "accepted"
+	CMD_REJECTED         = "rejected"
+	CMD_STATEMENT        = "statement"
+	CMD_CONDITION        = "condition"
+	CMD_ACTION           = "action"
+	CMD_UPDATE           = "update"
+	CMD_ROTATE           = "rotate"
+	CMD_BMP              = "bmp"
+	CMD_LARGECOMMUNITY   = "large-community"
+	CMD_SUMMARY          = "summary"
+	CMD_PEER_GROUP       = "peer-group"
+	CMD_DYNAMIC_NEIGHBOR = "dynamic-neighbor"
+	CMD_DAMPENED         = "dampened"
+	CMD_CONFIG           = "config"
+	CMD_SHOW             = "show"
+	CMD_EVENTS           = "events"
+	CMD_HEALTH_CHECK     = "health-check"
 )
 
 var subOpts struct {
@@ -103,6 +112,11 @@ var actionOpts struct {
 	NexthopAction       string `long:"next-hop" description:"specifying a next-hop action of policy"`
 }
 
+var bmpOpts struct {
+	LocRib    bool   `long:"loc-rib" description:"send the Loc-RIB"`
+	AdjRibOut string `long:"adj-rib-out" description:"send the Adj-RIB-Out (pre | post | both)"`
+}
+
 var mrtOpts struct {
 	OutputDir  string
 	FileFormat string
@@ -224,7 +238,23 @@ func (v vrfs) Less(i, j int) bool {
 
 func newClient() *cli.Client {
 	target := net.JoinHostPort(globalOpts.Host, strconv.Itoa(globalOpts.Port))
-	client, err := cli.New(target)
+	if strings.HasPrefix(globalOpts.Host, "unix://") {
+		target = globalOpts.Host
+	}
+	opts := []grpc.DialOption{grpc.WithTimeout(time.Second), grpc.WithBlock()}
+	if globalOpts.TLS {
+		opt, err := cli.WithTLS(globalOpts.CaFile, globalOpts.CertFile, globalOpts.KeyFile, globalOpts.ServerName)
+		if err != nil {
+			exitWithError(err)
+		}
+		opts = append(opts, opt)
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	if globalOpts.Token != "" {
+		opts = append(opts, cli.WithToken(globalOpts.Token, globalOpts.TLS))
+	}
+	client, err := cli.New(target, opts...)
 	if err != nil {
 		exitWithError(err)
 	}
@@ -0,0 +1,58 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var configOpts struct {
+	Format string
+}
+
+func showConfig() error {
+	format := configOpts.Format
+	if globalOpts.Json {
+		format = "json"
+	}
+	c, err := client.GetConfig(format)
+	if err != nil {
+		return err
+	}
+	fmt.Print(c)
+	return nil
+}
+
+func NewConfigCmd() *cobra.Command {
+	configCmd := &cobra.Command{
+		Use: CMD_CONFIG,
+	}
+
+	showCmd := &cobra.Command{
+		Use: CMD_SHOW,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := showConfig(); err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+	showCmd.PersistentFlags().StringVarP(&configOpts.Format, "format", "", "toml", "output format (toml|yaml|json)")
+
+	configCmd.AddCommand(showCmd)
+	return configCmd
+}
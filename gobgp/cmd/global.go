@@ -951,6 +951,12 @@ func showGlobalConfig(args []string) error {
 	if g.UseMultiplePaths.Config.Enabled {
 		fmt.Printf("Multipath: enabled")
 	}
+	if len(g.State.PendingRestartList) > 0 {
+		fmt.Println("Pending restart:")
+		for _, r := range g.State.PendingRestartList {
+			fmt.Println("   ", r)
+		}
+	}
 	return nil
 }
 
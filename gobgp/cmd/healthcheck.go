@@ -0,0 +1,139 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/spf13/cobra"
+)
+
+func healthCheckTarget(h *config.HealthCheck) string {
+	switch h.Config.Type {
+	case config.HEALTH_CHECK_TYPE_HTTP:
+		return h.Config.Url
+	case config.HEALTH_CHECK_TYPE_EXEC:
+		return h.Config.Command
+	}
+	return h.Config.Address
+}
+
+func healthCheckStateString(h *config.HealthCheck) (string, string) {
+	state := "Down"
+	if h.State.Up {
+		state = "Up"
+	}
+	since := "never"
+	if h.State.LastChange != 0 {
+		since = formatTimedelta(int64(time.Now().Sub(time.Unix(h.State.LastChange, 0)).Seconds()))
+	}
+	return state, since
+}
+
+func showHealthCheck(args []string) error {
+	checks, err := client.GetHealthCheck()
+	if err != nil {
+		return err
+	}
+	if len(args) > 0 {
+		l := make([]*config.HealthCheck, 0, 1)
+		for _, h := range checks {
+			if h.Config.Name == args[0] {
+				l = append(l, h)
+			}
+		}
+		if len(l) == 0 {
+			return fmt.Errorf("health check %s not found", args[0])
+		}
+		checks = l
+	}
+	if globalOpts.Json {
+		j, _ := json.Marshal(checks)
+		fmt.Println(string(j))
+		return nil
+	}
+	if globalOpts.Quiet {
+		for _, h := range checks {
+			fmt.Println(h.Config.Name)
+		}
+		return nil
+	}
+
+	if len(args) == 0 {
+		format := "%-16s %-5s %-5s %-10s %-7s %s\n"
+		fmt.Printf(format, "Name", "Type", "State", "Since", "#Routes", "Target")
+		for _, h := range checks {
+			state, since := healthCheckStateString(h)
+			fmt.Printf(format, h.Config.Name, h.Config.Type, state, since, fmt.Sprint(len(h.Routes)), healthCheckTarget(h))
+		}
+		return nil
+	}
+
+	h := checks[0]
+	state, since := healthCheckStateString(h)
+	fmt.Printf("Health check: %s, State: %s, Since: %s\n", h.Config.Name, state, since)
+	fmt.Printf("  Type: %s, Target: %s", h.Config.Type, healthCheckTarget(h))
+	if h.Config.Type == config.HEALTH_CHECK_TYPE_HTTP {
+		fmt.Printf(", Expected status: %d", h.Config.ExpectedStatus)
+	}
+	fmt.Print("\n")
+	fmt.Printf("  Interval: %ds, Timeout: %ds, Rise: %d, Fall: %d\n", h.Config.Interval, h.Config.Timeout, h.Config.Rise, h.Config.Fall)
+	fmt.Printf("  Consecutive successes: %d, failures: %d\n", h.State.Successes, h.State.Failures)
+	if h.State.LastError != "" {
+		fmt.Println("  Last error:", h.State.LastError)
+	}
+	if len(h.Routes) > 0 {
+		fmt.Println("  Routes:")
+		for _, r := range h.Routes {
+			fmt.Printf("    %s", r.Config.Prefix)
+			if r.Config.NextHop != "" {
+				fmt.Printf(", next-hop %s", r.Config.NextHop)
+			}
+			if r.Config.Med != 0 {
+				fmt.Printf(", med %d", r.Config.Med)
+			}
+			if len(r.Config.CommunityList) > 0 {
+				fmt.Printf(", community %s", strings.Join(r.Config.CommunityList, " "))
+			}
+			if r.Config.DegradedMed != 0 {
+				fmt.Printf(", degraded med %d", r.Config.DegradedMed)
+			}
+			if len(r.Config.DegradedCommunityList) > 0 {
+				fmt.Printf(", degraded community %s", strings.Join(r.Config.DegradedCommunityList, " "))
+			}
+			fmt.Print("\n")
+		}
+	}
+	return nil
+}
+
+func NewHealthCheckCmd() *cobra.Command {
+	return &cobra.Command{
+		Use: CMD_HEALTH_CHECK,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) > 1 {
+				exitWithError(fmt.Errorf("usage: gobgp health-check [<name>]"))
+			}
+			if err := showHealthCheck(args); err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"net"
 
 	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/citizen-insane/gobgp/server"
 	"github.com/citizen-insane/gobgp/table"
 	"github.com/spf13/cobra"
 )
@@ -124,12 +125,66 @@ func NewMonitorCmd() *cobra.Command {
 	adjInCmd.PersistentFlags().StringVarP(&subOpts.AddressFamily, "address-family", "a", "", "address family")
 	adjInCmd.PersistentFlags().BoolVarP(&current, "current", "", false, "dump current contents")
 
+	var eventTypes []string
+	filter := &server.EventFilter{}
+	eventsCmd := &cobra.Command{
+		Use: CMD_EVENTS,
+		Run: func(cmd *cobra.Command, args []string) {
+			family, err := checkAddressFamily(bgp.RouteFamily(0))
+			if err != nil {
+				exitWithError(err)
+			}
+			if family != 0 {
+				filter.Families = []bgp.RouteFamily{family}
+			}
+			for _, t := range eventTypes {
+				filter.Types = append(filter.Types, server.EventType(t))
+			}
+			stream, err := client.MonitorEvents(filter, current)
+			if err != nil {
+				exitWithError(err)
+			}
+			for {
+				e, err := stream.Recv()
+				if err == io.EOF {
+					break
+				} else if err != nil {
+					exitWithError(err)
+				}
+				if globalOpts.Json {
+					j, _ := json.Marshal(e)
+					fmt.Println(string(j))
+					continue
+				}
+				switch e.Type {
+				case server.EVENT_TYPE_PEER_STATE:
+					fmt.Printf("[%d] %s %s fsm: %s admin: %s\n", e.Sequence, e.Type, e.Neighbor, e.State, e.AdminState)
+				case server.EVENT_TYPE_GAP:
+					fmt.Printf("[%d] %s %d events dropped\n", e.Sequence, e.Type, e.Dropped)
+				default:
+					action := "update"
+					if e.Withdrawal {
+						action = "withdraw"
+					}
+					fmt.Printf("[%d] %s %s %s from %s nexthop: %s as-path: [%s]\n", e.Sequence, e.Type, action, e.Prefix, e.Neighbor, e.Nexthop, e.AsPath)
+				}
+			}
+		},
+	}
+	eventsCmd.PersistentFlags().StringVarP(&subOpts.AddressFamily, "address-family", "a", "", "address family")
+	eventsCmd.PersistentFlags().BoolVarP(&current, "current", "", false, "dump current contents")
+	eventsCmd.PersistentFlags().StringSliceVarP(&eventTypes, "type", "", nil, "event types (pre-update, post-update, best-path, peer-state)")
+	eventsCmd.PersistentFlags().StringSliceVarP(&filter.Neighbors, "neighbor", "", nil, "neighbor addresses")
+	eventsCmd.PersistentFlags().StringVarP(&filter.PrefixSet, "prefix-set", "", "", "prefix set name")
+	eventsCmd.PersistentFlags().StringSliceVarP(&filter.Communities, "community", "", nil, "communities")
+
 	monitorCmd := &cobra.Command{
 		Use: CMD_MONITOR,
 	}
 	monitorCmd.AddCommand(globalCmd)
 	monitorCmd.AddCommand(neighborCmd)
 	monitorCmd.AddCommand(adjInCmd)
+	monitorCmd.AddCommand(eventsCmd)
 
 	return monitorCmd
 }
@@ -154,6 +154,10 @@ func showNeighbor(args []string) error {
 
 	fmt.Printf("BGP neighbor is %s, remote AS %d", p.Config.NeighborAddress, p.Config.PeerAs)
 
+	if p.Config.PeerType == config.PEER_TYPE_CONFED_EXTERNAL {
+		fmt.Printf(", confed-external")
+	}
+
 	if p.RouteReflector.Config.RouteReflectorClient {
 		fmt.Printf(", route-reflector-client\n")
 	} else if p.RouteServer.Config.RouteServerClient {
@@ -169,6 +173,11 @@ func showNeighbor(args []string) error {
 	fmt.Printf("  BGP version 4, remote router ID %s\n", id)
 	fmt.Printf("  BGP state = %s, up for %s\n", p.State.SessionState, formatTimedelta(int64(p.Timers.State.Uptime)-time.Now().Unix()))
 	fmt.Printf("  BGP OutQ = %d, Flops = %d\n", p.State.Queues.Output, p.State.Flops)
+	if t := p.Transport.State; t.MinTtl > 0 {
+		fmt.Printf("  TTL security is enabled, TTL = %d, minimum TTL = %d\n", t.Ttl, t.MinTtl)
+	} else if t.Ttl > 0 {
+		fmt.Printf("  TTL = %d\n", t.Ttl)
+	}
 	fmt.Printf("  Hold time is %d, keepalive interval is %d seconds\n", int(p.Timers.State.NegotiatedHoldTime), int(p.Timers.State.KeepaliveInterval))
 	fmt.Printf("  Configured hold time is %d, keepalive interval is %d seconds\n", int(p.Timers.Config.HoldTime), int(p.Timers.Config.KeepaliveInterval))
 
@@ -310,6 +319,29 @@ func showNeighbor(args []string) error {
 	fmt.Printf("    Advertised:    %10d\n", p.State.AdjTable.Advertised)
 	fmt.Printf("    Received:      %10d\n", p.State.AdjTable.Received)
 	fmt.Printf("    Accepted:      %10d\n", p.State.AdjTable.Accepted)
+	if e := p.ErrorHandling; e.Config.TreatAsWithdraw || e.State.ErroneousUpdateMessages > 0 {
+		fmt.Print("  Error handling statistics:\n")
+		fmt.Printf("    Erroneous:     %10d\n", e.State.ErroneousUpdateMessages)
+		fmt.Printf("    Session Reset: %10d\n", e.State.SessionResetCount)
+		fmt.Printf("    AFI/SAFI Disable: %7d\n", e.State.AfiSafiDisableCount)
+		fmt.Printf("    Withdrawn:     %10d\n", e.State.TreatAsWithdrawCount)
+		fmt.Printf("    Attr Discard:  %10d\n", e.State.AttributeDiscardCount)
+	}
+	if b := p.Bfd; b.Config.Enabled {
+		fmt.Print("  BFD:\n")
+		if b.Config.Multihop {
+			fmt.Printf("    Session state: %s (multihop)", b.State.SessionState)
+		} else {
+			fmt.Printf("    Session state: %s", b.State.SessionState)
+		}
+		if b.State.Uptime > 0 {
+			fmt.Printf(", up for %s", formatTimedelta(b.State.Uptime-time.Now().Unix()))
+		}
+		fmt.Print("\n")
+		fmt.Printf("    Diagnostic:    %s\n", b.State.Diagnostic)
+		fmt.Printf("    Discriminator: local %d, remote %d\n", b.State.LocalDiscriminator, b.State.RemoteDiscriminator)
+		fmt.Printf("    Detection time: %dms, up count %d\n", b.State.DetectionTime, b.State.UpCount)
+	}
 	first := true
 	for _, afisafi := range p.AfiSafis {
 		if afisafi.PrefixLimit.Config.MaxPrefixes > 0 {
@@ -325,6 +357,39 @@ func showNeighbor(args []string) error {
 			}
 		}
 	}
+	first = true
+	for _, afisafi := range p.AfiSafis {
+		if d := afisafi.DefaultOriginate; d.Config.Enabled {
+			if first {
+				fmt.Println("  Default Originate:")
+				first = false
+			}
+			fmt.Printf("    %s:\t", afisafi.Config.AfiSafiName)
+			if d.State.Originated {
+				fmt.Print("originated")
+			} else {
+				fmt.Print("not originated")
+			}
+			if d.Config.RoutePolicy != "" {
+				fmt.Printf(", route-policy %s", d.Config.RoutePolicy)
+			}
+			fmt.Print("\n")
+		}
+	}
+	if len(p.ConditionalAdvertisements) > 0 {
+		fmt.Println("  Conditional Advertisement:")
+		for _, c := range p.ConditionalAdvertisements {
+			condition := "exist-prefix-set " + c.Config.ExistPrefixSet
+			if c.Config.NonExistPrefixSet != "" {
+				condition = "non-exist-prefix-set " + c.Config.NonExistPrefixSet
+			}
+			state := "not met"
+			if c.State.ConditionMet {
+				state = "met"
+			}
+			fmt.Printf("    %s:\t%s, %s, %d tracked paths\n", c.Config.AdvertisePolicy, condition, state, c.State.TrackedPaths)
+		}
+	}
 	return nil
 }
 
@@ -500,6 +565,44 @@ func showRibInfo(r, name string) error {
 
 }
 
+func showNeighborDampened(r, name string, args []string) error {
+	family, err := checkAddressFamily(addr2AddressFamily(net.ParseIP(name)))
+	if err != nil {
+		return err
+	}
+	l, err := client.GetDampenedPath(name, family)
+	if err != nil {
+		return err
+	}
+
+	if globalOpts.Json {
+		j, _ := json.Marshal(l)
+		fmt.Println(string(j))
+		return nil
+	}
+	if len(l) == 0 {
+		fmt.Println("Network not in table")
+		return nil
+	}
+
+	maxPrefixLen := 20
+	maxNexthopLen := 20
+	for _, d := range l {
+		if n := len(d.Path.GetNlri().String()); maxPrefixLen < n {
+			maxPrefixLen = n
+		}
+		if n := len(d.Path.GetNexthop().String()); maxNexthopLen < n {
+			maxNexthopLen = n
+		}
+	}
+	format := fmt.Sprintf("%%-%ds %%-%ds %%-8s %%-6s %%-10s %%-s\n", maxPrefixLen, maxNexthopLen)
+	fmt.Printf(format, "Network", "Next Hop", "Penalty", "Flaps", "Reuse", "AS_PATH")
+	for _, d := range l {
+		fmt.Printf(format, d.Path.GetNlri(), d.Path.GetNexthop(), fmt.Sprint(d.Penalty), fmt.Sprint(d.Flaps), formatTimedelta(int64(d.ReuseTime.Seconds())), d.Path.GetAsString())
+	}
+	return nil
+}
+
 func parseCIDRorIP(str string) (net.IP, *net.IPNet, error) {
 	ip, n, err := net.ParseCIDR(str)
 	if err == nil {
@@ -771,13 +874,13 @@ func modNeighborPolicy(remoteIP, policyType, cmdType string, args []string) erro
 }
 
 func modNeighbor(cmdType string, args []string) error {
-	m := extractReserved(args, []string{"interface", "as", "vrf", "route-reflector-client", "route-server-client"})
+	m := extractReserved(args, []string{"interface", "as", "vrf", "route-reflector-client", "route-server-client", "peer-group", "remove-private-as", "allow-own-as", "treat-as-withdraw", "bfd", "ttl-security"})
 	usage := fmt.Sprintf("usage: gobgp neighbor %s [<neighbor-address>| interface <neighbor-interface>]", cmdType)
 	if cmdType == CMD_ADD {
-		usage += " as <VALUE> [ vrf <vrf-name> | route-reflector-client [<cluster-id>] | route-server-client ]"
+		usage += " [ as <VALUE> | peer-group <peer-group-name> ] [ vrf <vrf-name> | route-reflector-client [<cluster-id>] | route-server-client | remove-private-as { all | replace } | allow-own-as <VALUE> | treat-as-withdraw | bfd [multihop] | ttl-security ]"
 	}
 
-	if (len(m[""]) != 1 && len(m["interface"]) != 1) || len(m["as"]) > 1 || len(m["vrf"]) > 1 || len(m["route-reflector-client"]) > 1 {
+	if (len(m[""]) != 1 && len(m["interface"]) != 1) || len(m["as"]) > 1 || len(m["vrf"]) > 1 || len(m["route-reflector-client"]) > 1 || len(m["peer-group"]) > 1 || len(m["remove-private-as"]) > 1 || len(m["allow-own-as"]) > 1 || len(m["treat-as-withdraw"]) > 0 || len(m["bfd"]) > 1 || len(m["ttl-security"]) > 0 {
 		return fmt.Errorf("%s", usage)
 	}
 	unnumbered := len(m["interface"]) > 0
@@ -801,6 +904,9 @@ func modNeighbor(cmdType string, args []string) error {
 		if len(m["vrf"]) == 1 {
 			peer.Config.Vrf = m["vrf"][0]
 		}
+		if len(m["peer-group"]) == 1 {
+			peer.Config.PeerGroup = m["peer-group"][0]
+		}
 		if rr, ok := m["route-reflector-client"]; ok {
 			peer.RouteReflector.Config = config.RouteReflectorConfig{
 				RouteReflectorClient: true,
@@ -819,15 +925,44 @@ func modNeighbor(cmdType string, args []string) error {
 	var err error
 	switch cmdType {
 	case CMD_ADD:
-		if len(m["as"]) != 1 {
+		var as int
+		if len(m["as"]) == 1 {
+			as, err = strconv.Atoi(m["as"][0])
+			if err != nil {
+				return err
+			}
+		} else if len(m["peer-group"]) != 1 {
 			return fmt.Errorf("%s", usage)
 		}
-		var as int
-		as, err = strconv.Atoi(m["as"][0])
-		if err != nil {
-			return err
+		peer := getConf(as)
+		if len(m["remove-private-as"]) == 1 {
+			option := config.RemovePrivateAsOption(m["remove-private-as"][0])
+			if err := option.Validate(); err != nil {
+				return err
+			}
+			peer.Config.RemovePrivateAs = option
+		}
+		if _, ok := m["treat-as-withdraw"]; ok {
+			peer.ErrorHandling.Config.TreatAsWithdraw = true
+		}
+		if _, ok := m["ttl-security"]; ok {
+			peer.Transport.Config.TtlSecurity = true
+		}
+		if b, ok := m["bfd"]; ok {
+			if len(b) == 1 && b[0] != "multihop" {
+				return fmt.Errorf("%s", usage)
+			}
+			peer.Bfd.Config.Enabled = true
+			peer.Bfd.Config.Multihop = len(b) == 1
+		}
+		if len(m["allow-own-as"]) == 1 {
+			n, err := strconv.ParseUint(m["allow-own-as"][0], 10, 8)
+			if err != nil {
+				return err
+			}
+			peer.AsPathOptions.Config.AllowOwnAs = uint8(n)
 		}
-		err = client.AddNeighbor(getConf(as))
+		err = client.AddNeighbor(peer)
 	case CMD_DEL:
 		err = client.DeleteNeighbor(getConf(0))
 	}
@@ -843,10 +978,11 @@ func NewNeighborCmd() *cobra.Command {
 		f     func(string, string, []string) error
 	}
 
-	c := make([]cmds, 0, 3)
+	c := make([]cmds, 0, 4)
 	c = append(c, cmds{[]string{CMD_LOCAL, CMD_ADJ_IN, CMD_ADJ_OUT, CMD_ACCEPTED, CMD_REJECTED}, showNeighborRib})
 	c = append(c, cmds{[]string{CMD_RESET, CMD_SOFT_RESET, CMD_SOFT_RESET_IN, CMD_SOFT_RESET_OUT}, resetNeighbor})
 	c = append(c, cmds{[]string{CMD_SHUTDOWN, CMD_ENABLE, CMD_DISABLE}, stateChangeNeighbor})
+	c = append(c, cmds{[]string{CMD_DAMPENED}, showNeighborDampened})
 
 	for _, v := range c {
 		f := v.f
@@ -0,0 +1,235 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/spf13/cobra"
+	"net"
+	"strconv"
+	"strings"
+)
+
+func getPeerGroup(name string) (*config.PeerGroup, error) {
+	groups, err := client.GetPeerGroup()
+	if err != nil {
+		return nil, err
+	}
+	for _, pg := range groups {
+		if pg.Config.PeerGroupName == name {
+			return pg, nil
+		}
+	}
+	return nil, fmt.Errorf("peer-group %s not found", name)
+}
+
+func showPeerGroup(args []string) error {
+	groups, err := client.GetPeerGroup()
+	if err != nil {
+		return err
+	}
+	if len(args) > 0 {
+		pg, err := getPeerGroup(args[0])
+		if err != nil {
+			return err
+		}
+		groups = []*config.PeerGroup{pg}
+	}
+	if globalOpts.Json {
+		j, _ := json.Marshal(groups)
+		fmt.Println(string(j))
+		return nil
+	}
+	if globalOpts.Quiet {
+		for _, pg := range groups {
+			fmt.Println(pg.Config.PeerGroupName)
+		}
+		return nil
+	}
+
+	families := func(pg *config.PeerGroup) string {
+		l := make([]string, 0, len(pg.AfiSafis))
+		for _, a := range pg.AfiSafis {
+			l = append(l, string(a.Config.AfiSafiName))
+		}
+		return strings.Join(l, ", ")
+	}
+	if len(args) == 0 {
+		format := "%-16s %-10s %s\n"
+		fmt.Printf(format, "Name", "Peer AS", "Families")
+		for _, pg := range groups {
+			fmt.Printf(format, pg.Config.PeerGroupName, fmt.Sprint(pg.Config.PeerAs), families(pg))
+		}
+		return nil
+	}
+
+	pg := groups[0]
+	fmt.Printf("Peer group: %s, Peer AS: %d\n", pg.Config.PeerGroupName, pg.Config.PeerAs)
+	if pg.Config.LocalAs != 0 {
+		fmt.Printf("  Local AS: %d\n", pg.Config.LocalAs)
+	}
+	if pg.Config.Description != "" {
+		fmt.Printf("  Description: %s\n", pg.Config.Description)
+	}
+	if len(pg.AfiSafis) > 0 {
+		fmt.Printf("  Families: %s\n", families(pg))
+	}
+	fmt.Printf("  Hold time: %d, Keepalive interval: %d\n", int(pg.Timers.Config.HoldTime), int(pg.Timers.Config.KeepaliveInterval))
+	if pg.RouteReflector.Config.RouteReflectorClient {
+		fmt.Printf("  Route reflector client, Cluster ID: %s\n", pg.RouteReflector.Config.RouteReflectorClusterId)
+	}
+	if pg.RouteServer.Config.RouteServerClient {
+		fmt.Println("  Route server client")
+	}
+	if pg.Transport.Config.PassiveMode {
+		fmt.Println("  Passive mode")
+	}
+	if l := pg.ApplyPolicy.Config.ImportPolicyList; len(l) > 0 {
+		fmt.Printf("  Import policy: %s\n", strings.Join(l, ", "))
+	}
+	if l := pg.ApplyPolicy.Config.ExportPolicyList; len(l) > 0 {
+		fmt.Printf("  Export policy: %s\n", strings.Join(l, ", "))
+	}
+	return nil
+}
+
+func modPeerGroup(cmdType string, args []string) error {
+	m := extractReserved(args, []string{"as", "route-reflector-client", "route-server-client"})
+	usage := fmt.Sprintf("usage: gobgp peer-group %s <peer-group-name>", cmdType)
+	if cmdType != CMD_DEL {
+		usage += " [ as <VALUE> | route-reflector-client [<cluster-id>] | route-server-client ]"
+	}
+
+	if len(m[""]) != 1 || len(m["as"]) > 1 || len(m["route-reflector-client"]) > 1 {
+		return fmt.Errorf("%s", usage)
+	}
+
+	pg := &config.PeerGroup{
+		Config: config.PeerGroupConfig{
+			PeerGroupName: m[""][0],
+		},
+	}
+	if cmdType == CMD_UPDATE {
+		// only the given settings are changed.
+		var err error
+		if pg, err = getPeerGroup(m[""][0]); err != nil {
+			return err
+		}
+	}
+	if len(m["as"]) == 1 {
+		as, err := strconv.Atoi(m["as"][0])
+		if err != nil {
+			return err
+		}
+		pg.Config.PeerAs = uint32(as)
+	}
+	if rr, ok := m["route-reflector-client"]; ok {
+		pg.RouteReflector.Config = config.RouteReflectorConfig{
+			RouteReflectorClient: true,
+		}
+		if len(rr) == 1 {
+			pg.RouteReflector.Config.RouteReflectorClusterId = config.RrClusterIdType(rr[0])
+		}
+	}
+	if _, ok := m["route-server-client"]; ok {
+		pg.RouteServer.Config = config.RouteServerConfig{
+			RouteServerClient: true,
+		}
+	}
+
+	var err error
+	switch cmdType {
+	case CMD_ADD:
+		err = client.AddPeerGroup(pg)
+	case CMD_DEL:
+		err = client.DeletePeerGroup(pg)
+	case CMD_UPDATE:
+		_, err = client.UpdatePeerGroup(pg, true)
+	}
+	return err
+}
+
+func modDynamicNeighbor(cmdType string, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: gobgp peer-group %s %s <prefix> <peer-group-name>", CMD_DYNAMIC_NEIGHBOR, cmdType)
+	}
+	if _, _, err := net.ParseCIDR(args[0]); err != nil {
+		return err
+	}
+	d := &config.DynamicNeighbor{
+		Config: config.DynamicNeighborConfig{
+			Prefix:    args[0],
+			PeerGroup: args[1],
+		},
+	}
+	var err error
+	switch cmdType {
+	case CMD_ADD:
+		err = client.AddDynamicNeighbor(d)
+	case CMD_DEL:
+		err = client.DeleteDynamicNeighbor(d)
+	}
+	return err
+}
+
+func NewPeerGroupCmd() *cobra.Command {
+
+	peerGroupCmd := &cobra.Command{
+		Use: CMD_PEER_GROUP,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) > 1 {
+				exitWithError(fmt.Errorf("usage: gobgp peer-group [<peer-group-name>]"))
+			}
+			if err := showPeerGroup(args); err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	for _, w := range []string{CMD_ADD, CMD_DEL, CMD_UPDATE} {
+		subcmd := &cobra.Command{
+			Use: w,
+			Run: func(cmd *cobra.Command, args []string) {
+				err := modPeerGroup(cmd.Use, args)
+				if err != nil {
+					exitWithError(err)
+				}
+			},
+		}
+		peerGroupCmd.AddCommand(subcmd)
+	}
+
+	dynamicNeighborCmd := &cobra.Command{
+		Use: CMD_DYNAMIC_NEIGHBOR,
+	}
+	for _, w := range []string{CMD_ADD, CMD_DEL} {
+		subcmd := &cobra.Command{
+			Use: w,
+			Run: func(cmd *cobra.Command, args []string) {
+				err := modDynamicNeighbor(cmd.Use, args)
+				if err != nil {
+					exitWithError(err)
+				}
+			},
+		}
+		dynamicNeighborCmd.AddCommand(subcmd)
+	}
+	peerGroupCmd.AddCommand(dynamicNeighborCmd)
+
+	return peerGroupCmd
+}
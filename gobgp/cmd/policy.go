@@ -338,6 +338,18 @@ func printStatement(indent int, s *table.Statement) {
 			fmt.Printf("%sRPKI result: %s\n", ind, t.String())
 		case *table.RouteTypeCondition:
 			fmt.Printf("%sRoute Type: %s\n", ind, t.String())
+		case *table.MedCondition:
+			fmt.Printf("%sMED: %s\n", ind, t.String())
+		case *table.OriginCondition:
+			fmt.Printf("%sOrigin: %s\n", ind, t.String())
+		case *table.NextHopCondition:
+			fmt.Printf("%sNexthop: %s\n", ind, t.String())
+		case *table.AfiSafiInCondition:
+			fmt.Printf("%sAFI-SAFI: %s\n", ind, t.String())
+		case *table.LocalPrefCondition:
+			fmt.Printf("%sLocalPref: %s\n", ind, t.String())
+		case *table.CommunityCountCondition:
+			fmt.Printf("%sCommunityCount: %s\n", ind, t.String())
 		}
 	}
 
@@ -364,6 +376,10 @@ func printStatement(indent int, s *table.Statement) {
 			fmt.Println(ind, "ASPathPrepend: ", t.String())
 		case *table.NexthopAction:
 			fmt.Println(ind, "Nexthop: ", t.String())
+		case *table.AigpAction:
+			fmt.Println(ind, "AIGP: ", t.String())
+		case *table.OriginAction:
+			fmt.Println(ind, "Origin: ", t.String())
 		}
 	}
 
@@ -479,7 +495,7 @@ func modCondition(name, op string, args []string) error {
 	}
 	usage := fmt.Sprintf("usage: gobgp policy statement %s %s condition", name, op)
 	if len(args) < 1 {
-		return fmt.Errorf("%s { prefix | neighbor | as-path | community | ext-community | large-community | as-path-length | rpki | route-type }", usage)
+		return fmt.Errorf("%s { prefix | neighbor | as-path | community | ext-community | large-community | as-path-length | rpki | route-type | med | origin | next-hop | afi-safi-in | local-pref | community-count }", usage)
 	}
 	typ := args[0]
 	args = args[1:]
@@ -636,8 +652,68 @@ func modCondition(name, op string, args []string) error {
 		default:
 			return err
 		}
+	case "med":
+		if len(args) < 1 {
+			return fmt.Errorf("%s med <value>", usage)
+		}
+		value, err := strconv.ParseUint(args[0], 10, 32)
+		if err != nil {
+			return err
+		}
+		if value == 0 {
+			return fmt.Errorf("med condition with 0 isn't supported")
+		}
+		stmt.Conditions.BgpConditions.MedEq = uint32(value)
+	case "origin":
+		if len(args) < 1 {
+			return fmt.Errorf("%s origin { igp | egp | incomplete }", usage)
+		}
+		stmt.Conditions.BgpConditions.OriginEq = config.BgpOriginAttrType(strings.ToLower(args[0]))
+	case "next-hop":
+		if len(args) < 1 {
+			return fmt.Errorf("%s next-hop <address>...", usage)
+		}
+		stmt.Conditions.BgpConditions.NextHopInList = args
+	case "afi-safi-in":
+		if len(args) < 1 {
+			return fmt.Errorf("%s afi-safi-in <family>...", usage)
+		}
+		for _, a := range args {
+			stmt.Conditions.BgpConditions.AfiSafiInList = append(stmt.Conditions.BgpConditions.AfiSafiInList, config.AfiSafiType(a))
+		}
+	case "local-pref":
+		if len(args) < 1 {
+			return fmt.Errorf("%s local-pref <value>", usage)
+		}
+		value, err := strconv.ParseUint(args[0], 10, 32)
+		if err != nil {
+			return err
+		}
+		if value == 0 {
+			return fmt.Errorf("local-pref condition with 0 isn't supported")
+		}
+		stmt.Conditions.BgpConditions.LocalPrefEq = uint32(value)
+	case "community-count":
+		if len(args) < 2 {
+			return fmt.Errorf("%s community-count <count> { eq | ge | le }", usage)
+		}
+		count, err := strconv.ParseUint(args[0], 10, 32)
+		if err != nil {
+			return err
+		}
+		stmt.Conditions.BgpConditions.CommunityCount.Value = uint32(count)
+		switch strings.ToLower(args[1]) {
+		case "eq":
+			stmt.Conditions.BgpConditions.CommunityCount.Operator = config.ATTRIBUTE_COMPARISON_EQ
+		case "ge":
+			stmt.Conditions.BgpConditions.CommunityCount.Operator = config.ATTRIBUTE_COMPARISON_GE
+		case "le":
+			stmt.Conditions.BgpConditions.CommunityCount.Operator = config.ATTRIBUTE_COMPARISON_LE
+		default:
+			return fmt.Errorf("%s community-count <count> { eq | ge | le }", usage)
+		}
 	default:
-		return fmt.Errorf("%s { prefix | neighbor | as-path | community | ext-community | large-community | as-path-length | rpki | route-type }", usage)
+		return fmt.Errorf("%s { prefix | neighbor | as-path | community | ext-community | large-community | as-path-length | rpki | route-type | med | origin | next-hop | afi-safi-in | local-pref | community-count }", usage)
 	}
 
 	t, err := table.NewStatement(stmt)
@@ -663,7 +739,7 @@ func modAction(name, op string, args []string) error {
 	}
 	usage := fmt.Sprintf("usage: gobgp policy statement %s %s action", name, op)
 	if len(args) < 1 {
-		return fmt.Errorf("%s { reject | accept | community | ext-community | large-community | med | local-pref | as-prepend | next-hop }", usage)
+		return fmt.Errorf("%s { reject | accept | community | ext-community | large-community | med | local-pref | as-prepend | next-hop | aigp | origin }", usage)
 	}
 	typ := args[0]
 	args = args[1:]
@@ -759,6 +835,21 @@ func modAction(name, op string, args []string) error {
 			return fmt.Errorf("%s next-hop { <value> | self }", usage)
 		}
 		stmt.Actions.BgpActions.SetNextHop = config.BgpNextHopType(args[0])
+	case "aigp":
+		if len(args) != 1 {
+			return fmt.Errorf("%s aigp { <value> | remove }", usage)
+		}
+		if args[0] != "remove" {
+			if _, err := strconv.ParseUint(args[0], 10, 64); err != nil {
+				return err
+			}
+		}
+		stmt.Actions.BgpActions.SetAigp = args[0]
+	case "origin":
+		if len(args) != 1 {
+			return fmt.Errorf("%s origin { igp | egp | incomplete }", usage)
+		}
+		stmt.Actions.BgpActions.SetRouteOrigin = config.BgpOriginAttrType(strings.ToLower(args[0]))
 	}
 	t, err := table.NewStatement(stmt)
 	switch op {
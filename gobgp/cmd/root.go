@@ -33,6 +33,12 @@ var globalOpts struct {
 	GenCmpl      bool
 	BashCmplFile string
 	PprofPort    int
+	TLS          bool
+	CaFile       string
+	CertFile     string
+	KeyFile      string
+	ServerName   string
+	Token        string
 }
 
 var cmds []string
@@ -69,7 +75,7 @@ func NewRootCmd() *cobra.Command {
 		},
 	}
 
-	rootCmd.PersistentFlags().StringVarP(&globalOpts.Host, "host", "u", "127.0.0.1", "host")
+	rootCmd.PersistentFlags().StringVarP(&globalOpts.Host, "host", "u", "127.0.0.1", "host (or unix://path)")
 	rootCmd.PersistentFlags().IntVarP(&globalOpts.Port, "port", "p", 50051, "port")
 	rootCmd.PersistentFlags().BoolVarP(&globalOpts.Json, "json", "j", false, "use json format to output format")
 	rootCmd.PersistentFlags().BoolVarP(&globalOpts.Debug, "debug", "d", false, "use debug")
@@ -77,6 +83,12 @@ func NewRootCmd() *cobra.Command {
 	rootCmd.PersistentFlags().BoolVarP(&globalOpts.GenCmpl, "gen-cmpl", "c", false, "generate completion file")
 	rootCmd.PersistentFlags().StringVarP(&globalOpts.BashCmplFile, "bash-cmpl-file", "", "gobgp-completion.bash", "bash cmpl filename")
 	rootCmd.PersistentFlags().IntVarP(&globalOpts.PprofPort, "pprof-port", "r", 0, "pprof port")
+	rootCmd.PersistentFlags().BoolVarP(&globalOpts.TLS, "tls", "", false, "connect to gobgpd over TLS")
+	rootCmd.PersistentFlags().StringVarP(&globalOpts.CaFile, "tls-ca-file", "", "", "CA certificate file verifying gobgpd")
+	rootCmd.PersistentFlags().StringVarP(&globalOpts.CertFile, "tls-cert-file", "", "", "client certificate file")
+	rootCmd.PersistentFlags().StringVarP(&globalOpts.KeyFile, "tls-key-file", "", "", "client private key file")
+	rootCmd.PersistentFlags().StringVarP(&globalOpts.ServerName, "tls-server-name", "", "", "server name in the certificate of gobgpd")
+	rootCmd.PersistentFlags().StringVarP(&globalOpts.Token, "token", "", "", "bearer token for authentication")
 
 	globalCmd := NewGlobalCmd()
 	neighborCmd := NewNeighborCmd()
@@ -86,6 +98,9 @@ func NewRootCmd() *cobra.Command {
 	mrtCmd := NewMrtCmd()
 	rpkiCmd := NewRPKICmd()
 	bmpCmd := NewBmpCmd()
-	rootCmd.AddCommand(globalCmd, neighborCmd, vrfCmd, policyCmd, monitorCmd, mrtCmd, rpkiCmd, bmpCmd)
+	peerGroupCmd := NewPeerGroupCmd()
+	configCmd := NewConfigCmd()
+	healthCheckCmd := NewHealthCheckCmd()
+	rootCmd.AddCommand(globalCmd, neighborCmd, vrfCmd, policyCmd, monitorCmd, mrtCmd, rpkiCmd, bmpCmd, peerGroupCmd, configCmd, healthCheckCmd)
 	return rootCmd
 }
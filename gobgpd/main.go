@@ -21,15 +21,16 @@ import (
 	p "github.com/kr/pretty"
 	api "github.com/citizen-insane/gobgp/api"
 	"github.com/citizen-insane/gobgp/config"
-	"github.com/citizen-insane/gobgp/packet/bgp"
 	"github.com/citizen-insane/gobgp/server"
-	"github.com/citizen-insane/gobgp/table"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"io/ioutil"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
 	"os/signal"
 	"runtime"
+	"strconv"
 	"syscall"
 )
 
@@ -46,11 +47,18 @@ func main() {
 		Facility        string `long:"syslog-facility" description:"specify syslog facility"`
 		DisableStdlog   bool   `long:"disable-stdlog" description:"disable standard logging"`
 		CPUs            int    `long:"cpus" description:"specify the number of CPUs to be used"`
-		GrpcHosts       string `long:"api-hosts" description:"specify the hosts that gobgpd listens on" default:":50051"`
+		GrpcHosts       string `long:"api-hosts" description:"specify the hosts that gobgpd listens on (host:port or unix://path)" default:":50051"`
+		GrpcSocketMode  string `long:"api-socket-mode" description:"specify the file mode of the unix domain socket for the API (e.g. 0660)"`
+		GrpcSocketOwner string `long:"api-socket-owner" description:"specify the owner of the unix domain socket for the API (user[:group])"`
+		GrpcCertFile    string `long:"api-tls-cert-file" description:"specify the certificate file enabling TLS for the API"`
+		GrpcKeyFile     string `long:"api-tls-key-file" description:"specify the private key file enabling TLS for the API"`
+		GrpcClientCA    string `long:"api-tls-client-ca-file" description:"specify the CA certificate file verifying the API clients"`
+		GrpcTokenFile   string `long:"api-token-file" description:"specify the file of the bearer tokens and their roles for the API"`
 		GracefulRestart bool   `short:"r" long:"graceful-restart" description:"flag restart-state in graceful-restart capability"`
 		Dry             bool   `short:"d" long:"dry-run" description:"check configuration"`
 		PProfHost       string `long:"pprof-host" description:"specify the host that gobgpd listens on for pprof" default:"localhost:6060"`
 		PProfDisable    bool   `long:"pprof-disable" description:"disable pprof profiling"`
+		MetricsHost     string `long:"metrics-host" description:"specify the host that gobgpd listens on for prometheus metrics (disabled by default)"`
 	}
 	_, err := flags.Parse(&opts)
 	if err != nil {
@@ -118,8 +126,46 @@ func main() {
 	bgpServer := server.NewBgpServer()
 	go bgpServer.Serve()
 
+	if opts.MetricsHost != "" {
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(server.NewMetricsCollector(bgpServer))
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+		go func() {
+			log.Fatal(http.ListenAndServe(opts.MetricsHost, mux))
+		}()
+	}
+
 	// start grpc Server
-	grpcServer := api.NewGrpcServer(bgpServer, opts.GrpcHosts)
+	auth := &api.AuthConfig{
+		CertFile:     opts.GrpcCertFile,
+		KeyFile:      opts.GrpcKeyFile,
+		ClientCAFile: opts.GrpcClientCA,
+	}
+	if opts.GrpcTokenFile != "" {
+		if auth.Tokens, err = api.ReadTokenFile(opts.GrpcTokenFile); err != nil {
+			log.Fatalf("failed to read the token file: %s", err)
+		}
+	}
+	grpcServer, err := api.NewGrpcServerWithAuth(bgpServer, opts.GrpcHosts, auth)
+	if err != nil {
+		log.Fatalf("failed to create grpc server: %s", err)
+	}
+	if opts.GrpcSocketMode != "" || opts.GrpcSocketOwner != "" {
+		var mode uint64
+		if opts.GrpcSocketMode != "" {
+			if mode, err = strconv.ParseUint(opts.GrpcSocketMode, 8, 32); err != nil {
+				log.Fatalf("invalid socket mode: %s", opts.GrpcSocketMode)
+			}
+		}
+		uid, gid := -1, -1
+		if opts.GrpcSocketOwner != "" {
+			if uid, gid, err = lookupSocketOwner(opts.GrpcSocketOwner); err != nil {
+				log.Fatalf("invalid socket owner %s: %s", opts.GrpcSocketOwner, err)
+			}
+		}
+		grpcServer.SetUnixSocketPermission(os.FileMode(mode), uid, gid)
+	}
 	go func() {
 		if err := grpcServer.Serve(); err != nil {
 			log.Fatalf("failed to listen grpc port: %s", err)
@@ -134,8 +180,7 @@ func main() {
 	for {
 		select {
 		case newConfig := <-configCh:
-			var added, deleted, updated []config.Neighbor
-			var updatePolicy bool
+			var changes *config.BgpConfigSetChanges
 
 			if c == nil {
 				c = newConfig
@@ -170,88 +215,49 @@ func main() {
 						log.Fatalf("failed to set mrt config: %s", err)
 					}
 				}
+				for _, c := range newConfig.Vrfs {
+					rd, im, ex, err := config.ParseVrfConfig(&c.Config)
+					if err == nil {
+						err = bgpServer.AddVrf(c.Config.Name, c.Config.Id, rd, im, ex)
+					}
+					if err != nil {
+						log.Fatalf("failed to set vrf config: %s", err)
+					}
+				}
 				p := config.ConfigSetToRoutingPolicy(newConfig)
 				if err := bgpServer.UpdatePolicy(*p); err != nil {
 					log.Fatalf("failed to set routing policy: %s", err)
 				}
+				for _, c := range newConfig.Aggregates {
+					if err := bgpServer.AddAggregate(&c.Config); err != nil {
+						log.Fatalf("failed to set aggregate config: %s", err)
+					}
+				}
+				for i := range newConfig.HealthChecks {
+					if err := bgpServer.AddHealthCheck(&newConfig.HealthChecks[i]); err != nil {
+						log.Fatalf("failed to set health check config: %s", err)
+					}
+				}
 
-				added = newConfig.Neighbors
+				changes = &config.BgpConfigSetChanges{}
+				changes.Neighbors.Added = newConfig.Neighbors
+				changes.PeerGroups.Added = newConfig.PeerGroups
+				changes.DynamicNeighbors.Added = newConfig.DynamicNeighbors
+				changes.NeighborKeys = newConfig.NeighborKeys
 				if opts.GracefulRestart {
-					for i, n := range added {
+					for i, n := range changes.Neighbors.Added {
 						if n.GracefulRestart.Config.Enabled {
-							added[i].GracefulRestart.State.LocalRestarting = true
+							changes.Neighbors.Added[i].GracefulRestart.State.LocalRestarting = true
 						}
 					}
 				}
 
 			} else {
-				added, deleted, updated, updatePolicy = config.UpdateConfig(c, newConfig)
-				if updatePolicy {
-					log.Info("Policy config is updated")
-					p := config.ConfigSetToRoutingPolicy(newConfig)
-					bgpServer.UpdatePolicy(*p)
-				}
-				// global policy update
-				if !newConfig.Global.ApplyPolicy.Config.Equal(&c.Global.ApplyPolicy.Config) {
-					a := newConfig.Global.ApplyPolicy.Config
-					toDefaultTable := func(r config.DefaultPolicyType) table.RouteType {
-						var def table.RouteType
-						switch r {
-						case config.DEFAULT_POLICY_TYPE_ACCEPT_ROUTE:
-							def = table.ROUTE_TYPE_ACCEPT
-						case config.DEFAULT_POLICY_TYPE_REJECT_ROUTE:
-							def = table.ROUTE_TYPE_REJECT
-						}
-						return def
-					}
-					toPolicyDefinitions := func(r []string) []*config.PolicyDefinition {
-						p := make([]*config.PolicyDefinition, 0, len(r))
-						for _, n := range r {
-							p = append(p, &config.PolicyDefinition{
-								Name: n,
-							})
-						}
-						return p
-					}
-
-					def := toDefaultTable(a.DefaultImportPolicy)
-					ps := toPolicyDefinitions(a.ImportPolicyList)
-					bgpServer.ReplacePolicyAssignment("", table.POLICY_DIRECTION_IMPORT, ps, def)
-
-					def = toDefaultTable(a.DefaultExportPolicy)
-					ps = toPolicyDefinitions(a.ExportPolicyList)
-					bgpServer.ReplacePolicyAssignment("", table.POLICY_DIRECTION_EXPORT, ps, def)
-
-					updatePolicy = true
-
-				}
+				changes = config.UpdateConfig(c, newConfig)
 				c = newConfig
 			}
 
-			for i, p := range added {
-				log.Infof("Peer %v is added", p.Config.NeighborAddress)
-				if err := bgpServer.AddNeighbor(&added[i]); err != nil {
-					log.Warn(err)
-				}
-			}
-			for i, p := range deleted {
-				log.Infof("Peer %v is deleted", p.Config.NeighborAddress)
-				if err := bgpServer.DeleteNeighbor(&deleted[i]); err != nil {
-					log.Warn(err)
-				}
-			}
-			for i, p := range updated {
-				log.Infof("Peer %v is updated", p.Config.NeighborAddress)
-				u, err := bgpServer.UpdateNeighbor(&updated[i])
-				if err != nil {
-					log.Warn(err)
-				}
-				updatePolicy = updatePolicy || u
-			}
-
-			if updatePolicy {
-				bgpServer.SoftResetIn("", bgp.RouteFamily(0))
-			}
+			bgpServer.UpdateConfig(changes)
 		case <-sigCh:
 			bgpServer.Shutdown()
 		}
@@ -21,8 +21,10 @@ import (
 	"log/syslog"
 	"os"
 	"os/signal"
+	"os/user"
 	"runtime"
 	"runtime/debug"
+	"strconv"
 	"strings"
 	"syscall"
 
@@ -101,3 +103,30 @@ func addSyslogHook(host, facility string) error {
 	log.AddHook(hook)
 	return nil
 }
+
+// lookupSocketOwner returns the uid and the gid of "<user>[:<group>]".
+// The group is the primary group of the user if it's omitted.
+func lookupSocketOwner(owner string) (int, int, error) {
+	elems := strings.SplitN(owner, ":", 2)
+	u, err := user.Lookup(elems[0])
+	if err != nil {
+		return -1, -1, err
+	}
+	gid := u.Gid
+	if len(elems) == 2 {
+		g, err := user.LookupGroup(elems[1])
+		if err != nil {
+			return -1, -1, err
+		}
+		gid = g.Gid
+	}
+	uidNum, err := strconv.Atoi(u.Uid)
+	if err != nil {
+		return -1, -1, err
+	}
+	gidNum, err := strconv.Atoi(gid)
+	if err != nil {
+		return -1, -1, err
+	}
+	return uidNum, gidNum, nil
+}
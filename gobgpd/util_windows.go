@@ -22,3 +22,7 @@ import (
 func addSyslogHook(_, _ string) error {
 	return errors.New("syslog is not supported on this OS")
 }
+
+func lookupSocketOwner(_ string) (int, int, error) {
+	return -1, -1, errors.New("unix domain socket is not supported on this OS")
+}
@@ -0,0 +1,184 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfd
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	BFD_PORT            = 3784 // RFC 5881
+	BFD_MULTIHOP_PORT   = 4784 // RFC 5883
+	BFD_VERSION         = 1
+	BFD_CONTROL_LEN     = 24
+	BFD_MIN_SOURCE_PORT = 49152
+	BFD_MAX_SOURCE_PORT = 65535
+)
+
+type BFDState uint8
+
+const (
+	BFD_STATE_ADMIN_DOWN BFDState = iota
+	BFD_STATE_DOWN
+	BFD_STATE_INIT
+	BFD_STATE_UP
+)
+
+func (s BFDState) String() string {
+	switch s {
+	case BFD_STATE_ADMIN_DOWN:
+		return "admin-down"
+	case BFD_STATE_DOWN:
+		return "down"
+	case BFD_STATE_INIT:
+		return "init"
+	case BFD_STATE_UP:
+		return "up"
+	}
+	return fmt.Sprintf("unknown(%d)", s)
+}
+
+type BFDDiagnostic uint8
+
+const (
+	BFD_DIAG_NONE BFDDiagnostic = iota
+	BFD_DIAG_CONTROL_DETECTION_TIME_EXPIRED
+	BFD_DIAG_ECHO_FUNCTION_FAILED
+	BFD_DIAG_NEIGHBOR_SIGNALED_SESSION_DOWN
+	BFD_DIAG_FORWARDING_PLANE_RESET
+	BFD_DIAG_PATH_DOWN
+	BFD_DIAG_CONCATENATED_PATH_DOWN
+	BFD_DIAG_ADMINISTRATIVELY_DOWN
+	BFD_DIAG_REVERSE_CONCATENATED_PATH_DOWN
+)
+
+func (d BFDDiagnostic) String() string {
+	switch d {
+	case BFD_DIAG_NONE:
+		return "none"
+	case BFD_DIAG_CONTROL_DETECTION_TIME_EXPIRED:
+		return "control-detection-time-expired"
+	case BFD_DIAG_ECHO_FUNCTION_FAILED:
+		return "echo-function-failed"
+	case BFD_DIAG_NEIGHBOR_SIGNALED_SESSION_DOWN:
+		return "neighbor-signaled-session-down"
+	case BFD_DIAG_FORWARDING_PLANE_RESET:
+		return "forwarding-plane-reset"
+	case BFD_DIAG_PATH_DOWN:
+		return "path-down"
+	case BFD_DIAG_CONCATENATED_PATH_DOWN:
+		return "concatenated-path-down"
+	case BFD_DIAG_ADMINISTRATIVELY_DOWN:
+		return "administratively-down"
+	case BFD_DIAG_REVERSE_CONCATENATED_PATH_DOWN:
+		return "reverse-concatenated-path-down"
+	}
+	return fmt.Sprintf("unknown(%d)", d)
+}
+
+const (
+	BFD_FLAG_MULTIPOINT     = 1 << 0
+	BFD_FLAG_DEMAND         = 1 << 1
+	BFD_FLAG_AUTHENTICATION = 1 << 2
+	BFD_FLAG_CPI            = 1 << 3
+	BFD_FLAG_FINAL          = 1 << 4
+	BFD_FLAG_POLL           = 1 << 5
+)
+
+// BFDControlPacket is the mandatory section of the BFD Control packet
+// (RFC 5880 4.1). The authentication section isn't supported. The
+// intervals are in microseconds.
+type BFDControlPacket struct {
+	Version                   uint8
+	Diagnostic                BFDDiagnostic
+	State                     BFDState
+	Flags                     uint8
+	DetectMult                uint8
+	Length                    uint8
+	MyDiscriminator           uint32
+	YourDiscriminator         uint32
+	DesiredMinTxInterval      uint32
+	RequiredMinRxInterval     uint32
+	RequiredMinEchoRxInterval uint32
+}
+
+// DecodeFromBytes decodes the packet and does the checks required by
+// RFC 5880 6.8.6 that don't depend on the session.
+func (p *BFDControlPacket) DecodeFromBytes(data []byte) error {
+	if len(data) < BFD_CONTROL_LEN {
+		return fmt.Errorf("bfd control packet too short: %d", len(data))
+	}
+	p.Version = data[0] >> 5
+	p.Diagnostic = BFDDiagnostic(data[0] & 0x1f)
+	p.State = BFDState(data[1] >> 6)
+	p.Flags = data[1] & 0x3f
+	p.DetectMult = data[2]
+	p.Length = data[3]
+	p.MyDiscriminator = binary.BigEndian.Uint32(data[4:8])
+	p.YourDiscriminator = binary.BigEndian.Uint32(data[8:12])
+	p.DesiredMinTxInterval = binary.BigEndian.Uint32(data[12:16])
+	p.RequiredMinRxInterval = binary.BigEndian.Uint32(data[16:20])
+	p.RequiredMinEchoRxInterval = binary.BigEndian.Uint32(data[20:24])
+
+	if p.Version != BFD_VERSION {
+		return fmt.Errorf("unsupported bfd version: %d", p.Version)
+	}
+	if int(p.Length) < BFD_CONTROL_LEN || int(p.Length) > len(data) {
+		return fmt.Errorf("invalid bfd control packet length: %d", p.Length)
+	}
+	if p.DetectMult == 0 {
+		return fmt.Errorf("bfd detect multiplier is zero")
+	}
+	if p.Flags&BFD_FLAG_MULTIPOINT != 0 {
+		return fmt.Errorf("bfd multipoint bit is set")
+	}
+	if p.MyDiscriminator == 0 {
+		return fmt.Errorf("bfd my discriminator is zero")
+	}
+	if p.YourDiscriminator == 0 && p.State != BFD_STATE_DOWN && p.State != BFD_STATE_ADMIN_DOWN {
+		return fmt.Errorf("bfd your discriminator is zero in %s state", p.State)
+	}
+	return nil
+}
+
+func (p *BFDControlPacket) Serialize() ([]byte, error) {
+	buf := make([]byte, BFD_CONTROL_LEN)
+	buf[0] = BFD_VERSION<<5 | uint8(p.Diagnostic)&0x1f
+	buf[1] = uint8(p.State)<<6 | p.Flags&0x3f
+	buf[2] = p.DetectMult
+	buf[3] = BFD_CONTROL_LEN
+	binary.BigEndian.PutUint32(buf[4:8], p.MyDiscriminator)
+	binary.BigEndian.PutUint32(buf[8:12], p.YourDiscriminator)
+	binary.BigEndian.PutUint32(buf[12:16], p.DesiredMinTxInterval)
+	binary.BigEndian.PutUint32(buf[16:20], p.RequiredMinRxInterval)
+	binary.BigEndian.PutUint32(buf[20:24], p.RequiredMinEchoRxInterval)
+	return buf, nil
+}
+
+func (p *BFDControlPacket) String() string {
+	return fmt.Sprintf("{State: %s, Diag: %s, Flags: 0x%x, DetectMult: %d, MyDisc: %d, YourDisc: %d, TX: %d, RX: %d}",
+		p.State, p.Diagnostic, p.Flags, p.DetectMult, p.MyDiscriminator, p.YourDiscriminator,
+		p.DesiredMinTxInterval, p.RequiredMinRxInterval)
+}
+
+func ParseBFDControlPacket(data []byte) (*BFDControlPacket, error) {
+	p := &BFDControlPacket{}
+	if err := p.DecodeFromBytes(data); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_BFDControlPacket(t *testing.T) {
+	assert := assert.New(t)
+	p1 := &BFDControlPacket{
+		Diagnostic:                BFD_DIAG_CONTROL_DETECTION_TIME_EXPIRED,
+		State:                     BFD_STATE_UP,
+		Flags:                     BFD_FLAG_POLL,
+		DetectMult:                3,
+		MyDiscriminator:           1,
+		YourDiscriminator:         2,
+		DesiredMinTxInterval:      300000,
+		RequiredMinRxInterval:     300000,
+		RequiredMinEchoRxInterval: 0,
+	}
+	buf, err := p1.Serialize()
+	assert.Nil(err)
+	assert.Equal(BFD_CONTROL_LEN, len(buf))
+	assert.Equal([]byte{0x21, 0xe0, 3, 24}, buf[:4])
+
+	p2, err := ParseBFDControlPacket(buf)
+	assert.Nil(err)
+	p1.Version = BFD_VERSION
+	p1.Length = BFD_CONTROL_LEN
+	assert.Equal(p1, p2)
+}
+
+func Test_BFDControlPacketMalformed(t *testing.T) {
+	assert := assert.New(t)
+	p := &BFDControlPacket{
+		State:             BFD_STATE_UP,
+		DetectMult:        3,
+		MyDiscriminator:   1,
+		YourDiscriminator: 2,
+	}
+	buf, _ := p.Serialize()
+
+	_, err := ParseBFDControlPacket(buf[:20])
+	assert.NotNil(err)
+
+	// version 0
+	b := append([]byte{}, buf...)
+	b[0] = 0
+	_, err = ParseBFDControlPacket(b)
+	assert.NotNil(err)
+
+	// length is longer than the payload
+	b = append([]byte{}, buf...)
+	b[3] = 30
+	_, err = ParseBFDControlPacket(b)
+	assert.NotNil(err)
+
+	// zero detect multiplier
+	b = append([]byte{}, buf...)
+	b[2] = 0
+	_, err = ParseBFDControlPacket(b)
+	assert.NotNil(err)
+
+	// zero your discriminator is allowed only in down state
+	p.YourDiscriminator = 0
+	b, _ = p.Serialize()
+	_, err = ParseBFDControlPacket(b)
+	assert.NotNil(err)
+	p.State = BFD_STATE_DOWN
+	b, _ = p.Serialize()
+	_, err = ParseBFDControlPacket(b)
+	assert.Nil(err)
+}
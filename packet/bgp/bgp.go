@@ -506,52 +506,76 @@ func (m BGPAddPathMode) String() string {
 	}
 }
 
-type CapAddPath struct {
-	DefaultParameterCapability
+type CapAddPathTuple struct {
 	RouteFamily RouteFamily
 	Mode        BGPAddPathMode
 }
 
+func (t *CapAddPathTuple) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		RouteFamily RouteFamily    `json:"route_family"`
+		Mode        BGPAddPathMode `json:"mode"`
+	}{
+		RouteFamily: t.RouteFamily,
+		Mode:        t.Mode,
+	})
+}
+
+func NewCapAddPathTuple(family RouteFamily, mode BGPAddPathMode) *CapAddPathTuple {
+	return &CapAddPathTuple{
+		RouteFamily: family,
+		Mode:        mode,
+	}
+}
+
+type CapAddPath struct {
+	DefaultParameterCapability
+	Tuples []*CapAddPathTuple
+}
+
 func (c *CapAddPath) DecodeFromBytes(data []byte) error {
 	c.DefaultParameterCapability.DecodeFromBytes(data)
 	data = data[2:]
-	if len(data) < 4 {
+	if len(data)%4 != 0 {
 		return NewMessageError(BGP_ERROR_OPEN_MESSAGE_ERROR, BGP_ERROR_SUB_UNSUPPORTED_CAPABILITY, nil, "Not all CapabilityAddPath bytes available")
 	}
-	c.RouteFamily = AfiSafiToRouteFamily(binary.BigEndian.Uint16(data[:2]), data[2])
-	c.Mode = BGPAddPathMode(data[3])
+	c.Tuples = []*CapAddPathTuple{}
+	for len(data) >= 4 {
+		t := NewCapAddPathTuple(AfiSafiToRouteFamily(binary.BigEndian.Uint16(data[:2]), data[2]), BGPAddPathMode(data[3]))
+		c.Tuples = append(c.Tuples, t)
+		data = data[4:]
+	}
 	return nil
 }
 
 func (c *CapAddPath) Serialize() ([]byte, error) {
-	buf := make([]byte, 4)
-	afi, safi := RouteFamilyToAfiSafi(c.RouteFamily)
-	binary.BigEndian.PutUint16(buf, afi)
-	buf[2] = safi
-	buf[3] = byte(c.Mode)
+	buf := make([]byte, len(c.Tuples)*4)
+	for i, t := range c.Tuples {
+		afi, safi := RouteFamilyToAfiSafi(t.RouteFamily)
+		binary.BigEndian.PutUint16(buf[i*4:i*4+2], afi)
+		buf[i*4+2] = safi
+		buf[i*4+3] = byte(t.Mode)
+	}
 	c.DefaultParameterCapability.CapValue = buf
 	return c.DefaultParameterCapability.Serialize()
 }
 
 func (c *CapAddPath) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
-		Code  BGPCapabilityCode `json:"code"`
-		Value RouteFamily       `json:"value"`
-		Mode  BGPAddPathMode    `json:"mode"`
+		Code   BGPCapabilityCode  `json:"code"`
+		Tuples []*CapAddPathTuple `json:"tuples"`
 	}{
-		Code:  c.Code(),
-		Value: c.RouteFamily,
-		Mode:  c.Mode,
+		Code:   c.Code(),
+		Tuples: c.Tuples,
 	})
 }
 
-func NewCapAddPath(rf RouteFamily, mode BGPAddPathMode) *CapAddPath {
+func NewCapAddPath(tuples []*CapAddPathTuple) *CapAddPath {
 	return &CapAddPath{
 		DefaultParameterCapability: DefaultParameterCapability{
 			CapCode: BGP_CAP_ADD_PATH,
 		},
-		RouteFamily: rf,
-		Mode:        mode,
+		Tuples: tuples,
 	}
 }
 
@@ -778,7 +802,7 @@ type BGPOpen struct {
 	OptParams   []OptionParameterInterface
 }
 
-func (msg *BGPOpen) DecodeFromBytes(data []byte) error {
+func (msg *BGPOpen) DecodeFromBytes(data []byte, options ...*MarshallingOption) error {
 	msg.Version = data[0]
 	msg.MyAS = binary.BigEndian.Uint16(data[1:3])
 	msg.HoldTime = binary.BigEndian.Uint16(data[3:5])
@@ -819,7 +843,7 @@ func (msg *BGPOpen) DecodeFromBytes(data []byte) error {
 	return nil
 }
 
-func (msg *BGPOpen) Serialize() ([]byte, error) {
+func (msg *BGPOpen) Serialize(options ...*MarshallingOption) ([]byte, error) {
 	buf := make([]byte, 10)
 	buf[0] = msg.Version
 	binary.BigEndian.PutUint16(buf[1:3], msg.MyAS)
@@ -857,9 +881,75 @@ type AddrPrefixInterface interface {
 	// Create a flat map to describe attributes and their
 	// values. This can be used to create structured outputs.
 	Flat() map[string]string
+
+	// RFC 7911 path identifier received with the NLRI and the one
+	// assigned locally when advertising it.
+	PathIdentifier() uint32
+	SetPathIdentifier(uint32)
+	PathLocalIdentifier() uint32
+	SetPathLocalIdentifier(uint32)
+}
+
+// MarshallingOption carries the per-session state needed to encode or
+// decode BGP messages, such as the negotiated ADD-PATH modes.
+type MarshallingOption struct {
+	AddPath map[RouteFamily]BGPAddPathMode
+}
+
+func handleAddPath(decode bool, f RouteFamily, options []*MarshallingOption) bool {
+	for _, opt := range options {
+		if opt == nil || opt.AddPath == nil {
+			continue
+		}
+		mode := opt.AddPath[f]
+		if decode && mode&BGP_ADD_PATH_RECEIVE > 0 {
+			return true
+		} else if !decode && mode&BGP_ADD_PATH_SEND > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+type PrefixDefault struct {
+	id      uint32
+	localId uint32
+}
+
+func (p *PrefixDefault) PathIdentifier() uint32 {
+	return p.id
+}
+
+func (p *PrefixDefault) SetPathIdentifier(id uint32) {
+	p.id = id
+}
+
+func (p *PrefixDefault) PathLocalIdentifier() uint32 {
+	return p.localId
+}
+
+func (p *PrefixDefault) SetPathLocalIdentifier(id uint32) {
+	p.localId = id
+}
+
+func decodePathIdentifier(prefix AddrPrefixInterface, data []byte) ([]byte, error) {
+	if len(data) < 4 {
+		eCode := uint8(BGP_ERROR_UPDATE_MESSAGE_ERROR)
+		eSubCode := uint8(BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST)
+		return nil, NewMessageError(eCode, eSubCode, nil, "path identifier is short")
+	}
+	prefix.SetPathIdentifier(binary.BigEndian.Uint32(data))
+	return data[4:], nil
+}
+
+func serializePathIdentifier(prefix AddrPrefixInterface) []byte {
+	buf := make([]byte, 4)
+	binary.BigEndian.PutUint32(buf, prefix.PathLocalIdentifier())
+	return buf
 }
 
 type IPAddrPrefixDefault struct {
+	PrefixDefault
 	Length uint8
 	Prefix net.IP
 }
@@ -948,7 +1038,7 @@ func (r *IPAddrPrefix) SAFI() uint8 {
 
 func NewIPAddrPrefix(length uint8, prefix string) *IPAddrPrefix {
 	return &IPAddrPrefix{
-		IPAddrPrefixDefault{length, net.ParseIP(prefix).To4()},
+		IPAddrPrefixDefault{Length: length, Prefix: net.ParseIP(prefix).To4()},
 		4,
 	}
 }
@@ -976,7 +1066,7 @@ func (r *IPv6AddrPrefix) String() string {
 func NewIPv6AddrPrefix(length uint8, prefix string) *IPv6AddrPrefix {
 	return &IPv6AddrPrefix{
 		IPAddrPrefix{
-			IPAddrPrefixDefault{length, net.ParseIP(prefix)},
+			IPAddrPrefixDefault{Length: length, Prefix: net.ParseIP(prefix)},
 			16,
 		},
 	}
@@ -1425,7 +1515,7 @@ func NewLabeledVPNIPAddrPrefix(length uint8, prefix string, label MPLSLabelStack
 		rdlen = rd.Len()
 	}
 	return &LabeledVPNIPAddrPrefix{
-		IPAddrPrefixDefault{length + uint8(8*(label.Len()+rdlen)), net.ParseIP(prefix).To4()},
+		IPAddrPrefixDefault{Length: length + uint8(8*(label.Len()+rdlen)), Prefix: net.ParseIP(prefix).To4()},
 		label,
 		rd,
 		4,
@@ -1447,7 +1537,7 @@ func NewLabeledVPNIPv6AddrPrefix(length uint8, prefix string, label MPLSLabelSta
 	}
 	return &LabeledVPNIPv6AddrPrefix{
 		LabeledVPNIPAddrPrefix{
-			IPAddrPrefixDefault{length + uint8(8*(label.Len()+rdlen)), net.ParseIP(prefix)},
+			IPAddrPrefixDefault{Length: length + uint8(8*(label.Len()+rdlen)), Prefix: net.ParseIP(prefix)},
 			label,
 			rd,
 			16,
@@ -1519,7 +1609,7 @@ func (l *LabeledIPAddrPrefix) MarshalJSON() ([]byte, error) {
 
 func NewLabeledIPAddrPrefix(length uint8, prefix string, label MPLSLabelStack) *LabeledIPAddrPrefix {
 	return &LabeledIPAddrPrefix{
-		IPAddrPrefixDefault{length + uint8(label.Len()*8), net.ParseIP(prefix).To4()},
+		IPAddrPrefixDefault{Length: length + uint8(label.Len()*8), Prefix: net.ParseIP(prefix).To4()},
 		label,
 		4,
 	}
@@ -1536,7 +1626,7 @@ func (l *LabeledIPv6AddrPrefix) AFI() uint16 {
 func NewLabeledIPv6AddrPrefix(length uint8, prefix string, label MPLSLabelStack) *LabeledIPv6AddrPrefix {
 	return &LabeledIPv6AddrPrefix{
 		LabeledIPAddrPrefix{
-			IPAddrPrefixDefault{length + uint8(label.Len()*8), net.ParseIP(prefix)},
+			IPAddrPrefixDefault{Length: length + uint8(label.Len()*8), Prefix: net.ParseIP(prefix)},
 			label,
 			16,
 		},
@@ -1544,6 +1634,7 @@ func NewLabeledIPv6AddrPrefix(length uint8, prefix string, label MPLSLabelStack)
 }
 
 type RouteTargetMembershipNLRI struct {
+	PrefixDefault
 	Length      uint8
 	AS          uint32
 	RouteTarget ExtendedCommunityInterface
@@ -2196,6 +2287,7 @@ const (
 )
 
 type EVPNNLRI struct {
+	PrefixDefault
 	RouteType     uint8
 	Length        uint8
 	RouteTypeData EVPNRouteTypeInterface
@@ -2267,9 +2359,9 @@ func (n *EVPNNLRI) RD() RouteDistinguisherInterface {
 
 func NewEVPNNLRI(routetype uint8, length uint8, routetypedata EVPNRouteTypeInterface) *EVPNNLRI {
 	return &EVPNNLRI{
-		routetype,
-		length,
-		routetypedata,
+		RouteType:     routetype,
+		Length:        length,
+		RouteTypeData: routetypedata,
 	}
 }
 
@@ -2315,7 +2407,7 @@ func (n *EncapNLRI) SAFI() uint8 {
 
 func NewEncapNLRI(endpoint string) *EncapNLRI {
 	return &EncapNLRI{
-		IPAddrPrefixDefault{32, net.ParseIP(endpoint).To4()},
+		IPAddrPrefixDefault{Length: 32, Prefix: net.ParseIP(endpoint).To4()},
 		4,
 	}
 }
@@ -2331,7 +2423,7 @@ func (n *Encapv6NLRI) AFI() uint16 {
 func NewEncapv6NLRI(endpoint string) *Encapv6NLRI {
 	return &Encapv6NLRI{
 		EncapNLRI{
-			IPAddrPrefixDefault{128, net.ParseIP(endpoint)},
+			IPAddrPrefixDefault{Length: 128, Prefix: net.ParseIP(endpoint)},
 			16,
 		},
 	}
@@ -3354,6 +3446,7 @@ func (p *FlowSpecUnknown) MarshalJSON() ([]byte, error) {
 }
 
 type FlowSpecNLRI struct {
+	PrefixDefault
 	Value []FlowSpecComponentInterface
 	rf    RouteFamily
 	rd    RouteDistinguisherInterface
@@ -3666,7 +3759,7 @@ func (n *FlowSpecIPv4Unicast) DecodeFromBytes(data []byte) error {
 }
 
 func NewFlowSpecIPv4Unicast(value []FlowSpecComponentInterface) *FlowSpecIPv4Unicast {
-	return &FlowSpecIPv4Unicast{FlowSpecNLRI{value, RF_FS_IPv4_UC, nil}}
+	return &FlowSpecIPv4Unicast{FlowSpecNLRI{Value: value, rf: RF_FS_IPv4_UC}}
 }
 
 type FlowSpecIPv4VPN struct {
@@ -3678,7 +3771,7 @@ func (n *FlowSpecIPv4VPN) DecodeFromBytes(data []byte) error {
 }
 
 func NewFlowSpecIPv4VPN(rd RouteDistinguisherInterface, value []FlowSpecComponentInterface) *FlowSpecIPv4VPN {
-	return &FlowSpecIPv4VPN{FlowSpecNLRI{value, RF_FS_IPv4_VPN, rd}}
+	return &FlowSpecIPv4VPN{FlowSpecNLRI{Value: value, rf: RF_FS_IPv4_VPN, rd: rd}}
 }
 
 type FlowSpecIPv6Unicast struct {
@@ -3729,6 +3822,7 @@ func NewFlowSpecL2VPN(rd RouteDistinguisherInterface, value []FlowSpecComponentI
 }
 
 type OpaqueNLRI struct {
+	PrefixDefault
 	Length uint16
 	Key    []byte
 	Value  []byte
@@ -5165,6 +5259,10 @@ type PathAttributeMpReachNLRI struct {
 }
 
 func (p *PathAttributeMpReachNLRI) DecodeFromBytes(data []byte) error {
+	return p.decodeFromBytes(data)
+}
+
+func (p *PathAttributeMpReachNLRI) decodeFromBytes(data []byte, options ...*MarshallingOption) error {
 	err := p.PathAttribute.DecodeFromBytes(data)
 	if err != nil {
 		return err
@@ -5213,11 +5311,17 @@ func (p *PathAttributeMpReachNLRI) DecodeFromBytes(data []byte) error {
 		return NewMessageError(eCode, eSubCode, value, "no skip byte")
 	}
 	value = value[1:]
+	addpath := handleAddPath(true, AfiSafiToRouteFamily(afi, safi), options)
 	for len(value) > 0 {
 		prefix, err := NewPrefixFromRouteFamily(afi, safi)
 		if err != nil {
 			return NewMessageError(eCode, BGP_ERROR_SUB_ATTRIBUTE_FLAGS_ERROR, data[:p.PathAttribute.Len()], err.Error())
 		}
+		if addpath {
+			if value, err = decodePathIdentifier(prefix, value); err != nil {
+				return err
+			}
+		}
 		err = prefix.DecodeFromBytes(value)
 		if err != nil {
 			return err
@@ -5232,6 +5336,10 @@ func (p *PathAttributeMpReachNLRI) DecodeFromBytes(data []byte) error {
 }
 
 func (p *PathAttributeMpReachNLRI) Serialize() ([]byte, error) {
+	return p.serialize()
+}
+
+func (p *PathAttributeMpReachNLRI) serialize(options ...*MarshallingOption) ([]byte, error) {
 	afi := p.AFI
 	safi := p.SAFI
 	nexthoplen := 4
@@ -5264,7 +5372,11 @@ func (p *PathAttributeMpReachNLRI) Serialize() ([]byte, error) {
 		}
 	}
 	buf = append(buf, make([]byte, 1)...)
+	addpath := handleAddPath(false, AfiSafiToRouteFamily(afi, safi), options)
 	for _, prefix := range p.Value {
+		if addpath {
+			buf = append(buf, serializePathIdentifier(prefix)...)
+		}
 		pbuf, err := prefix.Serialize()
 		if err != nil {
 			return nil, err
@@ -5335,6 +5447,10 @@ type PathAttributeMpUnreachNLRI struct {
 }
 
 func (p *PathAttributeMpUnreachNLRI) DecodeFromBytes(data []byte) error {
+	return p.decodeFromBytes(data)
+}
+
+func (p *PathAttributeMpUnreachNLRI) decodeFromBytes(data []byte, options ...*MarshallingOption) error {
 	err := p.PathAttribute.DecodeFromBytes(data)
 	if err != nil {
 		return err
@@ -5355,11 +5471,17 @@ func (p *PathAttributeMpUnreachNLRI) DecodeFromBytes(data []byte) error {
 	value = value[3:]
 	p.AFI = afi
 	p.SAFI = safi
+	addpath := handleAddPath(true, AfiSafiToRouteFamily(afi, safi), options)
 	for len(value) > 0 {
 		prefix, err := NewPrefixFromRouteFamily(afi, safi)
 		if err != nil {
 			return NewMessageError(eCode, BGP_ERROR_SUB_ATTRIBUTE_FLAGS_ERROR, data[:p.PathAttribute.Len()], err.Error())
 		}
+		if addpath {
+			if value, err = decodePathIdentifier(prefix, value); err != nil {
+				return err
+			}
+		}
 		err = prefix.DecodeFromBytes(value)
 		if err != nil {
 			return err
@@ -5374,10 +5496,18 @@ func (p *PathAttributeMpUnreachNLRI) DecodeFromBytes(data []byte) error {
 }
 
 func (p *PathAttributeMpUnreachNLRI) Serialize() ([]byte, error) {
+	return p.serialize()
+}
+
+func (p *PathAttributeMpUnreachNLRI) serialize(options ...*MarshallingOption) ([]byte, error) {
 	buf := make([]byte, 3)
 	binary.BigEndian.PutUint16(buf, p.AFI)
 	buf[2] = p.SAFI
+	addpath := handleAddPath(false, AfiSafiToRouteFamily(p.AFI, p.SAFI), options)
 	for _, prefix := range p.Value {
+		if addpath {
+			buf = append(buf, serializePathIdentifier(prefix)...)
+		}
 		pbuf, err := prefix.Serialize()
 		if err != nil {
 			return nil, err
@@ -7161,6 +7291,25 @@ type PathAttributeUnknown struct {
 	PathAttribute
 }
 
+// pathAttributeLen returns the whole length of the path attribute at the
+// head of data, or -1 when the attribute header is broken.
+func pathAttributeLen(data []byte) int {
+	if len(data) < 3 {
+		return -1
+	}
+	l := 3 + int(data[2])
+	if BGPAttrFlag(data[0])&BGP_ATTR_FLAG_EXTENDED_LENGTH != 0 {
+		if len(data) < 4 {
+			return -1
+		}
+		l = 4 + int(binary.BigEndian.Uint16(data[2:4]))
+	}
+	if len(data) < l {
+		return -1
+	}
+	return l
+}
+
 func GetPathAttribute(data []byte) (PathAttributeInterface, error) {
 	if len(data) < 2 {
 		eCode := uint8(BGP_ERROR_UPDATE_MESSAGE_ERROR)
@@ -7218,7 +7367,7 @@ type BGPUpdate struct {
 	NLRI                  []*IPAddrPrefix
 }
 
-func (msg *BGPUpdate) DecodeFromBytes(data []byte) error {
+func (msg *BGPUpdate) DecodeFromBytes(data []byte, options ...*MarshallingOption) error {
 
 	// cache error codes
 	eCode := uint8(BGP_ERROR_UPDATE_MESSAGE_ERROR)
@@ -7237,13 +7386,28 @@ func (msg *BGPUpdate) DecodeFromBytes(data []byte) error {
 		return NewMessageError(eCode, eSubCode, nil, "withdrawn route length exceeds message length")
 	}
 
+	addpath := handleAddPath(true, RF_IPv4_UC, options)
+
 	msg.WithdrawnRoutes = make([]*IPAddrPrefix, 0, msg.WithdrawnRoutesLen)
 	for routelen := msg.WithdrawnRoutesLen; routelen > 0; {
 		w := &IPAddrPrefix{}
+		if addpath {
+			if routelen < 4 {
+				return NewMessageError(eCode, eSubCode, nil, "Withdrawn route length is short")
+			}
+			var err error
+			if data, err = decodePathIdentifier(w, data); err != nil {
+				return err
+			}
+			routelen -= 4
+		}
 		err := w.DecodeFromBytes(data)
 		if err != nil {
 			return err
 		}
+		if uint16(w.Len()) > routelen {
+			return NewMessageError(eCode, eSubCode, nil, "Withdrawn route length is short")
+		}
 		routelen -= uint16(w.Len())
 		if len(data) < w.Len() {
 			return NewMessageError(eCode, eSubCode, nil, "Withdrawn route length is short")
@@ -7265,15 +7429,37 @@ func (msg *BGPUpdate) DecodeFromBytes(data []byte) error {
 		return NewMessageError(eCode, eSubCode, nil, "path total attribute length exceeds message length")
 	}
 
+	// the error handled without a session reset (RFC 7606)
+	var revisedError *MessageError
+
 	msg.PathAttributes = []PathAttributeInterface{}
 	for pathlen := msg.TotalPathAttributeLen; pathlen > 0; {
 		p, err := GetPathAttribute(data)
 		if err != nil {
 			return err
 		}
-		err = p.DecodeFromBytes(data)
+		switch a := p.(type) {
+		case *PathAttributeMpReachNLRI:
+			err = a.decodeFromBytes(data, options...)
+		case *PathAttributeMpUnreachNLRI:
+			err = a.decodeFromBytes(data, options...)
+		default:
+			err = p.DecodeFromBytes(data)
+		}
 		if err != nil {
-			return err
+			// a malformed attribute can be skipped only when its
+			// length is known.
+			e, ok := err.(*MessageError)
+			l := pathAttributeLen(data)
+			if !ok || l < 0 || l > int(pathlen) {
+				return err
+			}
+			e.ErrorHandling = getErrorHandlingFromPathAttribute(p.GetType())
+			e.ErrorAttribute = p
+			revisedError = strongerError(revisedError, e)
+			pathlen -= uint16(l)
+			data = data[l:]
+			continue
 		}
 		pathlen -= uint16(p.Len())
 		if len(data) < p.Len() {
@@ -7286,6 +7472,13 @@ func (msg *BGPUpdate) DecodeFromBytes(data []byte) error {
 	msg.NLRI = make([]*IPAddrPrefix, 0)
 	for restlen := len(data); restlen > 0; {
 		n := &IPAddrPrefix{}
+		if addpath {
+			var err error
+			if data, err = decodePathIdentifier(n, data); err != nil {
+				return err
+			}
+			restlen -= 4
+		}
 		err := n.DecodeFromBytes(data)
 		if err != nil {
 			return err
@@ -7298,12 +7491,20 @@ func (msg *BGPUpdate) DecodeFromBytes(data []byte) error {
 		msg.NLRI = append(msg.NLRI, n)
 	}
 
+	if revisedError != nil {
+		return revisedError
+	}
 	return nil
 }
 
-func (msg *BGPUpdate) Serialize() ([]byte, error) {
+func (msg *BGPUpdate) Serialize(options ...*MarshallingOption) ([]byte, error) {
+	addpath := handleAddPath(false, RF_IPv4_UC, options)
+
 	wbuf := make([]byte, 2)
 	for _, w := range msg.WithdrawnRoutes {
+		if addpath {
+			wbuf = append(wbuf, serializePathIdentifier(w)...)
+		}
 		onewbuf, err := w.Serialize()
 		if err != nil {
 			return nil, err
@@ -7315,7 +7516,16 @@ func (msg *BGPUpdate) Serialize() ([]byte, error) {
 
 	pbuf := make([]byte, 2)
 	for _, p := range msg.PathAttributes {
-		onepbuf, err := p.Serialize()
+		var onepbuf []byte
+		var err error
+		switch a := p.(type) {
+		case *PathAttributeMpReachNLRI:
+			onepbuf, err = a.serialize(options...)
+		case *PathAttributeMpUnreachNLRI:
+			onepbuf, err = a.serialize(options...)
+		default:
+			onepbuf, err = p.Serialize()
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -7326,6 +7536,9 @@ func (msg *BGPUpdate) Serialize() ([]byte, error) {
 
 	buf := append(wbuf, pbuf...)
 	for _, n := range msg.NLRI {
+		if addpath {
+			buf = append(buf, serializePathIdentifier(n)...)
+		}
 		nbuf, err := n.Serialize()
 		if err != nil {
 			return nil, err
@@ -7381,7 +7594,7 @@ type BGPNotification struct {
 	Data         []byte
 }
 
-func (msg *BGPNotification) DecodeFromBytes(data []byte) error {
+func (msg *BGPNotification) DecodeFromBytes(data []byte, options ...*MarshallingOption) error {
 	if len(data) < 2 {
 		return NewMessageError(BGP_ERROR_MESSAGE_HEADER_ERROR, BGP_ERROR_SUB_BAD_MESSAGE_LENGTH, nil, "Not all Notificaiton bytes available")
 	}
@@ -7393,7 +7606,7 @@ func (msg *BGPNotification) DecodeFromBytes(data []byte) error {
 	return nil
 }
 
-func (msg *BGPNotification) Serialize() ([]byte, error) {
+func (msg *BGPNotification) Serialize(options ...*MarshallingOption) ([]byte, error) {
 	buf := make([]byte, 2)
 	buf[0] = msg.ErrorCode
 	buf[1] = msg.ErrorSubcode
@@ -7411,11 +7624,11 @@ func NewBGPNotificationMessage(errcode uint8, errsubcode uint8, data []byte) *BG
 type BGPKeepAlive struct {
 }
 
-func (msg *BGPKeepAlive) DecodeFromBytes(data []byte) error {
+func (msg *BGPKeepAlive) DecodeFromBytes(data []byte, options ...*MarshallingOption) error {
 	return nil
 }
 
-func (msg *BGPKeepAlive) Serialize() ([]byte, error) {
+func (msg *BGPKeepAlive) Serialize(options ...*MarshallingOption) ([]byte, error) {
 	return nil, nil
 }
 
@@ -7432,7 +7645,7 @@ type BGPRouteRefresh struct {
 	SAFI        uint8
 }
 
-func (msg *BGPRouteRefresh) DecodeFromBytes(data []byte) error {
+func (msg *BGPRouteRefresh) DecodeFromBytes(data []byte, options ...*MarshallingOption) error {
 	if len(data) < 4 {
 		return NewMessageError(BGP_ERROR_ROUTE_REFRESH_MESSAGE_ERROR, BGP_ERROR_SUB_INVALID_MESSAGE_LENGTH, nil, "Not all RouteRefresh bytes available")
 	}
@@ -7442,7 +7655,7 @@ func (msg *BGPRouteRefresh) DecodeFromBytes(data []byte) error {
 	return nil
 }
 
-func (msg *BGPRouteRefresh) Serialize() ([]byte, error) {
+func (msg *BGPRouteRefresh) Serialize(options ...*MarshallingOption) ([]byte, error) {
 	buf := make([]byte, 4)
 	binary.BigEndian.PutUint16(buf[0:2], msg.AFI)
 	buf[2] = msg.Demarcation
@@ -7458,8 +7671,8 @@ func NewBGPRouteRefreshMessage(afi uint16, demarcation uint8, safi uint8) *BGPMe
 }
 
 type BGPBody interface {
-	DecodeFromBytes([]byte) error
-	Serialize() ([]byte, error)
+	DecodeFromBytes([]byte, ...*MarshallingOption) error
+	Serialize(...*MarshallingOption) ([]byte, error)
 }
 
 const (
@@ -7501,7 +7714,7 @@ type BGPMessage struct {
 	Body   BGPBody
 }
 
-func parseBody(h *BGPHeader, data []byte) (*BGPMessage, error) {
+func parseBody(h *BGPHeader, data []byte, options ...*MarshallingOption) (*BGPMessage, error) {
 	if len(data) < int(h.Len)-BGP_HEADER_LENGTH {
 		return nil, NewMessageError(BGP_ERROR_MESSAGE_HEADER_ERROR, BGP_ERROR_SUB_BAD_MESSAGE_LENGTH, nil, "Not all BGP message bytes available")
 	}
@@ -7521,28 +7734,33 @@ func parseBody(h *BGPHeader, data []byte) (*BGPMessage, error) {
 	default:
 		return nil, NewMessageError(BGP_ERROR_MESSAGE_HEADER_ERROR, BGP_ERROR_SUB_BAD_MESSAGE_TYPE, nil, "unknown message type")
 	}
-	err := msg.Body.DecodeFromBytes(data)
+	err := msg.Body.DecodeFromBytes(data, options...)
 	if err != nil {
+		// the message is still available when the error doesn't
+		// require a session reset (RFC 7606)
+		if e, ok := err.(*MessageError); ok && e.ErrorHandling != ERROR_HANDLING_NONE && e.ErrorHandling != ERROR_HANDLING_SESSION_RESET {
+			return msg, err
+		}
 		return nil, err
 	}
 	return msg, nil
 }
 
-func ParseBGPMessage(data []byte) (*BGPMessage, error) {
+func ParseBGPMessage(data []byte, options ...*MarshallingOption) (*BGPMessage, error) {
 	h := &BGPHeader{}
 	err := h.DecodeFromBytes(data)
 	if err != nil {
 		return nil, err
 	}
-	return parseBody(h, data[19:h.Len])
+	return parseBody(h, data[19:h.Len], options...)
 }
 
-func ParseBGPBody(h *BGPHeader, data []byte) (*BGPMessage, error) {
-	return parseBody(h, data)
+func ParseBGPBody(h *BGPHeader, data []byte, options ...*MarshallingOption) (*BGPMessage, error) {
+	return parseBody(h, data, options...)
 }
 
-func (msg *BGPMessage) Serialize() ([]byte, error) {
-	b, err := msg.Body.Serialize()
+func (msg *BGPMessage) Serialize(options ...*MarshallingOption) ([]byte, error) {
+	b, err := msg.Body.Serialize(options...)
 	if err != nil {
 		return nil, err
 	}
@@ -7559,11 +7777,51 @@ func (msg *BGPMessage) Serialize() ([]byte, error) {
 	return append(h, b...), nil
 }
 
+// ErrorHandling is the approach to handle an erroneous UPDATE message
+// defined in RFC 7606. The larger value is the stronger approach.
+type ErrorHandling int
+
+const (
+	ERROR_HANDLING_NONE ErrorHandling = iota
+	ERROR_HANDLING_ATTRIBUTE_DISCARD
+	ERROR_HANDLING_TREAT_AS_WITHDRAW
+	ERROR_HANDLING_AFISAFI_DISABLE
+	ERROR_HANDLING_SESSION_RESET
+)
+
+func (h ErrorHandling) String() string {
+	switch h {
+	case ERROR_HANDLING_ATTRIBUTE_DISCARD:
+		return "attribute-discard"
+	case ERROR_HANDLING_TREAT_AS_WITHDRAW:
+		return "treat-as-withdraw"
+	case ERROR_HANDLING_AFISAFI_DISABLE:
+		return "afi-safi-disable"
+	case ERROR_HANDLING_SESSION_RESET:
+		return "session-reset"
+	}
+	return "none"
+}
+
+// getErrorHandlingFromPathAttribute returns the approach to handle a
+// malformed path attribute (RFC 7606 section 7).
+func getErrorHandlingFromPathAttribute(t BGPAttrType) ErrorHandling {
+	switch t {
+	case BGP_ATTR_TYPE_ATOMIC_AGGREGATE, BGP_ATTR_TYPE_AGGREGATOR, BGP_ATTR_TYPE_AS4_PATH, BGP_ATTR_TYPE_AS4_AGGREGATOR:
+		return ERROR_HANDLING_ATTRIBUTE_DISCARD
+	case BGP_ATTR_TYPE_MP_REACH_NLRI, BGP_ATTR_TYPE_MP_UNREACH_NLRI:
+		return ERROR_HANDLING_AFISAFI_DISABLE
+	}
+	return ERROR_HANDLING_TREAT_AS_WITHDRAW
+}
+
 type MessageError struct {
-	TypeCode    uint8
-	SubTypeCode uint8
-	Data        []byte
-	Message     string
+	TypeCode       uint8
+	SubTypeCode    uint8
+	Data           []byte
+	Message        string
+	ErrorHandling  ErrorHandling
+	ErrorAttribute PathAttributeInterface
 }
 
 func NewMessageError(typeCode, subTypeCode uint8, data []byte, msg string) error {
@@ -7575,6 +7833,25 @@ func NewMessageError(typeCode, subTypeCode uint8, data []byte, msg string) error
 	}
 }
 
+func NewMessageErrorWithErrorHandling(typeCode, subTypeCode uint8, data []byte, handling ErrorHandling, attr PathAttributeInterface, msg string) error {
+	return &MessageError{
+		TypeCode:       typeCode,
+		SubTypeCode:    subTypeCode,
+		Data:           data,
+		Message:        msg,
+		ErrorHandling:  handling,
+		ErrorAttribute: attr,
+	}
+}
+
+// strongerError returns the error which requires the stronger handling.
+func strongerError(lhs, rhs *MessageError) *MessageError {
+	if lhs == nil || (rhs != nil && rhs.ErrorHandling > lhs.ErrorHandling) {
+		return rhs
+	}
+	return lhs
+}
+
 func (e *MessageError) Error() string {
 	return e.Message
 }
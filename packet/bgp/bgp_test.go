@@ -739,3 +739,111 @@ func Test_MpReachNLRIWithVPNv6Prefix(t *testing.T) {
 	// Test serialised value
 	assert.Equal(bufin, bufout)
 }
+
+func Test_AddPath(t *testing.T) {
+	assert := assert.New(t)
+	opt := &MarshallingOption{AddPath: map[RouteFamily]BGPAddPathMode{RF_IPv4_UC: BGP_ADD_PATH_BOTH}}
+	{
+		n1 := NewIPAddrPrefix(24, "10.10.10.0")
+		n1.SetPathLocalIdentifier(10)
+		n2 := NewIPAddrPrefix(24, "20.20.20.0")
+		n2.SetPathLocalIdentifier(20)
+		m1 := NewBGPUpdateMessage([]*IPAddrPrefix{n1}, []PathAttributeInterface{NewPathAttributeOrigin(0), NewPathAttributeNextHop("10.0.0.1")}, []*IPAddrPrefix{n2})
+		bits, err := m1.Serialize(opt)
+		assert.Nil(err)
+		m2, err := ParseBGPMessage(bits, opt)
+		assert.Nil(err)
+		u := m2.Body.(*BGPUpdate)
+		assert.Equal(1, len(u.WithdrawnRoutes))
+		assert.Equal(uint32(10), u.WithdrawnRoutes[0].PathIdentifier())
+		assert.Equal(1, len(u.NLRI))
+		assert.Equal(uint32(20), u.NLRI[0].PathIdentifier())
+		// without the option, the path identifier isn't encoded
+		m1 = NewBGPUpdateMessage([]*IPAddrPrefix{n1}, []PathAttributeInterface{NewPathAttributeOrigin(0), NewPathAttributeNextHop("10.0.0.1")}, []*IPAddrPrefix{n2})
+		bits, err = m1.Serialize()
+		assert.Nil(err)
+		m2, err = ParseBGPMessage(bits)
+		assert.Nil(err)
+		assert.Equal(uint32(0), m2.Body.(*BGPUpdate).NLRI[0].PathIdentifier())
+	}
+	opt = &MarshallingOption{AddPath: map[RouteFamily]BGPAddPathMode{RF_IPv6_UC: BGP_ADD_PATH_BOTH}}
+	{
+		n1 := NewIPv6AddrPrefix(64, "2001::")
+		n1.SetPathLocalIdentifier(10)
+		n2 := NewIPv6AddrPrefix(64, "2002::")
+		n2.SetPathLocalIdentifier(20)
+		m1 := NewBGPUpdateMessage(nil, []PathAttributeInterface{NewPathAttributeOrigin(0), NewPathAttributeMpReachNLRI("fe80::", []AddrPrefixInterface{n1, n2}), NewPathAttributeMpUnreachNLRI([]AddrPrefixInterface{n1})}, nil)
+		bits, err := m1.Serialize(opt)
+		assert.Nil(err)
+		m2, err := ParseBGPMessage(bits, opt)
+		assert.Nil(err)
+		for _, a := range m2.Body.(*BGPUpdate).PathAttributes {
+			switch attr := a.(type) {
+			case *PathAttributeMpReachNLRI:
+				assert.Equal(2, len(attr.Value))
+				assert.Equal(uint32(10), attr.Value[0].PathIdentifier())
+				assert.Equal(uint32(20), attr.Value[1].PathIdentifier())
+			case *PathAttributeMpUnreachNLRI:
+				assert.Equal(1, len(attr.Value))
+				assert.Equal(uint32(10), attr.Value[0].PathIdentifier())
+			}
+		}
+	}
+	{
+		c1 := NewCapAddPath([]*CapAddPathTuple{NewCapAddPathTuple(RF_IPv4_UC, BGP_ADD_PATH_RECEIVE), NewCapAddPathTuple(RF_IPv6_UC, BGP_ADD_PATH_BOTH)})
+		bits, err := c1.Serialize()
+		assert.Nil(err)
+		c2, err := DecodeCapability(bits)
+		assert.Nil(err)
+		assert.Equal(c1.Tuples, c2.(*CapAddPath).Tuples)
+	}
+}
+
+func Test_RevisedErrorHandling(t *testing.T) {
+	assert := assert.New(t)
+	attrs := []PathAttributeInterface{
+		NewPathAttributeOrigin(0),
+		NewPathAttributeAsPath([]AsPathParamInterface{NewAs4PathParam(BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{65001})}),
+		NewPathAttributeNextHop("10.0.0.1"),
+	}
+	nlri := []*IPAddrPrefix{NewIPAddrPrefix(24, "10.10.0.0")}
+	buf, err := NewBGPUpdateMessage(nil, attrs, nlri).Body.Serialize()
+	assert.Nil(err)
+
+	// inserts the malformed attributes after the well-formed ones
+	build := func(malformed ...[]byte) []byte {
+		l := int(binary.BigEndian.Uint16(buf[2:4]))
+		body := make([]byte, 0, len(buf))
+		body = append(body, buf[:4+l]...)
+		for _, a := range malformed {
+			body = append(body, a...)
+			l += len(a)
+		}
+		binary.BigEndian.PutUint16(body[2:4], uint16(l))
+		return append(body, buf[4+int(binary.BigEndian.Uint16(buf[2:4])):]...)
+	}
+	aggregator := []byte{byte(BGP_ATTR_FLAG_OPTIONAL | BGP_ATTR_FLAG_TRANSITIVE), byte(BGP_ATTR_TYPE_AGGREGATOR), 3, 0, 1, 2}
+	localPref := []byte{byte(BGP_ATTR_FLAG_TRANSITIVE), byte(BGP_ATTR_TYPE_LOCAL_PREF), 2, 0, 1}
+
+	// the malformed aggregator is discarded
+	msg, err := ParseBGPBody(&BGPHeader{Type: BGP_MSG_UPDATE}, build(aggregator))
+	assert.NotNil(msg)
+	e, ok := err.(*MessageError)
+	assert.True(ok)
+	assert.Equal(ERROR_HANDLING_ATTRIBUTE_DISCARD, e.ErrorHandling)
+	update := msg.Body.(*BGPUpdate)
+	assert.Equal(3, len(update.PathAttributes))
+	assert.Equal(1, len(update.NLRI))
+
+	// the stronger handling wins
+	msg, err = ParseBGPBody(&BGPHeader{Type: BGP_MSG_UPDATE}, build(aggregator, localPref))
+	assert.NotNil(msg)
+	e = err.(*MessageError)
+	assert.Equal(ERROR_HANDLING_TREAT_AS_WITHDRAW, e.ErrorHandling)
+	assert.Equal(BGP_ATTR_TYPE_LOCAL_PREF, e.ErrorAttribute.GetType())
+
+	// the length overrunning the attributes requires a session reset
+	msg, err = ParseBGPBody(&BGPHeader{Type: BGP_MSG_UPDATE}, build([]byte{byte(BGP_ATTR_FLAG_TRANSITIVE), byte(BGP_ATTR_TYPE_LOCAL_PREF), 8, 0, 1}))
+	assert.Nil(msg)
+	assert.Error(err)
+}
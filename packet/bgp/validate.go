@@ -19,7 +19,10 @@ func ValidateUpdateMsg(m *BGPUpdate, rfs map[RouteFamily]bool, doConfedCheck boo
 		}
 	}
 
+	// the strongest error handled without a session reset (RFC 7606)
+	var revisedError *MessageError
 	seen := make(map[BGPAttrType]PathAttributeInterface)
+	attrs := make([]PathAttributeInterface, 0, len(m.PathAttributes))
 	// check path attribute
 	for _, a := range m.PathAttributes {
 		// check duplication
@@ -27,15 +30,37 @@ func ValidateUpdateMsg(m *BGPUpdate, rfs map[RouteFamily]bool, doConfedCheck boo
 			seen[a.GetType()] = a
 		} else {
 			eMsg := "the path attribute apears twice. Type : " + strconv.Itoa(int(a.GetType()))
-			return false, NewMessageError(eCode, eSubCodeAttrList, nil, eMsg)
+			handling := ERROR_HANDLING_ATTRIBUTE_DISCARD
+			if t := a.GetType(); t == BGP_ATTR_TYPE_MP_REACH_NLRI || t == BGP_ATTR_TYPE_MP_UNREACH_NLRI {
+				handling = ERROR_HANDLING_SESSION_RESET
+			}
+			e := NewMessageErrorWithErrorHandling(eCode, eSubCodeAttrList, nil, handling, a, eMsg).(*MessageError)
+			if handling == ERROR_HANDLING_SESSION_RESET {
+				return false, e
+			}
+			// only the first one is used
+			revisedError = strongerError(revisedError, e)
+			continue
 		}
 
 		//check specific path attribute
-		ok, e := ValidateAttribute(a, rfs, doConfedCheck)
+		ok, err := ValidateAttribute(a, rfs, doConfedCheck)
 		if !ok {
-			return false, e
+			e, y := err.(*MessageError)
+			if !y || e.TypeCode == 0 {
+				// the address family isn't negotiated
+				return false, err
+			}
+			e.ErrorHandling = getErrorHandlingFromPathAttribute(a.GetType())
+			e.ErrorAttribute = a
+			revisedError = strongerError(revisedError, e)
+			if e.ErrorHandling == ERROR_HANDLING_ATTRIBUTE_DISCARD {
+				continue
+			}
 		}
+		attrs = append(attrs, a)
 	}
+	m.PathAttributes = attrs
 
 	if len(m.NLRI) > 0 {
 		// check the existence of well-known mandatory attributes
@@ -52,9 +77,13 @@ func ValidateUpdateMsg(m *BGPUpdate, rfs map[RouteFamily]bool, doConfedCheck boo
 		if ok, t := exist(mandatory); !ok {
 			eMsg := "well-known mandatory attributes are not present. type : " + strconv.Itoa(int(t))
 			data := []byte{byte(t)}
-			return false, NewMessageError(eCode, eSubCodeMissing, data, eMsg)
+			e := NewMessageErrorWithErrorHandling(eCode, eSubCodeMissing, data, ERROR_HANDLING_TREAT_AS_WITHDRAW, nil, eMsg).(*MessageError)
+			revisedError = strongerError(revisedError, e)
 		}
 	}
+	if revisedError != nil {
+		return false, revisedError
+	}
 	return true, nil
 }
 
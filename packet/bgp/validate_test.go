@@ -158,6 +158,9 @@ func Test_Validate_duplicate_attribute(t *testing.T) {
 	assert.Equal(uint8(BGP_ERROR_UPDATE_MESSAGE_ERROR), e.TypeCode)
 	assert.Equal(uint8(BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST), e.SubTypeCode)
 	assert.Nil(e.Data)
+	// only the first one is kept
+	assert.Equal(ERROR_HANDLING_ATTRIBUTE_DISCARD, e.ErrorHandling)
+	assert.Equal(3, len(message.PathAttributes))
 }
 
 func Test_Validate_mandatory_missing(t *testing.T) {
@@ -170,6 +173,7 @@ func Test_Validate_mandatory_missing(t *testing.T) {
 	e := err.(*MessageError)
 	assert.Equal(uint8(BGP_ERROR_UPDATE_MESSAGE_ERROR), e.TypeCode)
 	assert.Equal(uint8(BGP_ERROR_SUB_MISSING_WELL_KNOWN_ATTRIBUTE), e.SubTypeCode)
+	assert.Equal(ERROR_HANDLING_TREAT_AS_WITHDRAW, e.ErrorHandling)
 	missing, _ := binary.Uvarint(e.Data)
 	assert.Equal(uint64(1), missing)
 }
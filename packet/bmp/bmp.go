@@ -42,6 +42,24 @@ const (
 const (
 	BMP_PEER_TYPE_GLOBAL uint8 = iota
 	BMP_PEER_TYPE_L3VPN
+	BMP_PEER_TYPE_LOCAL
+	BMP_PEER_TYPE_LOC_RIB
+)
+
+const (
+	BMP_PEER_FLAG_IPV6        uint8 = 1 << 7
+	BMP_PEER_FLAG_POST_POLICY uint8 = 1 << 6
+	BMP_PEER_FLAG_TWO_AS      uint8 = 1 << 5
+	BMP_PEER_FLAG_ADJ_RIB_OUT uint8 = 1 << 4
+	// the Loc-RIB instance peer uses the flags of its own (RFC 9069)
+	BMP_PEER_FLAG_LOC_RIB_FILTERED uint8 = 1 << 7
+)
+
+const (
+	BMP_INFO_TLV_TYPE_STRING uint16 = iota
+	BMP_INFO_TLV_TYPE_SYS_DESCR
+	BMP_INFO_TLV_TYPE_SYS_NAME
+	BMP_INFO_TLV_TYPE_VRF_TABLE_NAME
 )
 
 func (h *BMPHeader) DecodeFromBytes(data []byte) error {
@@ -65,6 +83,7 @@ func (h *BMPHeader) Serialize() ([]byte, error) {
 type BMPPeerHeader struct {
 	PeerType          uint8
 	IsPostPolicy      bool
+	IsAdjRIBOut       bool
 	PeerDistinguisher uint64
 	PeerAddress       net.IP
 	PeerAS            uint32
@@ -83,27 +102,55 @@ func NewBMPPeerHeader(t uint8, policy bool, dist uint64, address string, as uint
 		Timestamp:         stamp,
 	}
 	if policy == true {
-		h.Flags |= (1 << 6)
+		h.Flags |= BMP_PEER_FLAG_POST_POLICY
 	}
 	if net.ParseIP(address).To4() != nil {
 		h.PeerAddress = net.ParseIP(address).To4()
 	} else {
 		h.PeerAddress = net.ParseIP(address).To16()
-		h.Flags |= (1 << 7)
+		h.Flags |= BMP_PEER_FLAG_IPV6
 	}
 	return h
 }
 
+// NewBMPAdjRIBOutPeerHeader returns the header of the routes advertised
+// to the peer (draft-ietf-grow-bmp-adj-rib-out).
+func NewBMPAdjRIBOutPeerHeader(t uint8, policy bool, dist uint64, address string, as uint32, id string, stamp float64) *BMPPeerHeader {
+	h := NewBMPPeerHeader(t, policy, dist, address, as, id, stamp)
+	h.IsAdjRIBOut = true
+	h.Flags |= BMP_PEER_FLAG_ADJ_RIB_OUT
+	return h
+}
+
+// NewBMPLocRIBPeerHeader returns the header of the Loc-RIB instance
+// peer (RFC 9069). as and id are the ones of the local router.
+func NewBMPLocRIBPeerHeader(dist uint64, as uint32, id string, stamp float64) *BMPPeerHeader {
+	return &BMPPeerHeader{
+		PeerType:          BMP_PEER_TYPE_LOC_RIB,
+		PeerDistinguisher: dist,
+		PeerAddress:       net.IPv4zero.To4(),
+		PeerAS:            as,
+		PeerBGPID:         net.ParseIP(id).To4(),
+		Timestamp:         stamp,
+	}
+}
+
+func (h *BMPPeerHeader) isIPv6() bool {
+	return h.PeerType != BMP_PEER_TYPE_LOC_RIB && h.Flags&BMP_PEER_FLAG_IPV6 != 0
+}
+
 func (h *BMPPeerHeader) DecodeFromBytes(data []byte) error {
 	h.PeerType = data[0]
 	h.Flags = data[1]
-	if h.Flags&(1<<6) != 0 {
-		h.IsPostPolicy = true
+	if h.PeerType != BMP_PEER_TYPE_LOC_RIB {
+		h.IsPostPolicy = h.Flags&BMP_PEER_FLAG_POST_POLICY != 0
+		h.IsAdjRIBOut = h.Flags&BMP_PEER_FLAG_ADJ_RIB_OUT != 0
 	} else {
 		h.IsPostPolicy = false
+		h.IsAdjRIBOut = false
 	}
 	h.PeerDistinguisher = binary.BigEndian.Uint64(data[2:10])
-	if h.Flags&(1<<7) != 0 {
+	if h.isIPv6() {
 		h.PeerAddress = net.IP(data[10:26]).To16()
 	} else {
 		h.PeerAddress = net.IP(data[22:26]).To4()
@@ -122,7 +169,7 @@ func (h *BMPPeerHeader) Serialize() ([]byte, error) {
 	buf[0] = h.PeerType
 	buf[1] = h.Flags
 	binary.BigEndian.PutUint64(buf[2:10], h.PeerDistinguisher)
-	if h.Flags&(1<<7) != 0 {
+	if h.isIPv6() {
 		copy(buf[10:26], h.PeerAddress)
 	} else {
 		copy(buf[22:26], h.PeerAddress.To4())
@@ -269,6 +316,7 @@ type BMPPeerUpNotification struct {
 	RemotePort      uint16
 	SentOpenMsg     *bgp.BGPMessage
 	ReceivedOpenMsg *bgp.BGPMessage
+	Info            []BMPTLV
 }
 
 func NewBMPPeerUpNotification(p BMPPeerHeader, lAddr string, lPort, rPort uint16, sent, recv *bgp.BGPMessage) *BMPMessage {
@@ -295,7 +343,7 @@ func NewBMPPeerUpNotification(p BMPPeerHeader, lAddr string, lPort, rPort uint16
 }
 
 func (body *BMPPeerUpNotification) ParseBody(msg *BMPMessage, data []byte) error {
-	if msg.PeerHeader.Flags&(1<<7) != 0 {
+	if msg.PeerHeader.isIPv6() {
 		body.LocalAddress = net.IP(data[:16]).To16()
 	} else {
 		body.LocalAddress = net.IP(data[12:16]).To4()
@@ -315,6 +363,15 @@ func (body *BMPPeerUpNotification) ParseBody(msg *BMPMessage, data []byte) error
 	if err != nil {
 		return err
 	}
+	data = data[body.ReceivedOpenMsg.Header.Len:]
+	for len(data) > 0 {
+		tlv := BMPTLV{}
+		if err := tlv.DecodeFromBytes(data); err != nil {
+			return err
+		}
+		body.Info = append(body.Info, tlv)
+		data = data[tlv.Len():]
+	}
 	return nil
 }
 
@@ -333,6 +390,13 @@ func (body *BMPPeerUpNotification) Serialize() ([]byte, error) {
 	buf = append(buf, m...)
 	m, _ = body.ReceivedOpenMsg.Serialize()
 	buf = append(buf, m...)
+	for _, tlv := range body.Info {
+		b, err := tlv.Serialize()
+		if err != nil {
+			return buf, err
+		}
+		buf = append(buf, b...)
+	}
 	return buf, nil
 }
 
@@ -388,9 +452,14 @@ func NewBMPTLV(t uint16, v []byte) *BMPTLV {
 }
 
 func (tlv *BMPTLV) DecodeFromBytes(data []byte) error {
-	//TODO: check data length
+	if len(data) < 4 {
+		return fmt.Errorf("not all tlv header bytes are available")
+	}
 	tlv.Type = binary.BigEndian.Uint16(data[0:2])
 	tlv.Length = binary.BigEndian.Uint16(data[2:4])
+	if len(data) < 4+int(tlv.Length) {
+		return fmt.Errorf("not all tlv value bytes are available")
+	}
 	tlv.Value = data[4 : 4+tlv.Length]
 	return nil
 }
@@ -429,7 +498,9 @@ func NewBMPInitiation(info []BMPTLV) *BMPMessage {
 func (body *BMPInitiation) ParseBody(msg *BMPMessage, data []byte) error {
 	for len(data) > 0 {
 		tlv := BMPTLV{}
-		tlv.DecodeFromBytes(data)
+		if err := tlv.DecodeFromBytes(data); err != nil {
+			return err
+		}
 		body.Info = append(body.Info, tlv)
 		data = data[tlv.Len():]
 	}
@@ -467,7 +538,9 @@ func NewBMPTermination(info []BMPTLV) *BMPMessage {
 func (body *BMPTermination) ParseBody(msg *BMPMessage, data []byte) error {
 	for len(data) > 0 {
 		tlv := BMPTLV{}
-		tlv.DecodeFromBytes(data)
+		if err := tlv.DecodeFromBytes(data); err != nil {
+			return err
+		}
 		body.Info = append(body.Info, tlv)
 		data = data[tlv.Len():]
 	}
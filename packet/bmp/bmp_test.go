@@ -54,6 +54,25 @@ func Test_PeerUpNotification(t *testing.T) {
 	verify(t, NewBMPPeerUpNotification(*p1, "fe80::6e40:8ff:feab:2c2a", 10, 100, m, m))
 }
 
+func Test_PeerUpNotificationTruncatedTLV(t *testing.T) {
+	m := bgp.NewTestBGPOpenMessage()
+	p0 := NewBMPPeerHeader(0, false, 1000, "10.0.0.1", 70000, "10.0.0.2", 1)
+	msg := NewBMPPeerUpNotification(*p0, "10.0.0.3", 10, 100, m, m)
+	body := msg.Body.(*BMPPeerUpNotification)
+	body.Info = []BMPTLV{*NewBMPTLV(0, []byte("gobgp"))}
+	buf, err := body.Serialize()
+	assert.Nil(t, err)
+
+	// the value is shorter than the length in the tlv header
+	err = (&BMPPeerUpNotification{}).ParseBody(msg, buf[:len(buf)-2])
+	assert.NotNil(t, err)
+	// only a part of the tlv header is available
+	err = (&BMPPeerUpNotification{}).ParseBody(msg, buf[:len(buf)-7])
+	assert.NotNil(t, err)
+	err = (&BMPPeerUpNotification{}).ParseBody(msg, buf)
+	assert.Nil(t, err)
+}
+
 func Test_PeerDownNotification(t *testing.T) {
 	p0 := NewBMPPeerHeader(0, false, 1000, "10.0.0.1", 70000, "10.0.0.2", 1)
 	verify(t, NewBMPPeerDownNotification(*p0, BMP_PEER_DOWN_REASON_UNKNOWN, nil, []byte{0x3, 0xb}))
@@ -67,6 +86,34 @@ func Test_RouteMonitoring(t *testing.T) {
 	verify(t, NewBMPRouteMonitoring(*p0, m))
 }
 
+func Test_AdjRIBOutPeerHeader(t *testing.T) {
+	m := bgp.NewTestBGPUpdateMessage()
+	p0 := NewBMPAdjRIBOutPeerHeader(BMP_PEER_TYPE_GLOBAL, true, 0, "fe80::6e40:8ff:feab:2c2a", 70000, "10.0.0.2", 1)
+	assert.Equal(t, BMP_PEER_FLAG_IPV6|BMP_PEER_FLAG_POST_POLICY|BMP_PEER_FLAG_ADJ_RIB_OUT, p0.Flags)
+	verify(t, NewBMPRouteMonitoring(*p0, m))
+	p1 := NewBMPAdjRIBOutPeerHeader(BMP_PEER_TYPE_GLOBAL, false, 0, "10.0.0.1", 70000, "10.0.0.2", 1)
+	verify(t, NewBMPRouteMonitoring(*p1, m))
+}
+
+func Test_LocRIBPeerHeader(t *testing.T) {
+	m := bgp.NewTestBGPOpenMessage()
+	p0 := NewBMPLocRIBPeerHeader(0, 70000, "10.0.0.2", 1)
+	up := NewBMPPeerUpNotification(*p0, "0.0.0.0", 0, 0, m, m)
+	up.Body.(*BMPPeerUpNotification).Info = []BMPTLV{*NewBMPTLV(BMP_INFO_TLV_TYPE_VRF_TABLE_NAME, []byte("global"))}
+	verify(t, up)
+	verify(t, NewBMPRouteMonitoring(*p0, bgp.NewTestBGPUpdateMessage()))
+
+	// the same bit as the IPv6 flag means filtered for the Loc-RIB.
+	p0.Flags |= BMP_PEER_FLAG_LOC_RIB_FILTERED
+	buf, err := NewBMPRouteMonitoring(*p0, bgp.NewTestBGPUpdateMessage()).Serialize()
+	assert.Nil(t, err)
+	m2, err := ParseBMPMessage(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, BMP_PEER_TYPE_LOC_RIB, m2.PeerHeader.PeerType)
+	assert.Equal(t, "0.0.0.0", m2.PeerHeader.PeerAddress.String())
+	assert.False(t, m2.PeerHeader.IsPostPolicy)
+}
+
 func Test_BogusHeader(t *testing.T) {
 	h, err := ParseBMPMessage(make([]byte, 10))
 	assert.Nil(t, h)
@@ -0,0 +1,442 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/citizen-insane/gobgp/table"
+)
+
+// aggregate is originated while any route more specific than the prefix,
+// the contributor, is the best path in the Loc-RIB.
+type aggregate struct {
+	config config.AggregateConfig
+	prefix *net.IPNet
+	// the family of the contributors in the global rib, VPN for the
+	// aggregates in VRFs.
+	family       bgp.RouteFamily
+	vrf          *table.Vrf
+	contributors map[string]*table.Path
+	// the originated path, nil while no contributor exists.
+	path   *table.Path
+	origin uint8
+	asList []uint32
+}
+
+func newAggregate(c *config.AggregateConfig, vrfs map[string]*table.Vrf) (*aggregate, error) {
+	_, prefix, err := net.ParseCIDR(c.Prefix)
+	if err != nil {
+		return nil, fmt.Errorf("invalid aggregate prefix: %s", c.Prefix)
+	}
+	a := &aggregate{
+		config:       *c,
+		prefix:       prefix,
+		contributors: make(map[string]*table.Path),
+	}
+	v4 := prefix.IP.To4() != nil
+	if c.Vrf == "" {
+		a.family = bgp.RF_IPv6_UC
+		if v4 {
+			a.family = bgp.RF_IPv4_UC
+		}
+	} else {
+		vrf, ok := vrfs[c.Vrf]
+		if !ok {
+			return nil, fmt.Errorf("vrf %s not found", c.Vrf)
+		}
+		a.vrf = vrf
+		a.family = bgp.RF_IPv6_VPN
+		if v4 {
+			a.family = bgp.RF_IPv4_VPN
+		}
+	}
+	return a, nil
+}
+
+func (a *aggregate) match(c *config.AggregateConfig) bool {
+	_, prefix, err := net.ParseCIDR(c.Prefix)
+	return err == nil && prefix.String() == a.prefix.String() && c.Vrf == a.config.Vrf
+}
+
+func pathIPPrefix(path *table.Path) *net.IPNet {
+	var s string
+	switch n := path.GetNlri().(type) {
+	case *bgp.IPAddrPrefix:
+		s = n.String()
+	case *bgp.IPv6AddrPrefix:
+		s = n.String()
+	case *bgp.LabeledVPNIPAddrPrefix:
+		s = n.IPPrefix()
+	case *bgp.LabeledVPNIPv6AddrPrefix:
+		s = n.IPPrefix()
+	default:
+		return nil
+	}
+	_, prefix, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil
+	}
+	return prefix
+}
+
+// covers returns true if the path is more specific than the aggregate.
+func (a *aggregate) covers(path *table.Path) bool {
+	if path.GetRouteFamily() != a.family {
+		return false
+	}
+	if a.vrf != nil && !table.CanImportToVrf(a.vrf, path) {
+		return false
+	}
+	prefix := pathIPPrefix(path)
+	if prefix == nil {
+		return false
+	}
+	l, _ := a.prefix.Mask.Size()
+	m, _ := prefix.Mask.Size()
+	return m > l && a.prefix.Contains(prefix.IP)
+}
+
+// summary returns the ORIGIN and the AS numbers for AS_SET of the
+// aggregate. The ORIGIN is the one of the lowest preference in the
+// contributors (RFC 4271 9.2.2.2).
+func (a *aggregate) summary() (uint8, []uint32) {
+	origin := uint8(bgp.BGP_ORIGIN_ATTR_TYPE_IGP)
+	asList := make([]uint32, 0)
+	seen := make(map[uint32]bool)
+	for _, path := range a.contributors {
+		if o, err := path.GetOrigin(); err == nil && o > origin {
+			origin = o
+		}
+		if !a.config.AsSet {
+			continue
+		}
+		for _, as := range path.GetAsList() {
+			if !seen[as] {
+				seen[as] = true
+				asList = append(asList, as)
+			}
+		}
+	}
+	sort.Sort(asNumbers(asList))
+	return origin, asList
+}
+
+type asNumbers []uint32
+
+func (l asNumbers) Len() int           { return len(l) }
+func (l asNumbers) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+func (l asNumbers) Less(i, j int) bool { return l[i] < l[j] }
+
+func (a *aggregate) newPath(g *config.Global) *table.Path {
+	params := make([]bgp.AsPathParamInterface, 0)
+	for l := a.asList; len(l) > 0; {
+		n := len(l)
+		if n > 255 {
+			n = 255
+		}
+		params = append(params, bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SET, l[:n]))
+		l = l[n:]
+	}
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(a.origin),
+		bgp.NewPathAttributeAsPath(params),
+	}
+	if !a.config.AsSet {
+		attrs = append(attrs, bgp.NewPathAttributeAtomicAggregate())
+	}
+	attrs = append(attrs, bgp.NewPathAttributeAggregator(g.Config.As, g.Config.RouterId))
+
+	length, _ := a.prefix.Mask.Size()
+	var nlri bgp.AddrPrefixInterface
+	if a.prefix.IP.To4() != nil {
+		nlri = bgp.NewIPAddrPrefix(uint8(length), a.prefix.IP.String())
+		attrs = append(attrs, bgp.NewPathAttributeNextHop("0.0.0.0"))
+	} else {
+		nlri = bgp.NewIPv6AddrPrefix(uint8(length), a.prefix.IP.String())
+		attrs = append(attrs, bgp.NewPathAttributeMpReachNLRI("::", []bgp.AddrPrefixInterface{nlri}))
+	}
+	pi := &table.PeerInfo{
+		AS:      g.Config.As,
+		LocalID: net.ParseIP(g.Config.RouterId).To4(),
+	}
+	path := table.NewPath(pi, nlri, false, attrs, time.Now(), false)
+	if a.vrf != nil {
+		path = path.ToGlobal(a.vrf)
+	}
+	return path
+}
+
+// originate returns the aggregate to be advertised or withdrawn, or nil
+// if it doesn't change.
+func (a *aggregate) originate(g *config.Global) *table.Path {
+	if len(a.contributors) == 0 {
+		if a.path == nil {
+			return nil
+		}
+		path := a.path.Clone(true)
+		a.path = nil
+		return path
+	}
+	origin, asList := a.summary()
+	if a.path != nil && origin == a.origin && len(asList) == len(a.asList) {
+		changed := false
+		for i := range asList {
+			if asList[i] != a.asList[i] {
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			return nil
+		}
+	}
+	a.origin, a.asList = origin, asList
+	a.path = a.newPath(g)
+	return a.path
+}
+
+func (a *aggregate) toConfig() *config.Aggregate {
+	return &config.Aggregate{
+		Config: a.config,
+		State: config.AggregateState{
+			Originated:   a.path != nil,
+			Contributors: uint32(len(a.contributors)),
+		},
+	}
+}
+
+type aggregateManager struct {
+	aggregates []*aggregate
+}
+
+func newAggregateManager() *aggregateManager {
+	return &aggregateManager{
+		aggregates: make([]*aggregate, 0),
+	}
+}
+
+// isSuppressed returns true if the path is a contributor of any
+// summary-only aggregate and not advertised to the neighbors.
+func (m *aggregateManager) isSuppressed(path *table.Path) bool {
+	if m == nil {
+		return false
+	}
+	for _, a := range m.aggregates {
+		if !a.config.SummaryOnly || path.GetRouteFamily() != a.family {
+			continue
+		}
+		if _, ok := a.contributors[path.GetNlri().String()]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *BgpServer) isContributor(a *aggregate, path *table.Path) bool {
+	if path.IsWithdraw || path.IsNexthopInvalid {
+		return false
+	}
+	if a.config.ContributorPolicy == "" {
+		return true
+	}
+	result, err := s.policy.EvaluatePolicy(a.config.ContributorPolicy, path, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Topic": "Aggregate",
+			"Key":   a.config.Prefix,
+			"Error": err,
+		}).Warn("failed to evaluate the contributor policy")
+		return false
+	}
+	return result != table.ROUTE_TYPE_REJECT
+}
+
+// updateAggregates updates the contributors with the best path changes
+// and returns the aggregates to be advertised or withdrawn.
+func (s *BgpServer) updateAggregates(pathList []*table.Path) []*table.Path {
+	if len(s.aggregateManager.aggregates) == 0 {
+		return nil
+	}
+	changed := make(map[*aggregate]bool)
+	for _, path := range pathList {
+		if path == nil || path.IsEOR() {
+			continue
+		}
+		key := path.GetNlri().String()
+		for _, a := range s.aggregateManager.aggregates {
+			if !a.covers(path) {
+				continue
+			}
+			if s.isContributor(a, path) {
+				a.contributors[key] = path
+				changed[a] = true
+			} else if _, ok := a.contributors[key]; ok {
+				delete(a.contributors, key)
+				changed[a] = true
+			}
+		}
+	}
+	l := make([]*table.Path, 0, len(changed))
+	for _, a := range s.aggregateManager.aggregates {
+		if !changed[a] {
+			continue
+		}
+		if path := a.originate(&s.bgpConfig.Global); path != nil {
+			l = append(l, path)
+		}
+	}
+	return l
+}
+
+// scanAggregate collects the contributors in the Loc-RIB again, and
+// returns the contributors whose suppression is changed.
+func (s *BgpServer) scanAggregate(a *aggregate) []*table.Path {
+	old := a.contributors
+	a.contributors = make(map[string]*table.Path)
+	for _, path := range s.globalRib.GetBestPathList(table.GLOBAL_RIB_NAME, []bgp.RouteFamily{a.family}) {
+		if a.covers(path) && s.isContributor(a, path) {
+			a.contributors[path.GetNlri().String()] = path
+		}
+	}
+	l := make([]*table.Path, 0)
+	if !a.config.SummaryOnly {
+		return l
+	}
+	for key, path := range a.contributors {
+		if _, ok := old[key]; !ok {
+			l = append(l, path)
+		}
+	}
+	for key, path := range old {
+		if _, ok := a.contributors[key]; !ok {
+			l = append(l, path)
+		}
+	}
+	return l
+}
+
+// propagateAggregates sends the contributors whose suppression is changed
+// to the neighbors, and advertises or withdraws the aggregates.
+func (s *BgpServer) propagateAggregates(pathList, aggregated []*table.Path) {
+	if len(pathList) > 0 {
+		for _, peer := range s.neighborMap {
+			if peer.isRouteServerClient() {
+				continue
+			}
+			paths, pre := peer.processOutgoingPaths(pathList, nil)
+			addPaths, addPre := peer.processOutgoingAddPaths(pathList)
+			s.sendOutgoingPaths(peer, append(paths, addPaths...), append(pre, addPre...))
+		}
+	}
+	if len(aggregated) > 0 {
+		s.propagateUpdate(nil, aggregated)
+	}
+}
+
+// refreshAggregates evaluates the contributors of all the aggregates
+// again, e.g. after the policies are updated.
+func (s *BgpServer) refreshAggregates() {
+	pathList := make([]*table.Path, 0)
+	aggregated := make([]*table.Path, 0)
+	for _, a := range s.aggregateManager.aggregates {
+		pathList = append(pathList, s.scanAggregate(a)...)
+		if path := a.originate(&s.bgpConfig.Global); path != nil {
+			aggregated = append(aggregated, path)
+		}
+	}
+	s.propagateAggregates(pathList, aggregated)
+}
+
+// AddAggregate originates the aggregate while any more specific route
+// exists in the Loc-RIB of the family, or of the VRF.
+func (s *BgpServer) AddAggregate(c *config.AggregateConfig) error {
+	return s.mgmtOperation(func() error {
+		a, err := newAggregate(c, s.globalRib.Vrfs)
+		if err != nil {
+			return err
+		}
+		for _, b := range s.aggregateManager.aggregates {
+			if b.match(c) {
+				return fmt.Errorf("aggregate %s already exists", c.Prefix)
+			}
+		}
+		if name := c.ContributorPolicy; name != "" {
+			found := false
+			for _, p := range s.policy.GetAllPolicy() {
+				if p.Name == name {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("not found policy %s", name)
+			}
+		}
+		s.aggregateManager.aggregates = append(s.aggregateManager.aggregates, a)
+		pathList := s.scanAggregate(a)
+		aggregated := make([]*table.Path, 0, 1)
+		if path := a.originate(&s.bgpConfig.Global); path != nil {
+			aggregated = append(aggregated, path)
+		}
+		s.propagateAggregates(pathList, aggregated)
+		return nil
+	}, true)
+}
+
+// DeleteAggregate withdraws the aggregate, and advertises the more
+// specific routes suppressed by it.
+func (s *BgpServer) DeleteAggregate(c *config.AggregateConfig) error {
+	return s.mgmtOperation(func() error {
+		for i, a := range s.aggregateManager.aggregates {
+			if !a.match(c) {
+				continue
+			}
+			s.aggregateManager.aggregates = append(s.aggregateManager.aggregates[:i], s.aggregateManager.aggregates[i+1:]...)
+			pathList := make([]*table.Path, 0, len(a.contributors))
+			if a.config.SummaryOnly {
+				for _, path := range a.contributors {
+					pathList = append(pathList, path)
+				}
+			}
+			a.contributors = make(map[string]*table.Path)
+			aggregated := make([]*table.Path, 0, 1)
+			if path := a.originate(&s.bgpConfig.Global); path != nil {
+				aggregated = append(aggregated, path)
+			}
+			s.propagateAggregates(pathList, aggregated)
+			return nil
+		}
+		return fmt.Errorf("aggregate %s not found", c.Prefix)
+	}, true)
+}
+
+func (s *BgpServer) GetAggregate() (l []*config.Aggregate) {
+	s.mgmtOperation(func() error {
+		l = make([]*config.Aggregate, 0, len(s.aggregateManager.aggregates))
+		for _, a := range s.aggregateManager.aggregates {
+			l = append(l, a.toConfig())
+		}
+		return nil
+	}, false)
+	return l
+}
@@ -0,0 +1,268 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/citizen-insane/gobgp/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAggregateTestServer(t *testing.T) *BgpServer {
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       1,
+			RouterId: "1.1.1.1",
+			Port:     -1,
+		},
+	})
+	assert.Nil(t, err)
+	return s
+}
+
+func newContributor(prefix string, length uint8, origin uint8, as []uint32, withdraw bool) *table.Path {
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(origin),
+		bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, as)}),
+		bgp.NewPathAttributeNextHop("10.0.0.1"),
+	}
+	return table.NewPath(nil, bgp.NewIPAddrPrefix(length, prefix), withdraw, attrs, time.Now(), false)
+}
+
+func getBestPath(s *BgpServer, family bgp.RouteFamily, prefix string) *table.Path {
+	rib, err := s.GetRib("", family, nil)
+	if err != nil {
+		return nil
+	}
+	for _, dst := range rib.GetDestinations() {
+		if dst.GetNlri().String() == prefix {
+			return dst.GetBestPath(table.GLOBAL_RIB_NAME)
+		}
+	}
+	return nil
+}
+
+func findPathAttr(path *table.Path, typ bgp.BGPAttrType) bgp.PathAttributeInterface {
+	for _, a := range path.GetPathAttrs() {
+		if a.GetType() == typ {
+			return a
+		}
+	}
+	return nil
+}
+
+func TestAggregate(t *testing.T) {
+	assert := assert.New(t)
+	s := newAggregateTestServer(t)
+	defer s.Stop()
+
+	c := &config.AggregateConfig{Prefix: "10.0.0.0/16"}
+	assert.Nil(s.AddAggregate(c))
+	assert.NotNil(s.AddAggregate(c))
+	assert.NotNil(s.AddAggregate(&config.AggregateConfig{Prefix: "10.0.0.0"}))
+	assert.Nil(getBestPath(s, bgp.RF_IPv4_UC, "10.0.0.0/16"))
+
+	_, err := s.AddPath("", []*table.Path{
+		newContributor("10.0.1.0", 24, bgp.BGP_ORIGIN_ATTR_TYPE_IGP, []uint32{2}, false),
+		newContributor("10.0.2.0", 24, bgp.BGP_ORIGIN_ATTR_TYPE_INCOMPLETE, []uint32{3}, false),
+		newContributor("10.1.0.0", 24, bgp.BGP_ORIGIN_ATTR_TYPE_IGP, []uint32{4}, false),
+	})
+	assert.Nil(err)
+
+	path := getBestPath(s, bgp.RF_IPv4_UC, "10.0.0.0/16")
+	if assert.NotNil(path) {
+		origin, _ := path.GetOrigin()
+		assert.Equal(uint8(bgp.BGP_ORIGIN_ATTR_TYPE_INCOMPLETE), origin)
+		assert.Len(path.GetAsList(), 0)
+		assert.NotNil(findPathAttr(path, bgp.BGP_ATTR_TYPE_ATOMIC_AGGREGATE))
+		a := findPathAttr(path, bgp.BGP_ATTR_TYPE_AGGREGATOR).(*bgp.PathAttributeAggregator)
+		assert.Equal(uint32(1), a.Value.AS)
+		assert.Equal("1.1.1.1", a.Value.Address.String())
+	}
+	l := s.GetAggregate()
+	if assert.Len(l, 1) {
+		assert.True(l[0].State.Originated)
+		assert.Equal(uint32(2), l[0].State.Contributors)
+	}
+
+	// the aggregate is withdrawn with the last contributor.
+	_, err = s.AddPath("", []*table.Path{
+		newContributor("10.0.1.0", 24, bgp.BGP_ORIGIN_ATTR_TYPE_IGP, []uint32{2}, true),
+		newContributor("10.0.2.0", 24, bgp.BGP_ORIGIN_ATTR_TYPE_INCOMPLETE, []uint32{3}, true),
+	})
+	assert.Nil(err)
+	assert.Nil(getBestPath(s, bgp.RF_IPv4_UC, "10.0.0.0/16"))
+	l = s.GetAggregate()
+	if assert.Len(l, 1) {
+		assert.False(l[0].State.Originated)
+		assert.Equal(uint32(0), l[0].State.Contributors)
+	}
+
+	assert.Nil(s.DeleteAggregate(c))
+	assert.NotNil(s.DeleteAggregate(c))
+	assert.Len(s.GetAggregate(), 0)
+}
+
+func TestAggregateAsSet(t *testing.T) {
+	assert := assert.New(t)
+	s := newAggregateTestServer(t)
+	defer s.Stop()
+
+	_, err := s.AddPath("", []*table.Path{
+		newContributor("10.0.1.0", 24, bgp.BGP_ORIGIN_ATTR_TYPE_IGP, []uint32{3, 2}, false),
+		newContributor("10.0.2.0", 24, bgp.BGP_ORIGIN_ATTR_TYPE_IGP, []uint32{4, 2}, false),
+	})
+	assert.Nil(err)
+	c := &config.AggregateConfig{Prefix: "10.0.0.0/16", AsSet: true}
+	assert.Nil(s.AddAggregate(c))
+
+	path := getBestPath(s, bgp.RF_IPv4_UC, "10.0.0.0/16")
+	if assert.NotNil(path) {
+		assert.Equal("{2,3,4}", path.GetAsString())
+		assert.Nil(findPathAttr(path, bgp.BGP_ATTR_TYPE_ATOMIC_AGGREGATE))
+	}
+
+	// the AS_SET follows the contributors.
+	_, err = s.AddPath("", []*table.Path{
+		newContributor("10.0.2.0", 24, bgp.BGP_ORIGIN_ATTR_TYPE_IGP, []uint32{4, 2}, true),
+	})
+	assert.Nil(err)
+	path = getBestPath(s, bgp.RF_IPv4_UC, "10.0.0.0/16")
+	if assert.NotNil(path) {
+		assert.Equal("{2,3}", path.GetAsString())
+	}
+}
+
+func TestAggregateSummaryOnly(t *testing.T) {
+	assert := assert.New(t)
+	s := newAggregateTestServer(t)
+	defer s.Stop()
+
+	_, err := s.AddPath("", []*table.Path{
+		newContributor("10.0.1.0", 24, bgp.BGP_ORIGIN_ATTR_TYPE_IGP, []uint32{2}, false),
+	})
+	assert.Nil(err)
+	contributor := getBestPath(s, bgp.RF_IPv4_UC, "10.0.1.0/24")
+	assert.NotNil(contributor)
+
+	c := &config.AggregateConfig{Prefix: "10.0.0.0/16", SummaryOnly: true}
+	assert.Nil(s.AddAggregate(c))
+	assert.True(s.aggregateManager.isSuppressed(contributor))
+	aggregate := getBestPath(s, bgp.RF_IPv4_UC, "10.0.0.0/16")
+	if assert.NotNil(aggregate) {
+		assert.False(s.aggregateManager.isSuppressed(aggregate))
+	}
+
+	assert.Nil(s.DeleteAggregate(c))
+	assert.False(s.aggregateManager.isSuppressed(contributor))
+	assert.Nil(getBestPath(s, bgp.RF_IPv4_UC, "10.0.0.0/16"))
+}
+
+func TestAggregateContributorPolicy(t *testing.T) {
+	assert := assert.New(t)
+	s := newAggregateTestServer(t)
+	defer s.Stop()
+
+	policy := config.RoutingPolicy{
+		DefinedSets: config.DefinedSets{
+			PrefixSets: []config.PrefixSet{{
+				PrefixSetName: "ps1",
+				PrefixList: []config.Prefix{{
+					IpPrefix:        "10.0.1.0/24",
+					MasklengthRange: "24..32",
+				}},
+			}},
+		},
+		PolicyDefinitions: []config.PolicyDefinition{{
+			Name: "contributor",
+			Statements: []config.Statement{{
+				Name: "st1",
+				Conditions: config.Conditions{
+					MatchPrefixSet: config.MatchPrefixSet{PrefixSet: "ps1"},
+				},
+				Actions: config.Actions{
+					RouteDisposition: config.ROUTE_DISPOSITION_REJECT_ROUTE,
+				},
+			}},
+		}},
+	}
+	assert.Nil(s.UpdatePolicy(policy))
+
+	c := &config.AggregateConfig{Prefix: "10.0.0.0/16", ContributorPolicy: "contributor"}
+	assert.NotNil(s.AddAggregate(&config.AggregateConfig{Prefix: "10.0.0.0/16", ContributorPolicy: "unknown"}))
+	assert.Nil(s.AddAggregate(c))
+
+	_, err := s.AddPath("", []*table.Path{
+		newContributor("10.0.1.0", 24, bgp.BGP_ORIGIN_ATTR_TYPE_IGP, []uint32{2}, false),
+	})
+	assert.Nil(err)
+	assert.Nil(getBestPath(s, bgp.RF_IPv4_UC, "10.0.0.0/16"))
+
+	_, err = s.AddPath("", []*table.Path{
+		newContributor("10.0.2.0", 24, bgp.BGP_ORIGIN_ATTR_TYPE_IGP, []uint32{2}, false),
+	})
+	assert.Nil(err)
+	assert.NotNil(getBestPath(s, bgp.RF_IPv4_UC, "10.0.0.0/16"))
+	l := s.GetAggregate()
+	if assert.Len(l, 1) {
+		assert.Equal(uint32(1), l[0].State.Contributors)
+	}
+
+	// the contributors are evaluated again with the updated policy.
+	policy.DefinedSets.PrefixSets[0].PrefixList[0].IpPrefix = "10.0.3.0/24"
+	assert.Nil(s.UpdatePolicy(policy))
+	l = s.GetAggregate()
+	if assert.Len(l, 1) {
+		assert.Equal(uint32(2), l[0].State.Contributors)
+	}
+}
+
+func TestAggregateVrf(t *testing.T) {
+	assert := assert.New(t)
+	s := newAggregateTestServer(t)
+	defer s.Stop()
+
+	c := &config.AggregateConfig{Prefix: "10.0.0.0/16", Vrf: "vrf1"}
+	assert.NotNil(s.AddAggregate(c))
+
+	rd, _ := bgp.ParseRouteDistinguisher("100:100")
+	rt, _ := bgp.ParseRouteTarget("100:100")
+	rts := []bgp.ExtendedCommunityInterface{rt}
+	assert.Nil(s.AddVrf("vrf1", 1, rd, rts, rts))
+	assert.Nil(s.AddAggregate(c))
+
+	_, err := s.AddPath("vrf1", []*table.Path{
+		newContributor("10.0.1.0", 24, bgp.BGP_ORIGIN_ATTR_TYPE_IGP, []uint32{2}, false),
+	})
+	assert.Nil(err)
+	assert.NotNil(getBestPath(s, bgp.RF_IPv4_VPN, "100:100:10.0.0.0/16"))
+	l := s.GetAggregate()
+	if assert.Len(l, 1) {
+		assert.True(l[0].State.Originated)
+	}
+
+	// the VRF can't be deleted while the aggregate is in it.
+	assert.NotNil(s.DeleteVrf("vrf1"))
+	assert.Nil(s.DeleteAggregate(c))
+	assert.Nil(getBestPath(s, bgp.RF_IPv4_VPN, "100:100:10.0.0.0/16"))
+	assert.Nil(s.DeleteVrf("vrf1"))
+}
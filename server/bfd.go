@@ -0,0 +1,510 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	log "github.com/Sirupsen/logrus"
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bfd"
+	"gopkg.in/tomb.v2"
+	"math/rand"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// RFC 5880 6.8.3: the transmit interval must not be less than one
+	// second while the session isn't up.
+	bfdSlowTxInterval = 1000000
+	// RFC 5881 5 and RFC 5883 2: the packets are sent with the maximum
+	// TTL, and the single-hop session accepts only them.
+	bfdTTL = 255
+)
+
+type bfdSession struct {
+	t       tomb.Tomb
+	mu      sync.RWMutex
+	addr    string
+	remote  net.IP
+	conf    config.BfdConfig
+	conn    *net.UDPConn
+	rxCh    chan *bfd.BFDControlPacket
+	downCh  chan struct{}
+	state   bfd.BFDState
+	diag    bfd.BFDDiagnostic
+	polling bool
+	uptime  time.Time
+	upCount uint32
+	// the variables below are in microseconds as in the packet
+	localDisc        uint32
+	remoteDisc       uint32
+	remoteState      bfd.BFDState
+	remoteMinRx      uint32
+	remoteMinTx      uint32
+	remoteDetectMult uint8
+}
+
+func (s *bfdSession) desiredMinTx() uint32 {
+	tx := s.conf.DesiredMinTxInterval * 1000
+	if s.state != bfd.BFD_STATE_UP && tx < bfdSlowTxInterval {
+		return bfdSlowTxInterval
+	}
+	return tx
+}
+
+// RFC 5880 6.8.7: the interval is the larger of the local desired one and
+// the remote required one, reduced by 0 to 25 percent at random.
+func (s *bfdSession) txInterval() time.Duration {
+	tx := s.desiredMinTx()
+	if s.remoteMinRx > tx {
+		tx = s.remoteMinRx
+	}
+	jitter := 75 + rand.Intn(26)
+	if s.conf.DetectMultiplier == 1 {
+		jitter = 75 + rand.Intn(16)
+	}
+	return time.Duration(tx) * time.Microsecond * time.Duration(jitter) / 100
+}
+
+// RFC 5880 6.8.4: the detection time in the asynchronous mode.
+func (s *bfdSession) detectionTime() time.Duration {
+	rx := s.conf.RequiredMinRxInterval * 1000
+	if s.remoteMinTx > rx {
+		rx = s.remoteMinTx
+	}
+	return time.Duration(s.remoteDetectMult) * time.Duration(rx) * time.Microsecond
+}
+
+func (s *bfdSession) setState(state bfd.BFDState, diag bfd.BFDDiagnostic) {
+	if s.state == state {
+		return
+	}
+	log.WithFields(log.Fields{
+		"Topic": "BFD",
+		"Key":   s.addr,
+		"old":   s.state.String(),
+		"new":   state.String(),
+		"diag":  diag.String(),
+	}).Info("BFD session state changed")
+
+	old := s.state
+	s.state = state
+	s.diag = diag
+	if state == bfd.BFD_STATE_UP {
+		s.uptime = time.Now()
+		s.upCount++
+		// the transmit interval is changed from the slow one.
+		s.polling = true
+	} else if old == bfd.BFD_STATE_UP && s.remoteState != bfd.BFD_STATE_ADMIN_DOWN {
+		// RFC 5882 3.2: the session brought down administratively by
+		// the remote system isn't a failure of the path.
+		select {
+		case s.downCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (s *bfdSession) send(flags uint8) {
+	if s.polling && flags&bfd.BFD_FLAG_FINAL == 0 {
+		flags |= bfd.BFD_FLAG_POLL
+	}
+	pkt := &bfd.BFDControlPacket{
+		Diagnostic:            s.diag,
+		State:                 s.state,
+		Flags:                 flags,
+		DetectMult:            s.conf.DetectMultiplier,
+		MyDiscriminator:       s.localDisc,
+		YourDiscriminator:     s.remoteDisc,
+		DesiredMinTxInterval:  s.desiredMinTx(),
+		RequiredMinRxInterval: s.conf.RequiredMinRxInterval * 1000,
+	}
+	buf, _ := pkt.Serialize()
+	if _, err := s.conn.Write(buf); err != nil {
+		log.WithFields(log.Fields{
+			"Topic": "BFD",
+			"Key":   s.addr,
+			"Error": err,
+		}).Debug("failed to send BFD control packet")
+	}
+}
+
+// handle runs the reception procedure of RFC 5880 6.8.6 and returns the
+// detection time.
+func (s *bfdSession) handle(pkt *bfd.BFDControlPacket) time.Duration {
+	if pkt.Flags&bfd.BFD_FLAG_FINAL != 0 {
+		s.polling = false
+	}
+	s.remoteDisc = pkt.MyDiscriminator
+	s.remoteState = pkt.State
+	s.remoteMinRx = pkt.RequiredMinRxInterval
+	s.remoteMinTx = pkt.DesiredMinTxInterval
+	s.remoteDetectMult = pkt.DetectMult
+
+	old := s.state
+	if pkt.State == bfd.BFD_STATE_ADMIN_DOWN {
+		s.setState(bfd.BFD_STATE_DOWN, bfd.BFD_DIAG_NEIGHBOR_SIGNALED_SESSION_DOWN)
+	} else {
+		switch s.state {
+		case bfd.BFD_STATE_DOWN:
+			if pkt.State == bfd.BFD_STATE_DOWN {
+				s.setState(bfd.BFD_STATE_INIT, bfd.BFD_DIAG_NONE)
+			} else if pkt.State == bfd.BFD_STATE_INIT {
+				s.setState(bfd.BFD_STATE_UP, bfd.BFD_DIAG_NONE)
+			}
+		case bfd.BFD_STATE_INIT:
+			if pkt.State == bfd.BFD_STATE_INIT || pkt.State == bfd.BFD_STATE_UP {
+				s.setState(bfd.BFD_STATE_UP, bfd.BFD_DIAG_NONE)
+			}
+		case bfd.BFD_STATE_UP:
+			if pkt.State == bfd.BFD_STATE_DOWN {
+				s.setState(bfd.BFD_STATE_DOWN, bfd.BFD_DIAG_NEIGHBOR_SIGNALED_SESSION_DOWN)
+			}
+		}
+	}
+
+	if pkt.Flags&bfd.BFD_FLAG_POLL != 0 {
+		s.send(bfd.BFD_FLAG_FINAL)
+	} else if s.state != old {
+		s.send(0)
+	}
+	return s.detectionTime()
+}
+
+func (s *bfdSession) loop() error {
+	txTimer := time.NewTimer(0)
+	detectTimer := time.NewTimer(time.Hour)
+	detectTimer.Stop()
+	defer func() {
+		txTimer.Stop()
+		detectTimer.Stop()
+		s.conn.Close()
+	}()
+
+	for {
+		select {
+		case <-s.t.Dying():
+			// tell the remote system that the session is
+			// deconfigured rather than failed.
+			s.mu.Lock()
+			s.state = bfd.BFD_STATE_ADMIN_DOWN
+			s.diag = bfd.BFD_DIAG_ADMINISTRATIVELY_DOWN
+			s.send(0)
+			s.mu.Unlock()
+			return nil
+		case pkt := <-s.rxCh:
+			s.mu.Lock()
+			d := s.handle(pkt)
+			s.mu.Unlock()
+			detectTimer.Reset(d)
+		case <-txTimer.C:
+			s.mu.Lock()
+			// RFC 5880 6.8.7: the remote system doesn't want the
+			// periodic packets.
+			if s.remoteMinRx != 0 {
+				s.send(0)
+			}
+			d := s.txInterval()
+			s.mu.Unlock()
+			txTimer.Reset(d)
+		case <-detectTimer.C:
+			s.mu.Lock()
+			if s.state == bfd.BFD_STATE_INIT || s.state == bfd.BFD_STATE_UP {
+				s.setState(bfd.BFD_STATE_DOWN, bfd.BFD_DIAG_CONTROL_DETECTION_TIME_EXPIRED)
+				s.remoteDisc = 0
+			}
+			s.mu.Unlock()
+		}
+	}
+}
+
+func (s *bfdSession) ToConfig() config.BfdState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	state := config.BfdState{
+		SessionState:        s.state.String(),
+		Diagnostic:          s.diag.String(),
+		LocalDiscriminator:  s.localDisc,
+		RemoteDiscriminator: s.remoteDisc,
+		DetectionTime:       uint32(s.detectionTime() / time.Millisecond),
+		UpCount:             s.upCount,
+	}
+	if s.state == bfd.BFD_STATE_UP {
+		state.Uptime = s.uptime.Unix()
+	}
+	return state
+}
+
+type bfdManager struct {
+	mu           sync.RWMutex
+	sessions     map[string]*bfdSession
+	listeners    map[string]*net.UDPConn
+	port         int
+	multihopPort int
+}
+
+func newBfdManager() *bfdManager {
+	return &bfdManager{
+		sessions:     make(map[string]*bfdSession),
+		listeners:    make(map[string]*net.UDPConn),
+		port:         bfd.BFD_PORT,
+		multihopPort: bfd.BFD_MULTIHOP_PORT,
+	}
+}
+
+func (m *bfdManager) listen(addrs []string, multihop bool) error {
+	port := m.port
+	if multihop {
+		port = m.multihopPort
+	}
+	for _, addr := range addrs {
+		hostport := net.JoinHostPort(addr, strconv.Itoa(port))
+		if _, y := m.listeners[hostport]; y {
+			continue
+		}
+		proto := "udp4"
+		if ip := net.ParseIP(addr); ip == nil {
+			return fmt.Errorf("can't listen on %s", addr)
+		} else if ip.To4() == nil {
+			proto = "udp6"
+		}
+		laddr, err := net.ResolveUDPAddr(proto, hostport)
+		if err != nil {
+			return err
+		}
+		conn, err := net.ListenUDP(proto, laddr)
+		if err != nil {
+			return err
+		}
+		if !multihop {
+			if err := SetUdpRecvTTLSockopts(conn); err != nil {
+				log.WithFields(log.Fields{
+					"Topic": "BFD",
+					"Key":   hostport,
+					"Error": err,
+				}).Debug("failed to set sockopt to receive ttl")
+			}
+		}
+		m.listeners[hostport] = conn
+		go m.serve(conn, multihop)
+	}
+	return nil
+}
+
+func (m *bfdManager) serve(conn *net.UDPConn, multihop bool) {
+	buf := make([]byte, 1500)
+	oob := make([]byte, 64)
+	for {
+		n, oobn, _, raddr, err := conn.ReadMsgUDP(buf, oob)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Topic": "BFD",
+				"Key":   conn.LocalAddr().String(),
+				"Error": err,
+			}).Debug("stop receiving BFD control packets")
+			return
+		}
+		pkt, err := bfd.ParseBFDControlPacket(buf[:n])
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Topic": "BFD",
+				"Key":   raddr.String(),
+				"Error": err,
+			}).Debug("malformed BFD control packet")
+			continue
+		}
+		// the authentication isn't configured.
+		if pkt.Flags&bfd.BFD_FLAG_AUTHENTICATION != 0 {
+			continue
+		}
+		if !multihop {
+			if ttl, err := ParseUdpRecvTTL(oob[:oobn]); err == nil && ttl != bfdTTL {
+				continue
+			}
+		}
+		if s := m.lookup(pkt, raddr.IP, multihop); s != nil {
+			select {
+			case s.rxCh <- pkt:
+			default:
+			}
+		}
+	}
+}
+
+// RFC 5880 6.8.6: the session is selected by Your Discriminator, or by the
+// source address if it's zero.
+func (m *bfdManager) lookup(pkt *bfd.BFDControlPacket, src net.IP, multihop bool) *bfdSession {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, s := range m.sessions {
+		if s.conf.Multihop != multihop {
+			continue
+		}
+		if pkt.YourDiscriminator != 0 {
+			if s.localDisc == pkt.YourDiscriminator {
+				return s
+			}
+		} else if s.remote.Equal(src) {
+			return s
+		}
+	}
+	return nil
+}
+
+func (m *bfdManager) newDiscriminator() uint32 {
+	for {
+		disc := rand.Uint32()
+		if disc == 0 {
+			continue
+		}
+		unique := true
+		for _, s := range m.sessions {
+			if s.localDisc == disc {
+				unique = false
+				break
+			}
+		}
+		if unique {
+			return disc
+		}
+	}
+}
+
+// RFC 5881 4: the source port must be in the range 49152 through 65535.
+func (m *bfdManager) dial(local, remote *net.UDPAddr) (*net.UDPConn, error) {
+	proto := "udp4"
+	if remote.IP.To4() == nil {
+		proto = "udp6"
+	}
+	var err error
+	for i := 0; i < 16; i++ {
+		local.Port = bfd.BFD_MIN_SOURCE_PORT + rand.Intn(bfd.BFD_MAX_SOURCE_PORT-bfd.BFD_MIN_SOURCE_PORT+1)
+		var conn *net.UDPConn
+		if conn, err = net.DialUDP(proto, local, remote); err == nil {
+			if err := SetUdpTTLSockopts(conn, bfdTTL); err != nil {
+				log.WithFields(log.Fields{
+					"Topic": "BFD",
+					"Key":   remote.String(),
+					"Error": err,
+				}).Warn("failed to set ttl of BFD control packets")
+			}
+			return conn, nil
+		}
+	}
+	return nil, err
+}
+
+// addSession starts the BFD session with the neighbor. The listeners are
+// opened on addrs when the first session of the kind is added. The
+// transition from up to down is notified to downCh.
+func (m *bfdManager) addSession(c *config.Neighbor, addrs []string, downCh chan struct{}) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	addr := c.Config.NeighborAddress
+	if _, y := m.sessions[addr]; y {
+		return fmt.Errorf("bfd session for %s already exists", addr)
+	}
+	host, zone := addr, ""
+	if i := strings.Index(addr, "%"); i >= 0 {
+		host, zone = addr[:i], addr[i+1:]
+	}
+	remote := net.ParseIP(host)
+	if remote == nil {
+		return fmt.Errorf("invalid bfd neighbor address: %s", addr)
+	}
+
+	conf := c.Bfd.Config
+	if conf.DesiredMinTxInterval == 0 {
+		conf.DesiredMinTxInterval = config.DEFAULT_BFD_MIN_INTERVAL
+	}
+	if conf.RequiredMinRxInterval == 0 {
+		conf.RequiredMinRxInterval = config.DEFAULT_BFD_MIN_INTERVAL
+	}
+	if conf.DetectMultiplier == 0 {
+		conf.DetectMultiplier = config.DEFAULT_BFD_DETECT_MULTIPLIER
+	}
+
+	if err := m.listen(addrs, conf.Multihop); err != nil {
+		return err
+	}
+	port := m.port
+	if conf.Multihop {
+		port = m.multihopPort
+	}
+	local := &net.UDPAddr{IP: net.ParseIP(c.Transport.Config.LocalAddress)}
+	conn, err := m.dial(local, &net.UDPAddr{IP: remote, Port: port, Zone: zone})
+	if err != nil {
+		return err
+	}
+
+	s := &bfdSession{
+		addr:        addr,
+		remote:      remote,
+		conf:        conf,
+		conn:        conn,
+		rxCh:        make(chan *bfd.BFDControlPacket, 16),
+		downCh:      downCh,
+		state:       bfd.BFD_STATE_DOWN,
+		localDisc:   m.newDiscriminator(),
+		remoteMinRx: 1,
+	}
+	m.sessions[addr] = s
+	log.WithFields(log.Fields{
+		"Topic": "BFD",
+		"Key":   addr,
+	}).Info("Add a BFD session")
+	s.t.Go(s.loop)
+	return nil
+}
+
+func (m *bfdManager) deleteSession(addr string) {
+	m.mu.Lock()
+	s, y := m.sessions[addr]
+	if !y {
+		m.mu.Unlock()
+		return
+	}
+	delete(m.sessions, addr)
+	if len(m.sessions) == 0 {
+		for k, l := range m.listeners {
+			l.Close()
+			delete(m.listeners, k)
+		}
+	}
+	m.mu.Unlock()
+
+	log.WithFields(log.Fields{
+		"Topic": "BFD",
+		"Key":   addr,
+	}).Info("Delete a BFD session")
+	s.t.Kill(nil)
+	s.t.Wait()
+}
+
+func (m *bfdManager) getState(addr string) (config.BfdState, bool) {
+	m.mu.RLock()
+	s, y := m.sessions[addr]
+	m.mu.RUnlock()
+	if !y {
+		return config.BfdState{}, false
+	}
+	return s.ToConfig(), true
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func newBfdTestNeighbor(local, remote string) *config.Neighbor {
+	return &config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: remote,
+		},
+		Transport: config.Transport{
+			Config: config.TransportConfig{
+				LocalAddress: local,
+			},
+		},
+		Bfd: config.Bfd{
+			Config: config.BfdConfig{
+				Enabled:               true,
+				DesiredMinTxInterval:  50,
+				RequiredMinRxInterval: 50,
+				DetectMultiplier:      3,
+			},
+		},
+	}
+}
+
+func waitBfdState(m *bfdManager, addr, state string) bool {
+	for i := 0; i < 100; i++ {
+		if s, _ := m.getState(addr); s.SessionState == state {
+			return true
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	return false
+}
+
+func TestBfdSession(t *testing.T) {
+	assert := assert.New(t)
+
+	// two systems on the loopback addresses talk to each other on
+	// an unprivileged port.
+	a := newBfdManager()
+	a.port = 13784
+	b := newBfdManager()
+	b.port = 13784
+
+	aDownCh := make(chan struct{}, 1)
+	err := a.addSession(newBfdTestNeighbor("127.0.0.1", "127.0.0.2"), []string{"127.0.0.1"}, aDownCh)
+	if err != nil {
+		t.Skip("can't open the BFD session:", err)
+	}
+	defer a.deleteSession("127.0.0.2")
+	bDownCh := make(chan struct{}, 1)
+	err = b.addSession(newBfdTestNeighbor("127.0.0.2", "127.0.0.1"), []string{"127.0.0.2"}, bDownCh)
+	if err != nil {
+		t.Skip("can't open the BFD session:", err)
+	}
+	defer b.deleteSession("127.0.0.1")
+
+	assert.True(waitBfdState(a, "127.0.0.2", "up"))
+	assert.True(waitBfdState(b, "127.0.0.1", "up"))
+	s, _ := a.getState("127.0.0.2")
+	assert.Equal(uint32(1), s.UpCount)
+	assert.Equal(uint32(150), s.DetectionTime)
+	assert.NotZero(s.RemoteDiscriminator)
+
+	// b stops receiving the packets so that both detection timers
+	// expire.
+	b.mu.Lock()
+	for _, l := range b.listeners {
+		l.Close()
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-aDownCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("BFD session down isn't notified")
+	}
+	assert.True(waitBfdState(a, "127.0.0.2", "down"))
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/citizen-insane/gobgp/packet/bmp"
 	"github.com/citizen-insane/gobgp/table"
 	"net"
+	"sort"
 	"strconv"
 	"time"
 )
@@ -118,6 +119,14 @@ func (b *bmpClient) loop() {
 			} else if b.typ != config.BMP_ROUTE_MONITORING_POLICY_TYPE_PRE_POLICY {
 				ops = append(ops, WatchPostUpdate(true))
 			}
+			if b.locRib {
+				ops = append(ops, WatchBestPath(true))
+			}
+			if b.adjOut != "" {
+				pre := b.adjOut != config.BMP_ROUTE_MONITORING_POLICY_TYPE_POST_POLICY
+				post := b.adjOut != config.BMP_ROUTE_MONITORING_POLICY_TYPE_PRE_POLICY
+				ops = append(ops, WatchAdjOut(pre, post, true))
+			}
 			w := b.s.Watch(ops...)
 			defer w.Stop()
 
@@ -134,6 +143,18 @@ func (b *bmpClient) loop() {
 				return false
 			}
 
+			var local *table.PeerInfo
+			if b.locRib {
+				g := b.s.GetServer()
+				local = &table.PeerInfo{
+					AS: g.Config.As,
+					ID: net.ParseIP(g.Config.RouterId),
+				}
+				if err := write(bmpLocRibPeerUp(local, time.Now().Unix())); err != nil {
+					return false
+				}
+			}
+
 			for {
 				select {
 				case ev := <-w.Event():
@@ -162,6 +183,45 @@ func (b *bmpClient) loop() {
 								return false
 							}
 						}
+					case *WatchEventBestPath:
+						// the multiple paths aren't in the Loc-RIB.
+						families := make([]bgp.RouteFamily, 0)
+						seen := make(map[bgp.RouteFamily]bool)
+						for _, p := range msg.PathList {
+							if rf := p.GetRouteFamily(); !seen[rf] {
+								seen[rf] = true
+								families = append(families, rf)
+							}
+						}
+						for _, u := range table.CreateUpdateMsgFromPaths(msg.PathList) {
+							payload, _ := u.Serialize()
+							if err := write(bmpLocRibRoute(local, time.Now().Unix(), payload)); err != nil {
+								return false
+							}
+						}
+						if !msg.Snapshot {
+							break
+						}
+						// RFC 9069 5. the initial table dump ends with
+						// End-of-RIB of each family.
+						for _, rf := range families {
+							payload, _ := bgp.NewEndOfRib(rf).Serialize()
+							if err := write(bmpLocRibRoute(local, time.Now().Unix(), payload)); err != nil {
+								return false
+							}
+						}
+					case *WatchEventAdjOut:
+						info := &table.PeerInfo{
+							Address: msg.PeerAddress,
+							AS:      msg.PeerAS,
+							ID:      msg.PeerID,
+						}
+						for _, u := range table.CreateUpdateMsgFromPaths(msg.PathList) {
+							payload, _ := u.Serialize()
+							if err := write(bmpPeerAdjOutRoute(bmp.BMP_PEER_TYPE_GLOBAL, msg.PostPolicy, 0, info, msg.Timestamp.Unix(), payload)); err != nil {
+								return false
+							}
+						}
 					case *WatchEventPeerState:
 						info := &table.PeerInfo{
 							Address: msg.PeerAddress,
@@ -194,6 +254,8 @@ type bmpClient struct {
 	dead   chan struct{}
 	host   string
 	typ    config.BmpRouteMonitoringPolicyType
+	locRib bool
+	adjOut config.BmpRouteMonitoringPolicyType
 	ribout ribout
 }
 
@@ -209,6 +271,37 @@ func bmpPeerDown(reason uint8, t uint8, policy bool, pd uint64, peeri *table.Pee
 
 func bmpPeerRoute(t uint8, policy bool, pd uint64, peeri *table.PeerInfo, timestamp int64, payload []byte) *bmp.BMPMessage {
 	ph := bmp.NewBMPPeerHeader(t, policy, pd, peeri.Address.String(), peeri.AS, peeri.ID.String(), float64(timestamp))
+	return bmpRoute(ph, payload)
+}
+
+func bmpPeerAdjOutRoute(t uint8, policy bool, pd uint64, peeri *table.PeerInfo, timestamp int64, payload []byte) *bmp.BMPMessage {
+	ph := bmp.NewBMPAdjRIBOutPeerHeader(t, policy, pd, peeri.Address.String(), peeri.AS, peeri.ID.String(), float64(timestamp))
+	return bmpRoute(ph, payload)
+}
+
+// the Loc-RIB instance peer is the local router itself (RFC 9069).
+func bmpLocRibPeerUp(local *table.PeerInfo, timestamp int64) *bmp.BMPMessage {
+	ph := bmp.NewBMPLocRIBPeerHeader(0, local.AS, local.ID.String(), float64(timestamp))
+	// the OPEN messages are fabricated. the sent and received ones
+	// are the same.
+	as := local.AS
+	if as > (1<<16)-1 {
+		as = bgp.AS_TRANS
+	}
+	caps := []bgp.ParameterCapabilityInterface{bgp.NewCapFourOctetASNumber(local.AS)}
+	open := bgp.NewBGPOpenMessage(uint16(as), 0, local.ID.String(), []bgp.OptionParameterInterface{bgp.NewOptionParameterCapability(caps)})
+	m := bmp.NewBMPPeerUpNotification(*ph, net.IPv4zero.String(), 0, 0, open, open)
+	body := m.Body.(*bmp.BMPPeerUpNotification)
+	body.Info = []bmp.BMPTLV{*bmp.NewBMPTLV(bmp.BMP_INFO_TLV_TYPE_VRF_TABLE_NAME, []byte(table.GLOBAL_RIB_NAME))}
+	return m
+}
+
+func bmpLocRibRoute(local *table.PeerInfo, timestamp int64, payload []byte) *bmp.BMPMessage {
+	ph := bmp.NewBMPLocRIBPeerHeader(0, local.AS, local.ID.String(), float64(timestamp))
+	return bmpRoute(ph, payload)
+}
+
+func bmpRoute(ph *bmp.BMPPeerHeader, payload []byte) *bmp.BMPMessage {
 	m := bmp.NewBMPRouteMonitoring(*ph, nil)
 	body := m.Body.(*bmp.BMPRouteMonitoring)
 	body.BGPUpdatePayload = payload
@@ -220,11 +313,23 @@ func (b *bmpClientManager) addServer(c *config.BmpServerConfig) error {
 	if _, y := b.clientMap[host]; y {
 		return fmt.Errorf("bmp client %s is already configured", host)
 	}
+	if err := c.RouteMonitoringPolicy.Validate(); c.RouteMonitoringPolicy != "" && err != nil {
+		return err
+	}
+	if err := c.RouteMonitoringAdjRibOut.Validate(); c.RouteMonitoringAdjRibOut != "" && err != nil {
+		return err
+	}
+	typ := c.RouteMonitoringPolicy
+	if typ == "" {
+		typ = config.BMP_ROUTE_MONITORING_POLICY_TYPE_PRE_POLICY
+	}
 	b.clientMap[host] = &bmpClient{
 		s:      b.s,
 		dead:   make(chan struct{}),
 		host:   host,
-		typ:    c.RouteMonitoringPolicy,
+		typ:    typ,
+		locRib: c.RouteMonitoringLocRib,
+		adjOut: c.RouteMonitoringAdjRibOut,
 		ribout: newribout(),
 	}
 	go b.clientMap[host].loop()
@@ -242,6 +347,30 @@ func (b *bmpClientManager) deleteServer(c *config.BmpServerConfig) error {
 	return nil
 }
 
+func (b *bmpClientManager) getConfig() []config.BmpServer {
+	hosts := make([]string, 0, len(b.clientMap))
+	for host := range b.clientMap {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	l := make([]config.BmpServer, 0, len(hosts))
+	for _, host := range hosts {
+		c := b.clientMap[host]
+		addr, port, _ := net.SplitHostPort(c.host)
+		p, _ := strconv.Atoi(port)
+		l = append(l, config.BmpServer{
+			Config: config.BmpServerConfig{
+				Address:                  addr,
+				Port:                     uint32(p),
+				RouteMonitoringPolicy:    c.typ,
+				RouteMonitoringLocRib:    c.locRib,
+				RouteMonitoringAdjRibOut: c.adjOut,
+			},
+		})
+	}
+	return l
+}
+
 type bmpClientManager struct {
 	s         *BgpServer
 	clientMap map[string]*bmpClient
@@ -0,0 +1,205 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/citizen-insane/gobgp/packet/bmp"
+	"github.com/citizen-insane/gobgp/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchAdjOut(t *testing.T) {
+	assert := assert.New(t)
+	s := NewBgpServer()
+	go s.Serve()
+	assert.Nil(s.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       1,
+			RouterId: "1.1.1.1",
+			Port:     10181,
+		},
+	}))
+	assert.Nil(s.AddNeighbor(&config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: "127.0.0.1",
+			PeerAs:          2,
+		},
+		Transport: config.Transport{
+			Config: config.TransportConfig{
+				PassiveMode: true,
+			},
+		},
+	}))
+	assert.Nil(s.ReplacePolicyAssignment("", table.POLICY_DIRECTION_EXPORT, nil, table.ROUTE_TYPE_REJECT))
+
+	u := NewBgpServer()
+	go u.Serve()
+	assert.Nil(u.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       2,
+			RouterId: "2.2.2.2",
+			Port:     -1,
+		},
+	}))
+	assert.Nil(u.AddNeighbor(&config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: "127.0.0.1",
+			PeerAs:          1,
+		},
+		Transport: config.Transport{
+			Config: config.TransportConfig{
+				RemotePort: 10181,
+			},
+		},
+	}))
+	for i := 0; i < 30; i++ {
+		if u.GetNeighbor(false)[0].State.SessionState == config.SESSION_STATE_ESTABLISHED {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+
+	recv := func(w *Watcher) *WatchEventAdjOut {
+		for {
+			select {
+			case ev := <-w.Event():
+				if a, ok := ev.(*WatchEventAdjOut); ok {
+					return a
+				}
+			case <-time.After(time.Second * 5):
+				return nil
+			}
+		}
+	}
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeNextHop("10.0.0.1"),
+	}
+
+	// the export policy rejects the path, so only the pre-policy one.
+	w := s.Watch(WatchAdjOut(true, true, false))
+	_, err := s.AddPath("", []*table.Path{table.NewPath(nil, bgp.NewIPAddrPrefix(24, "10.1.0.0"), false, attrs, time.Now(), false)})
+	assert.Nil(err)
+	ev := recv(w)
+	if assert.NotNil(ev) {
+		assert.False(ev.PostPolicy)
+		assert.Equal("127.0.0.1", ev.PeerAddress.String())
+		assert.Equal(uint32(2), ev.PeerAS)
+		assert.Len(ev.PathList, 1)
+		assert.Equal("10.1.0.0/24", ev.PathList[0].GetNlri().String())
+	}
+	assert.Nil(recv(w))
+	w.Stop()
+
+	_, err = u.AddPath("", []*table.Path{table.NewPath(nil, bgp.NewIPAddrPrefix(24, "10.2.0.0"), false, attrs, time.Now(), false)})
+	assert.Nil(err)
+	time.Sleep(time.Second)
+	w = u.Watch(WatchAdjOut(false, true, true))
+	ev = recv(w)
+	if assert.NotNil(ev) {
+		assert.True(ev.PostPolicy)
+		assert.Equal(uint32(1), ev.PeerAS)
+		assert.Len(ev.PathList, 1)
+		assert.Equal("10.2.0.0/24", ev.PathList[0].GetNlri().String())
+		assert.Equal([]uint32{2}, ev.PathList[0].GetAsSeqList())
+	}
+	w.Stop()
+}
+
+func TestBmpLocRib(t *testing.T) {
+	assert := assert.New(t)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(err)
+	defer l.Close()
+
+	s := NewBgpServer()
+	go s.Serve()
+	assert.Nil(s.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       65001,
+			RouterId: "1.1.1.1",
+			Port:     -1,
+		},
+	}))
+	defer s.Stop()
+	_, port, _ := net.SplitHostPort(l.Addr().String())
+	p, _ := strconv.Atoi(port)
+	c := &config.BmpServerConfig{
+		Address:               "127.0.0.1",
+		Port:                  uint32(p),
+		RouteMonitoringLocRib: true,
+	}
+	assert.Nil(s.AddBmp(c))
+	defer s.DeleteBmp(c)
+	assert.Equal(config.BMP_ROUTE_MONITORING_POLICY_TYPE_PRE_POLICY, s.bmpManager.getConfig()[0].Config.RouteMonitoringPolicy)
+	assert.True(s.bmpManager.getConfig()[0].Config.RouteMonitoringLocRib)
+
+	conn, err := l.Accept()
+	assert.Nil(err)
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(time.Second * 10))
+	scanner := bufio.NewScanner(conn)
+	scanner.Split(bmp.SplitBMP)
+	read := func() *bmp.BMPMessage {
+		if !scanner.Scan() {
+			return nil
+		}
+		m, err := bmp.ParseBMPMessage(scanner.Bytes())
+		assert.Nil(err)
+		return m
+	}
+
+	m := read()
+	if assert.NotNil(m) {
+		assert.Equal(uint8(bmp.BMP_MSG_INITIATION), m.Header.Type)
+	}
+	m = read()
+	if assert.NotNil(m) {
+		assert.Equal(uint8(bmp.BMP_MSG_PEER_UP_NOTIFICATION), m.Header.Type)
+		assert.Equal(bmp.BMP_PEER_TYPE_LOC_RIB, m.PeerHeader.PeerType)
+		assert.Equal(uint32(65001), m.PeerHeader.PeerAS)
+		assert.Equal("1.1.1.1", m.PeerHeader.PeerBGPID.String())
+		up := m.Body.(*bmp.BMPPeerUpNotification)
+		if assert.Len(up.Info, 1) {
+			assert.Equal(bmp.BMP_INFO_TLV_TYPE_VRF_TABLE_NAME, up.Info[0].Type)
+			assert.Equal("global", string(up.Info[0].Value))
+		}
+	}
+
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeNextHop("10.0.0.1"),
+	}
+	_, err = s.AddPath("", []*table.Path{table.NewPath(nil, bgp.NewIPAddrPrefix(24, "10.1.0.0"), false, attrs, time.Now(), false)})
+	assert.Nil(err)
+	m = read()
+	if assert.NotNil(m) {
+		assert.Equal(uint8(bmp.BMP_MSG_ROUTE_MONITORING), m.Header.Type)
+		assert.Equal(bmp.BMP_PEER_TYPE_LOC_RIB, m.PeerHeader.PeerType)
+		u := m.Body.(*bmp.BMPRouteMonitoring).BGPUpdate.Body.(*bgp.BGPUpdate)
+		if assert.Len(u.NLRI, 1) {
+			assert.Equal("10.1.0.0/24", u.NLRI[0].String())
+		}
+	}
+}
@@ -0,0 +1,1188 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	log "github.com/Sirupsen/logrus"
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/citizen-insane/gobgp/table"
+	"net"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// the number of the committed configurations kept for rollback
+const MAX_COMMIT_HISTORY = 10
+
+var candidateDefinedTypes = []table.DefinedType{
+	table.DEFINED_TYPE_PREFIX,
+	table.DEFINED_TYPE_NEIGHBOR,
+	table.DEFINED_TYPE_AS_PATH,
+	table.DEFINED_TYPE_COMMUNITY,
+	table.DEFINED_TYPE_EXT_COMMUNITY,
+	table.DEFINED_TYPE_LARGE_COMMUNITY,
+}
+
+// Candidate is a copy of the running configuration which is edited
+// without affecting the running one. The changes take effect together
+// when the candidate is committed. It covers the neighbors, the peer
+// groups, the dynamic neighbors and the routing policy. The cross
+// references are validated at the commit so the changes can be made
+// in any order.
+type Candidate struct {
+	config      config.BgpConfigSet
+	definedSets table.DefinedSetMap
+	// the running configuration which the candidate was copied from.
+	base *config.BgpConfigSet
+}
+
+type ConfigCommit struct {
+	Id     uint32
+	Time   time.Time
+	Config *config.BgpConfigSet
+}
+
+// ConfigDiff is a difference between the running configuration and the
+// candidate. Running is nil for an added item and Candidate is nil for
+// a deleted one.
+type ConfigDiff struct {
+	Type      string
+	Name      string
+	Running   interface{}
+	Candidate interface{}
+}
+
+// clearState zeroes the state containers in the configuration which v
+// points to so that the configurations taken from the running server
+// can be compared. The slices are copied not to modify the original.
+func clearState(v interface{}) {
+	clearStateValue(reflect.ValueOf(v).Elem())
+}
+
+func clearStateValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Field(i)
+			if v.Type().Field(i).Name == "State" {
+				f.Set(reflect.Zero(f.Type()))
+			} else {
+				clearStateValue(f)
+			}
+		}
+	case reflect.Slice:
+		if v.IsNil() {
+			return
+		}
+		l := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		reflect.Copy(l, v)
+		v.Set(l)
+		for i := 0; i < l.Len(); i++ {
+			clearStateValue(l.Index(i))
+		}
+	}
+}
+
+func newDefinedSetMap(d config.DefinedSets) (table.DefinedSetMap, error) {
+	m := make(table.DefinedSetMap)
+	for _, t := range candidateDefinedTypes {
+		m[t] = make(map[string]table.DefinedSet)
+	}
+	add := func(s table.DefinedSet, err error) error {
+		if err != nil {
+			return err
+		}
+		if s != nil && !reflect.ValueOf(s).IsNil() {
+			m[s.Type()][s.Name()] = s
+		}
+		return nil
+	}
+	for _, x := range d.PrefixSets {
+		if err := add(table.NewPrefixSet(x)); err != nil {
+			return nil, err
+		}
+	}
+	for _, x := range d.NeighborSets {
+		if err := add(table.NewNeighborSet(x)); err != nil {
+			return nil, err
+		}
+	}
+	b := d.BgpDefinedSets
+	for _, x := range b.AsPathSets {
+		if err := add(table.NewAsPathSet(x)); err != nil {
+			return nil, err
+		}
+	}
+	for _, x := range b.CommunitySets {
+		if err := add(table.NewCommunitySet(x)); err != nil {
+			return nil, err
+		}
+	}
+	for _, x := range b.ExtCommunitySets {
+		if err := add(table.NewExtCommunitySet(x)); err != nil {
+			return nil, err
+		}
+	}
+	for _, x := range b.LargeCommunitySets {
+		if err := add(table.NewLargeCommunitySet(x)); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func definedSetMapToConfig(m table.DefinedSetMap) config.DefinedSets {
+	d := config.DefinedSets{}
+	for _, t := range candidateDefinedTypes {
+		names := make([]string, 0, len(m[t]))
+		for name := range m[t] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			switch s := m[t][name].(type) {
+			case *table.PrefixSet:
+				d.PrefixSets = append(d.PrefixSets, *s.ToConfig())
+			case *table.NeighborSet:
+				d.NeighborSets = append(d.NeighborSets, *s.ToConfig())
+			case *table.AsPathSet:
+				d.BgpDefinedSets.AsPathSets = append(d.BgpDefinedSets.AsPathSets, *s.ToConfig())
+			case *table.CommunitySet:
+				d.BgpDefinedSets.CommunitySets = append(d.BgpDefinedSets.CommunitySets, *s.ToConfig())
+			case *table.ExtCommunitySet:
+				d.BgpDefinedSets.ExtCommunitySets = append(d.BgpDefinedSets.ExtCommunitySets, *s.ToConfig())
+			case *table.LargeCommunitySet:
+				d.BgpDefinedSets.LargeCommunitySets = append(d.BgpDefinedSets.LargeCommunitySets, *s.ToConfig())
+			}
+		}
+	}
+	return d
+}
+
+func newCandidate(c *config.BgpConfigSet) (*Candidate, error) {
+	m, err := newDefinedSetMap(c.DefinedSets)
+	if err != nil {
+		return nil, err
+	}
+	candidate := &Candidate{
+		config:      *c,
+		definedSets: m,
+		base:        c,
+	}
+	candidate.config.Neighbors = append([]config.Neighbor(nil), c.Neighbors...)
+	candidate.config.PeerGroups = append([]config.PeerGroup(nil), c.PeerGroups...)
+	candidate.config.DynamicNeighbors = append([]config.DynamicNeighbor(nil), c.DynamicNeighbors...)
+	candidate.config.PolicyDefinitions = append([]config.PolicyDefinition(nil), c.PolicyDefinitions...)
+	candidate.config.NeighborKeys = make(map[string]map[string]bool, len(c.NeighborKeys))
+	for addr, keys := range c.NeighborKeys {
+		candidate.config.NeighborKeys[addr] = keys
+	}
+	return candidate, nil
+}
+
+func (c *Candidate) toConfig() *config.BgpConfigSet {
+	n := c.config
+	n.DefinedSets = definedSetMapToConfig(c.definedSets)
+	return &n
+}
+
+func (c *Candidate) neighborIndex(addr string) int {
+	for i, n := range c.config.Neighbors {
+		if n.Config.NeighborAddress == addr {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c *Candidate) setNeighborDefaults(n *config.Neighbor) error {
+	if n.Config.NeighborAddress == "" {
+		return fmt.Errorf("neighbor address is required")
+	}
+	// the members keep the configuration given to themselves to be
+	// merged with the peer group.
+	if n.Config.PeerGroup != "" {
+		return nil
+	}
+	return config.SetDefaultNeighborConfigValues(n, &c.config.Global)
+}
+
+func (c *Candidate) AddNeighbor(n *config.Neighbor) error {
+	addr := n.Config.NeighborAddress
+	if c.neighborIndex(addr) >= 0 {
+		return fmt.Errorf("Can't overwrite the existing peer: %s", addr)
+	}
+	if err := c.setNeighborDefaults(n); err != nil {
+		return err
+	}
+	c.config.Neighbors = append(c.config.Neighbors, *n)
+	return nil
+}
+
+func (c *Candidate) DeleteNeighbor(n *config.Neighbor) error {
+	idx := c.neighborIndex(n.Config.NeighborAddress)
+	if idx < 0 {
+		return fmt.Errorf("Neighbor that has %v doesn't exist.", n.Config.NeighborAddress)
+	}
+	c.config.Neighbors = append(c.config.Neighbors[:idx:idx], c.config.Neighbors[idx+1:]...)
+	delete(c.config.NeighborKeys, n.Config.NeighborAddress)
+	return nil
+}
+
+func (c *Candidate) UpdateNeighbor(n *config.Neighbor) error {
+	idx := c.neighborIndex(n.Config.NeighborAddress)
+	if idx < 0 {
+		return fmt.Errorf("Neighbor that has %v doesn't exist.", n.Config.NeighborAddress)
+	}
+	if err := c.setNeighborDefaults(n); err != nil {
+		return err
+	}
+	c.config.Neighbors[idx] = *n
+	// the zero values given with the API aren't distinguished.
+	delete(c.config.NeighborKeys, n.Config.NeighborAddress)
+	return nil
+}
+
+func (c *Candidate) peerGroupIndex(name string) int {
+	for i, pg := range c.config.PeerGroups {
+		if pg.Config.PeerGroupName == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c *Candidate) AddPeerGroup(pg *config.PeerGroup) error {
+	name := pg.Config.PeerGroupName
+	if c.peerGroupIndex(name) >= 0 {
+		return fmt.Errorf("Can't overwrite the existing peer-group: %s", name)
+	}
+	c.config.PeerGroups = append(c.config.PeerGroups, *pg)
+	return nil
+}
+
+func (c *Candidate) DeletePeerGroup(pg *config.PeerGroup) error {
+	idx := c.peerGroupIndex(pg.Config.PeerGroupName)
+	if idx < 0 {
+		return fmt.Errorf("Can't delete a peer-group configuration for %s", pg.Config.PeerGroupName)
+	}
+	c.config.PeerGroups = append(c.config.PeerGroups[:idx:idx], c.config.PeerGroups[idx+1:]...)
+	return nil
+}
+
+func (c *Candidate) UpdatePeerGroup(pg *config.PeerGroup) error {
+	idx := c.peerGroupIndex(pg.Config.PeerGroupName)
+	if idx < 0 {
+		return fmt.Errorf("Peer-group that has %s doesn't exist.", pg.Config.PeerGroupName)
+	}
+	c.config.PeerGroups[idx] = *pg
+	return nil
+}
+
+func (c *Candidate) dynamicNeighborIndex(prefix string) int {
+	for i, d := range c.config.DynamicNeighbors {
+		if d.Config.Prefix == prefix {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c *Candidate) AddDynamicNeighbor(d *config.DynamicNeighbor) error {
+	if c.dynamicNeighborIndex(d.Config.Prefix) >= 0 {
+		return fmt.Errorf("dynamic neighbor prefix %s already exists", d.Config.Prefix)
+	}
+	c.config.DynamicNeighbors = append(c.config.DynamicNeighbors, *d)
+	return nil
+}
+
+func (c *Candidate) DeleteDynamicNeighbor(d *config.DynamicNeighbor) error {
+	idx := c.dynamicNeighborIndex(d.Config.Prefix)
+	if idx < 0 {
+		return fmt.Errorf("Can't delete a dynamic neighbor prefix %s", d.Config.Prefix)
+	}
+	c.config.DynamicNeighbors = append(c.config.DynamicNeighbors[:idx:idx], c.config.DynamicNeighbors[idx+1:]...)
+	return nil
+}
+
+func (c *Candidate) AddDefinedSet(a table.DefinedSet) error {
+	m, ok := c.definedSets[a.Type()]
+	if !ok {
+		return fmt.Errorf("invalid defined-set type: %d", a.Type())
+	}
+	if d, ok := m[a.Name()]; ok {
+		return d.Append(a)
+	}
+	m[a.Name()] = a
+	return nil
+}
+
+// DeleteDefinedSet deletes the defined set if all is true. Otherwise,
+// it removes the items of a from the set. The policies using it are
+// checked at the commit.
+func (c *Candidate) DeleteDefinedSet(a table.DefinedSet, all bool) error {
+	m, ok := c.definedSets[a.Type()]
+	if !ok {
+		return fmt.Errorf("invalid defined-set type: %d", a.Type())
+	}
+	d, ok := m[a.Name()]
+	if !ok {
+		return fmt.Errorf("not found defined-set: %s", a.Name())
+	}
+	if all {
+		delete(m, a.Name())
+		return nil
+	}
+	return d.Remove(a)
+}
+
+func (c *Candidate) ReplaceDefinedSet(a table.DefinedSet) error {
+	m, ok := c.definedSets[a.Type()]
+	if !ok {
+		return fmt.Errorf("invalid defined-set type: %d", a.Type())
+	}
+	d, ok := m[a.Name()]
+	if !ok {
+		return fmt.Errorf("not found defined-set: %s", a.Name())
+	}
+	return d.Replace(a)
+}
+
+func (c *Candidate) policyIndex(name string) int {
+	for i, p := range c.config.PolicyDefinitions {
+		if p.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// statements returns the statements of x. If refer is true, the
+// statements are the existing ones with the same names.
+func (c *Candidate) statements(x *config.PolicyDefinition, refer bool) ([]config.Statement, error) {
+	if !refer {
+		return x.Statements, nil
+	}
+	l := make([]config.Statement, 0, len(x.Statements))
+	for _, st := range x.Statements {
+		found := false
+		for _, p := range c.config.PolicyDefinitions {
+			for _, y := range p.Statements {
+				if y.Name == st.Name {
+					l = append(l, y)
+					found = true
+					break
+				}
+			}
+			if found {
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("not found statement %s", st.Name)
+		}
+	}
+	return l, nil
+}
+
+func (c *Candidate) AddPolicy(x *config.PolicyDefinition, refer bool) error {
+	l, err := c.statements(x, refer)
+	if err != nil {
+		return err
+	}
+	if idx := c.policyIndex(x.Name); idx >= 0 {
+		p := &c.config.PolicyDefinitions[idx]
+		p.Statements = append(append([]config.Statement(nil), p.Statements...), l...)
+		return nil
+	}
+	c.config.PolicyDefinitions = append(c.config.PolicyDefinitions, config.PolicyDefinition{
+		Name:       x.Name,
+		Statements: l,
+	})
+	return nil
+}
+
+// DeletePolicy deletes the policy if all is true. Otherwise, it removes
+// the statements of x from the policy. The assignments using it are
+// checked at the commit.
+func (c *Candidate) DeletePolicy(x *config.PolicyDefinition, all bool) error {
+	idx := c.policyIndex(x.Name)
+	if idx < 0 {
+		return fmt.Errorf("not found policy: %s", x.Name)
+	}
+	if all {
+		c.config.PolicyDefinitions = append(c.config.PolicyDefinitions[:idx:idx], c.config.PolicyDefinitions[idx+1:]...)
+		return nil
+	}
+	p := &c.config.PolicyDefinitions[idx]
+	l := make([]config.Statement, 0, len(p.Statements))
+	for _, st := range p.Statements {
+		found := false
+		for _, y := range x.Statements {
+			if st.Name == y.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			l = append(l, st)
+		}
+	}
+	p.Statements = l
+	return nil
+}
+
+func (c *Candidate) ReplacePolicy(x *config.PolicyDefinition, refer bool) error {
+	idx := c.policyIndex(x.Name)
+	if idx < 0 {
+		return fmt.Errorf("not found policy: %s", x.Name)
+	}
+	l, err := c.statements(x, refer)
+	if err != nil {
+		return err
+	}
+	c.config.PolicyDefinitions[idx].Statements = l
+	return nil
+}
+
+func toDefaultPolicyType(t table.RouteType) config.DefaultPolicyType {
+	switch t {
+	case table.ROUTE_TYPE_ACCEPT:
+		return config.DEFAULT_POLICY_TYPE_ACCEPT_ROUTE
+	case table.ROUTE_TYPE_REJECT:
+		return config.DEFAULT_POLICY_TYPE_REJECT_ROUTE
+	}
+	return ""
+}
+
+// applyPolicy returns the policy list and the default policy of the
+// assignment in the same way as BgpServer.toPolicyInfo.
+func (c *Candidate) applyPolicy(name string, dir table.PolicyDirection) (*[]string, *config.DefaultPolicyType, error) {
+	var a *config.ApplyPolicyConfig
+	if name == "" {
+		switch dir {
+		case table.POLICY_DIRECTION_IMPORT, table.POLICY_DIRECTION_EXPORT:
+			a = &c.config.Global.ApplyPolicy.Config
+		default:
+			return nil, nil, fmt.Errorf("invalid policy type")
+		}
+	} else {
+		idx := c.neighborIndex(name)
+		if idx < 0 {
+			return nil, nil, fmt.Errorf("not found peer %s", name)
+		}
+		n := &c.config.Neighbors[idx]
+		if !n.RouteServer.Config.RouteServerClient {
+			return nil, nil, fmt.Errorf("non-rs-client peer %s doesn't have per peer policy", name)
+		}
+		a = &n.ApplyPolicy.Config
+	}
+	switch dir {
+	case table.POLICY_DIRECTION_IN:
+		return &a.InPolicyList, &a.DefaultInPolicy, nil
+	case table.POLICY_DIRECTION_IMPORT:
+		return &a.ImportPolicyList, &a.DefaultImportPolicy, nil
+	case table.POLICY_DIRECTION_EXPORT:
+		return &a.ExportPolicyList, &a.DefaultExportPolicy, nil
+	}
+	return nil, nil, fmt.Errorf("invalid policy direction")
+}
+
+func (c *Candidate) AddPolicyAssignment(name string, dir table.PolicyDirection, policies []*config.PolicyDefinition, def table.RouteType) error {
+	l, d, err := c.applyPolicy(name, dir)
+	if err != nil {
+		return err
+	}
+	n := append([]string(nil), *l...)
+	for _, p := range policies {
+		for _, y := range n {
+			if y == p.Name {
+				return fmt.Errorf("duplicated policy %s", p.Name)
+			}
+		}
+		n = append(n, p.Name)
+	}
+	*l = n
+	if def != table.ROUTE_TYPE_NONE {
+		*d = toDefaultPolicyType(def)
+	}
+	return nil
+}
+
+func (c *Candidate) DeletePolicyAssignment(name string, dir table.PolicyDirection, policies []*config.PolicyDefinition, all bool) error {
+	l, d, err := c.applyPolicy(name, dir)
+	if err != nil {
+		return err
+	}
+	if all {
+		*l = nil
+		*d = ""
+		return nil
+	}
+	n := make([]string, 0, len(*l))
+	for _, y := range *l {
+		found := false
+		for _, p := range policies {
+			if y == p.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			n = append(n, y)
+		}
+	}
+	*l = n
+	return nil
+}
+
+func (c *Candidate) ReplacePolicyAssignment(name string, dir table.PolicyDirection, policies []*config.PolicyDefinition, def table.RouteType) error {
+	l, d, err := c.applyPolicy(name, dir)
+	if err != nil {
+		return err
+	}
+	n := make([]string, 0, len(policies))
+	for _, p := range policies {
+		n = append(n, p.Name)
+	}
+	*l = n
+	if def != table.ROUTE_TYPE_NONE {
+		*d = toDefaultPolicyType(def)
+	}
+	return nil
+}
+
+// runningConfig returns the part of the running configuration which the
+// candidate covers.
+func (s *BgpServer) runningConfig() *config.BgpConfigSet {
+	c := &config.BgpConfigSet{
+		Global: s.bgpConfig.Global,
+	}
+	clearState(&c.Global)
+
+	names := make([]string, 0, len(s.peerGroupMap))
+	for name := range s.peerGroupMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		pg := s.peerGroupMap[name]
+		conf := *pg.Conf
+		clearState(&conf)
+		c.PeerGroups = append(c.PeerGroups, conf)
+		prefixes := make([]string, 0, len(pg.dynamicNeighbors))
+		for prefix := range pg.dynamicNeighbors {
+			prefixes = append(prefixes, prefix)
+		}
+		sort.Strings(prefixes)
+		for _, prefix := range prefixes {
+			c.DynamicNeighbors = append(c.DynamicNeighbors, *pg.dynamicNeighbors[prefix])
+		}
+	}
+
+	addrs := make([]string, 0, len(s.neighborMap))
+	for addr, peer := range s.neighborMap {
+		if !peer.isDynamicNeighbor() {
+			addrs = append(addrs, addr)
+		}
+	}
+	sort.Strings(addrs)
+	for _, addr := range addrs {
+		n := *s.neighborMap[addr].fsm.pConf
+		if pg, ok := s.peerGroupMap[n.Config.PeerGroup]; ok {
+			if m, ok := pg.members[addr]; ok {
+				n = m.conf
+				if len(m.keys) > 0 {
+					if c.NeighborKeys == nil {
+						c.NeighborKeys = make(map[string]map[string]bool)
+					}
+					c.NeighborKeys[addr] = m.keys
+				}
+			}
+		}
+		clearState(&n)
+		c.Neighbors = append(c.Neighbors, n)
+	}
+
+	d := config.DefinedSets{}
+	for _, t := range candidateDefinedTypes {
+		x, err := s.policy.GetDefinedSet(t)
+		if err != nil {
+			continue
+		}
+		d.PrefixSets = append(d.PrefixSets, x.PrefixSets...)
+		d.NeighborSets = append(d.NeighborSets, x.NeighborSets...)
+		b := &d.BgpDefinedSets
+		b.AsPathSets = append(b.AsPathSets, x.BgpDefinedSets.AsPathSets...)
+		b.CommunitySets = append(b.CommunitySets, x.BgpDefinedSets.CommunitySets...)
+		b.ExtCommunitySets = append(b.ExtCommunitySets, x.BgpDefinedSets.ExtCommunitySets...)
+		b.LargeCommunitySets = append(b.LargeCommunitySets, x.BgpDefinedSets.LargeCommunitySets...)
+	}
+	// the defined sets are sorted in the same way as the candidate's.
+	if m, err := newDefinedSetMap(d); err == nil {
+		d = definedSetMapToConfig(m)
+	}
+	c.DefinedSets = d
+
+	policies := make(map[string]*config.PolicyDefinition)
+	names = names[:0]
+	for _, p := range s.policy.GetAllPolicy() {
+		policies[p.Name] = p
+		names = append(names, p.Name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		c.PolicyDefinitions = append(c.PolicyDefinitions, *policies[name])
+	}
+	return c
+}
+
+// assignedPolicy returns the policy assignments of the table id in the
+// form of the configuration.
+func (s *BgpServer) assignedPolicy(id string) config.ApplyPolicy {
+	f := func(dir table.PolicyDirection) ([]string, config.DefaultPolicyType) {
+		rt, policies, _ := s.policy.GetPolicyAssignment(id, dir)
+		l := make([]string, 0, len(policies))
+		for _, p := range policies {
+			l = append(l, p.Name)
+		}
+		return l, toDefaultPolicyType(rt)
+	}
+	a := config.ApplyPolicy{}
+	a.Config.ImportPolicyList, a.Config.DefaultImportPolicy = f(table.POLICY_DIRECTION_IMPORT)
+	a.Config.ExportPolicyList, a.Config.DefaultExportPolicy = f(table.POLICY_DIRECTION_EXPORT)
+	return a
+}
+
+// GetConfig returns the whole running configuration in the form which
+// gobgpd can load as the configuration file.
+func (s *BgpServer) GetConfig() (c *config.BgpConfigSet) {
+	s.mgmtOperation(func() error {
+		c = s.runningConfig()
+		// the assignments might be changed via the API after the
+		// configuration was loaded.
+		c.Global.ApplyPolicy = s.assignedPolicy(table.GLOBAL_RIB_NAME)
+		for i, n := range c.Neighbors {
+			if peer, ok := s.neighborMap[n.Config.NeighborAddress]; ok && peer.isRouteServerClient() {
+				c.Neighbors[i].ApplyPolicy = s.assignedPolicy(peer.ID())
+			}
+		}
+
+		rpki := make(map[string]config.RpkiServer)
+		names := make([]string, 0, len(s.roaManager.clientMap))
+		for _, r := range s.roaManager.GetServers() {
+			host := net.JoinHostPort(r.Config.Address, strconv.Itoa(int(r.Config.Port)))
+			rpki[host] = config.RpkiServer{Config: r.Config}
+			names = append(names, host)
+		}
+		sort.Strings(names)
+		for _, host := range names {
+			c.RpkiServers = append(c.RpkiServers, rpki[host])
+		}
+		c.BmpServers = s.bmpManager.getConfig()
+		c.MrtDump = s.mrtManager.getConfig()
+
+		if s.zclient != nil {
+			c.Zebra.Config = s.bgpConfig.Zebra.Config
+		}
+		c.Collector.Config = s.bgpConfig.Collector.Config
+
+		names = names[:0]
+		for name := range s.globalRib.Vrfs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		f := func(l []bgp.ExtendedCommunityInterface) []string {
+			rts := make([]string, 0, len(l))
+			for _, rt := range l {
+				rts = append(rts, rt.String())
+			}
+			return rts
+		}
+		for _, name := range names {
+			v := s.globalRib.Vrfs[name]
+			c.Vrfs = append(c.Vrfs, config.Vrf{
+				Config: config.VrfConfig{
+					Name:         v.Name,
+					Id:           v.Id,
+					Rd:           v.Rd.String(),
+					ImportRtList: f(v.ImportRt),
+					ExportRtList: f(v.ExportRt),
+				},
+			})
+		}
+
+		for _, a := range s.aggregateManager.aggregates {
+			c.Aggregates = append(c.Aggregates, config.Aggregate{Config: a.config})
+		}
+
+		names = names[:0]
+		for name := range s.healthCheckManager.checks {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			h := s.healthCheckManager.checks[name]
+			c.HealthChecks = append(c.HealthChecks, config.HealthCheck{
+				Config: h.config,
+				Routes: append([]config.HealthCheckRoute(nil), h.routes...),
+			})
+		}
+		return nil
+	}, false)
+	return c
+}
+
+// validateConfig checks the cross references in the configuration.
+func (s *BgpServer) validateConfig(c *config.BgpConfigSet) error {
+	p := table.NewRoutingPolicy()
+	if err := p.Reset(&config.RoutingPolicy{
+		DefinedSets:       c.DefinedSets,
+		PolicyDefinitions: c.PolicyDefinitions,
+	}, nil); err != nil {
+		return err
+	}
+	policies := make(map[string]bool)
+	for _, x := range c.PolicyDefinitions {
+		policies[x.Name] = true
+	}
+	checkApplyPolicy := func(key string, a *config.ApplyPolicyConfig) error {
+		for _, l := range [][]string{a.InPolicyList, a.ImportPolicyList, a.ExportPolicyList} {
+			for _, name := range l {
+				if !policies[name] {
+					return fmt.Errorf("%s refers to the undefined policy %s", key, name)
+				}
+			}
+		}
+		return nil
+	}
+	if err := checkApplyPolicy("global", &c.Global.ApplyPolicy.Config); err != nil {
+		return err
+	}
+
+	peerGroups := make(map[string]bool)
+	for _, pg := range c.PeerGroups {
+		name := pg.Config.PeerGroupName
+		peerGroups[name] = true
+		if err := checkApplyPolicy("peer-group "+name, &pg.ApplyPolicy.Config); err != nil {
+			return err
+		}
+	}
+	for _, n := range c.Neighbors {
+		addr := n.Config.NeighborAddress
+		if name := n.Config.PeerGroup; name != "" && !peerGroups[name] {
+			return fmt.Errorf("neighbor %s refers to the undefined peer-group %s", addr, name)
+		}
+		if vrf := n.Config.Vrf; vrf != "" {
+			if _, ok := s.globalRib.Vrfs[vrf]; !ok {
+				return fmt.Errorf("neighbor %s refers to the undefined vrf %s", addr, vrf)
+			}
+		}
+		if err := checkApplyPolicy("neighbor "+addr, &n.ApplyPolicy.Config); err != nil {
+			return err
+		}
+	}
+	for _, d := range c.DynamicNeighbors {
+		if _, _, err := net.ParseCIDR(d.Config.Prefix); err != nil {
+			return fmt.Errorf("invalid dynamic neighbor prefix: %s", d.Config.Prefix)
+		}
+		if !peerGroups[d.Config.PeerGroup] {
+			return fmt.Errorf("dynamic neighbor %s refers to the undefined peer-group %s", d.Config.Prefix, d.Config.PeerGroup)
+		}
+	}
+	return nil
+}
+
+func configEqual(a, b interface{}) bool {
+	switch x := a.(type) {
+	case *config.Neighbor:
+		return x.Equal(b.(*config.Neighbor))
+	case *config.PeerGroup:
+		return x.Equal(b.(*config.PeerGroup))
+	case *config.DynamicNeighbor:
+		return x.Equal(b.(*config.DynamicNeighbor))
+	case *config.PolicyDefinition:
+		return x.Equal(b.(*config.PolicyDefinition))
+	case *config.ApplyPolicy:
+		return x.Equal(b.(*config.ApplyPolicy))
+	case *config.PrefixSet:
+		return x.Equal(b.(*config.PrefixSet))
+	case *config.NeighborSet:
+		return x.Equal(b.(*config.NeighborSet))
+	case *config.AsPathSet:
+		return x.Equal(b.(*config.AsPathSet))
+	case *config.CommunitySet:
+		return x.Equal(b.(*config.CommunitySet))
+	case *config.ExtCommunitySet:
+		return x.Equal(b.(*config.ExtCommunitySet))
+	case *config.LargeCommunitySet:
+		return x.Equal(b.(*config.LargeCommunitySet))
+	}
+	return false
+}
+
+// configItems returns the items of the configuration which are compared
+// by the diff, keyed by the type and the name.
+func configItems(c *config.BgpConfigSet) (map[string]map[string]interface{}, map[string][]string) {
+	items := make(map[string]map[string]interface{})
+	names := make(map[string][]string)
+	add := func(typ, name string, v interface{}) {
+		if _, ok := items[typ]; !ok {
+			items[typ] = make(map[string]interface{})
+		}
+		items[typ][name] = v
+		names[typ] = append(names[typ], name)
+	}
+	add("global", "apply-policy", &c.Global.ApplyPolicy)
+	for i, x := range c.PeerGroups {
+		add("peer-group", x.Config.PeerGroupName, &c.PeerGroups[i])
+	}
+	for i, x := range c.Neighbors {
+		add("neighbor", x.Config.NeighborAddress, &c.Neighbors[i])
+	}
+	for i, x := range c.DynamicNeighbors {
+		add("dynamic-neighbor", x.Config.Prefix, &c.DynamicNeighbors[i])
+	}
+	d := &c.DefinedSets
+	for i, x := range d.PrefixSets {
+		add("prefix-set", x.PrefixSetName, &d.PrefixSets[i])
+	}
+	for i, x := range d.NeighborSets {
+		add("neighbor-set", x.NeighborSetName, &d.NeighborSets[i])
+	}
+	b := &d.BgpDefinedSets
+	for i, x := range b.AsPathSets {
+		add("as-path-set", x.AsPathSetName, &b.AsPathSets[i])
+	}
+	for i, x := range b.CommunitySets {
+		add("community-set", x.CommunitySetName, &b.CommunitySets[i])
+	}
+	for i, x := range b.ExtCommunitySets {
+		add("ext-community-set", x.ExtCommunitySetName, &b.ExtCommunitySets[i])
+	}
+	for i, x := range b.LargeCommunitySets {
+		add("large-community-set", x.LargeCommunitySetName, &b.LargeCommunitySets[i])
+	}
+	for i, x := range c.PolicyDefinitions {
+		add("policy", x.Name, &c.PolicyDefinitions[i])
+	}
+	return items, names
+}
+
+var configDiffTypes = []string{"global", "peer-group", "neighbor", "dynamic-neighbor", "prefix-set", "neighbor-set", "as-path-set", "community-set", "ext-community-set", "large-community-set", "policy"}
+
+func diffConfig(running, candidate *config.BgpConfigSet) []*ConfigDiff {
+	r, rnames := configItems(running)
+	c, cnames := configItems(candidate)
+	l := make([]*ConfigDiff, 0)
+	for _, typ := range configDiffTypes {
+		for _, name := range rnames[typ] {
+			x := r[typ][name]
+			if y, ok := c[typ][name]; !ok {
+				l = append(l, &ConfigDiff{Type: typ, Name: name, Running: x})
+			} else if !configEqual(x, y) {
+				l = append(l, &ConfigDiff{Type: typ, Name: name, Running: x, Candidate: y})
+			}
+		}
+		for _, name := range cnames[typ] {
+			if _, ok := r[typ][name]; !ok {
+				l = append(l, &ConfigDiff{Type: typ, Name: name, Candidate: c[typ][name]})
+			}
+		}
+	}
+	return l
+}
+
+func copyNeighbor(n *config.Neighbor) *config.Neighbor {
+	c := *n
+	c.AfiSafis = append([]config.AfiSafi(nil), n.AfiSafis...)
+	return &c
+}
+
+func copyPeerGroup(pg *config.PeerGroup) *config.PeerGroup {
+	c := *pg
+	c.AfiSafis = append([]config.AfiSafi(nil), pg.AfiSafis...)
+	return &c
+}
+
+// applyConfig changes the running configuration from cur to c. It has
+// to be called in a management operation.
+func (s *BgpServer) applyConfig(cur, c *config.BgpConfigSet) (policyUpdated bool, err error) {
+	curPeerGroups := make(map[string]*config.PeerGroup)
+	for i, pg := range cur.PeerGroups {
+		curPeerGroups[pg.Config.PeerGroupName] = &cur.PeerGroups[i]
+	}
+	newPeerGroups := make(map[string]*config.PeerGroup)
+	for i, pg := range c.PeerGroups {
+		name := pg.Config.PeerGroupName
+		newPeerGroups[name] = &c.PeerGroups[i]
+		if _, ok := curPeerGroups[name]; !ok {
+			x := pg
+			if err := s.addPeerGroup(&x); err != nil {
+				return policyUpdated, err
+			}
+		}
+	}
+
+	curPolicy := &config.RoutingPolicy{DefinedSets: cur.DefinedSets, PolicyDefinitions: cur.PolicyDefinitions}
+	newPolicy := &config.RoutingPolicy{DefinedSets: c.DefinedSets, PolicyDefinitions: c.PolicyDefinitions}
+	if config.CheckPolicyDifference(curPolicy, newPolicy) || !cur.Global.ApplyPolicy.Equal(&c.Global.ApplyPolicy) {
+		log.WithFields(log.Fields{
+			"Topic": "Config",
+		}).Info("update the routing policy")
+		// the assignments of the neighbors are changed with their
+		// configurations below.
+		ap := make(map[string]config.ApplyPolicy, len(s.neighborMap)+1)
+		ap[table.GLOBAL_RIB_NAME] = c.Global.ApplyPolicy
+		for _, peer := range s.neighborMap {
+			ap[peer.ID()] = peer.fsm.pConf.ApplyPolicy
+		}
+		if err := s.policy.Reset(newPolicy, ap); err != nil {
+			return policyUpdated, err
+		}
+		s.bgpConfig.Global.ApplyPolicy = c.Global.ApplyPolicy
+		policyUpdated = true
+	}
+
+	for name, pg := range newPeerGroups {
+		if x, ok := curPeerGroups[name]; ok && !x.Equal(pg) {
+			y := *pg
+			u, err := s.updatePeerGroup(&y)
+			if err != nil {
+				return policyUpdated, err
+			}
+			policyUpdated = policyUpdated || u
+		}
+	}
+
+	curDynamicNeighbors := make(map[string]bool)
+	for _, d := range cur.DynamicNeighbors {
+		curDynamicNeighbors[d.Config.Prefix] = true
+	}
+	newDynamicNeighbors := make(map[string]bool)
+	for _, d := range c.DynamicNeighbors {
+		newDynamicNeighbors[d.Config.Prefix] = true
+	}
+	for _, d := range cur.DynamicNeighbors {
+		if !newDynamicNeighbors[d.Config.Prefix] {
+			x := d
+			if err := s.deleteDynamicNeighbor(&x); err != nil {
+				return policyUpdated, err
+			}
+		}
+	}
+	for _, d := range c.DynamicNeighbors {
+		if !curDynamicNeighbors[d.Config.Prefix] {
+			x := d
+			if err := s.addDynamicNeighbor(&x); err != nil {
+				return policyUpdated, err
+			}
+		}
+	}
+
+	curNeighbors := make(map[string]*config.Neighbor)
+	for i, n := range cur.Neighbors {
+		curNeighbors[n.Config.NeighborAddress] = &cur.Neighbors[i]
+	}
+	newNeighbors := make(map[string]*config.Neighbor)
+	for i, n := range c.Neighbors {
+		newNeighbors[n.Config.NeighborAddress] = &c.Neighbors[i]
+	}
+	for addr, n := range curNeighbors {
+		if _, ok := newNeighbors[addr]; !ok {
+			if err := s.deleteNeighbor(copyNeighbor(n), bgp.BGP_ERROR_CEASE, bgp.BGP_ERROR_SUB_PEER_DECONFIGURED); err != nil {
+				return policyUpdated, err
+			}
+		}
+	}
+	for addr, n := range newNeighbors {
+		if x, ok := curNeighbors[addr]; !ok {
+			if err := s.addNeighbor(copyNeighbor(n), c.NeighborKeys[addr]); err != nil {
+				return policyUpdated, err
+			}
+		} else if !x.Equal(n) || !config.EqualKeys(cur.NeighborKeys[addr], c.NeighborKeys[addr]) {
+			u, err := s.updateNeighbor(copyNeighbor(n), c.NeighborKeys[addr])
+			if err != nil {
+				return policyUpdated, err
+			}
+			policyUpdated = policyUpdated || u
+		}
+	}
+
+	for name, pg := range curPeerGroups {
+		if _, ok := newPeerGroups[name]; !ok {
+			if err := s.deletePeerGroup(pg); err != nil {
+				return policyUpdated, err
+			}
+		}
+	}
+	return policyUpdated, nil
+}
+
+func (s *BgpServer) commitConfig(c *config.BgpConfigSet) (uint32, error) {
+	if err := s.validateConfig(c); err != nil {
+		return 0, err
+	}
+	cur := s.runningConfig()
+	if len(s.commitHistory) == 0 {
+		// keep the configuration before the first commit to roll
+		// back to.
+		s.commitHistory = append(s.commitHistory, &ConfigCommit{
+			Time:   time.Now(),
+			Config: cur,
+		})
+	}
+
+	policyUpdated, err := s.applyConfig(cur, c)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Topic": "Config",
+			"Error": err,
+		}).Warn("failed to commit the configuration, restoring the previous one")
+		if u, err := s.applyConfig(s.runningConfig(), cur); err != nil {
+			log.WithFields(log.Fields{
+				"Topic": "Config",
+				"Error": err,
+			}).Error("failed to restore the configuration")
+		} else {
+			policyUpdated = policyUpdated || u
+		}
+	}
+	if policyUpdated {
+		s.softResetIn("", bgp.RouteFamily(0))
+		s.softResetOut("", bgp.RouteFamily(0), false)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	id := s.commitHistory[len(s.commitHistory)-1].Id + 1
+	s.commitHistory = append(s.commitHistory, &ConfigCommit{
+		Id:     id,
+		Time:   time.Now(),
+		Config: s.runningConfig(),
+	})
+	if len(s.commitHistory) > MAX_COMMIT_HISTORY {
+		s.commitHistory = s.commitHistory[len(s.commitHistory)-MAX_COMMIT_HISTORY:]
+	}
+	log.WithFields(log.Fields{
+		"Topic": "Config",
+		"Id":    id,
+	}).Info("configuration committed")
+	return id, nil
+}
+
+// EditCandidate calls f with the candidate configuration. The candidate
+// is copied from the running configuration if it doesn't exist.
+func (s *BgpServer) EditCandidate(f func(*Candidate) error) error {
+	return s.mgmtOperation(func() error {
+		if s.candidate == nil {
+			c, err := newCandidate(s.runningConfig())
+			if err != nil {
+				return err
+			}
+			s.candidate = c
+		}
+		return f(s.candidate)
+	}, true)
+}
+
+// GetCandidateDiff validates the candidate configuration and returns the
+// differences from the running configuration.
+func (s *BgpServer) GetCandidateDiff() (l []*ConfigDiff, err error) {
+	err = s.mgmtOperation(func() error {
+		if s.candidate == nil {
+			return fmt.Errorf("no candidate configuration")
+		}
+		c := s.candidate.toConfig()
+		if err := s.validateConfig(c); err != nil {
+			return err
+		}
+		l = diffConfig(s.runningConfig(), c)
+		return nil
+	}, true)
+	return l, err
+}
+
+// CommitCandidate applies the candidate configuration with a single soft
+// reset and returns the id of the commit. If a change fails, the
+// previous configuration is restored. The candidate can't be committed
+// if the running configuration has been changed since the candidate was
+// copied, e.g., via the API or by reloading the configuration file, not
+// to revert the change.
+func (s *BgpServer) CommitCandidate() (id uint32, err error) {
+	err = s.mgmtOperation(func() error {
+		if s.candidate == nil {
+			return fmt.Errorf("no candidate configuration")
+		}
+		if diff := diffConfig(s.candidate.base, s.runningConfig()); len(diff) > 0 {
+			return fmt.Errorf("the running configuration has been changed since the candidate was created (%s %s), discard the candidate", diff[0].Type, diff[0].Name)
+		}
+		id, err = s.commitConfig(s.candidate.toConfig())
+		if err == nil {
+			s.candidate = nil
+		}
+		return err
+	}, true)
+	return id, err
+}
+
+func (s *BgpServer) DiscardCandidate() error {
+	return s.mgmtOperation(func() error {
+		if s.candidate == nil {
+			return fmt.Errorf("no candidate configuration")
+		}
+		s.candidate = nil
+		return nil
+	}, true)
+}
+
+// GetCommitHistory returns the last MAX_COMMIT_HISTORY committed
+// configurations. The one with id 0 is the configuration before the
+// first commit.
+func (s *BgpServer) GetCommitHistory() (l []*ConfigCommit) {
+	s.mgmtOperation(func() error {
+		l = append(l, s.commitHistory...)
+		return nil
+	}, false)
+	return l
+}
+
+// Rollback commits the configuration of the commit with the id again
+// and returns the id of the new commit.
+func (s *BgpServer) Rollback(id uint32) (newId uint32, err error) {
+	err = s.mgmtOperation(func() error {
+		if s.candidate != nil {
+			return fmt.Errorf("the candidate configuration is being edited")
+		}
+		for _, c := range s.commitHistory {
+			if c.Id == id {
+				log.WithFields(log.Fields{
+					"Topic": "Config",
+					"Id":    id,
+				}).Info("roll back the configuration")
+				newId, err = s.commitConfig(c.Config)
+				return err
+			}
+		}
+		return fmt.Errorf("not found commit %d", id)
+	}, true)
+	return newId, err
+}
@@ -0,0 +1,266 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/table"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCandidate(t *testing.T) {
+	assert := assert.New(t)
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       1,
+			RouterId: "1.1.1.1",
+			Port:     -1,
+		},
+	})
+	assert.Nil(err)
+	defer s.Stop()
+
+	policy := &config.PolicyDefinition{
+		Name: "p1",
+		Statements: []config.Statement{
+			{
+				Name: "st1",
+				Conditions: config.Conditions{
+					MatchPrefixSet: config.MatchPrefixSet{PrefixSet: "ps1"},
+				},
+				Actions: config.Actions{
+					RouteDisposition: config.ROUTE_DISPOSITION_ACCEPT_ROUTE,
+				},
+			},
+		},
+	}
+	err = s.EditCandidate(func(c *Candidate) error {
+		if err := c.AddPolicy(policy, false); err != nil {
+			return err
+		}
+		if err := c.AddPolicyAssignment("", table.POLICY_DIRECTION_EXPORT, []*config.PolicyDefinition{policy}, table.ROUTE_TYPE_REJECT); err != nil {
+			return err
+		}
+		return c.AddNeighbor(&config.Neighbor{
+			Config: config.NeighborConfig{
+				NeighborAddress: "10.0.0.1",
+				PeerAs:          2,
+			},
+			Transport: config.Transport{
+				Config: config.TransportConfig{
+					PassiveMode: true,
+				},
+			},
+		})
+	})
+	assert.Nil(err)
+
+	// the prefix set used by the policy is missing.
+	_, err = s.GetCandidateDiff()
+	assert.NotNil(err)
+	_, err = s.CommitCandidate()
+	assert.NotNil(err)
+
+	err = s.EditCandidate(func(c *Candidate) error {
+		ps, err := table.NewPrefixSet(config.PrefixSet{
+			PrefixSetName: "ps1",
+			PrefixList:    []config.Prefix{{IpPrefix: "10.0.0.0/8", MasklengthRange: "8..32"}},
+		})
+		if err != nil {
+			return err
+		}
+		return c.AddDefinedSet(ps)
+	})
+	assert.Nil(err)
+
+	diff, err := s.GetCandidateDiff()
+	assert.Nil(err)
+	assert.Len(diff, 4)
+	// nothing takes effect until the commit.
+	assert.Len(s.GetPolicy(), 0)
+	assert.Len(s.GetNeighbor(false), 0)
+
+	id, err := s.CommitCandidate()
+	assert.Nil(err)
+	assert.Equal(uint32(1), id)
+	assert.Len(s.GetPolicy(), 1)
+	assert.Len(s.GetNeighbor(false), 1)
+	rt, l, err := s.GetPolicyAssignment("", table.POLICY_DIRECTION_EXPORT)
+	assert.Nil(err)
+	assert.Equal(table.ROUTE_TYPE_REJECT, rt)
+	assert.Len(l, 1)
+
+	// the committed configuration is the running one.
+	assert.Nil(s.EditCandidate(func(c *Candidate) error { return nil }))
+	diff, err = s.GetCandidateDiff()
+	assert.Nil(err)
+	assert.Len(diff, 0)
+	assert.Nil(s.DiscardCandidate())
+
+	history := s.GetCommitHistory()
+	assert.Len(history, 2)
+	assert.Equal(uint32(0), history[0].Id)
+	assert.Equal(uint32(1), history[1].Id)
+
+	id, err = s.Rollback(0)
+	assert.Nil(err)
+	assert.Equal(uint32(2), id)
+	assert.Len(s.GetPolicy(), 0)
+	assert.Len(s.GetNeighbor(false), 0)
+	_, l, err = s.GetPolicyAssignment("", table.POLICY_DIRECTION_EXPORT)
+	assert.Nil(err)
+	assert.Len(l, 0)
+
+	// the neighbor added via the API after the candidate was created
+	// isn't deleted by the commit.
+	newNeighbor := func(addr string) *config.Neighbor {
+		return &config.Neighbor{
+			Config: config.NeighborConfig{
+				NeighborAddress: addr,
+				PeerAs:          2,
+			},
+			Transport: config.Transport{
+				Config: config.TransportConfig{
+					PassiveMode: true,
+				},
+			},
+		}
+	}
+	assert.Nil(s.EditCandidate(func(c *Candidate) error {
+		return c.AddNeighbor(newNeighbor("10.0.0.2"))
+	}))
+	assert.Nil(s.AddNeighbor(newNeighbor("10.0.0.3")))
+	_, err = s.CommitCandidate()
+	assert.NotNil(err)
+	assert.Len(s.GetNeighbor(false), 1)
+	assert.Nil(s.DiscardCandidate())
+
+	assert.Nil(s.EditCandidate(func(c *Candidate) error {
+		return c.AddNeighbor(newNeighbor("10.0.0.2"))
+	}))
+	_, err = s.CommitCandidate()
+	assert.Nil(err)
+	assert.Len(s.GetNeighbor(false), 2)
+}
+
+func TestGetConfig(t *testing.T) {
+	assert := assert.New(t)
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       1,
+			RouterId: "1.1.1.1",
+			Port:     -1,
+		},
+	})
+	assert.Nil(err)
+	defer s.Stop()
+
+	n := &config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: "10.0.0.1",
+			PeerAs:          2,
+		},
+		Transport: config.Transport{
+			Config: config.TransportConfig{
+				PassiveMode: true,
+			},
+		},
+	}
+	assert.Nil(s.AddNeighbor(n))
+	rd, im, ex, err := config.ParseVrfConfig(&config.VrfConfig{
+		Name:         "red",
+		Rd:           "100:100",
+		ImportRtList: []string{"100:100"},
+		ExportRtList: []string{"100:200"},
+	})
+	assert.Nil(err)
+	assert.Nil(s.AddVrf("red", 1, rd, im, ex))
+	assert.Nil(s.AddBmp(&config.BmpServerConfig{Address: "127.0.0.1", Port: 11019}))
+
+	ps, err := table.NewPrefixSet(config.PrefixSet{
+		PrefixSetName: "ps1",
+		PrefixList:    []config.Prefix{{IpPrefix: "10.0.0.0/8", MasklengthRange: "8..32"}},
+	})
+	assert.Nil(err)
+	assert.Nil(s.AddDefinedSet(ps))
+	policy := config.PolicyDefinition{
+		Name: "p1",
+		Statements: []config.Statement{
+			{
+				Name: "st1",
+				Conditions: config.Conditions{
+					MatchPrefixSet: config.MatchPrefixSet{PrefixSet: "ps1"},
+				},
+			},
+		},
+	}
+	p, err := table.NewPolicy(policy)
+	assert.Nil(err)
+	assert.Nil(s.AddPolicy(p, false))
+	assert.Nil(s.AddPolicyAssignment("", table.POLICY_DIRECTION_IMPORT, []*config.PolicyDefinition{&policy}, table.ROUTE_TYPE_ACCEPT))
+
+	assert.Nil(s.AddAggregate(&config.AggregateConfig{Prefix: "10.0.0.0/8", SummaryOnly: true}))
+	assert.Nil(s.AddHealthCheck(&config.HealthCheck{
+		Config: config.HealthCheckConfig{
+			Name:    "web",
+			Type:    config.HEALTH_CHECK_TYPE_EXEC,
+			Command: "exit 0",
+		},
+		Routes: []config.HealthCheckRoute{{
+			Config: config.HealthCheckRouteConfig{Prefix: "192.0.2.1/32", DegradedMed: 100},
+		}},
+	}))
+
+	c := s.GetConfig()
+	assert.Equal(uint32(1), c.Global.Config.As)
+	assert.Equal([]string{"p1"}, c.Global.ApplyPolicy.Config.ImportPolicyList)
+	assert.Equal(config.DEFAULT_POLICY_TYPE_ACCEPT_ROUTE, c.Global.ApplyPolicy.Config.DefaultImportPolicy)
+	assert.Len(c.Neighbors, 1)
+	assert.Equal("10.0.0.1", c.Neighbors[0].Config.NeighborAddress)
+	assert.Len(c.DefinedSets.PrefixSets, 1)
+	assert.Len(c.PolicyDefinitions, 1)
+	assert.Len(c.BmpServers, 1)
+	assert.Equal(uint32(11019), c.BmpServers[0].Config.Port)
+	assert.Len(c.Vrfs, 1)
+	assert.Equal("100:100", c.Vrfs[0].Config.Rd)
+	assert.Equal([]string{"100:200"}, c.Vrfs[0].Config.ExportRtList)
+	assert.False(c.Zebra.Config.Enabled)
+	assert.Equal([]config.Aggregate{{Config: config.AggregateConfig{Prefix: "10.0.0.0/8", SummaryOnly: true}}}, c.Aggregates)
+	assert.Len(c.HealthChecks, 1)
+	assert.Equal("web", c.HealthChecks[0].Config.Name)
+	assert.Equal(uint32(100), c.HealthChecks[0].Routes[0].Config.DegradedMed)
+
+	// the exported configuration is read back as it is.
+	b, err := config.MarshalConfig(c, "toml")
+	assert.Nil(err)
+	v := viper.New()
+	v.SetConfigType("toml")
+	assert.Nil(v.ReadConfig(bytes.NewReader(b)))
+	imported := &config.BgpConfigSet{}
+	assert.Nil(v.UnmarshalExact(imported))
+	assert.Equal(c.Aggregates, imported.Aggregates)
+	assert.Equal(c.HealthChecks, imported.HealthChecks)
+	assert.Equal(c.Vrfs, imported.Vrfs)
+	assert.Equal(c.BmpServers, imported.BmpServers)
+}
@@ -16,35 +16,197 @@
 package server
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	log "github.com/Sirupsen/logrus"
-	"github.com/influxdata/influxdb/client/v2"
+	"github.com/citizen-insane/gobgp/config"
 	"github.com/citizen-insane/gobgp/packet/bgp"
 	"github.com/citizen-insane/gobgp/table"
+	"github.com/influxdata/influxdb/client/v2"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
 	"time"
 )
 
-type Collector struct {
-	s        *BgpServer
-	url      string
-	dbName   string
-	interval uint64
-	client   client.Client
-}
-
 const (
 	MEATUREMENT_UPDATE = "update"
 	MEATUREMENT_PEER   = "peer"
 	MEATUREMENT_TABLE  = "table"
 )
 
-func (c *Collector) writePoints(points []*client.Point) error {
+// CollectorPoint is a record written by the collector. Measurement is
+// one of MEATUREMENT_*.
+type CollectorPoint struct {
+	Measurement string                 `json:"measurement"`
+	Tags        map[string]string      `json:"tags"`
+	Fields      map[string]interface{} `json:"fields"`
+	Time        time.Time              `json:"time"`
+}
+
+// CollectorBackend stores the points of the collector.
+type CollectorBackend interface {
+	Write([]*CollectorPoint) error
+}
+
+type CollectorBackendFunc func(*config.CollectorConfig) (CollectorBackend, error)
+
+var collectorBackendMap = map[config.CollectorType]CollectorBackendFunc{
+	config.COLLECTOR_TYPE_INFLUXDB: newInfluxdbBackend,
+	config.COLLECTOR_TYPE_JSON:     newJsonBackend,
+	config.COLLECTOR_TYPE_HTTP:     newHttpBackend,
+}
+
+// RegisterCollectorBackend adds a backend of the collector or replaces
+// the existing one. It must be called before the collector is started.
+func RegisterCollectorBackend(typ config.CollectorType, f CollectorBackendFunc) {
+	collectorBackendMap[typ] = f
+}
+
+type influxdbBackend struct {
+	dbName string
+	client client.Client
+}
+
+func newInfluxdbBackend(c *config.CollectorConfig) (CollectorBackend, error) {
+	cli, err := client.NewHTTPClient(client.HTTPConfig{
+		Addr: c.Url,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, err = cli.Ping(0)
+	if err != nil {
+		log.WithFields(log.Fields{"Type": "collector", "Error": err}).Error("Failed to connect to InfluxDB")
+		return nil, err
+	}
+
+	q := client.NewQuery("CREATE DATABASE "+c.DbName, "", "")
+	if response, err := cli.Query(q); err != nil || response.Error() != nil {
+		if err == nil {
+			err = response.Error()
+		}
+		log.WithFields(log.Fields{"Type": "collector", "Error": err}).Errorf("Failed to create database:%s", c.DbName)
+		return nil, err
+	}
+	return &influxdbBackend{
+		dbName: c.DbName,
+		client: cli,
+	}, nil
+}
+
+func (b *influxdbBackend) Write(points []*CollectorPoint) error {
 	bp, _ := client.NewBatchPoints(client.BatchPointsConfig{
-		Database:  c.dbName,
+		Database:  b.dbName,
 		Precision: "ms",
 	})
-	bp.AddPoints(points)
-	return c.client.Write(bp)
+	for _, p := range points {
+		pt, err := client.NewPoint(p.Measurement, p.Tags, p.Fields, p.Time)
+		if err != nil {
+			return fmt.Errorf("failed to write %s, %v", p.Measurement, err)
+		}
+		bp.AddPoint(pt)
+	}
+	return b.client.Write(bp)
+}
+
+// jsonBackend writes a JSON object per line to the file or to stdout if
+// the url is "-".
+type jsonBackend struct {
+	w io.Writer
+}
+
+func newJsonBackend(c *config.CollectorConfig) (CollectorBackend, error) {
+	if c.Url == "-" {
+		return &jsonBackend{w: os.Stdout}, nil
+	}
+	f, err := os.OpenFile(c.Url, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonBackend{w: f}, nil
+}
+
+func (b *jsonBackend) Write(points []*CollectorPoint) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, p := range points {
+		if err := enc.Encode(p); err != nil {
+			return err
+		}
+	}
+	_, err := b.w.Write(buf.Bytes())
+	return err
+}
+
+// httpBackend POSTs the points to the url as a JSON array, e.g. to a
+// webhook or to the REST proxy of Kafka.
+type httpBackend struct {
+	url    string
+	client *http.Client
+}
+
+func newHttpBackend(c *config.CollectorConfig) (CollectorBackend, error) {
+	return &httpBackend{
+		url: c.Url,
+		client: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}, nil
+}
+
+func (b *httpBackend) Write(points []*CollectorPoint) error {
+	body, err := json.Marshal(points)
+	if err != nil {
+		return err
+	}
+	res, err := b.client.Post(b.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	io.Copy(ioutil.Discard, res.Body)
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("%s returned %s", b.url, res.Status)
+	}
+	return nil
+}
+
+type Collector struct {
+	s             *BgpServer
+	interval      uint64
+	backend       CollectorBackend
+	batchSize     int
+	flushInterval time.Duration
+	points        []*CollectorPoint
+}
+
+// writePoints writes the points at once without the batching.
+// Otherwise the points are buffered until the batch is full or the
+// flush interval passes.
+func (c *Collector) writePoints(points []*CollectorPoint) error {
+	if c.batchSize == 0 {
+		return c.backend.Write(points)
+	}
+	c.points = append(c.points, points...)
+	if len(c.points) >= c.batchSize {
+		return c.flush()
+	}
+	return nil
+}
+
+func (c *Collector) flush() error {
+	points := c.points
+	c.points = nil
+	if len(points) == 0 {
+		return nil
+	}
+	// the points are dropped on failure not to grow the buffer while
+	// the backend is unavailable.
+	return c.backend.Write(points)
 }
 
 func (c *Collector) writePeer(msg *WatchEventPeerState) error {
@@ -68,11 +230,12 @@ func (c *Collector) writePeer(msg *WatchEventPeerState) error {
 		"PeerID": msg.PeerID.String(),
 	}
 
-	pt, err := client.NewPoint(MEATUREMENT_PEER, tags, fields, msg.Timestamp)
-	if err != nil {
-		return err
-	}
-	return c.writePoints([]*client.Point{pt})
+	return c.writePoints([]*CollectorPoint{{
+		Measurement: MEATUREMENT_PEER,
+		Tags:        tags,
+		Fields:      fields,
+		Time:        msg.Timestamp,
+	}})
 }
 
 func path2data(path *table.Path) (map[string]interface{}, map[string]string) {
@@ -127,29 +290,31 @@ func (c *Collector) writeUpdate(msg *WatchEventUpdate) error {
 		return nil
 	}
 	now := time.Now()
-	points := make([]*client.Point, 0, len(msg.PathList))
+	points := make([]*CollectorPoint, 0, len(msg.PathList))
 	for _, path := range msg.PathList {
 		fields, tags := path2data(path)
 		tags["Withdraw"] = fmt.Sprintf("%v", path.IsWithdraw)
-		pt, err := client.NewPoint(MEATUREMENT_UPDATE, tags, fields, now)
-		if err != nil {
-			return fmt.Errorf("failed to write update, %v", err)
-		}
-		points = append(points, pt)
+		points = append(points, &CollectorPoint{
+			Measurement: MEATUREMENT_UPDATE,
+			Tags:        tags,
+			Fields:      fields,
+			Time:        now,
+		})
 	}
 	return c.writePoints(points)
 }
 
 func (c *Collector) writeTable(msg *WatchEventAdjIn) error {
 	now := time.Now()
-	points := make([]*client.Point, 0, len(msg.PathList))
+	points := make([]*CollectorPoint, 0, len(msg.PathList))
 	for _, path := range msg.PathList {
 		fields, tags := path2data(path)
-		pt, err := client.NewPoint(MEATUREMENT_TABLE, tags, fields, now)
-		if err != nil {
-			return fmt.Errorf("failed to write table, %v", err)
-		}
-		points = append(points, pt)
+		points = append(points, &CollectorPoint{
+			Measurement: MEATUREMENT_TABLE,
+			Tags:        tags,
+			Fields:      fields,
+			Time:        now,
+		})
 	}
 	return c.writePoints(points)
 }
@@ -165,10 +330,21 @@ func (c *Collector) loop() {
 		return time.NewTicker(time.Second * time.Duration(c.interval))
 	}()
 
+	flushTicker := func() *time.Ticker {
+		if c.batchSize == 0 {
+			return &time.Ticker{}
+		}
+		return time.NewTicker(c.flushInterval)
+	}()
+
 	for {
 		select {
 		case <-ticker.C:
 			w.Generate(WATCH_EVENT_TYPE_PRE_UPDATE)
+		case <-flushTicker.C:
+			if err := c.flush(); err != nil {
+				log.WithFields(log.Fields{"Type": "collector", "Error": err}).Error("Failed to flush points")
+			}
 		case ev := <-w.Event():
 			switch msg := ev.(type) {
 			case *WatchEventUpdate:
@@ -188,33 +364,33 @@ func (c *Collector) loop() {
 	}
 }
 
-func NewCollector(s *BgpServer, url, dbName string, interval uint64) (*Collector, error) {
-	c, err := client.NewHTTPClient(client.HTTPConfig{
-		Addr: url,
-	})
-	if err != nil {
-		return nil, err
+func NewCollector(s *BgpServer, c *config.CollectorConfig) (*Collector, error) {
+	typ := c.Type
+	if typ == "" {
+		typ = config.COLLECTOR_TYPE_INFLUXDB
 	}
-
-	_, _, err = c.Ping(0)
+	f, ok := collectorBackendMap[typ]
+	if !ok {
+		return nil, fmt.Errorf("unsupported collector type: %s", typ)
+	}
+	backend, err := f(c)
 	if err != nil {
-		log.Error("can not connect to InfluxDB")
-		log.WithFields(log.Fields{"Type": "collector", "Error": err}).Error("Failed to connect to InfluxDB")
 		return nil, err
 	}
-
-	q := client.NewQuery("CREATE DATABASE "+dbName, "", "")
-	if response, err := c.Query(q); err != nil || response.Error() != nil {
-		log.WithFields(log.Fields{"Type": "collector", "Error": err}).Errorf("Failed to create database:%s", dbName)
-		return nil, err
+	if backend == nil {
+		return nil, fmt.Errorf("no backend of the collector type: %s", typ)
 	}
 
+	flushInterval := time.Second
+	if c.FlushInterval != 0 {
+		flushInterval = time.Second * time.Duration(c.FlushInterval)
+	}
 	collector := &Collector{
-		s:        s,
-		url:      url,
-		dbName:   dbName,
-		interval: interval,
-		client:   c,
+		s:             s,
+		interval:      c.TableDumpInterval,
+		backend:       backend,
+		batchSize:     int(c.BatchSize),
+		flushInterval: flushInterval,
 	}
 	go collector.loop()
 	return collector, nil
@@ -0,0 +1,148 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func testCollectorPoints() []*CollectorPoint {
+	return []*CollectorPoint{
+		{
+			Measurement: MEATUREMENT_PEER,
+			Tags:        map[string]string{"PeerAddress": "10.0.0.1", "State": "Established"},
+			Fields:      map[string]interface{}{"PeerID": "1.1.1.1"},
+			Time:        time.Unix(1500000000, 0).UTC(),
+		},
+		{
+			Measurement: MEATUREMENT_UPDATE,
+			Tags:        map[string]string{"PeerAddress": "10.0.0.1", "Withdraw": "false"},
+			Fields:      map[string]interface{}{"NextHop": "10.0.0.1"},
+			Time:        time.Unix(1500000001, 0).UTC(),
+		},
+	}
+}
+
+func TestJsonCollectorBackend(t *testing.T) {
+	assert := assert.New(t)
+	dir, err := ioutil.TempDir("", "gobgp-collector")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "points.json")
+
+	b, err := newJsonBackend(&config.CollectorConfig{Url: name})
+	assert.Nil(err)
+	assert.Nil(b.Write(testCollectorPoints()))
+	assert.Nil(b.Write(testCollectorPoints()[:1]))
+
+	f, err := os.Open(name)
+	assert.Nil(err)
+	defer f.Close()
+	points := make([]*CollectorPoint, 0)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		p := &CollectorPoint{}
+		assert.Nil(json.Unmarshal(scanner.Bytes(), p))
+		points = append(points, p)
+	}
+	assert.Len(points, 3)
+	assert.Equal(MEATUREMENT_UPDATE, points[1].Measurement)
+	assert.Equal("10.0.0.1", points[1].Fields["NextHop"])
+	assert.Equal(testCollectorPoints()[0].Time, points[2].Time)
+}
+
+func TestHttpCollectorBackend(t *testing.T) {
+	assert := assert.New(t)
+	ch := make(chan []*CollectorPoint, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		points := make([]*CollectorPoint, 0)
+		if r.Method != "POST" || json.NewDecoder(r.Body).Decode(&points) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		ch <- points
+	}))
+	defer ts.Close()
+
+	b, err := newHttpBackend(&config.CollectorConfig{Url: ts.URL})
+	assert.Nil(err)
+	assert.Nil(b.Write(testCollectorPoints()))
+	points := <-ch
+	assert.Len(points, 2)
+	assert.Equal(MEATUREMENT_PEER, points[0].Measurement)
+	assert.Equal("Established", points[0].Tags["State"])
+
+	ts404 := httptest.NewServer(http.NotFoundHandler())
+	defer ts404.Close()
+	b, err = newHttpBackend(&config.CollectorConfig{Url: ts404.URL})
+	assert.Nil(err)
+	assert.NotNil(b.Write(testCollectorPoints()))
+}
+
+type testCollectorBackend struct {
+	writes [][]*CollectorPoint
+}
+
+func (b *testCollectorBackend) Write(points []*CollectorPoint) error {
+	b.writes = append(b.writes, points)
+	return nil
+}
+
+func TestCollectorBatch(t *testing.T) {
+	assert := assert.New(t)
+	b := &testCollectorBackend{}
+	c := &Collector{
+		backend:   b,
+		batchSize: 3,
+	}
+	assert.Nil(c.writePoints(testCollectorPoints()))
+	assert.Len(b.writes, 0)
+	assert.Nil(c.writePoints(testCollectorPoints()))
+	assert.Len(b.writes, 1)
+	assert.Len(b.writes[0], 4)
+	assert.Nil(c.writePoints(testCollectorPoints()[:1]))
+	assert.Nil(c.flush())
+	assert.Len(b.writes, 2)
+	assert.Len(b.writes[1], 1)
+	assert.Nil(c.flush())
+	assert.Len(b.writes, 2)
+
+	c.batchSize = 0
+	assert.Nil(c.writePoints(testCollectorPoints()[:1]))
+	assert.Len(b.writes, 3)
+
+	_, err := NewCollector(nil, &config.CollectorConfig{Type: "unknown", Url: "-"})
+	assert.NotNil(err)
+
+	// the loop isn't started without the backend.
+	RegisterCollectorBackend("nil", func(*config.CollectorConfig) (CollectorBackend, error) {
+		return nil, nil
+	})
+	defer delete(collectorBackendMap, "nil")
+	_, err = NewCollector(nil, &config.CollectorConfig{Type: "nil"})
+	assert.NotNil(err)
+}
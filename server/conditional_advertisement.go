@@ -0,0 +1,184 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/citizen-insane/gobgp/table"
+)
+
+// conditionalAdvertisement advertises the routes accepted by the advertise
+// policy to the neighbor only while any best path in the tracked prefix
+// set exists in the Loc-RIB, or with non-exist-prefix-set, doesn't exist.
+type conditionalAdvertisement struct {
+	config config.ConditionalAdvertisementConfig
+	// the best paths in the tracked prefix set.
+	tracked map[string]bool
+	met     bool
+}
+
+func validateConditionalAdvertisements(n *config.Neighbor) error {
+	if n.RouteServer.Config.RouteServerClient && len(n.ConditionalAdvertisements) > 0 {
+		return fmt.Errorf("conditional advertisement isn't supported for route server client")
+	}
+	for _, c := range n.ConditionalAdvertisements {
+		if c.Config.AdvertisePolicy == "" {
+			return fmt.Errorf("advertise-policy of conditional advertisement is not specified")
+		}
+		if (c.Config.ExistPrefixSet == "") == (c.Config.NonExistPrefixSet == "") {
+			return fmt.Errorf("either exist-prefix-set or non-exist-prefix-set must be specified for advertise-policy %s", c.Config.AdvertisePolicy)
+		}
+	}
+	return nil
+}
+
+func conditionalAdvertisementsChanged(a, b []config.ConditionalAdvertisement) bool {
+	if len(a) != len(b) {
+		return true
+	}
+	for i := range a {
+		if !a[i].Config.Equal(&b[i].Config) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *conditionalAdvertisement) prefixSet() string {
+	if c.config.ExistPrefixSet != "" {
+		return c.config.ExistPrefixSet
+	}
+	return c.config.NonExistPrefixSet
+}
+
+func (c *conditionalAdvertisement) isMet() bool {
+	if c.config.ExistPrefixSet != "" {
+		return len(c.tracked) > 0
+	}
+	return len(c.tracked) == 0
+}
+
+// isWithheld returns true if the path is accepted by the advertise policy
+// whose condition isn't met.
+func (peer *Peer) isWithheld(path *table.Path) bool {
+	for _, c := range peer.conditionalAdvertisements {
+		if c.met {
+			continue
+		}
+		if result, err := peer.policy.EvaluatePolicy(c.config.AdvertisePolicy, path, nil); err == nil && result == table.ROUTE_TYPE_ACCEPT {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *BgpServer) trackConditionalAdvertisement(c *conditionalAdvertisement, path *table.Path) {
+	key := path.GetNlri().String()
+	if !path.IsWithdraw && !path.IsNexthopInvalid {
+		match, err := s.policy.MatchPrefixSet(c.prefixSet(), path)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Topic":     "Peer",
+				"PrefixSet": c.prefixSet(),
+				"Error":     err,
+			}).Warn("failed to track the prefix set of conditional advertisement")
+		} else if match {
+			c.tracked[key] = true
+			return
+		}
+	}
+	delete(c.tracked, key)
+}
+
+// setConditionalAdvertisements applies the conditional advertisement
+// configuration of the peer with all the best paths in the Loc-RIB.
+func (s *BgpServer) setConditionalAdvertisements(peer *Peer) {
+	peer.conditionalAdvertisements = make([]*conditionalAdvertisement, 0, len(peer.fsm.pConf.ConditionalAdvertisements))
+	if len(peer.fsm.pConf.ConditionalAdvertisements) == 0 {
+		return
+	}
+	pathList := s.globalRib.GetBestPathList(table.GLOBAL_RIB_NAME, s.globalRib.GetRFlist())
+	for _, a := range peer.fsm.pConf.ConditionalAdvertisements {
+		c := &conditionalAdvertisement{
+			config:  a.Config,
+			tracked: make(map[string]bool),
+		}
+		for _, path := range pathList {
+			s.trackConditionalAdvertisement(c, path)
+		}
+		c.met = c.isMet()
+		peer.conditionalAdvertisements = append(peer.conditionalAdvertisements, c)
+	}
+}
+
+// updateConditionalAdvertisements tracks the best path changes, and
+// advertises or withdraws the routes again when the condition is changed.
+func (s *BgpServer) updateConditionalAdvertisements(pathList []*table.Path) {
+	for _, peer := range s.neighborMap {
+		if len(peer.conditionalAdvertisements) == 0 {
+			continue
+		}
+		changed := false
+		for _, c := range peer.conditionalAdvertisements {
+			for _, path := range pathList {
+				if path == nil || path.IsEOR() {
+					continue
+				}
+				s.trackConditionalAdvertisement(c, path)
+			}
+			if met := c.isMet(); met != c.met {
+				c.met = met
+				log.WithFields(log.Fields{
+					"Topic":           "Peer",
+					"Key":             peer.ID(),
+					"AdvertisePolicy": c.config.AdvertisePolicy,
+					"ConditionMet":    met,
+				}).Info("condition of conditional advertisement is changed")
+				changed = true
+			}
+		}
+		if changed {
+			// the advertised routes could be in any family, e.g.
+			// IPv6 routes for the tracked IPv4 prefix.
+			s.softResetOut(peer.ID(), bgp.RouteFamily(0), false)
+		}
+	}
+}
+
+// refreshConditionalAdvertisements tracks all the best paths again, e.g.
+// after the prefix sets are updated.
+func (s *BgpServer) refreshConditionalAdvertisements() {
+	for _, peer := range s.neighborMap {
+		if len(peer.conditionalAdvertisements) == 0 {
+			continue
+		}
+		met := make([]bool, 0, len(peer.conditionalAdvertisements))
+		for _, c := range peer.conditionalAdvertisements {
+			met = append(met, c.met)
+		}
+		s.setConditionalAdvertisements(peer)
+		for i, c := range peer.conditionalAdvertisements {
+			if c.met != met[i] {
+				s.softResetOut(peer.ID(), bgp.RouteFamily(0), false)
+				break
+			}
+		}
+	}
+}
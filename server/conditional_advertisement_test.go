@@ -0,0 +1,313 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/citizen-insane/gobgp/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConditionalAdvertisement(t *testing.T) {
+	assert := assert.New(t)
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       1,
+			RouterId: "1.1.1.1",
+			Port:     10183,
+		},
+	})
+	assert.Nil(err)
+	// 20.0.0.0/24 is advertised while 10.0.0.0/24 is in the Loc-RIB.
+	err = s.UpdatePolicy(config.RoutingPolicy{
+		DefinedSets: config.DefinedSets{
+			PrefixSets: []config.PrefixSet{{
+				PrefixSetName: "tracked",
+				PrefixList:    []config.Prefix{{IpPrefix: "10.0.0.0/24"}},
+			}, {
+				PrefixSetName: "advertised",
+				PrefixList:    []config.Prefix{{IpPrefix: "20.0.0.0/24"}},
+			}},
+		},
+		PolicyDefinitions: []config.PolicyDefinition{{
+			Name: "advertise",
+			Statements: []config.Statement{{
+				Name: "st1",
+				Conditions: config.Conditions{
+					MatchPrefixSet: config.MatchPrefixSet{PrefixSet: "advertised"},
+				},
+				Actions: config.Actions{
+					RouteDisposition: config.ROUTE_DISPOSITION_ACCEPT_ROUTE,
+				},
+			}},
+		}, {
+			// the local address of the session isn't a valid next hop.
+			Name: "nexthop",
+			Statements: []config.Statement{{
+				Name: "st2",
+				Actions: config.Actions{
+					BgpActions: config.BgpActions{SetNextHop: "10.0.0.1"},
+				},
+			}},
+		}},
+	})
+	assert.Nil(err)
+	err = s.ReplacePolicyAssignment("", table.POLICY_DIRECTION_EXPORT, []*config.PolicyDefinition{{Name: "nexthop"}}, table.ROUTE_TYPE_ACCEPT)
+	assert.Nil(err)
+	n := &config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: "127.0.0.1",
+			PeerAs:          2,
+		},
+		Transport: config.Transport{
+			Config: config.TransportConfig{
+				PassiveMode: true,
+			},
+		},
+		ConditionalAdvertisements: []config.ConditionalAdvertisement{{
+			Config: config.ConditionalAdvertisementConfig{
+				AdvertisePolicy:   "advertise",
+				ExistPrefixSet:    "tracked",
+				NonExistPrefixSet: "tracked",
+			},
+		}},
+	}
+	// either exist-prefix-set or non-exist-prefix-set is allowed.
+	assert.NotNil(s.AddNeighbor(n))
+	n.ConditionalAdvertisements[0].Config.NonExistPrefixSet = ""
+	assert.Nil(s.AddNeighbor(n))
+
+	r := NewBgpServer()
+	go r.Serve()
+	err = r.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       2,
+			RouterId: "2.2.2.2",
+			Port:     -1,
+		},
+	})
+	assert.Nil(err)
+	err = r.AddNeighbor(&config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: "127.0.0.1",
+			PeerAs:          1,
+		},
+		Transport: config.Transport{
+			Config: config.TransportConfig{
+				RemotePort: 10183,
+			},
+		},
+	})
+	assert.Nil(err)
+
+	for r.GetNeighbor(false)[0].State.SessionState != config.SESSION_STATE_ESTABLISHED {
+		time.Sleep(time.Second)
+	}
+
+	newPath := func(prefix string, withdraw bool) *table.Path {
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop("10.0.0.1"),
+		}
+		return table.NewPath(nil, bgp.NewIPAddrPrefix(24, prefix), withdraw, attrs, time.Now(), false)
+	}
+	received := func() bool {
+		return getBestPath(r, bgp.RF_IPv4_UC, "20.0.0.0/24") != nil
+	}
+	wait := func(expected bool) bool {
+		for i := 0; i < 50; i++ {
+			if received() == expected {
+				return true
+			}
+			time.Sleep(time.Millisecond * 100)
+		}
+		return false
+	}
+	state := func() config.ConditionalAdvertisementState {
+		return s.GetNeighbor(false)[0].ConditionalAdvertisements[0].State
+	}
+
+	_, err = s.AddPath("", []*table.Path{newPath("20.0.0.0", false)})
+	assert.Nil(err)
+	assert.False(state().ConditionMet)
+	assert.False(wait(true))
+
+	_, err = s.AddPath("", []*table.Path{newPath("10.0.0.0", false)})
+	assert.Nil(err)
+	assert.True(state().ConditionMet)
+	assert.Equal(uint32(1), state().TrackedPaths)
+	assert.True(wait(true))
+
+	_, err = s.AddPath("", []*table.Path{newPath("10.0.0.0", true)})
+	assert.Nil(err)
+	assert.False(state().ConditionMet)
+	assert.Equal(uint32(0), state().TrackedPaths)
+	assert.True(wait(false))
+
+	// the condition is turned over with non-exist-prefix-set.
+	u := *n
+	u.ConditionalAdvertisements = []config.ConditionalAdvertisement{{
+		Config: config.ConditionalAdvertisementConfig{
+			AdvertisePolicy:   "advertise",
+			NonExistPrefixSet: "tracked",
+		},
+	}}
+	_, err = s.UpdateNeighbor(&u)
+	assert.Nil(err)
+	assert.True(state().ConditionMet)
+	assert.True(wait(true))
+	assert.Equal(config.SESSION_STATE_ESTABLISHED, r.GetNeighbor(false)[0].State.SessionState)
+}
+
+func TestConditionalAdvertisementFamilies(t *testing.T) {
+	assert := assert.New(t)
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       1,
+			RouterId: "1.1.1.1",
+			Port:     10184,
+		},
+	})
+	assert.Nil(err)
+	// 2001:db8::/64 is advertised while 10.0.0.0/24 is in the Loc-RIB.
+	err = s.UpdatePolicy(config.RoutingPolicy{
+		DefinedSets: config.DefinedSets{
+			PrefixSets: []config.PrefixSet{{
+				PrefixSetName: "tracked4",
+				PrefixList:    []config.Prefix{{IpPrefix: "10.0.0.0/24"}},
+			}, {
+				PrefixSetName: "advertised6",
+				PrefixList:    []config.Prefix{{IpPrefix: "2001:db8::/64"}},
+			}},
+		},
+		PolicyDefinitions: []config.PolicyDefinition{{
+			Name: "advertise6",
+			Statements: []config.Statement{{
+				Name: "st3",
+				Conditions: config.Conditions{
+					MatchPrefixSet: config.MatchPrefixSet{PrefixSet: "advertised6"},
+				},
+				Actions: config.Actions{
+					RouteDisposition: config.ROUTE_DISPOSITION_ACCEPT_ROUTE,
+				},
+			}},
+		}, {
+			// the local address of the session isn't a valid next hop.
+			Name: "nexthop6",
+			Statements: []config.Statement{{
+				Name: "st4",
+				Conditions: config.Conditions{
+					MatchPrefixSet: config.MatchPrefixSet{PrefixSet: "advertised6"},
+				},
+				Actions: config.Actions{
+					BgpActions: config.BgpActions{SetNextHop: "2001:db8::1"},
+				},
+			}},
+		}},
+	})
+	assert.Nil(err)
+	err = s.ReplacePolicyAssignment("", table.POLICY_DIRECTION_EXPORT, []*config.PolicyDefinition{{Name: "nexthop6"}}, table.ROUTE_TYPE_ACCEPT)
+	assert.Nil(err)
+	afiSafis := []config.AfiSafi{{
+		Config: config.AfiSafiConfig{AfiSafiName: config.AFI_SAFI_TYPE_IPV4_UNICAST},
+	}, {
+		Config: config.AfiSafiConfig{AfiSafiName: config.AFI_SAFI_TYPE_IPV6_UNICAST},
+	}}
+	err = s.AddNeighbor(&config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: "127.0.0.1",
+			PeerAs:          2,
+		},
+		AfiSafis: afiSafis,
+		Transport: config.Transport{
+			Config: config.TransportConfig{
+				PassiveMode: true,
+			},
+		},
+		ConditionalAdvertisements: []config.ConditionalAdvertisement{{
+			Config: config.ConditionalAdvertisementConfig{
+				AdvertisePolicy: "advertise6",
+				ExistPrefixSet:  "tracked4",
+			},
+		}},
+	})
+	assert.Nil(err)
+
+	r := NewBgpServer()
+	go r.Serve()
+	err = r.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       2,
+			RouterId: "2.2.2.2",
+			Port:     -1,
+		},
+	})
+	assert.Nil(err)
+	err = r.AddNeighbor(&config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: "127.0.0.1",
+			PeerAs:          1,
+		},
+		AfiSafis: afiSafis,
+		Transport: config.Transport{
+			Config: config.TransportConfig{
+				RemotePort: 10184,
+			},
+		},
+	})
+	assert.Nil(err)
+
+	for r.GetNeighbor(false)[0].State.SessionState != config.SESSION_STATE_ESTABLISHED {
+		time.Sleep(time.Second)
+	}
+
+	origin := bgp.NewPathAttributeOrigin(0)
+	tracked := func(withdraw bool) *table.Path {
+		attrs := []bgp.PathAttributeInterface{origin, bgp.NewPathAttributeNextHop("10.0.0.1")}
+		return table.NewPath(nil, bgp.NewIPAddrPrefix(24, "10.0.0.0"), withdraw, attrs, time.Now(), false)
+	}
+	nlri := bgp.NewIPv6AddrPrefix(64, "2001:db8::")
+	attrs := []bgp.PathAttributeInterface{origin, bgp.NewPathAttributeMpReachNLRI("2001:db8::1", []bgp.AddrPrefixInterface{nlri})}
+	wait := func(expected bool) bool {
+		for i := 0; i < 50; i++ {
+			if (getBestPath(r, bgp.RF_IPv6_UC, "2001:db8::/64") != nil) == expected {
+				return true
+			}
+			time.Sleep(time.Millisecond * 100)
+		}
+		return false
+	}
+
+	_, err = s.AddPath("", []*table.Path{table.NewPath(nil, nlri, false, attrs, time.Now(), false)})
+	assert.Nil(err)
+	assert.False(wait(true))
+
+	_, err = s.AddPath("", []*table.Path{tracked(false)})
+	assert.Nil(err)
+	assert.True(wait(true))
+
+	_, err = s.AddPath("", []*table.Path{tracked(true)})
+	assert.Nil(err)
+	assert.True(wait(false))
+}
@@ -0,0 +1,254 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/citizen-insane/gobgp/table"
+)
+
+// defaultOriginate is the default route sent to a neighbor regardless of
+// the Loc-RIB. It doesn't go into the global RIB.
+type defaultOriginate struct {
+	config config.DefaultOriginateConfig
+	family bgp.RouteFamily
+	// the family of the Loc-RIB the route policy is evaluated with, VPN
+	// for VRFed neighbors.
+	ribFamily bgp.RouteFamily
+	// the best paths in the Loc-RIB accepted by the route policy.
+	matched map[string]bool
+	// the originated default route, nil while the route policy accepts
+	// no best path.
+	path *table.Path
+}
+
+func (d *defaultOriginate) isActive() bool {
+	return d.config.RoutePolicy == "" || len(d.matched) > 0
+}
+
+func newDefaultRoute(g *config.Global, family bgp.RouteFamily) *table.Path {
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(bgp.BGP_ORIGIN_ATTR_TYPE_IGP),
+		bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{}),
+	}
+	var nlri bgp.AddrPrefixInterface
+	if family == bgp.RF_IPv4_UC {
+		nlri = bgp.NewIPAddrPrefix(0, "0.0.0.0")
+		attrs = append(attrs, bgp.NewPathAttributeNextHop("0.0.0.0"))
+	} else {
+		nlri = bgp.NewIPv6AddrPrefix(0, "::")
+		attrs = append(attrs, bgp.NewPathAttributeMpReachNLRI("::", []bgp.AddrPrefixInterface{nlri}))
+	}
+	// the next hop is replaced with the local address of the session.
+	pi := &table.PeerInfo{
+		AS:      g.Config.As,
+		LocalID: net.ParseIP(g.Config.RouterId).To4(),
+	}
+	return table.NewPath(pi, nlri, false, attrs, time.Now(), false)
+}
+
+// isDefaultRouteOriginated returns true if the path is the default route
+// of the family which the default route is originated to the peer for.
+// Such a path in the Loc-RIB isn't sent to the peer.
+func (peer *Peer) isDefaultRouteOriginated(path *table.Path) bool {
+	d, ok := peer.defaultOriginates[path.GetRouteFamily()]
+	return ok && d.path != nil && path.GetNlri().String() == d.path.GetNlri().String()
+}
+
+// filterDefaultRoute passes the default route through the export policy
+// of the peer. The default route originated to the peer isn't in the
+// pre-policy Adj-RIB-Out.
+func (peer *Peer) filterDefaultRoute(path *table.Path) *table.Path {
+	if _, ok := peer.fsm.rfMap[path.GetRouteFamily()]; !ok {
+		return nil
+	}
+	path, _ = peer.exportpath(path)
+	return path
+}
+
+// getDefaultRoutes returns the default routes originated to the peer for
+// the families.
+func (peer *Peer) getDefaultRoutes(rfList []bgp.RouteFamily) ([]*table.Path, []*table.Path) {
+	pathList := []*table.Path{}
+	filtered := []*table.Path{}
+	for _, family := range rfList {
+		if d, ok := peer.defaultOriginates[family]; ok && d.path != nil {
+			if p := peer.filterDefaultRoute(d.path); p != nil && !p.IsWithdraw {
+				pathList = append(pathList, p)
+			} else {
+				filtered = append(filtered, d.path)
+			}
+		}
+	}
+	return pathList, filtered
+}
+
+func (s *BgpServer) matchDefaultOriginate(d *defaultOriginate, path *table.Path) bool {
+	if path.IsWithdraw || path.IsNexthopInvalid {
+		return false
+	}
+	result, err := s.policy.EvaluatePolicy(d.config.RoutePolicy, path, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Topic":  "Peer",
+			"Policy": d.config.RoutePolicy,
+			"Error":  err,
+		}).Warn("failed to evaluate the default-originate route policy")
+		return false
+	}
+	return result == table.ROUTE_TYPE_ACCEPT
+}
+
+// scanDefaultOriginate evaluates the route policy with all the best
+// paths in the Loc-RIB.
+func (s *BgpServer) scanDefaultOriginate(d *defaultOriginate) {
+	d.matched = make(map[string]bool)
+	if d.config.RoutePolicy == "" {
+		return
+	}
+	for _, path := range s.globalRib.GetBestPathList(table.GLOBAL_RIB_NAME, []bgp.RouteFamily{d.ribFamily}) {
+		if s.matchDefaultOriginate(d, path) {
+			d.matched[path.GetNlri().String()] = true
+		}
+	}
+}
+
+// originateDefaultRoute advertises or withdraws the default route
+// following the route policy, and returns the paths to be sent to the
+// peer.
+func (s *BgpServer) originateDefaultRoute(peer *Peer, d *defaultOriginate, active bool) []*table.Path {
+	if active == (d.path != nil) {
+		return nil
+	}
+	old := d.path
+	d.path = nil
+	if active {
+		d.path = newDefaultRoute(&s.bgpConfig.Global, d.family)
+	}
+	// the default route is sent with the other paths when the session
+	// is established.
+	if peer.fsm.state != bgp.BGP_FSM_ESTABLISHED || peer.fsm.pConf.GracefulRestart.State.LocalRestarting {
+		return nil
+	}
+	if active {
+		if p := peer.filterDefaultRoute(d.path); p != nil {
+			return []*table.Path{p}
+		}
+		return nil
+	}
+	// the default route in the Loc-RIB replaces the originated one.
+	if peer.fsm.pConf.Config.Vrf == "" {
+		if dst := peer.localRib.GetDestination(old); dst != nil {
+			if best := dst.GetBestPath(peer.TableID()); best != nil {
+				if p, _ := peer.filterpath(best, nil); p != nil {
+					return []*table.Path{p}
+				}
+			}
+		}
+	}
+	if p := peer.filterDefaultRoute(old.Clone(true)); p != nil {
+		return []*table.Path{p}
+	}
+	return nil
+}
+
+// setDefaultOriginate applies the default-originate configuration of the
+// peer, and returns the paths to be sent to the peer.
+func (s *BgpServer) setDefaultOriginate(peer *Peer) []*table.Path {
+	if peer.isRouteServerClient() {
+		return nil
+	}
+	configured := make(map[bgp.RouteFamily]config.DefaultOriginateConfig)
+	for _, a := range peer.fsm.pConf.AfiSafis {
+		family, err := bgp.GetRouteFamily(string(a.Config.AfiSafiName))
+		if err != nil || !a.DefaultOriginate.Config.Enabled {
+			continue
+		}
+		if family != bgp.RF_IPv4_UC && family != bgp.RF_IPv6_UC {
+			log.WithFields(log.Fields{
+				"Topic":  "Peer",
+				"Key":    peer.ID(),
+				"Family": family,
+			}).Warn("default-originate is supported only for ipv4-unicast and ipv6-unicast")
+			continue
+		}
+		configured[family] = a.DefaultOriginate.Config
+	}
+
+	pathList := make([]*table.Path, 0)
+	for family, d := range peer.defaultOriginates {
+		if _, ok := configured[family]; !ok {
+			pathList = append(pathList, s.originateDefaultRoute(peer, d, false)...)
+			delete(peer.defaultOriginates, family)
+		}
+	}
+	for family, c := range configured {
+		d, ok := peer.defaultOriginates[family]
+		if !ok {
+			d = &defaultOriginate{
+				family:    family,
+				ribFamily: peer.toGlobalFamilies([]bgp.RouteFamily{family})[0],
+			}
+			peer.defaultOriginates[family] = d
+		}
+		d.config = c
+		s.scanDefaultOriginate(d)
+		pathList = append(pathList, s.originateDefaultRoute(peer, d, d.isActive())...)
+	}
+	return pathList
+}
+
+// updateDefaultOriginate evaluates the route policy with the best path
+// changes, and returns the paths to be sent to the peer.
+func (s *BgpServer) updateDefaultOriginate(peer *Peer, pathList []*table.Path) []*table.Path {
+	if len(peer.defaultOriginates) == 0 {
+		return nil
+	}
+	outgoing := make([]*table.Path, 0)
+	for _, d := range peer.defaultOriginates {
+		if d.config.RoutePolicy == "" {
+			continue
+		}
+		for _, path := range pathList {
+			if path == nil || path.IsEOR() || path.GetRouteFamily() != d.ribFamily {
+				continue
+			}
+			if s.matchDefaultOriginate(d, path) {
+				d.matched[path.GetNlri().String()] = true
+			} else {
+				delete(d.matched, path.GetNlri().String())
+			}
+		}
+		outgoing = append(outgoing, s.originateDefaultRoute(peer, d, d.isActive())...)
+	}
+	return outgoing
+}
+
+// refreshDefaultOriginate evaluates the route policies of all the peers
+// again, e.g. after the policies are updated.
+func (s *BgpServer) refreshDefaultOriginate() {
+	for _, peer := range s.neighborMap {
+		if len(peer.defaultOriginates) == 0 {
+			continue
+		}
+		s.sendOutgoingPaths(peer, s.setDefaultOriginate(peer), nil)
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/citizen-insane/gobgp/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultOriginate(t *testing.T) {
+	assert := assert.New(t)
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       1,
+			RouterId: "1.1.1.1",
+			Port:     10182,
+		},
+	})
+	assert.Nil(err)
+	// the default route is sent while 10.0.0.0/24 is in the Loc-RIB.
+	err = s.UpdatePolicy(config.RoutingPolicy{
+		DefinedSets: config.DefinedSets{
+			PrefixSets: []config.PrefixSet{{
+				PrefixSetName: "ps1",
+				PrefixList:    []config.Prefix{{IpPrefix: "10.0.0.0/24"}},
+			}},
+		},
+		PolicyDefinitions: []config.PolicyDefinition{{
+			Name: "condition",
+			Statements: []config.Statement{{
+				Name: "st1",
+				Conditions: config.Conditions{
+					MatchPrefixSet: config.MatchPrefixSet{PrefixSet: "ps1"},
+				},
+				Actions: config.Actions{
+					RouteDisposition: config.ROUTE_DISPOSITION_ACCEPT_ROUTE,
+				},
+			}},
+		}, {
+			// the local address of the session isn't a valid next hop.
+			Name: "nexthop",
+			Statements: []config.Statement{{
+				Name: "st2",
+				Actions: config.Actions{
+					BgpActions: config.BgpActions{SetNextHop: "10.0.0.1"},
+				},
+			}},
+		}},
+	})
+	assert.Nil(err)
+	err = s.ReplacePolicyAssignment("", table.POLICY_DIRECTION_EXPORT, []*config.PolicyDefinition{{Name: "nexthop"}}, table.ROUTE_TYPE_ACCEPT)
+	assert.Nil(err)
+	err = s.AddNeighbor(&config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: "127.0.0.1",
+			PeerAs:          2,
+		},
+		Transport: config.Transport{
+			Config: config.TransportConfig{
+				PassiveMode: true,
+			},
+		},
+		AfiSafis: []config.AfiSafi{{
+			Config: config.AfiSafiConfig{
+				AfiSafiName: config.AFI_SAFI_TYPE_IPV4_UNICAST,
+			},
+			DefaultOriginate: config.DefaultOriginate{
+				Config: config.DefaultOriginateConfig{
+					Enabled:     true,
+					RoutePolicy: "condition",
+				},
+			},
+		}},
+	})
+	assert.Nil(err)
+
+	r := NewBgpServer()
+	go r.Serve()
+	err = r.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       2,
+			RouterId: "2.2.2.2",
+			Port:     -1,
+		},
+	})
+	assert.Nil(err)
+	err = r.AddNeighbor(&config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: "127.0.0.1",
+			PeerAs:          1,
+		},
+		Transport: config.Transport{
+			Config: config.TransportConfig{
+				RemotePort: 10182,
+			},
+		},
+	})
+	assert.Nil(err)
+
+	for r.GetNeighbor(false)[0].State.SessionState != config.SESSION_STATE_ESTABLISHED {
+		time.Sleep(time.Second)
+	}
+
+	hasDefault := func(rib *table.Table) bool {
+		for _, dst := range rib.GetDestinations() {
+			if dst.GetNlri().String() == "0.0.0.0/0" {
+				return true
+			}
+		}
+		return false
+	}
+	received := func() bool {
+		rib, err := r.GetRib("", bgp.RF_IPv4_UC, nil)
+		return err == nil && hasDefault(rib)
+	}
+	advertised := func() bool {
+		rib, err := s.GetAdjRib("127.0.0.1", bgp.RF_IPv4_UC, false, nil)
+		return err == nil && hasDefault(rib)
+	}
+	wait := func(f func() bool, expected bool) bool {
+		for i := 0; i < 50; i++ {
+			if f() == expected {
+				return true
+			}
+			time.Sleep(time.Millisecond * 100)
+		}
+		return false
+	}
+
+	assert.False(advertised())
+	assert.False(s.GetNeighbor(false)[0].AfiSafis[0].DefaultOriginate.State.Originated)
+
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeNextHop("10.0.0.1"),
+	}
+	_, err = s.AddPath("", []*table.Path{table.NewPath(nil, bgp.NewIPAddrPrefix(24, "10.0.0.0"), false, attrs, time.Now(), false)})
+	assert.Nil(err)
+	assert.True(advertised())
+	assert.True(s.GetNeighbor(false)[0].AfiSafis[0].DefaultOriginate.State.Originated)
+	assert.True(wait(received, true))
+	// the default route doesn't go into the global RIB.
+	rib, err := s.GetRib("", bgp.RF_IPv4_UC, nil)
+	assert.Nil(err)
+	assert.False(hasDefault(rib))
+
+	_, err = s.AddPath("", []*table.Path{table.NewPath(nil, bgp.NewIPAddrPrefix(24, "10.0.0.0"), true, attrs, time.Now(), false)})
+	assert.Nil(err)
+	assert.False(advertised())
+	assert.True(wait(received, false))
+}
@@ -0,0 +1,329 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/citizen-insane/gobgp/table"
+)
+
+type EventType string
+
+const (
+	EVENT_TYPE_PRE_UPDATE  EventType = "pre-update"
+	EVENT_TYPE_POST_UPDATE EventType = "post-update"
+	EVENT_TYPE_BEST_PATH   EventType = "best-path"
+	EVENT_TYPE_PEER_STATE  EventType = "peer-state"
+	// EVENT_TYPE_GAP tells that the events from Sequence were dropped
+	// because the consumer was slow.
+	EVENT_TYPE_GAP EventType = "gap"
+)
+
+const defaultEventBufferSize = 1024
+
+// Event is a route change or a peer state change. A route event has a
+// path. The JSON encoding is the stable format of the event stream.
+type Event struct {
+	Sequence  uint64    `json:"sequence"`
+	Type      EventType `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	Neighbor  string    `json:"neighbor,omitempty"`
+	PeerAs    uint32    `json:"peer-as,omitempty"`
+	// route events
+	Family      string   `json:"family,omitempty"`
+	Prefix      string   `json:"prefix,omitempty"`
+	Withdrawal  bool     `json:"withdrawal,omitempty"`
+	Nexthop     string   `json:"nexthop,omitempty"`
+	AsPath      string   `json:"as-path,omitempty"`
+	Origin      string   `json:"origin,omitempty"`
+	Med         *uint32  `json:"med,omitempty"`
+	LocalPref   uint32   `json:"local-pref,omitempty"`
+	Communities []string `json:"communities,omitempty"`
+	// peer-state events
+	State      config.SessionState `json:"state,omitempty"`
+	AdminState config.AdminState   `json:"admin-state,omitempty"`
+	// gap events
+	Dropped uint64 `json:"dropped,omitempty"`
+}
+
+// EventFilter selects the events. The empty fields match everything.
+// Families, Neighbors, PrefixSet and Communities are applied to the
+// route events, Neighbors to the peer-state events too.
+type EventFilter struct {
+	Types     []EventType
+	Families  []bgp.RouteFamily
+	Neighbors []string
+	// PrefixSet is the name of the prefix set defined in the policy.
+	PrefixSet string
+	// Communities matches the paths having any of them.
+	Communities []string
+}
+
+// EventWatcher delivers the events matching the filter. The events are
+// dropped instead of being queued when the buffer is full, and an event
+// of EVENT_TYPE_GAP is sent when the consumer catches up.
+type EventWatcher struct {
+	w           *Watcher
+	ch          chan *Event
+	types       map[EventType]bool
+	families    map[bgp.RouteFamily]bool
+	neighbors   map[string]bool
+	prefixSet   *table.PrefixSet
+	communities map[uint32]bool
+	seq         uint64
+	dropped     uint64
+}
+
+func newEventWatcher(f *EventFilter, size int) (*EventWatcher, error) {
+	w := &EventWatcher{
+		ch:          make(chan *Event, size),
+		types:       make(map[EventType]bool),
+		families:    make(map[bgp.RouteFamily]bool),
+		neighbors:   make(map[string]bool),
+		communities: make(map[uint32]bool),
+	}
+	for _, t := range f.Types {
+		switch t {
+		case EVENT_TYPE_PRE_UPDATE, EVENT_TYPE_POST_UPDATE, EVENT_TYPE_BEST_PATH, EVENT_TYPE_PEER_STATE:
+			w.types[t] = true
+		default:
+			return nil, fmt.Errorf("unsupported event type: %s", t)
+		}
+	}
+	if len(w.types) == 0 {
+		for _, t := range []EventType{EVENT_TYPE_PRE_UPDATE, EVENT_TYPE_POST_UPDATE, EVENT_TYPE_BEST_PATH, EVENT_TYPE_PEER_STATE} {
+			w.types[t] = true
+		}
+	}
+	for _, rf := range f.Families {
+		w.families[rf] = true
+	}
+	for _, n := range f.Neighbors {
+		if net.ParseIP(n) == nil {
+			return nil, fmt.Errorf("invalid neighbor address: %s", n)
+		}
+		w.neighbors[net.ParseIP(n).String()] = true
+	}
+	for _, c := range f.Communities {
+		v, err := table.ParseCommunity(c)
+		if err != nil {
+			return nil, err
+		}
+		w.communities[v] = true
+	}
+	return w, nil
+}
+
+// WatchEvent starts the delivery of the events matching the filter. If
+// current is true, the current peer states and paths are sent first.
+func (s *BgpServer) WatchEvent(f *EventFilter, current bool) (*EventWatcher, error) {
+	w, err := newEventWatcher(f, defaultEventBufferSize)
+	if err != nil {
+		return nil, err
+	}
+	if f.PrefixSet != "" {
+		sets, err := s.policy.GetDefinedSet(table.DEFINED_TYPE_PREFIX)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range sets.PrefixSets {
+			if c.PrefixSetName == f.PrefixSet {
+				// a copy not to race with the updates of the set.
+				if w.prefixSet, err = table.NewPrefixSet(c); err != nil {
+					return nil, err
+				}
+				break
+			}
+		}
+		if w.prefixSet == nil {
+			return nil, fmt.Errorf("not found prefix set %s", f.PrefixSet)
+		}
+	}
+
+	opts := make([]WatchOption, 0, len(w.types))
+	if w.types[EVENT_TYPE_PRE_UPDATE] {
+		opts = append(opts, WatchUpdate(current))
+	}
+	if w.types[EVENT_TYPE_POST_UPDATE] {
+		opts = append(opts, WatchPostUpdate(current))
+	}
+	if w.types[EVENT_TYPE_BEST_PATH] {
+		opts = append(opts, WatchBestPath(current))
+	}
+	if w.types[EVENT_TYPE_PEER_STATE] {
+		opts = append(opts, WatchPeerState(current))
+	}
+	w.w = s.Watch(opts...)
+	go w.loop()
+	return w, nil
+}
+
+func (w *EventWatcher) Event() <-chan *Event {
+	return w.ch
+}
+
+func (w *EventWatcher) Stop() {
+	w.w.Stop()
+}
+
+func (w *EventWatcher) loop() {
+	for ev := range w.w.Event() {
+		for _, e := range w.toEvents(ev) {
+			w.push(e)
+		}
+	}
+	close(w.ch)
+}
+
+func (w *EventWatcher) push(e *Event) {
+	w.seq++
+	e.Sequence = w.seq
+	if w.dropped > 0 {
+		select {
+		case w.ch <- &Event{
+			Sequence:  w.seq - w.dropped,
+			Type:      EVENT_TYPE_GAP,
+			Timestamp: time.Now(),
+			Dropped:   w.dropped,
+		}:
+			w.dropped = 0
+		default:
+			w.dropped++
+			return
+		}
+	}
+	select {
+	case w.ch <- e:
+	default:
+		w.dropped++
+	}
+}
+
+func (w *EventWatcher) toEvents(ev WatchEvent) []*Event {
+	l := make([]*Event, 0)
+	add := func(typ EventType, pathList []*table.Path) {
+		for _, path := range pathList {
+			if path == nil || path.IsEOR() || !w.match(path) {
+				continue
+			}
+			l = append(l, newRouteEvent(typ, path))
+		}
+	}
+	switch msg := ev.(type) {
+	case *WatchEventUpdate:
+		typ := EVENT_TYPE_PRE_UPDATE
+		if msg.PostPolicy {
+			typ = EVENT_TYPE_POST_UPDATE
+		}
+		pathList := msg.PathList
+		if len(pathList) == 0 && msg.Message != nil {
+			// the current contents are sent as the messages.
+			pathList = table.ProcessMessage(msg.Message, &table.PeerInfo{
+				AS:      msg.PeerAS,
+				ID:      msg.PeerID,
+				Address: msg.PeerAddress,
+			}, msg.Timestamp)
+		}
+		add(typ, pathList)
+	case *WatchEventBestPath:
+		if len(msg.MultiPathList) > 0 {
+			for _, pathList := range msg.MultiPathList {
+				add(EVENT_TYPE_BEST_PATH, pathList)
+			}
+		} else {
+			add(EVENT_TYPE_BEST_PATH, msg.PathList)
+		}
+	case *WatchEventPeerState:
+		if len(w.neighbors) > 0 && !w.neighbors[msg.PeerAddress.String()] {
+			break
+		}
+		l = append(l, &Event{
+			Type:       EVENT_TYPE_PEER_STATE,
+			Timestamp:  msg.Timestamp,
+			Neighbor:   msg.PeerAddress.String(),
+			PeerAs:     msg.PeerAS,
+			State:      config.IntToSessionStateMap[int(msg.State)],
+			AdminState: config.IntToAdminStateMap[int(msg.AdminState)],
+		})
+	}
+	return l
+}
+
+func (w *EventWatcher) match(path *table.Path) bool {
+	if len(w.families) > 0 && !w.families[path.GetRouteFamily()] {
+		return false
+	}
+	if len(w.neighbors) > 0 {
+		if source := path.GetSource(); source == nil || source.Address == nil || !w.neighbors[source.Address.String()] {
+			return false
+		}
+	}
+	if w.prefixSet != nil && !w.prefixSet.Match(path) {
+		return false
+	}
+	if len(w.communities) > 0 {
+		for _, c := range path.GetCommunities() {
+			if w.communities[c] {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func newRouteEvent(typ EventType, path *table.Path) *Event {
+	e := &Event{
+		Type:       typ,
+		Timestamp:  path.GetTimestamp(),
+		Family:     path.GetRouteFamily().String(),
+		Prefix:     path.GetNlri().String(),
+		Withdrawal: path.IsWithdraw,
+		AsPath:     path.GetAsString(),
+	}
+	if source := path.GetSource(); source != nil && source.Address != nil {
+		e.Neighbor = source.Address.String()
+		e.PeerAs = source.AS
+	}
+	if nexthop := path.GetNexthop(); len(nexthop) > 0 {
+		e.Nexthop = nexthop.String()
+	}
+	if origin, err := path.GetOrigin(); err == nil {
+		switch origin {
+		case bgp.BGP_ORIGIN_ATTR_TYPE_IGP:
+			e.Origin = "i"
+		case bgp.BGP_ORIGIN_ATTR_TYPE_EGP:
+			e.Origin = "e"
+		case bgp.BGP_ORIGIN_ATTR_TYPE_INCOMPLETE:
+			e.Origin = "?"
+		}
+	}
+	if med, err := path.GetMed(); err == nil {
+		e.Med = &med
+	}
+	if !path.IsWithdraw {
+		e.LocalPref, _ = path.GetLocalPref()
+	}
+	for _, c := range path.GetCommunities() {
+		e.Communities = append(e.Communities, fmt.Sprintf("%d:%d", c>>16, c&0xffff))
+	}
+	return e
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/citizen-insane/gobgp/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventFilter(t *testing.T) {
+	assert := assert.New(t)
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       1,
+			RouterId: "1.1.1.1",
+			Port:     -1,
+		},
+	})
+	assert.Nil(err)
+	defer s.Stop()
+
+	ps, err := table.NewPrefixSet(config.PrefixSet{
+		PrefixSetName: "ps1",
+		PrefixList:    []config.Prefix{{IpPrefix: "10.0.0.0/8", MasklengthRange: "8..32"}},
+	})
+	assert.Nil(err)
+	assert.Nil(s.AddDefinedSet(ps))
+
+	_, err = s.WatchEvent(&EventFilter{PrefixSet: "ps2"}, false)
+	assert.NotNil(err)
+	_, err = s.WatchEvent(&EventFilter{Types: []EventType{EVENT_TYPE_GAP}}, false)
+	assert.NotNil(err)
+
+	byPrefix, err := s.WatchEvent(&EventFilter{
+		Types:     []EventType{EVENT_TYPE_BEST_PATH},
+		PrefixSet: "ps1",
+	}, false)
+	assert.Nil(err)
+	defer byPrefix.Stop()
+	byCommunity, err := s.WatchEvent(&EventFilter{
+		Types:       []EventType{EVENT_TYPE_BEST_PATH},
+		Communities: []string{"65000:100"},
+	}, false)
+	assert.Nil(err)
+	defer byCommunity.Stop()
+	byNeighbor, err := s.WatchEvent(&EventFilter{
+		Neighbors: []string{"10.0.0.2"},
+	}, false)
+	assert.Nil(err)
+	defer byNeighbor.Stop()
+
+	newPath := func(prefix string, communities ...uint32) *table.Path {
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop("10.0.0.1"),
+			bgp.NewPathAttributeMultiExitDisc(10),
+		}
+		if len(communities) > 0 {
+			attrs = append(attrs, bgp.NewPathAttributeCommunities(communities))
+		}
+		return table.NewPath(nil, bgp.NewIPAddrPrefix(24, prefix), false, attrs, time.Now(), false)
+	}
+	_, err = s.AddPath("", []*table.Path{newPath("10.1.0.0")})
+	assert.Nil(err)
+	_, err = s.AddPath("", []*table.Path{newPath("20.1.0.0", 65000<<16|100)})
+	assert.Nil(err)
+
+	recv := func(w *EventWatcher) *Event {
+		select {
+		case e := <-w.Event():
+			return e
+		case <-time.After(time.Second * 5):
+			return nil
+		}
+	}
+	e := recv(byPrefix)
+	if assert.NotNil(e) {
+		assert.Equal(uint64(1), e.Sequence)
+		assert.Equal(EVENT_TYPE_BEST_PATH, e.Type)
+		assert.Equal("10.1.0.0/24", e.Prefix)
+		assert.Equal("10.0.0.1", e.Nexthop)
+		assert.Equal("i", e.Origin)
+		assert.Equal(uint32(10), *e.Med)
+	}
+	e = recv(byCommunity)
+	if assert.NotNil(e) {
+		assert.Equal(uint64(1), e.Sequence)
+		assert.Equal("20.1.0.0/24", e.Prefix)
+		assert.Equal([]string{"65000:100"}, e.Communities)
+	}
+
+	_, err = s.AddPath("", []*table.Path{newPath("10.2.0.0")})
+	assert.Nil(err)
+	e = recv(byPrefix)
+	if assert.NotNil(e) {
+		assert.Equal(uint64(2), e.Sequence)
+		assert.Equal("10.2.0.0/24", e.Prefix)
+	}
+	select {
+	case e := <-byNeighbor.Event():
+		t.Errorf("unexpected event %v", e)
+	case e := <-byCommunity.Event():
+		t.Errorf("unexpected event %v", e)
+	default:
+	}
+}
+
+func TestEventGap(t *testing.T) {
+	assert := assert.New(t)
+	w, err := newEventWatcher(&EventFilter{}, 2)
+	assert.Nil(err)
+	for i := 0; i < 5; i++ {
+		w.push(&Event{Type: EVENT_TYPE_PEER_STATE})
+	}
+	assert.Equal(uint64(1), (<-w.ch).Sequence)
+	assert.Equal(uint64(2), (<-w.ch).Sequence)
+	w.push(&Event{Type: EVENT_TYPE_PEER_STATE})
+	gap := <-w.ch
+	assert.Equal(EVENT_TYPE_GAP, gap.Type)
+	assert.Equal(uint64(3), gap.Sequence)
+	assert.Equal(uint64(3), gap.Dropped)
+	e := <-w.ch
+	assert.Equal(uint64(6), e.Sequence)
+
+	b, err := json.Marshal(gap)
+	assert.Nil(err)
+	m := make(map[string]interface{})
+	assert.Nil(json.Unmarshal(b, &m))
+	assert.Equal("gap", m["type"])
+	assert.Equal(float64(3), m["dropped"])
+	assert.Equal(float64(3), m["sequence"])
+}
@@ -49,6 +49,7 @@ const (
 	FSM_OPEN_MSG_RECEIVED       = "open-msg-received"
 	FSM_OPEN_MSG_NEGOTIATED     = "open-msg-negotiated"
 	FSM_HARD_RESET              = "hard-reset"
+	FSM_BFD_DOWN                = "bfd-down"
 )
 
 type FsmMsgType int
@@ -68,6 +69,8 @@ type FsmMsg struct {
 	timestamp time.Time
 	payload   []byte
 	Version   uint
+	// the error handled without a session reset (RFC 7606)
+	handling *bgp.MessageError
 }
 
 type FsmOutgoingMsg struct {
@@ -122,9 +125,11 @@ type FSM struct {
 	adminState           AdminState
 	adminStateCh         chan AdminStateOperation
 	getActiveCh          chan struct{}
+	bfdDownCh            chan struct{}
 	h                    *FSMHandler
 	rfMap                map[bgp.RouteFamily]bool
 	capMap               map[bgp.BGPCapabilityCode][]bgp.ParameterCapabilityInterface
+	marshallingOptions   *bgp.MarshallingOption
 	recvOpen             *bgp.BGPMessage
 	peerInfo             *table.PeerInfo
 	policy               *table.RoutingPolicy
@@ -199,6 +204,7 @@ func NewFSM(gConf *config.Global, pConf *config.Neighbor, policy *table.RoutingP
 		adminState:           adminState,
 		adminStateCh:         make(chan AdminStateOperation, 1),
 		getActiveCh:          make(chan struct{}),
+		bfdDownCh:            make(chan struct{}, 1),
 		rfMap:                make(map[bgp.RouteFamily]bool),
 		capMap:               make(map[bgp.BGPCapabilityCode][]bgp.ParameterCapabilityInterface),
 		peerInfo:             table.NewPeerInfo(gConf, pConf),
@@ -298,6 +304,36 @@ func (fsm *FSM) sendNotification(code, subType uint8, data []byte, msg string) e
 	return fsm.sendNotificationFromErrorMsg(e.(*bgp.MessageError))
 }
 
+// isOwnASLoop returns true when the AS_PATH of a path from an eBGP peer
+// contains the local AS more times than allow-own-as permits. For a peer
+// in the confederation, the path looping through the local member AS or
+// coming back into the confederation is detected (RFC 5065).
+func (fsm *FSM) isOwnASLoop(path *table.Path) bool {
+	if !config.IsEBGPPeer(fsm.gConf, fsm.pConf) {
+		return false
+	}
+	if fsm.pConf.Config.PeerType == config.PEER_TYPE_CONFED_EXTERNAL {
+		for _, as := range path.GetConfedAsList() {
+			if as == fsm.pConf.Config.LocalAs {
+				return true
+			}
+		}
+		for _, as := range path.GetAsList() {
+			if as == fsm.gConf.Confederation.Config.Identifier {
+				return true
+			}
+		}
+		return false
+	}
+	count := 0
+	for _, as := range path.GetAsList() {
+		if as == fsm.pConf.Config.LocalAs {
+			count++
+		}
+	}
+	return count > int(fsm.pConf.AsPathOptions.Config.AllowOwnAs)
+}
+
 func (fsm *FSM) connectLoop() error {
 	tick := int(fsm.pConf.Timers.Config.ConnectRetry)
 	if tick < MIN_CONNECT_RETRY {
@@ -320,9 +356,13 @@ func (fsm *FSM) connectLoop() error {
 		laddr := fsm.pConf.Transport.Config.LocalAddress
 		var conn net.Conn
 		var err error
+		ttl, minTtl := 0, 0
+		if fsm.pConf.Transport.Config.TtlSecurity {
+			ttl, minTtl = ttlFromConfig(fsm.pConf)
+		}
 		if fsm.pConf.Config.AuthPassword != "" {
 			deadline := (MIN_CONNECT_RETRY - 1) * 1000 // msec
-			conn, err = DialTCPTimeoutWithMD5Sig(addr, port, laddr, fsm.pConf.Config.AuthPassword, deadline)
+			conn, err = DialTCPTimeoutWithMD5Sig(addr, port, laddr, fsm.pConf.Config.AuthPassword, ttl, minTtl, deadline)
 		} else {
 			lhost := net.JoinHostPort(laddr, "0")
 			ltcpaddr, e := net.ResolveTCPAddr("tcp", lhost)
@@ -334,6 +374,10 @@ func (fsm *FSM) connectLoop() error {
 				return
 			}
 			d := net.Dialer{LocalAddr: ltcpaddr, Timeout: time.Duration(MIN_CONNECT_RETRY-1) * time.Second}
+			if ttl != 0 {
+				// SYN must be sent with TTL 255 for GTSM
+				d.Control = TcpDialerControl(ttl, minTtl)
+			}
 			conn, err = d.Dial("tcp", host)
 		}
 
@@ -474,13 +518,17 @@ func (h *FSMHandler) active() (bgp.FSMState, FsmStateReason) {
 				break
 			}
 			fsm.conn = conn
-			if fsm.pConf.Config.PeerType == config.PEER_TYPE_EXTERNAL {
-				ttl := 1
-				if fsm.pConf.EbgpMultihop.Config.Enabled == true {
-					ttl = int(fsm.pConf.EbgpMultihop.Config.MultihopTtl)
-				}
-				if ttl != 0 {
-					SetTcpTTLSockopts(conn.(*net.TCPConn), ttl)
+			ttl, minTtl := ttlFromConfig(fsm.pConf)
+			if ttl != 0 {
+				SetTcpTTLSockopts(conn.(*net.TCPConn), ttl)
+			}
+			if minTtl != 0 {
+				if err := SetTcpMinTTLSockopts(conn.(*net.TCPConn), minTtl); err != nil {
+					log.WithFields(log.Fields{
+						"Topic": "Peer",
+						"Key":   fsm.pConf.Config.NeighborAddress,
+						"Error": err,
+					}).Warn("failed to set minimum ttl")
 				}
 			}
 			// we don't implement delayed open timer so move to opensent right
@@ -516,6 +564,29 @@ func (h *FSMHandler) active() (bgp.FSMState, FsmStateReason) {
 	}
 }
 
+// ttlFromConfig returns the TTL of the outgoing packets and the minimum
+// TTL of the incoming ones, zero if the system default is used. With
+// ttl-security (RFC 5082), the neighbor must be within the ebgp-multihop
+// hops, or directly connected.
+func ttlFromConfig(pConf *config.Neighbor) (int, int) {
+	if pConf.Transport.Config.TtlSecurity {
+		hops := 1
+		if pConf.EbgpMultihop.Config.Enabled && pConf.EbgpMultihop.Config.MultihopTtl > 0 {
+			hops = int(pConf.EbgpMultihop.Config.MultihopTtl)
+		}
+		return 255, 256 - hops
+	}
+	// the peers in the other member AS of the confederation are eBGP
+	// peers at the transport level.
+	if t := pConf.Config.PeerType; t == config.PEER_TYPE_EXTERNAL || t == config.PEER_TYPE_CONFED_EXTERNAL {
+		if pConf.EbgpMultihop.Config.Enabled {
+			return int(pConf.EbgpMultihop.Config.MultihopTtl), 0
+		}
+		return 1, 0
+	}
+	return 0, 0
+}
+
 func capabilitiesFromConfig(pConf *config.Neighbor) []bgp.ParameterCapabilityInterface {
 	caps := make([]bgp.ParameterCapabilityInterface, 0, 4)
 	caps = append(caps, bgp.NewCapRouteRefresh())
@@ -525,6 +596,24 @@ func capabilitiesFromConfig(pConf *config.Neighbor) []bgp.ParameterCapabilityInt
 	}
 	caps = append(caps, bgp.NewCapFourOctetASNumber(pConf.Config.LocalAs))
 
+	addPathTuples := make([]*bgp.CapAddPathTuple, 0, len(pConf.AfiSafis))
+	for _, rf := range pConf.AfiSafis {
+		k, _ := bgp.GetRouteFamily(string(rf.Config.AfiSafiName))
+		var mode bgp.BGPAddPathMode
+		if rf.AddPaths.Config.Receive {
+			mode |= bgp.BGP_ADD_PATH_RECEIVE
+		}
+		if rf.AddPaths.Config.SendMax > 0 {
+			mode |= bgp.BGP_ADD_PATH_SEND
+		}
+		if mode > 0 {
+			addPathTuples = append(addPathTuples, bgp.NewCapAddPathTuple(k, mode))
+		}
+	}
+	if len(addPathTuples) > 0 {
+		caps = append(caps, bgp.NewCapAddPath(addPathTuples))
+	}
+
 	if c := pConf.GracefulRestart.Config; c.Enabled {
 		tuples := []*bgp.CapGracefulRestartTuple{}
 		ltuples := []*bgp.CapLongLivedGracefulRestartTuple{}
@@ -585,6 +674,39 @@ func readAll(conn net.Conn, length int) ([]byte, error) {
 	return buf, nil
 }
 
+// errorRouteFamily returns the address family of the erroneous
+// MP_REACH_NLRI or MP_UNREACH_NLRI attribute.
+func errorRouteFamily(e *bgp.MessageError) (bgp.RouteFamily, bool) {
+	switch a := e.ErrorAttribute.(type) {
+	case *bgp.PathAttributeMpReachNLRI:
+		return bgp.AfiSafiToRouteFamily(a.AFI, a.SAFI), true
+	case *bgp.PathAttributeMpUnreachNLRI:
+		return bgp.AfiSafiToRouteFamily(a.AFI, a.SAFI), true
+	}
+	return 0, false
+}
+
+// handlingError returns the approach to handle the error of the received
+// message. The revised error handling (RFC 7606) is applied only to UPDATE
+// messages from the neighbor configured with treat-as-withdraw.
+func (h *FSMHandler) handlingError(m *bgp.BGPMessage, err error) bgp.ErrorHandling {
+	if m == nil || m.Header.Type != bgp.BGP_MSG_UPDATE || !h.fsm.pConf.ErrorHandling.Config.TreatAsWithdraw {
+		return bgp.ERROR_HANDLING_SESSION_RESET
+	}
+	e, ok := err.(*bgp.MessageError)
+	if !ok || e.ErrorHandling == bgp.ERROR_HANDLING_NONE {
+		return bgp.ERROR_HANDLING_SESSION_RESET
+	}
+	if e.ErrorHandling == bgp.ERROR_HANDLING_AFISAFI_DISABLE {
+		// the family must be identified to be disabled
+		rf, ok := errorRouteFamily(e)
+		if _, y := h.fsm.rfMap[rf]; !ok || !y {
+			return bgp.ERROR_HANDLING_SESSION_RESET
+		}
+	}
+	return e.ErrorHandling
+}
+
 func (h *FSMHandler) recvMessageWithError() (*FsmMsg, error) {
 	sendToErrorCh := func(reason FsmStateReason) {
 		// probably doesn't happen but be cautious
@@ -626,7 +748,12 @@ func (h *FSMHandler) recvMessageWithError() (*FsmMsg, error) {
 	}
 
 	now := time.Now()
-	m, err := bgp.ParseBGPBody(hd, bodyBuf)
+	var handling *bgp.MessageError
+	m, err := bgp.ParseBGPBody(hd, bodyBuf, h.fsm.marshallingOptions)
+	if err != nil && h.handlingError(m, err) != bgp.ERROR_HANDLING_SESSION_RESET {
+		handling = err.(*bgp.MessageError)
+		err = nil
+	}
 	if err == nil {
 		h.fsm.bgpMessageStateUpdate(m.Header.Type, true)
 		err = bgp.ValidateBGPMessage(m)
@@ -657,6 +784,12 @@ func (h *FSMHandler) recvMessageWithError() (*FsmMsg, error) {
 				body := m.Body.(*bgp.BGPUpdate)
 				confedCheck := !config.IsConfederationMember(h.fsm.gConf, h.fsm.pConf) && config.IsEBGPPeer(h.fsm.gConf, h.fsm.pConf)
 				_, err = bgp.ValidateUpdateMsg(body, h.fsm.rfMap, confedCheck)
+				if err != nil && h.handlingError(m, err) != bgp.ERROR_HANDLING_SESSION_RESET {
+					if e := err.(*bgp.MessageError); handling == nil || e.ErrorHandling > handling.ErrorHandling {
+						handling = e
+					}
+					err = nil
+				}
 				if err != nil {
 					log.WithFields(log.Fields{
 						"Topic": "Peer",
@@ -671,12 +804,19 @@ func (h *FSMHandler) recvMessageWithError() (*FsmMsg, error) {
 					err = table.UpdatePathAggregator4ByteAs(body)
 					if err == nil {
 						fmsg.PathList = table.ProcessMessage(m, h.fsm.peerInfo, fmsg.timestamp)
+						fmsg.handling = handling
 						id := h.fsm.pConf.Config.NeighborAddress
 						for _, path := range fmsg.PathList {
-							if path.IsEOR() {
+							// treated as withdrawn anyway
+							if path.IsEOR() || (handling != nil && handling.ErrorHandling == bgp.ERROR_HANDLING_TREAT_AS_WITHDRAW) {
 								continue
 							}
-							if h.fsm.policy.ApplyPolicy(id, table.POLICY_DIRECTION_IN, path, nil) == nil {
+							// RFC 7311 3.1: AIGP received on a session where
+							// it isn't enabled is discarded.
+							if !config.IsAigpEnabled(h.fsm.pConf, path.GetRouteFamily()) {
+								path.RemoveAigp()
+							}
+							if h.fsm.isOwnASLoop(path) || h.fsm.policy.ApplyPolicy(id, table.POLICY_DIRECTION_IN, path, nil) == nil {
 								path.Filter(id, table.POLICY_DIRECTION_IN)
 							}
 						}
@@ -776,6 +916,36 @@ func open2Cap(open *bgp.BGPOpen, n *config.Neighbor) (map[bgp.BGPCapabilityCode]
 	return capMap, rfMap
 }
 
+// RFC 7911 4. the path identifiers are used for a family only when
+// the sender advertised SEND and the receiver advertised RECEIVE. The
+// returned modes are from the local point of view.
+func open2AddPath(capMap map[bgp.BGPCapabilityCode][]bgp.ParameterCapabilityInterface, rfMap map[bgp.RouteFamily]bool, n *config.Neighbor) map[bgp.RouteFamily]bgp.BGPAddPathMode {
+	remote := make(map[bgp.RouteFamily]bgp.BGPAddPathMode)
+	for _, c := range capMap[bgp.BGP_CAP_ADD_PATH] {
+		for _, t := range c.(*bgp.CapAddPath).Tuples {
+			remote[t.RouteFamily] = t.Mode
+		}
+	}
+	modes := make(map[bgp.RouteFamily]bgp.BGPAddPathMode)
+	for _, a := range n.AfiSafis {
+		k, _ := bgp.GetRouteFamily(string(a.Config.AfiSafiName))
+		if !rfMap[k] {
+			continue
+		}
+		var mode bgp.BGPAddPathMode
+		if a.AddPaths.Config.Receive && remote[k]&bgp.BGP_ADD_PATH_SEND > 0 {
+			mode |= bgp.BGP_ADD_PATH_RECEIVE
+		}
+		if a.AddPaths.Config.SendMax > 0 && remote[k]&bgp.BGP_ADD_PATH_RECEIVE > 0 {
+			mode |= bgp.BGP_ADD_PATH_SEND
+		}
+		if mode > 0 {
+			modes[k] = mode
+		}
+	}
+	return modes
+}
+
 func (h *FSMHandler) opensent() (bgp.FSMState, FsmStateReason) {
 	fsm := h.fsm
 	m := buildopen(fsm.gConf, fsm.pConf)
@@ -836,6 +1006,9 @@ func (h *FSMHandler) opensent() (bgp.FSMState, FsmStateReason) {
 					}
 					fsm.peerInfo.ID = body.ID
 					fsm.capMap, fsm.rfMap = open2Cap(body, fsm.pConf)
+					fsm.marshallingOptions = &bgp.MarshallingOption{
+						AddPath: open2AddPath(fsm.capMap, fsm.rfMap, fsm.pConf),
+					}
 
 					// calculate HoldTime
 					// RFC 4271 P.13
@@ -1083,7 +1256,7 @@ func (h *FSMHandler) sendMessageloop() error {
 			table.UpdatePathAttrs2ByteAs(m.Body.(*bgp.BGPUpdate))
 			table.UpdatePathAggregator2ByteAs(m.Body.(*bgp.BGPUpdate))
 		}
-		b, err := m.Serialize()
+		b, err := m.Serialize(fsm.marshallingOptions)
 		if err != nil {
 			log.WithFields(log.Fields{
 				"Topic": "Peer",
@@ -1167,7 +1340,7 @@ func (h *FSMHandler) sendMessageloop() error {
 			return nil
 		case o := <-h.outgoing.Out():
 			m := o.(*FsmOutgoingMsg)
-			for _, msg := range table.CreateUpdateMsgFromPaths(m.Paths) {
+			for _, msg := range table.CreateUpdateMsgFromPaths(m.Paths, h.fsm.marshallingOptions) {
 				if err := send(msg); err != nil {
 					return nil
 				}
@@ -1218,6 +1391,12 @@ func (h *FSMHandler) established() (bgp.FSMState, FsmStateReason) {
 
 	fsm.gracefulRestartTimer.Stop()
 
+	// drop the failure detected before the session was established
+	select {
+	case <-fsm.bfdDownCh:
+	default:
+	}
+
 	for {
 		select {
 		case <-h.t.Dying():
@@ -1254,6 +1433,15 @@ func (h *FSMHandler) established() (bgp.FSMState, FsmStateReason) {
 			m := bgp.NewBGPNotificationMessage(bgp.BGP_ERROR_HOLD_TIMER_EXPIRED, 0, nil)
 			h.outgoing.In() <- &FsmOutgoingMsg{Notification: m}
 			return bgp.BGP_FSM_IDLE, FSM_HOLD_TIMER_EXPIRED
+		case <-fsm.bfdDownCh:
+			log.WithFields(log.Fields{
+				"Topic": "Peer",
+				"Key":   fsm.pConf.Config.NeighborAddress,
+				"State": fsm.state.String(),
+			}).Warn("BFD session down")
+			h.conn.Close()
+			h.t.Kill(nil)
+			return bgp.BGP_FSM_IDLE, FSM_BFD_DOWN
 		case <-h.holdTimerResetCh:
 			if fsm.pConf.Timers.State.NegotiatedHoldTime != 0 {
 				holdTimer.Reset(time.Second * time.Duration(fsm.pConf.Timers.State.NegotiatedHoldTime))
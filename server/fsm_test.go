@@ -322,3 +322,98 @@ func open() *bgp.BGPMessage {
 func keepalive() *bgp.BGPMessage {
 	return bgp.NewBGPKeepAliveMessage()
 }
+
+func TestTtlFromConfig(t *testing.T) {
+	assert := assert.New(t)
+	n := &config.Neighbor{}
+
+	// iBGP uses the system default
+	n.Config.PeerType = config.PEER_TYPE_INTERNAL
+	ttl, minTtl := ttlFromConfig(n)
+	assert.Equal(0, ttl)
+	assert.Equal(0, minTtl)
+
+	n.Config.PeerType = config.PEER_TYPE_EXTERNAL
+	ttl, minTtl = ttlFromConfig(n)
+	assert.Equal(1, ttl)
+	assert.Equal(0, minTtl)
+
+	n.Config.PeerType = config.PEER_TYPE_CONFED_EXTERNAL
+	ttl, minTtl = ttlFromConfig(n)
+	assert.Equal(1, ttl)
+	assert.Equal(0, minTtl)
+
+	n.EbgpMultihop.Config.Enabled = true
+	n.EbgpMultihop.Config.MultihopTtl = 3
+	ttl, minTtl = ttlFromConfig(n)
+	assert.Equal(3, ttl)
+	assert.Equal(0, minTtl)
+
+	n.Config.PeerType = config.PEER_TYPE_EXTERNAL
+	ttl, minTtl = ttlFromConfig(n)
+	assert.Equal(3, ttl)
+	assert.Equal(0, minTtl)
+
+	n.Transport.Config.TtlSecurity = true
+	ttl, minTtl = ttlFromConfig(n)
+	assert.Equal(255, ttl)
+	assert.Equal(253, minTtl)
+
+	n.EbgpMultihop.Config.Enabled = false
+	ttl, minTtl = ttlFromConfig(n)
+	assert.Equal(255, ttl)
+	assert.Equal(255, minTtl)
+}
+
+func TestOpen2AddPath(t *testing.T) {
+	assert := assert.New(t)
+	n := &config.Neighbor{
+		AfiSafis: []config.AfiSafi{{
+			Config:   config.AfiSafiConfig{AfiSafiName: config.AFI_SAFI_TYPE_IPV4_UNICAST},
+			AddPaths: config.AddPaths{Config: config.AddPathsConfig{Receive: true, SendMax: 2}},
+		}, {
+			Config:   config.AfiSafiConfig{AfiSafiName: config.AFI_SAFI_TYPE_IPV6_UNICAST},
+			AddPaths: config.AddPaths{Config: config.AddPathsConfig{Receive: true}},
+		}, {
+			Config:   config.AfiSafiConfig{AfiSafiName: config.AFI_SAFI_TYPE_L3VPN_IPV4_UNICAST},
+			AddPaths: config.AddPaths{Config: config.AddPathsConfig{Receive: true, SendMax: 2}},
+		}},
+	}
+	rfMap := map[bgp.RouteFamily]bool{
+		bgp.RF_IPv4_UC: true,
+		bgp.RF_IPv6_UC: true,
+	}
+	capMap := func(tuples ...*bgp.CapAddPathTuple) map[bgp.BGPCapabilityCode][]bgp.ParameterCapabilityInterface {
+		return map[bgp.BGPCapabilityCode][]bgp.ParameterCapabilityInterface{
+			bgp.BGP_CAP_ADD_PATH: {bgp.NewCapAddPath(tuples)},
+		}
+	}
+
+	// nothing is negotiated without the capability of the peer.
+	assert.Len(open2AddPath(nil, rfMap, n), 0)
+
+	// the local modes are the reverse of the remote ones, and the
+	// families not negotiated with the multiprotocol capability are
+	// ignored.
+	modes := open2AddPath(capMap(
+		bgp.NewCapAddPathTuple(bgp.RF_IPv4_UC, bgp.BGP_ADD_PATH_BOTH),
+		bgp.NewCapAddPathTuple(bgp.RF_IPv6_UC, bgp.BGP_ADD_PATH_BOTH),
+		bgp.NewCapAddPathTuple(bgp.RF_IPv4_VPN, bgp.BGP_ADD_PATH_BOTH),
+	), rfMap, n)
+	assert.Len(modes, 2)
+	assert.Equal(bgp.BGP_ADD_PATH_BOTH, modes[bgp.RF_IPv4_UC])
+	assert.Equal(bgp.BGP_ADD_PATH_RECEIVE, modes[bgp.RF_IPv6_UC])
+
+	modes = open2AddPath(capMap(
+		bgp.NewCapAddPathTuple(bgp.RF_IPv4_UC, bgp.BGP_ADD_PATH_RECEIVE),
+		bgp.NewCapAddPathTuple(bgp.RF_IPv6_UC, bgp.BGP_ADD_PATH_RECEIVE),
+	), rfMap, n)
+	assert.Len(modes, 1)
+	assert.Equal(bgp.BGP_ADD_PATH_SEND, modes[bgp.RF_IPv4_UC])
+
+	modes = open2AddPath(capMap(
+		bgp.NewCapAddPathTuple(bgp.RF_IPv4_UC, bgp.BGP_ADD_PATH_SEND),
+	), rfMap, n)
+	assert.Len(modes, 1)
+	assert.Equal(bgp.BGP_ADD_PATH_RECEIVE, modes[bgp.RF_IPv4_UC])
+}
@@ -0,0 +1,328 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os/exec"
+	"sort"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/citizen-insane/gobgp/table"
+	"gopkg.in/tomb.v2"
+)
+
+type healthCheckResult struct {
+	check *healthCheck
+	err   error
+}
+
+// healthCheck probes a local service periodically, and announces the
+// routes bound to it while the service is up. The routes with the
+// degraded MED or communities are announced with them while the service
+// is down instead of being withdrawn.
+type healthCheck struct {
+	t        tomb.Tomb
+	config   config.HealthCheckConfig
+	routes   []config.HealthCheckRoute
+	state    config.HealthCheckState
+	resultCh chan *healthCheckResult
+	// the paths announced for the routes, nil while withdrawn.
+	paths []*table.Path
+}
+
+func (h *healthCheck) probe() error {
+	timeout := time.Duration(h.config.Timeout) * time.Second
+	switch h.config.Type {
+	case config.HEALTH_CHECK_TYPE_TCP:
+		conn, err := net.DialTimeout("tcp", h.config.Address, timeout)
+		if err != nil {
+			return err
+		}
+		conn.Close()
+	case config.HEALTH_CHECK_TYPE_HTTP:
+		client := &http.Client{Timeout: timeout}
+		rsp, err := client.Get(h.config.Url)
+		if err != nil {
+			return err
+		}
+		rsp.Body.Close()
+		if rsp.StatusCode != int(h.config.ExpectedStatus) {
+			return fmt.Errorf("unexpected status %d", rsp.StatusCode)
+		}
+	case config.HEALTH_CHECK_TYPE_EXEC:
+		cmd := exec.Command("/bin/sh", "-c", h.config.Command)
+		if err := cmd.Start(); err != nil {
+			return err
+		}
+		done := make(chan error, 1)
+		go func() {
+			done <- cmd.Wait()
+		}()
+		select {
+		case err := <-done:
+			return err
+		case <-time.After(timeout):
+			cmd.Process.Kill()
+			<-done
+			return fmt.Errorf("timed out")
+		}
+	}
+	return nil
+}
+
+func (h *healthCheck) loop() error {
+	ticker := time.NewTicker(time.Duration(h.config.Interval) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case h.resultCh <- &healthCheckResult{check: h, err: h.probe()}:
+		case <-h.t.Dying():
+			return nil
+		}
+		select {
+		case <-ticker.C:
+		case <-h.t.Dying():
+			return nil
+		}
+	}
+}
+
+func (h *healthCheck) toConfig() *config.HealthCheck {
+	return &config.HealthCheck{
+		Config: h.config,
+		State:  h.state,
+		Routes: append([]config.HealthCheckRoute(nil), h.routes...),
+	}
+}
+
+func isDegradable(c *config.HealthCheckRouteConfig) bool {
+	return c.DegradedMed != 0 || len(c.DegradedCommunityList) > 0
+}
+
+func newHealthCheckPath(c *config.HealthCheckRouteConfig, degraded bool) (*table.Path, error) {
+	ip, n, err := net.ParseCIDR(c.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	ones, _ := n.Mask.Size()
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(bgp.BGP_ORIGIN_ATTR_TYPE_IGP),
+	}
+	// the next hop is replaced with the local address of the session
+	// unless specified.
+	nexthop := c.NextHop
+	var nlri bgp.AddrPrefixInterface
+	if ip.To4() != nil {
+		if nexthop == "" {
+			nexthop = "0.0.0.0"
+		}
+		if net.ParseIP(nexthop).To4() == nil {
+			return nil, fmt.Errorf("invalid next hop %s for %s", nexthop, c.Prefix)
+		}
+		nlri = bgp.NewIPAddrPrefix(uint8(ones), n.IP.String())
+		attrs = append(attrs, bgp.NewPathAttributeNextHop(nexthop))
+	} else {
+		if nexthop == "" {
+			nexthop = "::"
+		}
+		if ip := net.ParseIP(nexthop); ip == nil || ip.To4() != nil {
+			return nil, fmt.Errorf("invalid next hop %s for %s", nexthop, c.Prefix)
+		}
+		nlri = bgp.NewIPv6AddrPrefix(uint8(ones), n.IP.String())
+		attrs = append(attrs, bgp.NewPathAttributeMpReachNLRI(nexthop, []bgp.AddrPrefixInterface{nlri}))
+	}
+
+	med := c.Med
+	communities := append([]string(nil), c.CommunityList...)
+	if degraded {
+		if c.DegradedMed != 0 {
+			med = c.DegradedMed
+		}
+		communities = append(communities, c.DegradedCommunityList...)
+	}
+	if med != 0 {
+		attrs = append(attrs, bgp.NewPathAttributeMultiExitDisc(med))
+	}
+	if len(communities) > 0 {
+		l := make([]uint32, 0, len(communities))
+		for _, s := range communities {
+			v, err := table.ParseCommunity(s)
+			if err != nil {
+				return nil, err
+			}
+			l = append(l, v)
+		}
+		attrs = append(attrs, bgp.NewPathAttributeCommunities(l))
+	}
+	return table.NewPath(nil, nlri, false, attrs, time.Now(), false), nil
+}
+
+type healthCheckManager struct {
+	checks   map[string]*healthCheck
+	resultCh chan *healthCheckResult
+}
+
+func newHealthCheckManager() *healthCheckManager {
+	return &healthCheckManager{
+		checks:   make(map[string]*healthCheck),
+		resultCh: make(chan *healthCheckResult, 16),
+	}
+}
+
+func (m *healthCheckManager) stop() {
+	for name, h := range m.checks {
+		h.t.Kill(nil)
+		delete(m.checks, name)
+	}
+}
+
+// announceHealthCheckRoutes announces or withdraws the routes following
+// the state of the health check.
+func (s *BgpServer) announceHealthCheckRoutes(h *healthCheck) {
+	pathList := make([]*table.Path, 0, len(h.routes))
+	for i, r := range h.routes {
+		var path *table.Path
+		if h.state.Up || isDegradable(&r.Config) {
+			// validated when the health check is added.
+			path, _ = newHealthCheckPath(&r.Config, !h.state.Up)
+		}
+		if path != nil {
+			pathList = append(pathList, path)
+		} else if h.paths[i] != nil {
+			pathList = append(pathList, h.paths[i].Clone(true))
+		}
+		h.paths[i] = path
+	}
+	if len(pathList) == 0 {
+		return
+	}
+	if err := s.fixupApiPath("", pathList); err != nil {
+		log.WithFields(log.Fields{
+			"Topic": "HealthCheck",
+			"Key":   h.config.Name,
+			"Error": err,
+		}).Error("failed to announce the routes")
+		return
+	}
+	s.propagateUpdate(nil, pathList)
+}
+
+func (s *BgpServer) handleHealthCheckResult(r *healthCheckResult) {
+	h := r.check
+	if s.healthCheckManager.checks[h.config.Name] != h {
+		// deleted while probing
+		return
+	}
+	if r.err == nil {
+		h.state.Successes++
+		h.state.Failures = 0
+		h.state.LastError = ""
+		if h.state.Up || h.state.Successes < h.config.Rise {
+			return
+		}
+	} else {
+		h.state.Failures++
+		h.state.Successes = 0
+		h.state.LastError = r.err.Error()
+		if !h.state.Up || h.state.Failures < h.config.Fall {
+			return
+		}
+	}
+	h.state.Up = !h.state.Up
+	h.state.LastChange = time.Now().Unix()
+	fields := log.Fields{
+		"Topic": "HealthCheck",
+		"Key":   h.config.Name,
+		"Up":    h.state.Up,
+	}
+	if r.err != nil {
+		fields["Error"] = r.err
+	}
+	log.WithFields(fields).Info("health check state changed")
+	s.announceHealthCheckRoutes(h)
+}
+
+func (s *BgpServer) AddHealthCheck(c *config.HealthCheck) error {
+	return s.mgmtOperation(func() error {
+		if err := config.SetDefaultHealthCheckConfigValues(&c.Config); err != nil {
+			return err
+		}
+		if _, ok := s.healthCheckManager.checks[c.Config.Name]; ok {
+			return fmt.Errorf("health check %s already exists", c.Config.Name)
+		}
+		for _, r := range c.Routes {
+			if _, err := newHealthCheckPath(&r.Config, false); err != nil {
+				return err
+			}
+			if _, err := newHealthCheckPath(&r.Config, true); err != nil {
+				return err
+			}
+		}
+		h := &healthCheck{
+			config:   c.Config,
+			routes:   append([]config.HealthCheckRoute(nil), c.Routes...),
+			resultCh: s.healthCheckManager.resultCh,
+			paths:    make([]*table.Path, len(c.Routes)),
+		}
+		s.healthCheckManager.checks[c.Config.Name] = h
+		s.announceHealthCheckRoutes(h)
+		h.t.Go(h.loop)
+		return nil
+	}, true)
+}
+
+func (s *BgpServer) DeleteHealthCheck(c *config.HealthCheckConfig) error {
+	return s.mgmtOperation(func() error {
+		h, ok := s.healthCheckManager.checks[c.Name]
+		if !ok {
+			return fmt.Errorf("health check %s not found", c.Name)
+		}
+		h.t.Kill(nil)
+		delete(s.healthCheckManager.checks, c.Name)
+		pathList := make([]*table.Path, 0, len(h.paths))
+		for _, path := range h.paths {
+			if path != nil {
+				pathList = append(pathList, path.Clone(true))
+			}
+		}
+		if len(pathList) > 0 {
+			s.propagateUpdate(nil, pathList)
+		}
+		return nil
+	}, true)
+}
+
+func (s *BgpServer) GetHealthCheck() (l []*config.HealthCheck) {
+	s.mgmtOperation(func() error {
+		names := make([]string, 0, len(s.healthCheckManager.checks))
+		for name := range s.healthCheckManager.checks {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		l = make([]*config.HealthCheck, 0, len(names))
+		for _, name := range names {
+			l = append(l, s.healthCheckManager.checks[name].toConfig())
+		}
+		return nil
+	}, false)
+	return l
+}
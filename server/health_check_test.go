@@ -0,0 +1,125 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthCheckProbe(t *testing.T) {
+	assert := assert.New(t)
+
+	probe := func(c config.HealthCheckConfig) error {
+		c.Name = "test"
+		assert.Nil(config.SetDefaultHealthCheckConfigValues(&c))
+		return (&healthCheck{config: c}).probe()
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(err)
+	addr := l.Addr().String()
+	assert.Nil(probe(config.HealthCheckConfig{Type: config.HEALTH_CHECK_TYPE_TCP, Address: addr}))
+	l.Close()
+	assert.NotNil(probe(config.HealthCheckConfig{Type: config.HEALTH_CHECK_TYPE_TCP, Address: addr}))
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/down" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer ts.Close()
+	assert.Nil(probe(config.HealthCheckConfig{Type: config.HEALTH_CHECK_TYPE_HTTP, Url: ts.URL + "/up"}))
+	assert.NotNil(probe(config.HealthCheckConfig{Type: config.HEALTH_CHECK_TYPE_HTTP, Url: ts.URL + "/down"}))
+	assert.Nil(probe(config.HealthCheckConfig{Type: config.HEALTH_CHECK_TYPE_HTTP, Url: ts.URL + "/down", ExpectedStatus: http.StatusServiceUnavailable}))
+
+	assert.Nil(probe(config.HealthCheckConfig{Type: config.HEALTH_CHECK_TYPE_EXEC, Command: "exit 0"}))
+	assert.NotNil(probe(config.HealthCheckConfig{Type: config.HEALTH_CHECK_TYPE_EXEC, Command: "exit 1"}))
+	assert.NotNil(probe(config.HealthCheckConfig{Type: config.HEALTH_CHECK_TYPE_EXEC, Command: "sleep 5", Timeout: 1, Interval: 1}))
+}
+
+func TestHealthCheck(t *testing.T) {
+	assert := assert.New(t)
+	s := newAggregateTestServer(t)
+	defer s.Stop()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(err)
+	c := &config.HealthCheck{
+		Config: config.HealthCheckConfig{
+			Name:     "web",
+			Type:     config.HEALTH_CHECK_TYPE_TCP,
+			Address:  l.Addr().String(),
+			Interval: 1,
+			Rise:     1,
+			Fall:     1,
+		},
+		Routes: []config.HealthCheckRoute{{
+			Config: config.HealthCheckRouteConfig{Prefix: "192.0.2.1/32"},
+		}, {
+			Config: config.HealthCheckRouteConfig{Prefix: "192.0.2.2/32", DegradedMed: 100},
+		}},
+	}
+	assert.NotNil(s.AddHealthCheck(&config.HealthCheck{
+		Config: config.HealthCheckConfig{Name: "invalid", Address: "127.0.0.1:80"},
+		Routes: []config.HealthCheckRoute{{Config: config.HealthCheckRouteConfig{Prefix: "192.0.2.1"}}},
+	}))
+	assert.Nil(s.AddHealthCheck(c))
+	assert.NotNil(s.AddHealthCheck(c))
+
+	wait := func(up bool) bool {
+		for i := 0; i < 50; i++ {
+			if l := s.GetHealthCheck(); len(l) == 1 && l[0].State.Up == up {
+				return true
+			}
+			time.Sleep(time.Millisecond * 100)
+		}
+		return false
+	}
+	med := func(prefix string) uint32 {
+		med, _ := getBestPath(s, bgp.RF_IPv4_UC, prefix).GetMed()
+		return med
+	}
+
+	assert.True(wait(true))
+	assert.NotNil(getBestPath(s, bgp.RF_IPv4_UC, "192.0.2.1/32"))
+	if assert.NotNil(getBestPath(s, bgp.RF_IPv4_UC, "192.0.2.2/32")) {
+		assert.Equal(uint32(0), med("192.0.2.2/32"))
+	}
+
+	// the route with the degraded MED is kept while the check is down.
+	l.Close()
+	assert.True(wait(false))
+	assert.Nil(getBestPath(s, bgp.RF_IPv4_UC, "192.0.2.1/32"))
+	if assert.NotNil(getBestPath(s, bgp.RF_IPv4_UC, "192.0.2.2/32")) {
+		assert.Equal(uint32(100), med("192.0.2.2/32"))
+	}
+	h := s.GetHealthCheck()[0]
+	assert.NotEqual("", h.State.LastError)
+	assert.NotEqual(int64(0), h.State.LastChange)
+
+	assert.Nil(s.DeleteHealthCheck(&c.Config))
+	assert.NotNil(s.DeleteHealthCheck(&c.Config))
+	assert.Nil(getBestPath(s, bgp.RF_IPv4_UC, "192.0.2.2/32"))
+	assert.Len(s.GetHealthCheck(), 0)
+}
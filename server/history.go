@@ -0,0 +1,80 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"time"
+)
+
+// bestPathHistory numbers the best path changes and keeps the recent
+// ones to replay them to the watchers resuming from a sequence number.
+// The sequence numbers are valid only within the epoch, which differs
+// every time gobgpd starts. Up to size paths are kept, and nothing is
+// kept if size is zero.
+type bestPathHistory struct {
+	epoch  uint64
+	seq    uint64
+	events []*WatchEventBestPath
+	paths  int
+	size   int
+}
+
+func newBestPathHistory(size int) *bestPathHistory {
+	return &bestPathHistory{
+		epoch: uint64(time.Now().UnixNano()),
+		size:  size,
+	}
+}
+
+func numBestPath(ev *WatchEventBestPath) int {
+	n := len(ev.PathList)
+	for _, l := range ev.MultiPathList {
+		n += len(l)
+	}
+	return n
+}
+
+func (h *bestPathHistory) add(ev *WatchEventBestPath) {
+	h.seq++
+	ev.Epoch = h.epoch
+	ev.Sequence = h.seq
+	if h.size == 0 {
+		return
+	}
+	h.events = append(h.events, ev)
+	h.paths += numBestPath(ev)
+	for h.paths > h.size && len(h.events) > 0 {
+		h.paths -= numBestPath(h.events[0])
+		h.events[0] = nil
+		h.events = h.events[1:]
+	}
+}
+
+// since returns the changes after the sequence number of the epoch.
+// false is returned if some of them are no longer kept or the sequence
+// number is of another epoch, e.g., before gobgpd restarted.
+func (h *bestPathHistory) since(epoch, seq uint64) ([]*WatchEventBestPath, bool) {
+	if epoch != h.epoch || seq > h.seq {
+		return nil, false
+	}
+	if seq == h.seq {
+		return nil, true
+	}
+	if len(h.events) == 0 || h.events[0].Sequence > seq+1 {
+		return nil, false
+	}
+	return h.events[seq+1-h.events[0].Sequence:], true
+}
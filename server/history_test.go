@@ -0,0 +1,154 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/citizen-insane/gobgp/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBestPathHistory(t *testing.T) {
+	assert := assert.New(t)
+	h := newBestPathHistory(3)
+	l, ok := h.since(h.epoch, 0)
+	assert.True(ok)
+	assert.Len(l, 0)
+
+	for i := 0; i < 3; i++ {
+		h.add(&WatchEventBestPath{PathList: make([]*table.Path, 1)})
+	}
+	l, ok = h.since(h.epoch, 0)
+	assert.True(ok)
+	assert.Len(l, 3)
+	assert.Equal(uint64(1), l[0].Sequence)
+	l, ok = h.since(h.epoch, 2)
+	assert.True(ok)
+	assert.Len(l, 1)
+	assert.Equal(uint64(3), l[0].Sequence)
+
+	// the first two changes are dropped.
+	h.add(&WatchEventBestPath{PathList: make([]*table.Path, 2)})
+	_, ok = h.since(h.epoch, 0)
+	assert.False(ok)
+	_, ok = h.since(h.epoch, 1)
+	assert.False(ok)
+	l, ok = h.since(h.epoch, 2)
+	assert.True(ok)
+	assert.Len(l, 2)
+	l, ok = h.since(h.epoch, 4)
+	assert.True(ok)
+	assert.Len(l, 0)
+	_, ok = h.since(h.epoch, 5)
+	assert.False(ok)
+
+	// the sequence number before restarting.
+	_, ok = h.since(h.epoch+1, 4)
+	assert.False(ok)
+	_, ok = newBestPathHistory(3).since(h.epoch, 0)
+	assert.False(ok)
+
+	// the changes are only numbered.
+	h = newBestPathHistory(0)
+	h.add(&WatchEventBestPath{PathList: make([]*table.Path, 1)})
+	assert.Equal(uint64(1), h.seq)
+	assert.Nil(h.events)
+	_, ok = h.since(h.epoch, 0)
+	assert.False(ok)
+	l, ok = h.since(h.epoch, 1)
+	assert.True(ok)
+	assert.Len(l, 0)
+}
+
+func TestWatchBestPathFrom(t *testing.T) {
+	assert := assert.New(t)
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:                  1,
+			RouterId:            "1.1.1.1",
+			Port:                -1,
+			BestPathHistorySize: 2,
+		},
+	})
+	assert.Nil(err)
+	defer s.Stop()
+
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeNextHop("10.0.0.1"),
+	}
+	for _, prefix := range []string{"10.1.0.0", "10.2.0.0", "10.3.0.0"} {
+		_, err = s.AddPath("", []*table.Path{table.NewPath(nil, bgp.NewIPAddrPrefix(24, prefix), false, attrs, time.Now(), false)})
+		assert.Nil(err)
+	}
+
+	recv := func(w *Watcher) *WatchEventBestPath {
+		select {
+		case ev := <-w.Event():
+			return ev.(*WatchEventBestPath)
+		case <-time.After(time.Second * 5):
+			return nil
+		}
+	}
+
+	epoch := s.bestHistory.epoch
+	w := s.Watch(WatchBestPathFrom(epoch, 1))
+	ev := recv(w)
+	if assert.NotNil(ev) {
+		assert.Equal(uint64(2), ev.Sequence)
+		assert.Equal(epoch, ev.Epoch)
+		assert.False(ev.Snapshot)
+		assert.Equal("10.2.0.0/24", ev.PathList[0].GetNlri().String())
+	}
+	ev = recv(w)
+	if assert.NotNil(ev) {
+		assert.Equal(uint64(3), ev.Sequence)
+	}
+	_, err = s.AddPath("", []*table.Path{table.NewPath(nil, bgp.NewIPAddrPrefix(24, "10.1.0.0"), true, attrs, time.Now(), false)})
+	assert.Nil(err)
+	ev = recv(w)
+	if assert.NotNil(ev) {
+		assert.Equal(uint64(4), ev.Sequence)
+		assert.True(ev.PathList[0].IsWithdraw)
+	}
+	w.Stop()
+
+	// the change after 1 is no longer kept.
+	w = s.Watch(WatchBestPathFrom(epoch, 1))
+	ev = recv(w)
+	if assert.NotNil(ev) {
+		assert.Equal(uint64(4), ev.Sequence)
+		assert.Equal(epoch, ev.Epoch)
+		assert.True(ev.Snapshot)
+		assert.Len(ev.PathList, 2)
+	}
+	w.Stop()
+
+	// the sequence number of another process.
+	w = s.Watch(WatchBestPathFrom(epoch-1, 3))
+	ev = recv(w)
+	if assert.NotNil(ev) {
+		assert.True(ev.Snapshot)
+		assert.Len(ev.PathList, 2)
+	}
+	w.Stop()
+}
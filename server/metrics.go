@@ -0,0 +1,187 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/citizen-insane/gobgp/table"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	peerStateDesc = prometheus.NewDesc(
+		"gobgp_peer_session_state",
+		"Session state of the neighbor (0: idle, 1: connect, 2: active, 3: opensent, 4: openconfirm, 5: established).",
+		[]string{"peer"}, nil)
+	peerUptimeDesc = prometheus.NewDesc(
+		"gobgp_peer_uptime_seconds",
+		"Seconds since the session with the neighbor was established, 0 if it isn't established.",
+		[]string{"peer"}, nil)
+	peerReceivedDesc = prometheus.NewDesc(
+		"gobgp_peer_messages_received_total",
+		"Number of the messages received from the neighbor.",
+		[]string{"peer", "type"}, nil)
+	peerSentDesc = prometheus.NewDesc(
+		"gobgp_peer_messages_sent_total",
+		"Number of the messages sent to the neighbor.",
+		[]string{"peer", "type"}, nil)
+	peerPrefixesReceivedDesc = prometheus.NewDesc(
+		"gobgp_peer_prefixes_received",
+		"Number of the prefixes in the Adj-RIB-In of the neighbor.",
+		[]string{"peer", "family"}, nil)
+	peerPrefixesAcceptedDesc = prometheus.NewDesc(
+		"gobgp_peer_prefixes_accepted",
+		"Number of the prefixes accepted by the import policy of the neighbor.",
+		[]string{"peer", "family"}, nil)
+	peerPrefixesAdvertisedDesc = prometheus.NewDesc(
+		"gobgp_peer_prefixes_advertised",
+		"Number of the prefixes advertised to the neighbor.",
+		[]string{"peer", "family"}, nil)
+	ribDestinationsDesc = prometheus.NewDesc(
+		"gobgp_rib_destinations",
+		"Number of the destinations in the Loc-RIB.",
+		[]string{"family"}, nil)
+	ribPathsDesc = prometheus.NewDesc(
+		"gobgp_rib_paths",
+		"Number of the paths in the Loc-RIB.",
+		[]string{"family"}, nil)
+	rpkiUpDesc = prometheus.NewDesc(
+		"gobgp_rpki_server_up",
+		"1 if the session with the RPKI server is up.",
+		[]string{"server"}, nil)
+	rpkiRoasDesc = prometheus.NewDesc(
+		"gobgp_rpki_roas",
+		"Number of the ROAs received from the RPKI server.",
+		[]string{"server", "family"}, nil)
+)
+
+func newMgmtDurationHistogram() prometheus.Histogram {
+	return prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "gobgp_mgmt_operation_duration_seconds",
+		Help:    "Latency of the management operations of BgpServer including the time waiting for the main loop.",
+		Buckets: []float64{.0001, .0005, .001, .005, .01, .05, .1, .5, 1, 5},
+	})
+}
+
+// MetricsCollector is a prometheus.Collector exporting the state of
+// BgpServer. The state is read when the metrics are scraped.
+type MetricsCollector struct {
+	s *BgpServer
+}
+
+func NewMetricsCollector(s *BgpServer) *MetricsCollector {
+	return &MetricsCollector{s: s}
+}
+
+func (c *MetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{
+		peerStateDesc,
+		peerUptimeDesc,
+		peerReceivedDesc,
+		peerSentDesc,
+		peerPrefixesReceivedDesc,
+		peerPrefixesAcceptedDesc,
+		peerPrefixesAdvertisedDesc,
+		ribDestinationsDesc,
+		ribPathsDesc,
+		rpkiUpDesc,
+		rpkiRoasDesc,
+	} {
+		ch <- d
+	}
+	c.s.mgmtDuration.Describe(ch)
+}
+
+func (c *MetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	var metrics []prometheus.Metric
+	// the metrics are built in the main loop and sent after that not
+	// to block the loop while prometheus is receiving them.
+	c.s.mgmtOperation(func() error {
+		metrics = c.s.metrics()
+		return nil
+	}, false)
+	for _, m := range metrics {
+		ch <- m
+	}
+	c.s.mgmtDuration.Collect(ch)
+}
+
+func (s *BgpServer) metrics() []prometheus.Metric {
+	l := make([]prometheus.Metric, 0)
+	add := func(desc *prometheus.Desc, t prometheus.ValueType, v float64, labels ...string) {
+		l = append(l, prometheus.MustNewConstMetric(desc, t, v, labels...))
+	}
+
+	now := time.Now().Unix()
+	for addr, peer := range s.neighborMap {
+		state := peer.fsm.state
+		add(peerStateDesc, prometheus.GaugeValue, float64(state), addr)
+		uptime := int64(0)
+		if state == bgp.BGP_FSM_ESTABLISHED {
+			uptime = now - peer.fsm.pConf.Timers.State.Uptime
+		}
+		add(peerUptimeDesc, prometheus.GaugeValue, float64(uptime), addr)
+
+		messages := func(desc *prometheus.Desc, m config.Received) {
+			for typ, v := range map[string]uint64{
+				"open":         m.Open,
+				"update":       m.Update,
+				"notification": m.Notification,
+				"keepalive":    m.Keepalive,
+				"refresh":      m.Refresh,
+				"discarded":    m.Discarded,
+				"total":        m.Total,
+			} {
+				add(desc, prometheus.CounterValue, float64(v), addr, typ)
+			}
+		}
+		messages(peerReceivedDesc, peer.fsm.pConf.State.Messages.Received)
+		messages(peerSentDesc, config.Received(peer.fsm.pConf.State.Messages.Sent))
+
+		for _, rf := range peer.configuredRFlist() {
+			family := rf.String()
+			add(peerPrefixesReceivedDesc, prometheus.GaugeValue, float64(peer.adjRibIn.Count([]bgp.RouteFamily{rf})), addr, family)
+			add(peerPrefixesAcceptedDesc, prometheus.GaugeValue, float64(peer.adjRibIn.Accepted([]bgp.RouteFamily{rf})), addr, family)
+			add(peerPrefixesAdvertisedDesc, prometheus.GaugeValue, float64(peer.advertised(rf)), addr, family)
+		}
+	}
+
+	for _, rf := range s.globalRib.GetRFlist() {
+		info, err := s.globalRib.TableInfo(table.GLOBAL_RIB_NAME, rf)
+		if err != nil {
+			continue
+		}
+		add(ribDestinationsDesc, prometheus.GaugeValue, float64(info.NumDestination), rf.String())
+		add(ribPathsDesc, prometheus.GaugeValue, float64(info.NumPath), rf.String())
+	}
+
+	for _, r := range s.roaManager.GetServers() {
+		host := net.JoinHostPort(r.Config.Address, strconv.Itoa(int(r.Config.Port)))
+		up := 0.0
+		if r.State.Up {
+			up = 1
+		}
+		add(rpkiUpDesc, prometheus.GaugeValue, up, host)
+		add(rpkiRoasDesc, prometheus.GaugeValue, float64(r.State.RecordsV4), host, bgp.RF_IPv4_UC.String())
+		add(rpkiRoasDesc, prometheus.GaugeValue, float64(r.State.RecordsV6), host, bgp.RF_IPv6_UC.String())
+	}
+	return l
+}
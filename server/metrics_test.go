@@ -0,0 +1,153 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/citizen-insane/gobgp/table"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsCollector(t *testing.T) {
+	assert := assert.New(t)
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       1,
+			RouterId: "1.1.1.1",
+			Port:     -1,
+		},
+	})
+	assert.Nil(err)
+	defer s.Stop()
+
+	err = s.AddNeighbor(&config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: "10.0.0.1",
+			PeerAs:          2,
+		},
+		Transport: config.Transport{
+			Config: config.TransportConfig{
+				PassiveMode: true,
+			},
+		},
+	})
+	assert.Nil(err)
+
+	registry := prometheus.NewRegistry()
+	assert.Nil(registry.Register(NewMetricsCollector(s)))
+	families, err := registry.Gather()
+	assert.Nil(err)
+
+	m := make(map[string]int)
+	for _, f := range families {
+		m[f.GetName()] = len(f.GetMetric())
+	}
+	assert.Equal(1, m["gobgp_peer_session_state"])
+	assert.Equal(1, m["gobgp_peer_uptime_seconds"])
+	assert.Equal(7, m["gobgp_peer_messages_received_total"])
+	assert.Equal(1, m["gobgp_peer_prefixes_received"])
+	assert.Equal(len(s.globalRib.GetRFlist()), m["gobgp_rib_paths"])
+	assert.Equal(1, m["gobgp_mgmt_operation_duration_seconds"])
+	assert.Equal(0, m["gobgp_rpki_roas"])
+
+	for _, f := range families {
+		if f.GetName() == "gobgp_mgmt_operation_duration_seconds" {
+			assert.True(f.GetMetric()[0].GetHistogram().GetSampleCount() > 0)
+		}
+	}
+}
+
+func TestPeerAdvertised(t *testing.T) {
+	assert := assert.New(t)
+	rib := table.NewTableManager([]bgp.RouteFamily{bgp.RF_IPv4_UC})
+	p, _ := newPeerandInfo(65000, 65001, "192.168.0.1", rib)
+	p.fsm.pConf.Config.PeerType = config.PEER_TYPE_EXTERNAL
+	p.fsm.state = bgp.BGP_FSM_ESTABLISHED
+	p.policy = table.NewRoutingPolicy()
+	setExportPolicy := func(def config.DefaultPolicyType) {
+		p.policy.Reset(&config.RoutingPolicy{}, map[string]config.ApplyPolicy{
+			table.GLOBAL_RIB_NAME: {
+				Config: config.ApplyPolicyConfig{
+					DefaultExportPolicy: def,
+				},
+			},
+		})
+	}
+	setExportPolicy(config.DEFAULT_POLICY_TYPE_ACCEPT_ROUTE)
+	pi := &table.PeerInfo{AS: 65002, Address: net.ParseIP("192.168.0.2")}
+
+	newPath := func(prefix string, med uint32) *table.Path {
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop("10.0.0.1"),
+			bgp.NewPathAttributeMultiExitDisc(med),
+		}
+		return table.NewPath(pi, bgp.NewIPAddrPrefix(24, prefix), false, attrs, time.Now(), false)
+	}
+	send := func(pathList ...*table.Path) []*table.Path {
+		best, old, _ := rib.ProcessPaths([]string{table.GLOBAL_RIB_NAME}, pathList)
+		paths, _ := p.processOutgoingPaths(best[table.GLOBAL_RIB_NAME], old[table.GLOBAL_RIB_NAME])
+		p.updateAdvertised(paths)
+		return paths
+	}
+
+	path1 := newPath("10.10.10.0", 0)
+	path2 := newPath("10.10.20.0", 0)
+	send(path1, path2)
+	assert.Equal(2, p.advertised(bgp.RF_IPv4_UC))
+
+	// the update replacing the path sent before.
+	assert.Len(send(newPath("10.10.10.0", 10)), 1)
+	assert.Equal(2, p.advertised(bgp.RF_IPv4_UC))
+
+	send(path2.Clone(true))
+	assert.Equal(1, p.advertised(bgp.RF_IPv4_UC))
+	p.updateAdvertised([]*table.Path{table.NewEOR(bgp.RF_IPv4_UC)})
+	assert.Equal(1, p.advertised(bgp.RF_IPv4_UC))
+
+	// the withdrawal of the path rejected by the export policy is sent,
+	// but the prefix wasn't advertised.
+	setExportPolicy(config.DEFAULT_POLICY_TYPE_REJECT_ROUTE)
+	path3 := newPath("10.10.30.0", 0)
+	assert.Len(send(path3), 0)
+	assert.Len(send(path3.Clone(true)), 1)
+	assert.Equal(1, p.advertised(bgp.RF_IPv4_UC))
+
+	// the accepted path replacing the rejected one.
+	send(path3)
+	setExportPolicy(config.DEFAULT_POLICY_TYPE_ACCEPT_ROUTE)
+	assert.Len(send(newPath("10.10.30.0", 10)), 1)
+	assert.Equal(2, p.advertised(bgp.RF_IPv4_UC))
+
+	// all the paths are sent again.
+	paths, filtered, _ := p.getBestFromLocal([]bgp.RouteFamily{bgp.RF_IPv4_UC})
+	assert.Empty(filtered)
+	p.updateAdvertised(paths)
+	assert.Equal(2, p.advertised(bgp.RF_IPv4_UC))
+	assert.Equal(len(paths), p.advertised(bgp.RF_IPv4_UC))
+
+	// unnecessary withdrawn
+	p.updateAdvertised([]*table.Path{path1.Clone(true), path2.Clone(true)})
+	assert.Equal(1, p.advertised(bgp.RF_IPv4_UC))
+}
@@ -19,6 +19,7 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"sort"
 	"time"
 
 	log "github.com/Sirupsen/logrus"
@@ -357,6 +358,28 @@ func (m *mrtManager) disable(c *config.MrtConfig) error {
 	return nil
 }
 
+func (m *mrtManager) getConfig() []config.Mrt {
+	names := make([]string, 0, len(m.writer))
+	for name := range m.writer {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	l := make([]config.Mrt, 0, len(names))
+	for _, name := range names {
+		w := m.writer[name]
+		l = append(l, config.Mrt{
+			Config: config.MrtConfig{
+				DumpType:         w.dumpType,
+				FileName:         w.filename,
+				TableName:        w.tablename,
+				DumpInterval:     w.dumpInterval,
+				RotationInterval: w.rotationInterval,
+			},
+		})
+	}
+	return l
+}
+
 func newMrtManager(s *BgpServer) *mrtManager {
 	return &mrtManager{
 		bgpServer: s,
@@ -16,6 +16,7 @@
 package server
 
 import (
+	"bytes"
 	"fmt"
 	log "github.com/Sirupsen/logrus"
 	"github.com/eapache/channels"
@@ -27,10 +28,90 @@ import (
 )
 
 const (
-	FLOP_THRESHOLD    = time.Second * 30
-	MIN_CONNECT_RETRY = 10
+	FLOP_THRESHOLD         = time.Second * 30
+	MIN_CONNECT_RETRY      = 10
+	DAMPING_REUSE_INTERVAL = time.Second * 10
 )
 
+// peerGroupMember is the configuration given to the member of a peer
+// group, which is merged with the one of the peer group.
+type peerGroupMember struct {
+	conf config.Neighbor
+	// keys given to the member, see config.BgpConfigSet.NeighborKeys.
+	keys map[string]bool
+}
+
+type PeerGroup struct {
+	Conf             *config.PeerGroup
+	members          map[string]peerGroupMember
+	dynamicNeighbors map[string]*config.DynamicNeighbor
+}
+
+func NewPeerGroup(c *config.PeerGroup) *PeerGroup {
+	return &PeerGroup{
+		Conf:             c,
+		members:          make(map[string]peerGroupMember),
+		dynamicNeighbors: make(map[string]*config.DynamicNeighbor),
+	}
+}
+
+func (pg *PeerGroup) AddMember(c config.Neighbor, keys map[string]bool) {
+	pg.members[c.Config.NeighborAddress] = peerGroupMember{conf: c, keys: keys}
+}
+
+func (pg *PeerGroup) DeleteMember(c config.Neighbor) {
+	delete(pg.members, c.Config.NeighborAddress)
+}
+
+func (pg *PeerGroup) AddDynamicNeighbor(c *config.DynamicNeighbor) {
+	pg.dynamicNeighbors[c.Config.Prefix] = c
+}
+
+func (pg *PeerGroup) DeleteDynamicNeighbor(c *config.DynamicNeighbor) {
+	delete(pg.dynamicNeighbors, c.Config.Prefix)
+}
+
+// dynamicNeighborConfig returns the configuration of the dynamic
+// neighbor inherited from the peer group.
+func dynamicNeighborConfig(g *config.Global, neighborAddress string, pg *config.PeerGroup) (*config.Neighbor, error) {
+	conf := config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: neighborAddress,
+			PeerGroup:       pg.Config.PeerGroupName,
+		},
+		Transport: config.Transport{
+			Config: config.TransportConfig{
+				PassiveMode: true,
+			},
+		},
+	}
+	if err := config.OverwriteNeighborConfigWithPeerGroup(&conf, pg, nil); err != nil {
+		return nil, fmt.Errorf("can't overwrite neighbor config: %s", err)
+	}
+	if err := config.SetDefaultNeighborConfigValues(&conf, g); err != nil {
+		return nil, fmt.Errorf("can't set default config: %s", err)
+	}
+	return &conf, nil
+}
+
+func newDynamicPeer(g *config.Global, neighborAddress string, pg *config.PeerGroup, loc *table.TableManager, policy *table.RoutingPolicy) *Peer {
+	conf, err := dynamicNeighborConfig(g, neighborAddress, pg)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Topic": "Peer",
+			"Key":   neighborAddress,
+		}).Debug(err)
+		return nil
+	}
+	peer := NewPeer(g, conf, loc, policy)
+	peer.dynamic = true
+	// a dynamic peer is created for an accepted connection so that it
+	// starts from the active state instead of waiting in idle.
+	peer.fsm.state = bgp.BGP_FSM_ACTIVE
+	peer.fsm.pConf.State.SessionState = config.IntToSessionStateMap[int(bgp.BGP_FSM_ACTIVE)]
+	return peer
+}
+
 type Peer struct {
 	tableId           string
 	fsm               *FSM
@@ -40,6 +121,26 @@ type Peer struct {
 	localRib          *table.TableManager
 	prefixLimitWarned map[bgp.RouteFamily]bool
 	llgrEndChs        []chan struct{}
+	dynamic           bool
+	damping           *table.Damping
+	// families disabled by the revised error handling (RFC 7606)
+	disabledRfs map[bgp.RouteFamily]bool
+	// the paths before the export policy are returned while the
+	// pre-policy Adj-RIB-Out is watched.
+	watchAdjOut bool
+	// the more specific routes of the summary-only aggregates aren't
+	// advertised.
+	aggregates *aggregateManager
+	// the default routes sent regardless of the Loc-RIB.
+	defaultOriginates map[bgp.RouteFamily]*defaultOriginate
+	// the routes advertised only while the condition is met.
+	conditionalAdvertisements []*conditionalAdvertisement
+	// the prefixes sent to the peer for each family without ADD-PATH
+	// send, kept to count the advertised prefixes.
+	sentPrefixes map[bgp.RouteFamily]map[string]bool
+	// the paths sent to the peer for each prefix and path identifier
+	// in the families with ADD-PATH send enabled.
+	sentAddPaths map[bgp.RouteFamily]map[string]map[uint32]*table.Path
 }
 
 func NewPeer(g *config.Global, conf *config.Neighbor, loc *table.TableManager, policy *table.RoutingPolicy) *Peer {
@@ -49,6 +150,10 @@ func NewPeer(g *config.Global, conf *config.Neighbor, loc *table.TableManager, p
 		policy:            policy,
 		fsm:               NewFSM(g, conf, policy),
 		prefixLimitWarned: make(map[bgp.RouteFamily]bool),
+		disabledRfs:       make(map[bgp.RouteFamily]bool),
+		defaultOriginates: make(map[bgp.RouteFamily]*defaultOriginate),
+		sentPrefixes:      make(map[bgp.RouteFamily]map[string]bool),
+		sentAddPaths:      make(map[bgp.RouteFamily]map[string]map[uint32]*table.Path),
 	}
 	if peer.isRouteServerClient() {
 		peer.tableId = conf.Config.NeighborAddress
@@ -57,6 +162,9 @@ func NewPeer(g *config.Global, conf *config.Neighbor, loc *table.TableManager, p
 	}
 	rfs, _ := config.AfiSafis(conf.AfiSafis).ToRfList()
 	peer.adjRibIn = table.NewAdjRib(peer.ID(), rfs)
+	if conf.Config.RouteFlapDamping {
+		peer.damping = table.NewDamping(rfs, &conf.RouteFlapDamping.Config)
+	}
 	return peer
 }
 
@@ -64,6 +172,10 @@ func (peer *Peer) ID() string {
 	return peer.fsm.pConf.Config.NeighborAddress
 }
 
+func (peer *Peer) isDynamicNeighbor() bool {
+	return peer.dynamic
+}
+
 func (peer *Peer) TableID() string {
 	return peer.tableId
 }
@@ -72,6 +184,10 @@ func (peer *Peer) isIBGPPeer() bool {
 	return peer.fsm.pConf.Config.PeerAs == peer.fsm.gConf.Config.As
 }
 
+func (peer *Peer) isConfederationMember() bool {
+	return peer.fsm.pConf.Config.PeerType == config.PEER_TYPE_CONFED_EXTERNAL
+}
+
 func (peer *Peer) isRouteServerClient() bool {
 	return peer.fsm.pConf.RouteServer.Config.RouteServerClient
 }
@@ -231,7 +347,9 @@ func (peer *Peer) getAccepted(rfList []bgp.RouteFamily) []*table.Path {
 	return peer.adjRibIn.PathList(rfList, true)
 }
 
-func (peer *Peer) filterpath(path, old *table.Path) *table.Path {
+// filterpath returns the path to be sent to the peer, and the path
+// before the export policy while the pre-policy Adj-RIB-Out is watched.
+func (peer *Peer) filterpath(path, old *table.Path) (*table.Path, *table.Path) {
 	// special handling for RTC nlri
 	// see comments in (*Destination).Calculate()
 	if path != nil && path.GetRouteFamily() == bgp.RF_RTC_UC && !path.IsWithdraw {
@@ -252,30 +370,54 @@ func (peer *Peer) filterpath(path, old *table.Path) *table.Path {
 		}
 	}
 
+	if path != nil && !path.IsWithdraw && peer.aggregates.isSuppressed(path) {
+		path = path.Clone(true)
+	}
+
 	// only allow vpnv4 and vpnv6 paths to be advertised to VRFed neighbors.
 	// also check we can import this path using table.CanImportToVrf()
 	// if we can, make it local path by calling (*Path).ToLocal()
 	if path != nil && peer.fsm.pConf.Config.Vrf != "" {
 		if f := path.GetRouteFamily(); f != bgp.RF_IPv4_VPN && f != bgp.RF_IPv6_VPN {
-			return nil
+			return nil, nil
 		}
 		vrf := peer.localRib.Vrfs[peer.fsm.pConf.Config.Vrf]
 		if table.CanImportToVrf(vrf, path) {
 			path = path.ToLocal()
 		} else {
-			return nil
+			return nil, nil
 		}
 	}
 
+	if path != nil && peer.isDefaultRouteOriginated(path) {
+		return nil, nil
+	}
+
+	if path != nil && !path.IsWithdraw && peer.isWithheld(path) {
+		path = path.Clone(true)
+	}
+
 	if path = filterpath(peer, path, old); path == nil {
-		return nil
+		return nil, nil
 	}
+	return peer.exportpath(path)
+}
 
+// exportpath updates the path attributes for the peer and applies the
+// export policy. The path before the export policy is returned too while
+// the pre-policy Adj-RIB-Out is watched.
+func (peer *Peer) exportpath(path *table.Path) (*table.Path, *table.Path) {
 	path = path.Clone(path.IsWithdraw)
 	path.UpdatePathAttrs(peer.fsm.gConf, peer.fsm.pConf)
+	var pre *table.Path
+	if peer.watchAdjOut {
+		pre = path
+		path = path.Clone(path.IsWithdraw)
+	}
 
 	options := &table.PolicyOptions{
-		Info: peer.fsm.peerInfo,
+		Info:        peer.fsm.peerInfo,
+		AigpEnabled: config.IsAigpEnabled(peer.fsm.pConf, path.GetRouteFamily()),
 	}
 	path = peer.policy.ApplyPolicy(peer.TableID(), table.POLICY_DIRECTION_EXPORT, path, options)
 
@@ -293,58 +435,272 @@ func (peer *Peer) filterpath(path, old *table.Path) *table.Path {
 		path = path.Clone(true)
 	}
 
-	// remove local-pref attribute
+	// remove local-pref attribute except for the peers in the
+	// confederation (RFC 5065)
 	// we should do this after applying export policy since policy may
 	// set local-preference
-	if path != nil && !peer.isIBGPPeer() && !peer.isRouteServerClient() {
+	if path != nil && !peer.isIBGPPeer() && !peer.isConfederationMember() && !peer.isRouteServerClient() {
 		path.RemoveLocalPref()
 	}
-	return path
+	return path, pre
+}
+
+func (peer *Peer) isAddPathSendEnabled(family bgp.RouteFamily) bool {
+	// paths are converted to the local ones for vrfed neighbors so the
+	// local path identifiers can't be kept.
+	if peer.fsm.pConf.Config.Vrf != "" || peer.fsm.marshallingOptions == nil {
+		return false
+	}
+	return peer.fsm.marshallingOptions.AddPath[family]&bgp.BGP_ADD_PATH_SEND > 0
+}
+
+func (peer *Peer) getAddPathSendMax(family bgp.RouteFamily) int {
+	for _, a := range peer.fsm.pConf.AfiSafis {
+		if f, _ := bgp.GetRouteFamily(string(a.Config.AfiSafiName)); f == family {
+			return int(a.AddPaths.Config.SendMax)
+		}
+	}
+	return 0
 }
 
-func (peer *Peer) getBestFromLocal(rfList []bgp.RouteFamily) ([]*table.Path, []*table.Path) {
+// RFC 7911 allows to advertise multiple paths for the same prefix.
+// Up to send-max paths are picked from the known paths of the
+// destination in the order of preference. The picked paths are returned
+// unless they were already sent with the same attributes, or all of
+// them when all is true, along with the withdrawals of the paths sent
+// before but no longer picked, and the picked paths before the export
+// policy. dst is nil when the prefix is gone.
+func (peer *Peer) getAddPathsFromDestination(family bgp.RouteFamily, prefix string, dst *table.Destination, all bool) ([]*table.Path, []*table.Path, []*table.Path) {
+	sent := peer.sentAddPaths[family][prefix]
 	pathList := []*table.Path{}
-	filtered := []*table.Path{}
-	for _, path := range peer.localRib.GetBestPathList(peer.TableID(), peer.toGlobalFamilies(rfList)) {
-		if p := peer.filterpath(path, nil); p != nil {
-			pathList = append(pathList, p)
-		} else {
-			filtered = append(filtered, path)
+	preList := []*table.Path{}
+	picked := make(map[uint32]bool)
+	if dst != nil {
+		max := peer.getAddPathSendMax(family)
+		for _, path := range dst.GetKnownPathList(peer.TableID()) {
+			if len(picked) >= max {
+				break
+			}
+			if path.IsNexthopInvalid {
+				continue
+			}
+			p, pre := peer.filterpath(path, nil)
+			if pre != nil {
+				preList = append(preList, pre)
+			}
+			if p == nil || p.IsWithdraw {
+				continue
+			}
+			id := p.GetLocalIdentifier()
+			picked[id] = true
+			if old, ok := sent[id]; all || !ok || !equalPathAttrs(old, p) {
+				pathList = append(pathList, p)
+			}
+		}
+	}
+	withdrawn := []*table.Path{}
+	for id, old := range sent {
+		if !picked[id] {
+			withdrawn = append(withdrawn, old.Clone(true))
 		}
+	}
+	return pathList, withdrawn, preList
+}
 
+// equalPathAttrs returns true if the paths carry the same attributes on
+// the wire.
+func equalPathAttrs(a, b *table.Path) bool {
+	x, y := a.GetPathAttrs(), b.GetPathAttrs()
+	if len(x) != len(y) {
+		return false
 	}
+	for i := range x {
+		bx, err := x[i].Serialize()
+		if err != nil {
+			return false
+		}
+		by, err := y[i].Serialize()
+		if err != nil || !bytes.Equal(bx, by) {
+			return false
+		}
+	}
+	return true
+}
+
+// getBestFromLocal returns the paths to be sent to the peer, the ones
+// filtered out, and the ones before the export policy while the
+// pre-policy Adj-RIB-Out is watched.
+func (peer *Peer) getBestFromLocal(rfList []bgp.RouteFamily) ([]*table.Path, []*table.Path, []*table.Path) {
+	pathList := []*table.Path{}
+	filtered := []*table.Path{}
+	preList := []*table.Path{}
+	for _, family := range peer.toGlobalFamilies(rfList) {
+		if peer.isAddPathSendEnabled(family) {
+			if t, ok := peer.localRib.Tables[family]; ok {
+				for _, dst := range t.GetDestinations() {
+					p, f, pre := peer.getAddPathsFromDestination(family, dst.GetNlri().String(), dst, true)
+					pathList = append(pathList, p...)
+					filtered = append(filtered, f...)
+					preList = append(preList, pre...)
+				}
+			}
+			continue
+		}
+		for _, path := range peer.localRib.GetBestPathList(peer.TableID(), []bgp.RouteFamily{family}) {
+			p, pre := peer.filterpath(path, nil)
+			if p != nil {
+				pathList = append(pathList, p)
+			} else {
+				filtered = append(filtered, path)
+			}
+			if pre != nil {
+				preList = append(preList, pre)
+			}
+		}
+	}
+	p, f := peer.getDefaultRoutes(rfList)
+	pathList = append(pathList, p...)
+	filtered = append(filtered, f...)
 	if peer.isGracefulRestartEnabled() {
 		for _, family := range rfList {
 			pathList = append(pathList, table.NewEOR(family))
 		}
 	}
-	return pathList, filtered
+	return pathList, filtered, preList
 }
 
-func (peer *Peer) processOutgoingPaths(paths, olds []*table.Path) []*table.Path {
+// processOutgoingPaths returns the paths to be sent to the peer, and the
+// ones before the export policy while the pre-policy Adj-RIB-Out is
+// watched.
+func (peer *Peer) processOutgoingPaths(paths, olds []*table.Path) ([]*table.Path, []*table.Path) {
 	if peer.fsm.state != bgp.BGP_FSM_ESTABLISHED {
-		return nil
+		return nil, nil
 	}
 	if peer.fsm.pConf.GracefulRestart.State.LocalRestarting {
 		log.WithFields(log.Fields{
 			"Topic": "Peer",
 			"Key":   peer.fsm.pConf.Config.NeighborAddress,
 		}).Debug("now syncing, suppress sending updates")
-		return nil
+		return nil, nil
 	}
 
 	outgoing := make([]*table.Path, 0, len(paths))
+	preList := []*table.Path{}
 
 	for idx, path := range paths {
+		// handled by processOutgoingAddPaths()
+		if path != nil && peer.isAddPathSendEnabled(path.GetRouteFamily()) {
+			continue
+		}
 		var old *table.Path
 		if olds != nil {
 			old = olds[idx]
 		}
-		if p := peer.filterpath(path, old); p != nil {
+		p, pre := peer.filterpath(path, old)
+		if p != nil {
 			outgoing = append(outgoing, p)
 		}
+		if pre != nil {
+			preList = append(preList, pre)
+		}
+	}
+	return outgoing, preList
+}
+
+// processOutgoingAddPaths re-evaluates the destinations of the updated
+// paths for the families which the peer receives multiple paths for,
+// and returns the changes from the paths sent to the peer along with the
+// picked paths before the export policy.
+func (peer *Peer) processOutgoingAddPaths(paths []*table.Path) ([]*table.Path, []*table.Path) {
+	if peer.fsm.state != bgp.BGP_FSM_ESTABLISHED || peer.fsm.pConf.GracefulRestart.State.LocalRestarting {
+		return nil, nil
+	}
+
+	type key struct {
+		family bgp.RouteFamily
+		prefix string
+	}
+	outgoing := make([]*table.Path, 0, len(paths))
+	preList := []*table.Path{}
+	done := make(map[key]bool)
+	for _, path := range paths {
+		if path == nil || path.IsEOR() {
+			continue
+		}
+		family := path.GetRouteFamily()
+		if !peer.isAddPathSendEnabled(family) {
+			continue
+		}
+		k := key{family, path.GetNlri().String()}
+		if done[k] {
+			continue
+		}
+		done[k] = true
+		pathList, withdrawn, pre := peer.getAddPathsFromDestination(family, k.prefix, peer.localRib.GetDestination(path), false)
+		outgoing = append(outgoing, pathList...)
+		outgoing = append(outgoing, withdrawn...)
+		preList = append(preList, pre...)
+	}
+	return outgoing, preList
+}
+
+// updateAdvertised keeps the prefixes sent or withdrawn by the paths
+// sent to the peer. The paths in the families with ADD-PATH send are
+// kept in sentAddPaths. The withdrawals of the prefixes not sent, e.g.,
+// rejected by the export policy, are ignored.
+func (peer *Peer) updateAdvertised(paths []*table.Path) {
+	for _, path := range paths {
+		if path == nil || path.IsEOR() {
+			continue
+		}
+		family := path.GetRouteFamily()
+		if peer.isAddPathSendEnabled(family) {
+			peer.updateSentAddPaths(path)
+			continue
+		}
+		prefix := path.GetNlri().String()
+		if path.IsWithdraw {
+			delete(peer.sentPrefixes[family], prefix)
+			continue
+		}
+		if peer.sentPrefixes[family] == nil {
+			peer.sentPrefixes[family] = make(map[string]bool)
+		}
+		peer.sentPrefixes[family][prefix] = true
+	}
+}
+
+// advertised returns the number of the prefixes sent to the peer for the
+// family, or the number of the paths with ADD-PATH send.
+func (peer *Peer) advertised(family bgp.RouteFamily) int {
+	n := len(peer.sentPrefixes[family])
+	for _, m := range peer.sentAddPaths[family] {
+		n += len(m)
+	}
+	return n
+}
+
+// updateSentAddPaths keeps the path sent to the peer.
+func (peer *Peer) updateSentAddPaths(path *table.Path) {
+	family := path.GetRouteFamily()
+	prefix := path.GetNlri().String()
+	id := path.GetLocalIdentifier()
+	if path.IsWithdraw {
+		if m := peer.sentAddPaths[family][prefix]; m != nil {
+			delete(m, id)
+			if len(m) == 0 {
+				delete(peer.sentAddPaths[family], prefix)
+			}
+		}
+		return
+	}
+	if peer.sentAddPaths[family] == nil {
+		peer.sentAddPaths[family] = make(map[string]map[uint32]*table.Path)
 	}
-	return outgoing
+	if peer.sentAddPaths[family][prefix] == nil {
+		peer.sentAddPaths[family][prefix] = make(map[uint32]*table.Path)
+	}
+	peer.sentAddPaths[family][prefix][id] = path
 }
 
 func (peer *Peer) handleRouteRefresh(e *FsmMsg) []*table.Path {
@@ -367,7 +723,7 @@ func (peer *Peer) handleRouteRefresh(e *FsmMsg) []*table.Path {
 		return nil
 	}
 	rfList := []bgp.RouteFamily{rf}
-	accepted, filtered := peer.getBestFromLocal(rfList)
+	accepted, filtered, _ := peer.getBestFromLocal(rfList)
 	for _, path := range filtered {
 		path.IsWithdraw = true
 		accepted = append(accepted, path)
@@ -452,17 +808,35 @@ func (peer *Peer) handleUpdate(e *FsmMsg) ([]*table.Path, []bgp.RouteFamily, *bg
 		"attributes":  update.PathAttributes,
 	}).Debug("received update")
 	peer.fsm.pConf.Timers.State.UpdateRecvTime = time.Now().Unix()
-	if len(e.PathList) > 0 {
-		peer.adjRibIn.Update(e.PathList)
+	pathList := make([]*table.Path, 0, len(e.PathList))
+	for _, path := range e.PathList {
+		if !peer.disabledRfs[path.GetRouteFamily()] {
+			pathList = append(pathList, path)
+		}
+	}
+	if e.handling != nil {
+		pathList = peer.handleRevisedError(e.handling, pathList)
+	}
+	if len(pathList) > 0 {
+		// route flap damping compares the paths with the ones replaced.
+		var olds []*table.Path
+		if peer.damping != nil {
+			olds = make([]*table.Path, len(pathList))
+			for i, path := range pathList {
+				olds[i] = peer.adjRibIn.Get(path)
+			}
+		}
+		peer.adjRibIn.Update(pathList)
 		for _, family := range peer.fsm.pConf.AfiSafis {
 			k, _ := bgp.GetRouteFamily(string(family.Config.AfiSafiName))
 			if msg := peer.doPrefixLimit(k, &family.PrefixLimit.Config); msg != nil {
 				return nil, nil, msg
 			}
 		}
-		paths := make([]*table.Path, 0, len(e.PathList))
+		paths := make([]*table.Path, 0, len(pathList))
 		eor := []bgp.RouteFamily{}
-		for _, path := range e.PathList {
+		now := time.Now()
+		for i, path := range pathList {
 			if path.IsEOR() {
 				family := path.GetRouteFamily()
 				log.WithFields(log.Fields{
@@ -473,7 +847,10 @@ func (peer *Peer) handleUpdate(e *FsmMsg) ([]*table.Path, []bgp.RouteFamily, *bg
 				eor = append(eor, family)
 				continue
 			}
-			if path.Filtered(peer.ID()) != table.POLICY_DIRECTION_IN {
+			// suppressed paths are kept in adj-rib-in but withdrawn
+			// from the best path selection until they are reused.
+			suppressed := peer.damping != nil && peer.damping.Update(path, olds[i], now)
+			if path.Filtered(peer.ID()) != table.POLICY_DIRECTION_IN && !suppressed {
 				paths = append(paths, path)
 			} else {
 				paths = append(paths, path.Clone(true))
@@ -484,6 +861,52 @@ func (peer *Peer) handleUpdate(e *FsmMsg) ([]*table.Path, []bgp.RouteFamily, *bg
 	return nil, nil, nil
 }
 
+// handleRevisedError applies the revised error handling (RFC 7606) to the
+// paths of an erroneous UPDATE message.
+func (peer *Peer) handleRevisedError(e *bgp.MessageError, pathList []*table.Path) []*table.Path {
+	state := &peer.fsm.pConf.ErrorHandling.State
+	state.ErroneousUpdateMessages++
+	log.WithFields(log.Fields{
+		"Topic":         "Peer",
+		"Key":           peer.ID(),
+		"ErrorHandling": e.ErrorHandling,
+		"error":         e,
+	}).Warn("received erroneous update")
+	switch e.ErrorHandling {
+	case bgp.ERROR_HANDLING_ATTRIBUTE_DISCARD:
+		state.AttributeDiscardCount++
+	case bgp.ERROR_HANDLING_TREAT_AS_WITHDRAW:
+		state.TreatAsWithdrawCount++
+		l := make([]*table.Path, 0, len(pathList))
+		for _, path := range pathList {
+			if !path.IsEOR() {
+				path = path.Clone(true)
+			}
+			l = append(l, path)
+		}
+		return l
+	case bgp.ERROR_HANDLING_AFISAFI_DISABLE:
+		state.AfiSafiDisableCount++
+		rf, _ := errorRouteFamily(e)
+		peer.disabledRfs[rf] = true
+		l := make([]*table.Path, 0, len(pathList))
+		for _, path := range pathList {
+			if path.GetRouteFamily() != rf {
+				l = append(l, path)
+			}
+		}
+		// withdraw the paths already received
+		for _, path := range peer.adjRibIn.PathList([]bgp.RouteFamily{rf}, false) {
+			l = append(l, path.Clone(true))
+		}
+		if peer.damping != nil {
+			peer.damping.Drop([]bgp.RouteFamily{rf})
+		}
+		return l
+	}
+	return pathList
+}
+
 func (peer *Peer) startFSMHandler(incoming *channels.InfiniteChannel, stateCh chan *FsmMsg) {
 	peer.fsm.h = NewFSMHandler(peer.fsm, incoming, stateCh, peer.outgoing)
 }
@@ -492,6 +915,43 @@ func (peer *Peer) StaleAll(rfList []bgp.RouteFamily) {
 	peer.adjRibIn.StaleAll(rfList)
 }
 
+func (peer *Peer) stopFSM() {
+	addr := peer.ID()
+	failed := false
+	t1 := time.AfterFunc(time.Minute*5, func() {
+		log.WithFields(log.Fields{
+			"Topic": "Peer",
+		}).Warnf("Failed to free the fsm.h.t for %s", addr)
+		failed = true
+	})
+	peer.fsm.h.t.Kill(nil)
+	peer.fsm.h.t.Wait()
+	t1.Stop()
+	if !failed {
+		log.WithFields(log.Fields{
+			"Topic": "Peer",
+			"Key":   addr,
+		}).Debug("freed fsm.h.t")
+		cleanInfiniteChannel(peer.outgoing)
+	}
+	failed = false
+	t2 := time.AfterFunc(time.Minute*5, func() {
+		log.WithFields(log.Fields{
+			"Topic": "Peer",
+		}).Warnf("Failed to free the fsm.t for %s", addr)
+		failed = true
+	})
+	peer.fsm.t.Kill(nil)
+	peer.fsm.t.Wait()
+	t2.Stop()
+	if !failed {
+		log.WithFields(log.Fields{
+			"Topic": "Peer",
+			"Key":   addr,
+		}).Debug("freed fsm.t")
+	}
+}
+
 func (peer *Peer) PassConn(conn *net.TCPConn) {
 	select {
 	case peer.fsm.connCh <- conn:
@@ -511,6 +971,21 @@ func (peer *Peer) ToConfig(getAdvertised bool) *config.Neighbor {
 	conf.AfiSafis = make([]config.AfiSafi, len(peer.fsm.pConf.AfiSafis))
 	for i := 0; i < len(peer.fsm.pConf.AfiSafis); i++ {
 		conf.AfiSafis[i] = peer.fsm.pConf.AfiSafis[i]
+		if family, err := bgp.GetRouteFamily(string(conf.AfiSafis[i].Config.AfiSafiName)); err == nil {
+			d, ok := peer.defaultOriginates[family]
+			conf.AfiSafis[i].DefaultOriginate.State.Originated = ok && d.path != nil
+		}
+	}
+
+	conf.ConditionalAdvertisements = make([]config.ConditionalAdvertisement, len(peer.fsm.pConf.ConditionalAdvertisements))
+	for i, a := range peer.fsm.pConf.ConditionalAdvertisements {
+		conf.ConditionalAdvertisements[i] = a
+		for _, c := range peer.conditionalAdvertisements {
+			if c.config.AdvertisePolicy == a.Config.AdvertisePolicy {
+				conf.ConditionalAdvertisements[i].State.ConditionMet = c.met
+				conf.ConditionalAdvertisements[i].State.TrackedPaths = uint32(len(c.tracked))
+			}
+		}
 	}
 
 	remoteCap := make([]bgp.ParameterCapabilityInterface, 0, len(peer.fsm.capMap))
@@ -532,7 +1007,7 @@ func (peer *Peer) ToConfig(getAdvertised bool) *config.Neighbor {
 	if peer.fsm.state == bgp.BGP_FSM_ESTABLISHED {
 		rfList := peer.configuredRFlist()
 		if getAdvertised {
-			pathList, _ := peer.getBestFromLocal(rfList)
+			pathList, _, _ := peer.getBestFromLocal(rfList)
 			conf.State.AdjTable.Advertised = uint32(len(pathList))
 		} else {
 			conf.State.AdjTable.Advertised = 0
@@ -542,6 +1017,9 @@ func (peer *Peer) ToConfig(getAdvertised bool) *config.Neighbor {
 
 		conf.Transport.State.LocalAddress, conf.Transport.State.LocalPort = peer.fsm.LocalHostPort()
 		_, conf.Transport.State.RemotePort = peer.fsm.RemoteHostPort()
+		conf.Transport.State.TtlSecurity = conf.Transport.Config.TtlSecurity
+		ttl, minTtl := ttlFromConfig(&conf)
+		conf.Transport.State.Ttl, conf.Transport.State.MinTtl = uint8(ttl), uint8(minTtl)
 		buf, _ := peer.fsm.recvOpen.Serialize()
 		// need to copy all values here
 		conf.State.ReceivedOpenMessage, _ = bgp.ParseBGPMessage(buf)
@@ -551,4 +1029,22 @@ func (peer *Peer) ToConfig(getAdvertised bool) *config.Neighbor {
 
 func (peer *Peer) DropAll(rfList []bgp.RouteFamily) {
 	peer.adjRibIn.Drop(rfList)
+	if peer.damping != nil {
+		peer.damping.Drop(rfList)
+	}
+}
+
+// reuseDampenedPaths returns the paths in adj-rib-in whose suppression
+// by route flap damping has ended.
+func (peer *Peer) reuseDampenedPaths(now time.Time) []*table.Path {
+	if peer.damping == nil {
+		return nil
+	}
+	paths := make([]*table.Path, 0)
+	for _, path := range peer.damping.Reuse(peer.adjRibIn, now) {
+		if path.Filtered(peer.ID()) != table.POLICY_DIRECTION_IN {
+			paths = append(paths, path)
+		}
+	}
+	return paths
 }
@@ -0,0 +1,406 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	log "github.com/Sirupsen/logrus"
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/citizen-insane/gobgp/table"
+	"net"
+	"sort"
+)
+
+// globalRestartReasons returns the settings of the global section which
+// can't be changed without restarting gobgpd.
+func globalRestartReasons(cur, new *config.Global) []string {
+	reasons := make([]string, 0)
+	if cur.Config.As != new.Config.As {
+		reasons = append(reasons, "global as")
+	}
+	if cur.Config.RouterId != new.Config.RouterId {
+		reasons = append(reasons, "global router-id")
+	}
+	// the listeners and the global policy assignment are changed live.
+	c, n := *cur, *new
+	c.Config, c.ApplyPolicy = n.Config, n.ApplyPolicy
+	if !c.Equal(&n) {
+		reasons = append(reasons, "global")
+	}
+	return reasons
+}
+
+func (s *BgpServer) setPendingRestart(section string, reasons []string) {
+	if len(reasons) == 0 {
+		delete(s.pendingRestart, section)
+	} else {
+		s.pendingRestart[section] = reasons
+	}
+	l := make([]string, 0, len(s.pendingRestart))
+	for _, r := range s.pendingRestart {
+		l = append(l, r...)
+	}
+	sort.Strings(l)
+	s.bgpConfig.Global.State.PendingRestartList = l
+}
+
+// updateListeners opens the listeners on the new address and port. The
+// per-neighbor sockopts of the listeners are set again.
+func (s *BgpServer) updateListeners(c *config.GlobalConfig) error {
+	for _, l := range s.listeners {
+		if err := l.Close(); err != nil {
+			log.WithFields(log.Fields{
+				"Topic": "Config",
+				"Key":   l.l.Addr().String(),
+				"Error": err,
+			}).Warn("failed to close the listener")
+		}
+	}
+	s.listeners = nil
+	s.bgpConfig.Global.Config.Port = c.Port
+	s.bgpConfig.Global.Config.LocalAddressList = c.LocalAddressList
+	if c.Port <= 0 {
+		return nil
+	}
+	if s.acceptCh == nil {
+		s.acceptCh = make(chan *net.TCPConn, 4096)
+	}
+	for _, addr := range c.LocalAddressList {
+		l, err := NewTCPListener(addr, uint32(c.Port), s.acceptCh)
+		if err != nil {
+			return err
+		}
+		s.listeners = append(s.listeners, l)
+	}
+	for addr, peer := range s.neighborMap {
+		for _, l := range s.Listeners(addr) {
+			if password := peer.fsm.pConf.Config.AuthPassword; password != "" {
+				SetTcpMD5SigSockopts(l, addr, password)
+			}
+		}
+	}
+	s.setListenerTtl()
+	return nil
+}
+
+func (s *BgpServer) updateGlobalConfig(c *config.Global) (policyUpdated bool, err error) {
+	var assignGlobalPolicy bool
+	err = s.mgmtOperation(func() error {
+		cur := &s.bgpConfig.Global
+		s.setPendingRestart("global", globalRestartReasons(cur, c))
+		assignGlobalPolicy = !cur.ApplyPolicy.Config.Equal(&c.ApplyPolicy.Config)
+		l, r := cur.Config, c.Config
+		l.As, l.RouterId = r.As, r.RouterId
+		if !l.Equal(&r) {
+			log.WithFields(log.Fields{
+				"Topic":     "Config",
+				"Port":      c.Config.Port,
+				"Addresses": c.Config.LocalAddressList,
+			}).Info("update listeners")
+			return s.updateListeners(&c.Config)
+		}
+		return nil
+	}, true)
+	if err != nil || !assignGlobalPolicy {
+		return false, err
+	}
+
+	a := c.ApplyPolicy.Config
+	toDefaultTable := func(r config.DefaultPolicyType) table.RouteType {
+		var def table.RouteType
+		switch r {
+		case config.DEFAULT_POLICY_TYPE_ACCEPT_ROUTE:
+			def = table.ROUTE_TYPE_ACCEPT
+		case config.DEFAULT_POLICY_TYPE_REJECT_ROUTE:
+			def = table.ROUTE_TYPE_REJECT
+		}
+		return def
+	}
+	toPolicyDefinitions := func(r []string) []*config.PolicyDefinition {
+		p := make([]*config.PolicyDefinition, 0, len(r))
+		for _, n := range r {
+			p = append(p, &config.PolicyDefinition{
+				Name: n,
+			})
+		}
+		return p
+	}
+
+	def := toDefaultTable(a.DefaultImportPolicy)
+	ps := toPolicyDefinitions(a.ImportPolicyList)
+	if err := s.ReplacePolicyAssignment("", table.POLICY_DIRECTION_IMPORT, ps, def); err != nil {
+		return false, err
+	}
+	def = toDefaultTable(a.DefaultExportPolicy)
+	ps = toPolicyDefinitions(a.ExportPolicyList)
+	if err := s.ReplacePolicyAssignment("", table.POLICY_DIRECTION_EXPORT, ps, def); err != nil {
+		return false, err
+	}
+	return true, s.mgmtOperation(func() error {
+		s.bgpConfig.Global.ApplyPolicy = c.ApplyPolicy
+		return nil
+	}, false)
+}
+
+func (s *BgpServer) updateZebraConfig(c *config.ZebraConfig) error {
+	var start bool
+	err := s.mgmtOperation(func() error {
+		cur := s.bgpConfig.Zebra.Config
+		if s.zclient == nil {
+			start = c.Enabled
+			s.setPendingRestart("zebra", nil)
+		} else if !cur.Equal(c) {
+			s.setPendingRestart("zebra", []string{"zebra"})
+		} else {
+			s.setPendingRestart("zebra", nil)
+		}
+		return nil
+	}, false)
+	if err != nil || !start {
+		return err
+	}
+	return s.StartZebraClient(c)
+}
+
+func (s *BgpServer) updateCollectorConfig(c *config.CollectorConfig) error {
+	var start bool
+	err := s.mgmtOperation(func() error {
+		cur := s.bgpConfig.Collector.Config
+		if cur.Url == "" {
+			start = c.Url != ""
+			s.setPendingRestart("collector", nil)
+		} else if !cur.Equal(c) {
+			s.setPendingRestart("collector", []string{"collector"})
+		} else {
+			s.setPendingRestart("collector", nil)
+		}
+		return nil
+	}, false)
+	if err != nil || !start {
+		return err
+	}
+	return s.StartCollector(c)
+}
+
+func (s *BgpServer) addVrfFromConfig(c *config.VrfConfig) error {
+	rd, im, ex, err := config.ParseVrfConfig(c)
+	if err != nil {
+		return err
+	}
+	return s.AddVrf(c.Name, c.Id, rd, im, ex)
+}
+
+// UpdateConfig applies the changes of the configuration to the running
+// server. The changes which need gobgpd to be restarted are logged and
+// reported as the pending-restart list of the global state.
+func (s *BgpServer) UpdateConfig(c *config.BgpConfigSetChanges) {
+	warn := func(err error) {
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Topic": "Config",
+			}).Warn(err)
+		}
+	}
+	var updatePolicy bool
+
+	if c.Global != nil {
+		u, err := s.updateGlobalConfig(c.Global)
+		warn(err)
+		updatePolicy = updatePolicy || u
+	}
+	if c.Zebra != nil {
+		warn(s.updateZebraConfig(&c.Zebra.Config))
+	}
+	if c.Collector != nil {
+		warn(s.updateCollectorConfig(&c.Collector.Config))
+	}
+
+	for _, r := range c.RpkiServers.Deleted {
+		log.Infof("RPKI server %s is deleted", r.Config.Address)
+		warn(s.DeleteRpki(&r.Config))
+	}
+	for _, r := range c.RpkiServers.Updated {
+		log.Infof("RPKI server %s is updated", r.Config.Address)
+		warn(s.DeleteRpki(&r.Config))
+		warn(s.AddRpki(&r.Config))
+	}
+	for _, r := range c.RpkiServers.Added {
+		log.Infof("RPKI server %s is added", r.Config.Address)
+		warn(s.AddRpki(&r.Config))
+	}
+
+	for _, b := range c.BmpServers.Deleted {
+		log.Infof("BMP server %s:%d is deleted", b.Config.Address, b.Config.Port)
+		warn(s.DeleteBmp(&b.Config))
+	}
+	for _, b := range c.BmpServers.Updated {
+		log.Infof("BMP server %s:%d is updated", b.Config.Address, b.Config.Port)
+		warn(s.DeleteBmp(&b.Config))
+		warn(s.AddBmp(&b.Config))
+	}
+	for _, b := range c.BmpServers.Added {
+		log.Infof("BMP server %s:%d is added", b.Config.Address, b.Config.Port)
+		warn(s.AddBmp(&b.Config))
+	}
+
+	for _, m := range c.MrtDump.Deleted {
+		if len(m.Config.FileName) == 0 {
+			continue
+		}
+		log.Infof("MRT dump %s is deleted", m.Config.FileName)
+		warn(s.DisableMrt(&m.Config))
+	}
+	for _, m := range c.MrtDump.Updated {
+		log.Infof("MRT dump %s is updated", m.Config.FileName)
+		warn(s.DisableMrt(&m.Config))
+		warn(s.EnableMrt(&m.Config))
+	}
+	for _, m := range c.MrtDump.Added {
+		if len(m.Config.FileName) == 0 {
+			continue
+		}
+		log.Infof("MRT dump %s is added", m.Config.FileName)
+		warn(s.EnableMrt(&m.Config))
+	}
+
+	// the aggregates are deleted before the VRFs are updated, and added
+	// after the contributor policies.
+	for _, a := range c.Aggregates.Deleted {
+		log.Infof("Aggregate %s is deleted", a.Config.Prefix)
+		warn(s.DeleteAggregate(&a.Config))
+	}
+
+	// the VRFs are added before the neighbors, and deleted after them.
+	for _, v := range c.Vrfs.Added {
+		log.Infof("VRF %s is added", v.Config.Name)
+		warn(s.addVrfFromConfig(&v.Config))
+	}
+	for _, v := range c.Vrfs.Updated {
+		log.Infof("VRF %s is updated", v.Config.Name)
+		section := fmt.Sprintf("vrf %s", v.Config.Name)
+		var reasons []string
+		// the VRF can't be re-created while any neighbor is in it.
+		if err := s.DeleteVrf(v.Config.Name); err != nil {
+			warn(err)
+			reasons = []string{section}
+		} else {
+			warn(s.addVrfFromConfig(&v.Config))
+		}
+		s.mgmtOperation(func() error {
+			s.setPendingRestart(section, reasons)
+			return nil
+		}, false)
+	}
+
+	if c.Policy != nil {
+		log.Info("Policy config is updated")
+		warn(s.UpdatePolicy(*c.Policy))
+		updatePolicy = true
+	}
+
+	for _, a := range c.Aggregates.Updated {
+		log.Infof("Aggregate %s is updated", a.Config.Prefix)
+		warn(s.DeleteAggregate(&a.Config))
+		warn(s.AddAggregate(&a.Config))
+	}
+	for _, a := range c.Aggregates.Added {
+		log.Infof("Aggregate %s is added", a.Config.Prefix)
+		warn(s.AddAggregate(&a.Config))
+	}
+
+	for _, h := range c.HealthChecks.Deleted {
+		log.Infof("HealthCheck %s is deleted", h.Config.Name)
+		warn(s.DeleteHealthCheck(&h.Config))
+	}
+	for i, h := range c.HealthChecks.Updated {
+		log.Infof("HealthCheck %s is updated", h.Config.Name)
+		warn(s.DeleteHealthCheck(&h.Config))
+		warn(s.AddHealthCheck(&c.HealthChecks.Updated[i]))
+	}
+	for i, h := range c.HealthChecks.Added {
+		log.Infof("HealthCheck %s is added", h.Config.Name)
+		warn(s.AddHealthCheck(&c.HealthChecks.Added[i]))
+	}
+
+	for i, pg := range c.PeerGroups.Added {
+		log.Infof("PeerGroup %s is added", pg.Config.PeerGroupName)
+		warn(s.AddPeerGroup(&c.PeerGroups.Added[i]))
+	}
+	for i, pg := range c.PeerGroups.Updated {
+		log.Infof("PeerGroup %s is updated", pg.Config.PeerGroupName)
+		u, err := s.UpdatePeerGroup(&c.PeerGroups.Updated[i])
+		warn(err)
+		updatePolicy = updatePolicy || u
+	}
+	for i, dn := range c.DynamicNeighbors.Deleted {
+		log.Infof("Dynamic Neighbor %s is deleted from PeerGroup %s", dn.Config.Prefix, dn.Config.PeerGroup)
+		warn(s.DeleteDynamicNeighbor(&c.DynamicNeighbors.Deleted[i]))
+	}
+	for i, dn := range c.DynamicNeighbors.Added {
+		log.Infof("Dynamic Neighbor %s is added to PeerGroup %s", dn.Config.Prefix, dn.Config.PeerGroup)
+		warn(s.AddDynamicNeighbor(&c.DynamicNeighbors.Added[i]))
+	}
+	// the members of the peer groups are given the keys read from the
+	// configuration file.
+	for i, p := range c.Neighbors.Added {
+		log.Infof("Peer %v is added", p.Config.NeighborAddress)
+		warn(s.mgmtOperation(func() error {
+			return s.addNeighbor(&c.Neighbors.Added[i], c.NeighborKeys[p.Config.NeighborAddress])
+		}, true))
+	}
+	for i, p := range c.Neighbors.Deleted {
+		log.Infof("Peer %v is deleted", p.Config.NeighborAddress)
+		warn(s.DeleteNeighbor(&c.Neighbors.Deleted[i]))
+	}
+	for i, p := range c.Neighbors.Updated {
+		log.Infof("Peer %v is updated", p.Config.NeighborAddress)
+		var u bool
+		err := s.mgmtOperation(func() (err error) {
+			u, err = s.updateNeighbor(&c.Neighbors.Updated[i], c.NeighborKeys[p.Config.NeighborAddress])
+			return err
+		}, true)
+		warn(err)
+		updatePolicy = updatePolicy || u
+	}
+	for i, pg := range c.PeerGroups.Deleted {
+		log.Infof("PeerGroup %s is deleted", pg.Config.PeerGroupName)
+		warn(s.DeletePeerGroup(&c.PeerGroups.Deleted[i]))
+	}
+
+	for _, v := range c.Vrfs.Deleted {
+		log.Infof("VRF %s is deleted", v.Config.Name)
+		warn(s.DeleteVrf(v.Config.Name))
+		s.mgmtOperation(func() error {
+			s.setPendingRestart(fmt.Sprintf("vrf %s", v.Config.Name), nil)
+			return nil
+		}, false)
+	}
+
+	if updatePolicy {
+		warn(s.SoftResetIn("", bgp.RouteFamily(0)))
+	}
+
+	s.mgmtOperation(func() error {
+		if l := s.bgpConfig.Global.State.PendingRestartList; len(l) > 0 {
+			log.WithFields(log.Fields{
+				"Topic":   "Config",
+				"Pending": l,
+			}).Warn("some configuration changes take effect only after gobgpd is restarted")
+		}
+		return nil
+	}, false)
+}
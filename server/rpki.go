@@ -447,8 +447,9 @@ func (c *roaManager) GetServers() []*config.RpkiServer {
 		addr, port, _ := net.SplitHostPort(client.host)
 		l = append(l, &config.RpkiServer{
 			Config: config.RpkiServerConfig{
-				Address: addr,
-				Port:    func() uint32 { p, _ := strconv.Atoi(port); return uint32(p) }(),
+				Address:        addr,
+				Port:           func() uint32 { p, _ := strconv.Atoi(port); return uint32(p) }(),
+				RecordLifetime: client.lifetime,
 			},
 			State: client.state,
 		})
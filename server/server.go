@@ -20,19 +20,24 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"sort"
 	"strconv"
 	"time"
 
 	log "github.com/Sirupsen/logrus"
-	"github.com/eapache/channels"
 	"github.com/citizen-insane/gobgp/config"
 	"github.com/citizen-insane/gobgp/packet/bgp"
 	"github.com/citizen-insane/gobgp/table"
+	"github.com/eapache/channels"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 type TCPListener struct {
 	l  *net.TCPListener
 	ch chan struct{}
+	// TTL sockopts set by setListenerTtl, zero if the system default.
+	ttl    int
+	minTtl int
 }
 
 func (l *TCPListener) Close() error {
@@ -92,30 +97,49 @@ type BgpServer struct {
 	fsmStateCh    chan *FsmMsg
 	acceptCh      chan *net.TCPConn
 
-	mgmtCh      chan *mgmtOp
-	policy      *table.RoutingPolicy
-	listeners   []*TCPListener
-	neighborMap map[string]*Peer
-	globalRib   *table.TableManager
-	roaManager  *roaManager
-	shutdown    bool
-	watcherMap  map[WatchEventType][]*Watcher
-	zclient     *zebraClient
-	bmpManager  *bmpClientManager
-	mrtManager  *mrtManager
+	mgmtCh             chan *mgmtOp
+	policy             *table.RoutingPolicy
+	listeners          []*TCPListener
+	neighborMap        map[string]*Peer
+	peerGroupMap       map[string]*PeerGroup
+	globalRib          *table.TableManager
+	roaManager         *roaManager
+	shutdown           bool
+	watcherMap         map[WatchEventType][]*Watcher
+	zclient            *zebraClient
+	bmpManager         *bmpClientManager
+	mrtManager         *mrtManager
+	bfdManager         *bfdManager
+	aggregateManager   *aggregateManager
+	healthCheckManager *healthCheckManager
+	// configuration changes which need gobgpd to be restarted
+	pendingRestart map[string][]string
+	candidate      *Candidate
+	commitHistory  []*ConfigCommit
+	mgmtDuration   prometheus.Histogram
+	bestHistory    *bestPathHistory
+	// running only while route flap damping is enabled for a neighbor.
+	dampingTicker *time.Ticker
 }
 
 func NewBgpServer() *BgpServer {
 	roaManager, _ := NewROAManager(0)
 	s := &BgpServer{
-		neighborMap: make(map[string]*Peer),
-		policy:      table.NewRoutingPolicy(),
-		roaManager:  roaManager,
-		mgmtCh:      make(chan *mgmtOp, 1),
-		watcherMap:  make(map[WatchEventType][]*Watcher),
+		neighborMap:    make(map[string]*Peer),
+		peerGroupMap:   make(map[string]*PeerGroup),
+		policy:         table.NewRoutingPolicy(),
+		roaManager:     roaManager,
+		mgmtCh:         make(chan *mgmtOp, 1),
+		watcherMap:     make(map[WatchEventType][]*Watcher),
+		pendingRestart: make(map[string][]string),
+		mgmtDuration:   newMgmtDurationHistogram(),
+		bestHistory:    newBestPathHistory(0),
 	}
 	s.bmpManager = newBmpClientManager(s)
 	s.mrtManager = newMrtManager(s)
+	s.bfdManager = newBfdManager()
+	s.aggregateManager = newAggregateManager()
+	s.healthCheckManager = newHealthCheckManager()
 	return s
 }
 
@@ -132,6 +156,99 @@ func (server *BgpServer) Listeners(addr string) []*net.TCPListener {
 	return list
 }
 
+// updateDampingTicker starts the ticker reusing the dampened paths when
+// a neighbor enables route flap damping, and stops it when none does.
+func (server *BgpServer) updateDampingTicker() {
+	enabled := false
+	for _, peer := range server.neighborMap {
+		if peer.damping != nil {
+			enabled = true
+			break
+		}
+	}
+	if enabled && server.dampingTicker == nil {
+		server.dampingTicker = time.NewTicker(DAMPING_REUSE_INTERVAL)
+	} else if !enabled && server.dampingTicker != nil {
+		server.dampingTicker.Stop()
+		server.dampingTicker = nil
+	}
+}
+
+func (server *BgpServer) dampingTickerCh() <-chan time.Time {
+	if server.dampingTicker == nil {
+		return nil
+	}
+	return server.dampingTicker.C
+}
+
+// setListenerTtl sets the TTL sockopts of the listeners for the neighbors
+// with ttl-security (RFC 5082). SYN-ACK is sent by the listener before the
+// connection is passed to the peer. Unlike the md5 key, the minimum TTL
+// can't be set per neighbor on the listener, so it's set only while all
+// the neighbors of the address family have ttl-security. Otherwise, it's
+// set on the connection after accepted.
+func (server *BgpServer) setListenerTtl() {
+	for _, l := range server.listeners {
+		host, _, _ := net.SplitHostPort(l.l.Addr().String())
+		v4 := net.ParseIP(host).To4() != nil
+		ttl, minTtl, all := 0, 0, true
+		add := func(c *config.Neighbor) {
+			if !c.Transport.Config.TtlSecurity {
+				all = false
+				return
+			}
+			t, m := ttlFromConfig(c)
+			ttl = t
+			if minTtl == 0 || m < minTtl {
+				minTtl = m
+			}
+		}
+		for _, peer := range server.neighborMap {
+			// covered by the prefix below
+			if peer.isDynamicNeighbor() || (net.ParseIP(peer.ID()).To4() != nil) != v4 {
+				continue
+			}
+			add(peer.fsm.pConf)
+		}
+		for _, pg := range server.peerGroupMap {
+			for prefix := range pg.dynamicNeighbors {
+				if ip, _, _ := net.ParseCIDR(prefix); (ip.To4() != nil) != v4 {
+					continue
+				}
+				add(&config.Neighbor{
+					Transport:    pg.Conf.Transport,
+					EbgpMultihop: pg.Conf.EbgpMultihop,
+				})
+			}
+		}
+		if !all {
+			minTtl = 0
+		}
+		if ttl != l.ttl {
+			if err := SetTcpListenerTTLSockopts(l.l, ttl); err != nil {
+				log.WithFields(log.Fields{
+					"Topic": "Peer",
+					"Key":   host,
+					"Error": err,
+				}).Warn("failed to set ttl of the listener")
+			} else {
+				l.ttl = ttl
+			}
+		}
+		if minTtl != l.minTtl {
+			if err := SetTcpListenerMinTTLSockopts(l.l, minTtl); err != nil {
+				log.WithFields(log.Fields{
+					"Topic": "Peer",
+					"Key":   host,
+					"Error": err,
+				}).Warn("failed to set minimum ttl of the listener")
+			} else {
+				l.minTtl = minTtl
+			}
+		}
+	}
+}
+
 func (s *BgpServer) active() error {
 	if s.bgpConfig.Global.Config.As == 0 {
 		return fmt.Errorf("bgp server hasn't started yet")
@@ -157,7 +274,11 @@ func (server *BgpServer) handleMGMTOp(op *mgmtOp) {
 
 func (s *BgpServer) mgmtOperation(f func() error, checkActive bool) (err error) {
 	ch := make(chan error)
-	defer func() { err = <-ch }()
+	start := time.Now()
+	defer func() {
+		err = <-ch
+		s.mgmtDuration.Observe(time.Since(start).Seconds())
+	}()
 	s.mgmtCh <- &mgmtOp{
 		f:           f,
 		errCh:       ch,
@@ -234,6 +355,21 @@ func (server *BgpServer) Serve() {
 					"Topic": "Peer",
 				}).Debugf("Accepted a new passive connection from:%s", remoteAddr)
 				peer.PassConn(conn)
+			} else if pg := server.matchLongestDynamicNeighborPrefix(remoteAddr); pg != nil {
+				log.WithFields(log.Fields{
+					"Topic": "Peer",
+				}).Debugf("Accepted a new dynamic neighbor from:%s", remoteAddr)
+				peer := newDynamicPeer(&server.bgpConfig.Global, remoteAddr, pg.Conf, server.globalRib, server.policy)
+				if peer == nil {
+					log.WithFields(log.Fields{
+						"Topic": "Peer",
+						"Key":   remoteAddr,
+					}).Infof("Can't create new dynamic neighbor")
+					conn.Close()
+					return
+				}
+				server.startPeer(peer)
+				peer.PassConn(conn)
 			} else {
 				log.WithFields(log.Fields{
 					"Topic": "Peer",
@@ -265,6 +401,8 @@ func (server *BgpServer) Serve() {
 			server.handleMGMTOp(op)
 		case rmsg := <-server.roaManager.ReceiveROA():
 			server.roaManager.HandleROAEvent(rmsg)
+		case r := <-server.healthCheckManager.resultCh:
+			server.handleHealthCheckResult(r)
 		case conn := <-server.acceptCh:
 			passConn(conn)
 		case e, ok := <-server.fsmincomingCh.Out():
@@ -274,8 +412,33 @@ func (server *BgpServer) Serve() {
 			handleFsmMsg(e.(*FsmMsg))
 		case e := <-server.fsmStateCh:
 			handleFsmMsg(e)
+		case now := <-server.dampingTickerCh():
+			for _, peer := range server.neighborMap {
+				if pathList := peer.reuseDampenedPaths(now); len(pathList) > 0 {
+					server.propagateUpdate(peer, pathList)
+				}
+			}
+		}
+	}
+}
+
+func (server *BgpServer) matchLongestDynamicNeighborPrefix(a string) *PeerGroup {
+	ipAddr := net.ParseIP(a)
+	longest := -1
+	var longestPG *PeerGroup
+	for _, pg := range server.peerGroupMap {
+		for _, d := range pg.dynamicNeighbors {
+			_, netAddr, err := net.ParseCIDR(d.Config.Prefix)
+			if err != nil || !netAddr.Contains(ipAddr) {
+				continue
+			}
+			if ones, _ := netAddr.Mask.Size(); ones > longest {
+				longest = ones
+				longestPG = pg
+			}
 		}
 	}
+	return longestPG
 }
 
 func sendFsmOutgoingMsg(peer *Peer, paths []*table.Path, notification *bgp.BGPMessage, stayIdle bool) {
@@ -286,12 +449,30 @@ func sendFsmOutgoingMsg(peer *Peer, paths []*table.Path, notification *bgp.BGPMe
 	}
 }
 
+// sendOutgoingPaths sends the paths exported to the peer and notifies
+// the watchers of the Adj-RIB-Out of them and the paths before the export
+// policy.
+func (server *BgpServer) sendOutgoingPaths(peer *Peer, paths, pre []*table.Path) {
+	server.notifyAdjOutWatcher(peer, paths, pre)
+	peer.updateAdvertised(paths)
+	if len(paths) > 0 {
+		sendFsmOutgoingMsg(peer, paths, nil, false)
+	}
+}
+
 func isASLoop(peer *Peer, path *table.Path) bool {
 	for _, as := range path.GetAsList() {
 		if as == peer.fsm.pConf.Config.PeerAs {
 			return true
 		}
 	}
+	if peer.isConfederationMember() {
+		for _, as := range path.GetConfedAsList() {
+			if as == peer.fsm.pConf.Config.PeerAs {
+				return true
+			}
+		}
+	}
 	return false
 }
 
@@ -302,6 +483,9 @@ func filterpath(peer *Peer, path, old *table.Path) *table.Path {
 	if _, ok := peer.fsm.rfMap[path.GetRouteFamily()]; !ok {
 		return nil
 	}
+	if peer.disabledRfs[path.GetRouteFamily()] {
+		return nil
+	}
 
 	//iBGP handling
 	if peer.isIBGPPeer() {
@@ -444,7 +628,9 @@ func (server *BgpServer) notifyBestWatcher(best map[string][]*table.Path, multip
 			}
 		}
 	}
-	server.notifyWatcher(WATCH_EVENT_TYPE_BEST_PATH, &WatchEventBestPath{PathList: clonedB, MultiPathList: clonedM})
+	ev := &WatchEventBestPath{PathList: clonedB, MultiPathList: clonedM}
+	server.bestHistory.add(ev)
+	server.notifyWatcher(WATCH_EVENT_TYPE_BEST_PATH, ev)
 }
 
 func (server *BgpServer) notifyPostPolicyUpdateWatcher(peer *Peer, pathList []*table.Path) {
@@ -471,6 +657,41 @@ func (server *BgpServer) notifyPostPolicyUpdateWatcher(peer *Peer, pathList []*t
 	server.notifyWatcher(WATCH_EVENT_TYPE_POST_UPDATE, ev)
 }
 
+func createWatchEventAdjOut(peer *Peer, pathList []*table.Path, postPolicy bool) *WatchEventAdjOut {
+	return &WatchEventAdjOut{
+		PeerAS:      peer.fsm.peerInfo.AS,
+		LocalAS:     peer.fsm.peerInfo.LocalAS,
+		PeerAddress: peer.fsm.peerInfo.Address,
+		PeerID:      peer.fsm.peerInfo.ID,
+		Timestamp:   time.Now(),
+		PostPolicy:  postPolicy,
+		PathList:    pathList,
+	}
+}
+
+// notifyAdjOutWatcher notifies the paths sent to the peer and the ones
+// before the export policy.
+func (server *BgpServer) notifyAdjOutWatcher(peer *Peer, pathList, pre []*table.Path) {
+	if len(pre) > 0 && server.isWatched(WATCH_EVENT_TYPE_PRE_ADJ_OUT) {
+		server.notifyWatcher(WATCH_EVENT_TYPE_PRE_ADJ_OUT, createWatchEventAdjOut(peer, pre, false))
+	}
+	if !server.isWatched(WATCH_EVENT_TYPE_POST_ADJ_OUT) {
+		return
+	}
+	if cloned := clonePathList(pathList); len(cloned) > 0 {
+		server.notifyWatcher(WATCH_EVENT_TYPE_POST_ADJ_OUT, createWatchEventAdjOut(peer, cloned, true))
+	}
+}
+
+// updateAdjOutWatch makes the peers return the paths before the export
+// policy only while the pre-policy Adj-RIB-Out is watched.
+func (server *BgpServer) updateAdjOutWatch() {
+	watched := server.isWatched(WATCH_EVENT_TYPE_PRE_ADJ_OUT)
+	for _, peer := range server.neighborMap {
+		peer.watchAdjOut = watched
+	}
+}
+
 func (server *BgpServer) dropPeerAllRoutes(peer *Peer, families []bgp.RouteFamily) {
 
 	families = peer.toGlobalFamilies(families)
@@ -487,22 +708,61 @@ func (server *BgpServer) dropPeerAllRoutes(peer *Peer, families []bgp.RouteFamil
 		ids = append(ids, table.GLOBAL_RIB_NAME)
 	}
 	for _, rf := range families {
-		best, _, multipath := server.globalRib.DeletePathsByPeer(ids, peer.fsm.peerInfo, rf)
+		withdrawn := server.getPeerPathsForAddPath(peer, rf)
+		best, old, multipath := server.globalRib.DeletePathsByPeer(ids, peer.fsm.peerInfo, rf)
+		var aggregated []*table.Path
 		if !peer.isRouteServerClient() {
 			server.notifyBestWatcher(best, multipath)
+			aggregated = server.updateAggregates(best[table.GLOBAL_RIB_NAME])
 		}
 
 		for _, targetPeer := range server.neighborMap {
 			if peer.isRouteServerClient() != targetPeer.isRouteServerClient() || targetPeer == peer {
 				continue
 			}
-			if paths := targetPeer.processOutgoingPaths(best[targetPeer.TableID()], nil); len(paths) > 0 {
-				sendFsmOutgoingMsg(targetPeer, paths, nil, false)
+			paths, pre := targetPeer.processOutgoingPaths(best[targetPeer.TableID()], old[targetPeer.TableID()])
+			addPaths, addPre := targetPeer.processOutgoingAddPaths(withdrawn)
+			server.sendOutgoingPaths(targetPeer, append(paths, addPaths...), append(pre, addPre...))
+		}
+		if !peer.isRouteServerClient() {
+			for _, targetPeer := range server.neighborMap {
+				if paths := server.updateDefaultOriginate(targetPeer, best[table.GLOBAL_RIB_NAME]); len(paths) > 0 {
+					server.sendOutgoingPaths(targetPeer, paths, nil)
+				}
 			}
+			server.updateConditionalAdvertisements(best[table.GLOBAL_RIB_NAME])
+		}
+		if len(aggregated) > 0 {
+			server.propagateUpdate(nil, aggregated)
 		}
 	}
 }
 
+// getPeerPathsForAddPath returns the withdrawals of the paths from the
+// peer, which are necessary to update neighbors receiving multiple paths.
+func (server *BgpServer) getPeerPathsForAddPath(peer *Peer, rf bgp.RouteFamily) []*table.Path {
+	enabled := false
+	for _, targetPeer := range server.neighborMap {
+		if targetPeer != peer && targetPeer.isAddPathSendEnabled(rf) {
+			enabled = true
+			break
+		}
+	}
+	t, ok := server.globalRib.Tables[rf]
+	if !enabled || !ok {
+		return nil
+	}
+	pathList := []*table.Path{}
+	for _, dst := range t.GetDestinations() {
+		for _, path := range dst.GetAllKnownPathList() {
+			if path.GetSource().Equal(peer.fsm.peerInfo) {
+				pathList = append(pathList, path.Clone(true))
+			}
+		}
+	}
+	return pathList
+}
+
 func createWatchEventPeerState(peer *Peer) *WatchEventPeerState {
 	_, rport := peer.fsm.RemoteHostPort()
 	laddr, lport := peer.fsm.LocalHostPort()
@@ -558,6 +818,7 @@ func (server *BgpServer) RSimportPaths(peer *Peer, pathList []*table.Path) []*ta
 func (server *BgpServer) propagateUpdate(peer *Peer, pathList []*table.Path) {
 	rib := server.globalRib
 	var best, old map[string][]*table.Path
+	var aggregated []*table.Path
 
 	if peer != nil && peer.fsm.pConf.Config.Vrf != "" {
 		vrf := server.globalRib.Vrfs[peer.fsm.pConf.Config.Vrf]
@@ -618,7 +879,7 @@ func (server *BgpServer) propagateUpdate(peer *Peer, pathList []*table.Path) {
 				}
 				var candidates []*table.Path
 				if path.IsWithdraw {
-					candidates, _ = peer.getBestFromLocal(peer.configuredRFlist())
+					candidates, _, _ = peer.getBestFromLocal(peer.configuredRFlist())
 				} else {
 					candidates = rib.GetBestPathList(peer.TableID(), fs)
 				}
@@ -634,31 +895,44 @@ func (server *BgpServer) propagateUpdate(peer *Peer, pathList []*table.Path) {
 						}
 					}
 				}
+				var pre []*table.Path
 				if path.IsWithdraw {
-					paths = peer.processOutgoingPaths(nil, paths)
+					paths, pre = peer.processOutgoingPaths(nil, paths)
 				} else {
-					paths = peer.processOutgoingPaths(paths, nil)
+					paths, pre = peer.processOutgoingPaths(paths, nil)
 				}
-				sendFsmOutgoingMsg(peer, paths, nil, false)
+				server.sendOutgoingPaths(peer, paths, pre)
 			}
 		}
 		server.notifyPostPolicyUpdateWatcher(peer, pathList)
 		var multipath [][]*table.Path
 		best, old, multipath = rib.ProcessPaths([]string{table.GLOBAL_RIB_NAME}, pathList)
 
-		if len(best[table.GLOBAL_RIB_NAME]) == 0 {
-			return
+		if len(best[table.GLOBAL_RIB_NAME]) > 0 {
+			server.notifyBestWatcher(best, multipath)
 		}
-		server.notifyBestWatcher(best, multipath)
+		aggregated = server.updateAggregates(best[table.GLOBAL_RIB_NAME])
 	}
 
 	for _, targetPeer := range server.neighborMap {
 		if (peer == nil && targetPeer.isRouteServerClient()) || (peer != nil && peer.isRouteServerClient() != targetPeer.isRouteServerClient()) {
 			continue
 		}
-		if paths := targetPeer.processOutgoingPaths(best[targetPeer.TableID()], old[targetPeer.TableID()]); len(paths) > 0 {
-			sendFsmOutgoingMsg(targetPeer, paths, nil, false)
+		// the best path doesn't change when a path other than the best
+		// one is updated, but neighbors receiving multiple paths need
+		// to know it.
+		paths, pre := targetPeer.processOutgoingPaths(best[targetPeer.TableID()], old[targetPeer.TableID()])
+		addPaths, addPre := targetPeer.processOutgoingAddPaths(pathList)
+		paths = append(paths, addPaths...)
+		pre = append(pre, addPre...)
+		if !targetPeer.isRouteServerClient() {
+			paths = append(paths, server.updateDefaultOriginate(targetPeer, best[table.GLOBAL_RIB_NAME])...)
 		}
+		server.sendOutgoingPaths(targetPeer, paths, pre)
+	}
+	server.updateConditionalAdvertisements(best[table.GLOBAL_RIB_NAME])
+	if len(aggregated) > 0 {
+		server.propagateUpdate(nil, aggregated)
 	}
 }
 
@@ -676,7 +950,7 @@ func (server *BgpServer) handleFSMMessage(peer *Peer, e *FsmMsg) {
 				peer.fsm.pConf.State.Flops++
 			}
 			var drop []bgp.RouteFamily
-			if peer.fsm.reason == FSM_GRACEFUL_RESTART {
+			if peer.fsm.reason == FSM_GRACEFUL_RESTART && !peer.isDynamicNeighbor() {
 				peer.fsm.pConf.GracefulRestart.State.PeerRestarting = true
 				var p []bgp.RouteFamily
 				p, drop = peer.forwardingPreservedFamilies()
@@ -685,6 +959,8 @@ func (server *BgpServer) handleFSMMessage(peer *Peer, e *FsmMsg) {
 				drop = peer.configuredRFlist()
 			}
 			peer.prefixLimitWarned = make(map[bgp.RouteFamily]bool)
+			peer.sentPrefixes = make(map[bgp.RouteFamily]map[string]bool)
+			peer.sentAddPaths = make(map[bgp.RouteFamily]map[string]map[uint32]*table.Path)
 			peer.DropAll(drop)
 			server.dropPeerAllRoutes(peer, drop)
 		} else if peer.fsm.pConf.GracefulRestart.State.PeerRestarting && nextState == bgp.BGP_FSM_IDLE {
@@ -754,9 +1030,25 @@ func (server *BgpServer) handleFSMMessage(peer *Peer, e *FsmMsg) {
 			}
 		}
 
+		if peer.isDynamicNeighbor() && nextState == bgp.BGP_FSM_IDLE {
+			// a dynamic neighbor lives only while its session is up
+			log.WithFields(log.Fields{
+				"Topic": "Peer",
+				"Key":   peer.ID(),
+			}).Info("Delete a dynamic neighbor")
+			go peer.stopFSM()
+			server.bfdManager.deleteSession(peer.ID())
+			server.policy.DeleteAssignment(peer.ID())
+			delete(server.neighborMap, peer.ID())
+			server.updateDampingTicker()
+			server.broadcastPeerState(peer, oldState)
+			return
+		}
+
 		cleanInfiniteChannel(peer.outgoing)
 		peer.outgoing = channels.NewInfiniteChannel()
 		if nextState == bgp.BGP_FSM_ESTABLISHED {
+			peer.disabledRfs = make(map[bgp.RouteFamily]bool)
 			// update for export policy
 			laddr, _ := peer.fsm.LocalHostPort()
 			peer.fsm.pConf.Transport.State.LocalAddress = laddr
@@ -779,9 +1071,9 @@ func (server *BgpServer) handleFSMMessage(peer *Peer, e *FsmMsg) {
 				// However, when the peer is graceful restarting, give up
 				// waiting sending non-route-target NLRIs since the peer won't send
 				// any routes (and EORs) before we send ours (or deferral-timer expires).
-				var pathList []*table.Path
+				var pathList, pre []*table.Path
 				if c := config.GetAfiSafi(peer.fsm.pConf, bgp.RF_RTC_UC); !peer.fsm.pConf.GracefulRestart.State.PeerRestarting && peer.fsm.rfMap[bgp.RF_RTC_UC] && c.RouteTargetMembership.Config.DeferralTime > 0 {
-					pathList, _ = peer.getBestFromLocal([]bgp.RouteFamily{bgp.RF_RTC_UC})
+					pathList, _, pre = peer.getBestFromLocal([]bgp.RouteFamily{bgp.RF_RTC_UC})
 					t := c.RouteTargetMembership.Config.DeferralTime
 					for _, f := range peer.configuredRFlist() {
 						if f != bgp.RF_RTC_UC {
@@ -789,12 +1081,9 @@ func (server *BgpServer) handleFSMMessage(peer *Peer, e *FsmMsg) {
 						}
 					}
 				} else {
-					pathList, _ = peer.getBestFromLocal(peer.configuredRFlist())
-				}
-
-				if len(pathList) > 0 {
-					sendFsmOutgoingMsg(peer, pathList, nil, false)
+					pathList, _, pre = peer.getBestFromLocal(peer.configuredRFlist())
 				}
+				server.sendOutgoingPaths(peer, pathList, pre)
 			} else {
 				// RFC 4724 4.1
 				// Once the session between the Restarting Speaker and the Receiving
@@ -833,12 +1122,16 @@ func (server *BgpServer) handleFSMMessage(peer *Peer, e *FsmMsg) {
 		server.broadcastPeerState(peer, oldState)
 	case FSM_MSG_ROUTE_REFRESH:
 		if paths := peer.handleRouteRefresh(e); len(paths) > 0 {
-			sendFsmOutgoingMsg(peer, paths, nil, false)
+			server.sendOutgoingPaths(peer, paths, nil)
 			return
 		}
 	case FSM_MSG_BGP_MESSAGE:
 		switch m := e.MsgData.(type) {
 		case *bgp.MessageError:
+			if m.TypeCode == bgp.BGP_ERROR_UPDATE_MESSAGE_ERROR {
+				peer.fsm.pConf.ErrorHandling.State.ErroneousUpdateMessages++
+				peer.fsm.pConf.ErrorHandling.State.SessionResetCount++
+			}
 			sendFsmOutgoingMsg(peer, nil, bgp.NewBGPNotificationMessage(m.TypeCode, m.SubTypeCode, m.Data), false)
 			return
 		case *bgp.BGPMessage:
@@ -906,10 +1199,8 @@ func (server *BgpServer) handleFSMMessage(peer *Peer, e *FsmMsg) {
 							if !p.isGracefulRestartEnabled() {
 								continue
 							}
-							paths, _ := p.getBestFromLocal(p.configuredRFlist())
-							if len(paths) > 0 {
-								sendFsmOutgoingMsg(p, paths, nil, false)
-							}
+							paths, _, pre := p.getBestFromLocal(p.configuredRFlist())
+							server.sendOutgoingPaths(p, paths, pre)
 						}
 						log.WithFields(log.Fields{
 							"Topic": "Server",
@@ -948,9 +1239,8 @@ func (server *BgpServer) handleFSMMessage(peer *Peer, e *FsmMsg) {
 							families = append(families, f)
 						}
 					}
-					if paths, _ := peer.getBestFromLocal(families); len(paths) > 0 {
-						sendFsmOutgoingMsg(peer, paths, nil, false)
-					}
+					paths, _, pre := peer.getBestFromLocal(families)
+					server.sendOutgoingPaths(peer, paths, pre)
 				}
 			}
 		default:
@@ -966,8 +1256,11 @@ func (server *BgpServer) handleFSMMessage(peer *Peer, e *FsmMsg) {
 
 func (s *BgpServer) StartCollector(c *config.CollectorConfig) error {
 	return s.mgmtOperation(func() error {
-		_, err := NewCollector(s, c.Url, c.DbName, c.TableDumpInterval)
-		return err
+		if _, err := NewCollector(s, c); err != nil {
+			return err
+		}
+		s.bgpConfig.Collector.Config = *c
+		return nil
 	}, false)
 }
 
@@ -982,6 +1275,9 @@ func (s *BgpServer) StartZebraClient(c *config.ZebraConfig) error {
 		}
 		var err error
 		s.zclient, err = newZebraClient(s, c.Url, protos, c.Version, c.NexthopTriggerEnable, c.NexthopTriggerDelay)
+		if err == nil {
+			s.bgpConfig.Zebra.Config = *c
+		}
 		return err
 	}, false)
 }
@@ -1021,7 +1317,18 @@ func (s *BgpServer) UpdatePolicy(policy config.RoutingPolicy) error {
 			}).Info("call set policy")
 			ap[peer.ID()] = peer.fsm.pConf.ApplyPolicy
 		}
-		return s.policy.Reset(&policy, ap)
+		if err := s.policy.Reset(&policy, ap); err != nil {
+			return err
+		}
+		// the contributor policies of the aggregates, the route
+		// policies of default-originate and the prefix sets tracked by
+		// conditional advertisement could be changed.
+		if s.globalRib != nil {
+			s.refreshAggregates()
+			s.refreshDefaultOriginate()
+			s.refreshConditionalAdvertisements()
+		}
+		return nil
 	}, false)
 }
 
@@ -1223,6 +1530,7 @@ func (s *BgpServer) Start(c *config.Global) error {
 		// update route selection options
 		table.SelectionOptions = c.RouteSelectionOptions.Config
 		table.UseMultiplePaths = c.UseMultiplePaths.Config
+		s.bestHistory.size = int(c.Config.BestPathHistorySize)
 
 		s.roaManager.SetAS(s.bgpConfig.Global.Config.As)
 		return nil
@@ -1262,6 +1570,11 @@ func (s *BgpServer) DeleteVrf(name string) error {
 				return fmt.Errorf("failed to delete VRF %s: neighbor %s is in use", name, n.ID())
 			}
 		}
+		for _, a := range s.aggregateManager.aggregates {
+			if a.config.Vrf == name {
+				return fmt.Errorf("failed to delete VRF %s: aggregate %s is in use", name, a.config.Prefix)
+			}
+		}
 		pathList, err := s.globalRib.DeleteVrf(name)
 		if err != nil {
 			return err
@@ -1284,6 +1597,8 @@ func (s *BgpServer) Stop() error {
 		for _, l := range s.listeners {
 			l.Close()
 		}
+		s.aggregateManager.aggregates = make([]*aggregate, 0)
+		s.healthCheckManager.stop()
 		s.bgpConfig.Global = config.Global{}
 		return nil
 	}, true)
@@ -1303,7 +1618,7 @@ func (s *BgpServer) softResetIn(addr string, family bgp.RouteFamily) error {
 		for _, path := range peer.adjRibIn.PathList(families, false) {
 			exResult := path.Filtered(peer.ID())
 			path.Filter(peer.ID(), table.POLICY_DIRECTION_NONE)
-			if s.policy.ApplyPolicy(peer.ID(), table.POLICY_DIRECTION_IN, path, nil) != nil {
+			if !peer.fsm.isOwnASLoop(path) && s.policy.ApplyPolicy(peer.ID(), table.POLICY_DIRECTION_IN, path, nil) != nil {
 				pathList = append(pathList, path.Clone(false))
 				// this path still in rib's
 				// knownPathList. We can't
@@ -1362,16 +1677,14 @@ func (s *BgpServer) softResetOut(addr string, family bgp.RouteFamily, deferral b
 			}
 		}
 
-		pathList, filtered := peer.getBestFromLocal(families)
-		if len(pathList) > 0 {
-			sendFsmOutgoingMsg(peer, pathList, nil, false)
-		}
+		pathList, filtered, pre := peer.getBestFromLocal(families)
+		s.sendOutgoingPaths(peer, pathList, pre)
 		if deferral == false && len(filtered) > 0 {
 			withdrawnList := make([]*table.Path, 0, len(filtered))
 			for _, p := range filtered {
 				withdrawnList = append(withdrawnList, p.Clone(true))
 			}
-			sendFsmOutgoingMsg(peer, withdrawnList, nil, false)
+			s.sendOutgoingPaths(peer, withdrawnList, nil)
 		}
 	}
 	return nil
@@ -1475,7 +1788,7 @@ func (s *BgpServer) GetAdjRib(addr string, family bgp.RouteFamily, in bool, pref
 			adjRib = peer.adjRibIn
 		} else {
 			adjRib = table.NewAdjRib(peer.ID(), peer.configuredRFlist())
-			accepted, _ := peer.getBestFromLocal(peer.configuredRFlist())
+			accepted, _, _ := peer.getBestFromLocal(peer.configuredRFlist())
 			adjRib.Update(accepted)
 		}
 		rib, err = adjRib.Select(family, false, table.TableSelectOption{ID: id, LookupPrefixes: prefixes})
@@ -1484,6 +1797,25 @@ func (s *BgpServer) GetAdjRib(addr string, family bgp.RouteFamily, in bool, pref
 	return
 }
 
+func (s *BgpServer) GetDampenedPath(addr string, family bgp.RouteFamily) (l []*table.DampenedPath, err error) {
+	err = s.mgmtOperation(func() error {
+		peer, ok := s.neighborMap[addr]
+		if !ok {
+			return fmt.Errorf("Neighbor that has %v doesn't exist.", addr)
+		}
+		if peer.damping == nil {
+			return fmt.Errorf("route flap damping is not enabled for %v", addr)
+		}
+		rfList := peer.configuredRFlist()
+		if family != 0 {
+			rfList = []bgp.RouteFamily{family}
+		}
+		l = peer.damping.PathList(rfList, time.Now())
+		return nil
+	}, true)
+	return
+}
+
 func (s *BgpServer) GetRibInfo(addr string, family bgp.RouteFamily) (info *table.TableInfo, err error) {
 	err = s.mgmtOperation(func() error {
 		m := s.globalRib
@@ -1516,7 +1848,7 @@ func (s *BgpServer) GetAdjRibInfo(addr string, family bgp.RouteFamily, in bool)
 			adjRib = peer.adjRibIn
 		} else {
 			adjRib = table.NewAdjRib(peer.ID(), peer.configuredRFlist())
-			accepted, _ := peer.getBestFromLocal(peer.configuredRFlist())
+			accepted, _, _ := peer.getBestFromLocal(peer.configuredRFlist())
 			adjRib.Update(accepted)
 		}
 		info, err = adjRib.TableInfo(family)
@@ -1538,16 +1870,35 @@ func (s *BgpServer) GetNeighbor(getAdvertised bool) (l []*config.Neighbor) {
 	s.mgmtOperation(func() error {
 		l = make([]*config.Neighbor, 0, len(s.neighborMap))
 		for _, peer := range s.neighborMap {
-			l = append(l, peer.ToConfig(getAdvertised))
+			conf := peer.ToConfig(getAdvertised)
+			if state, ok := s.bfdManager.getState(peer.ID()); ok {
+				conf.Bfd.State = state
+			}
+			l = append(l, conf)
 		}
 		return nil
 	}, false)
 	return l
 }
 
-func (server *BgpServer) addNeighbor(c *config.Neighbor) error {
+func (server *BgpServer) addNeighbor(c *config.Neighbor, keys map[string]bool) error {
+	var pg *PeerGroup
+	var member config.Neighbor
+	if name := c.Config.PeerGroup; name != "" {
+		var ok bool
+		if pg, ok = server.peerGroupMap[name]; !ok {
+			return fmt.Errorf("no such peer-group: %s", name)
+		}
+		// keep the configuration given to the neighbor itself to merge
+		// it again when the peer group is updated.
+		member = *c
+		member.AfiSafis = append([]config.AfiSafi(nil), c.AfiSafis...)
+		if err := config.OverwriteNeighborConfigWithPeerGroup(c, pg.Conf, keys); err != nil {
+			return err
+		}
+	}
 
-	if err := config.SetDefaultNeighborConfigValues(c, server.bgpConfig.Global.Config.As); err != nil {
+	if err := config.SetDefaultNeighborConfigValues(c, &server.bgpConfig.Global); err != nil {
 		return err
 	}
 
@@ -1576,6 +1927,10 @@ func (server *BgpServer) addNeighbor(c *config.Neighbor) error {
 		return fmt.Errorf("can't be both route-server-client and route-reflector-client")
 	}
 
+	if err := validateConditionalAdvertisements(c); err != nil {
+		return err
+	}
+
 	if server.bgpConfig.Global.Config.Port > 0 {
 		for _, l := range server.Listeners(addr) {
 			if err := SetTcpMD5SigSockopts(l, addr, c.Config.AuthPassword); err != nil {
@@ -1594,7 +1949,7 @@ func (server *BgpServer) addNeighbor(c *config.Neighbor) error {
 	}).Infof("Add a peer configuration for:%s", addr)
 
 	peer := NewPeer(&server.bgpConfig.Global, c, server.globalRib, server.policy)
-	server.policy.Reset(nil, map[string]config.ApplyPolicy{peer.ID(): c.ApplyPolicy})
+	server.startPeer(peer)
 	if peer.isRouteServerClient() {
 		pathList := make([]*table.Path, 0)
 		rfList := peer.configuredRFlist()
@@ -1609,15 +1964,65 @@ func (server *BgpServer) addNeighbor(c *config.Neighbor) error {
 			server.globalRib.ProcessPaths(nil, moded)
 		}
 	}
-	server.neighborMap[addr] = peer
+	if pg != nil {
+		member.Config.NeighborAddress = addr
+		pg.AddMember(member, keys)
+	}
+	server.setListenerTtl()
+	return nil
+}
+
+// startPeer sets up the peer with the policies and the server wide
+// features, registers it and starts its FSM. Both the configured and the
+// dynamic neighbors are started with this.
+func (server *BgpServer) startPeer(peer *Peer) {
+	server.policy.Reset(nil, map[string]config.ApplyPolicy{peer.ID(): peer.fsm.pConf.ApplyPolicy})
+	peer.watchAdjOut = server.isWatched(WATCH_EVENT_TYPE_PRE_ADJ_OUT)
+	peer.aggregates = server.aggregateManager
+	server.setDefaultOriginate(peer)
+	server.setConditionalAdvertisements(peer)
+	server.neighborMap[peer.ID()] = peer
+	server.updateDampingTicker()
+	server.addBfdSession(peer)
 	peer.startFSMHandler(server.fsmincomingCh, server.fsmStateCh)
 	server.broadcastPeerState(peer, bgp.BGP_FSM_IDLE)
-	return nil
+}
+
+func (server *BgpServer) addBfdSession(peer *Peer) {
+	c := peer.fsm.pConf
+	if !c.Bfd.Config.Enabled {
+		return
+	}
+	if err := server.bfdManager.addSession(c, server.bgpConfig.Global.Config.LocalAddressList, peer.fsm.bfdDownCh); err != nil {
+		log.WithFields(log.Fields{
+			"Topic": "Peer",
+			"Key":   peer.ID(),
+			"Error": err,
+		}).Warn("failed to add a BFD session")
+	}
 }
 
 func (s *BgpServer) AddNeighbor(c *config.Neighbor) error {
 	return s.mgmtOperation(func() error {
-		return s.addNeighbor(c)
+		return s.addNeighbor(c, nil)
+	}, true)
+}
+
+func (s *BgpServer) addPeerGroup(c *config.PeerGroup) error {
+	name := c.Config.PeerGroupName
+	if _, y := s.peerGroupMap[name]; y {
+		return fmt.Errorf("Can't overwrite the existing peer-group: %s", name)
+	}
+	log.WithFields(log.Fields{
+		"Topic": "Peer",
+	}).Infof("Add a peer group configuration for:%s", name)
+	s.peerGroupMap[name] = NewPeerGroup(copyPeerGroup(c))
+	return nil
+}
+
+func (s *BgpServer) AddPeerGroup(c *config.PeerGroup) error {
+	return s.mgmtOperation(func() error {
+		return s.addPeerGroup(c)
 	}, true)
 }
 
@@ -1634,6 +2039,9 @@ func (server *BgpServer) deleteNeighbor(c *config.Neighbor, code, subcode uint8)
 	if !y {
 		return fmt.Errorf("Can't delete a peer configuration for %s", addr)
 	}
+	if pg, y := server.peerGroupMap[n.fsm.pConf.Config.PeerGroup]; y {
+		pg.DeleteMember(*n.fsm.pConf)
+	}
 	for _, l := range server.Listeners(addr) {
 		SetTcpMD5SigSockopts(l, addr, "")
 	}
@@ -1644,42 +2052,14 @@ func (server *BgpServer) deleteNeighbor(c *config.Neighbor, code, subcode uint8)
 	n.fsm.sendNotification(code, subcode, nil, "")
 	n.stopPeerRestarting()
 
-	go func(addr string) {
-		failed := false
-		t1 := time.AfterFunc(time.Minute*5, func() {
-			log.WithFields(log.Fields{
-				"Topic": "Peer",
-			}).Warnf("Failed to free the fsm.h.t for %s", addr)
-			failed = true
-		})
-		n.fsm.h.t.Kill(nil)
-		n.fsm.h.t.Wait()
-		t1.Stop()
-		if !failed {
-			log.WithFields(log.Fields{
-				"Topic": "Peer",
-				"Key":   addr,
-			}).Debug("freed fsm.h.t")
-			cleanInfiniteChannel(n.outgoing)
-		}
-		failed = false
-		t2 := time.AfterFunc(time.Minute*5, func() {
-			log.WithFields(log.Fields{
-				"Topic": "Peer",
-			}).Warnf("Failed to free the fsm.t for %s", addr)
-			failed = true
-		})
-		n.fsm.t.Kill(nil)
-		n.fsm.t.Wait()
-		t2.Stop()
-		if !failed {
-			log.WithFields(log.Fields{
-				"Topic": "Peer",
-				"Key":   addr,
-			}).Debug("freed fsm.t")
-		}
-	}(addr)
+	go n.stopFSM()
+	server.bfdManager.deleteSession(addr)
+	if n.isDynamicNeighbor() {
+		server.policy.DeleteAssignment(addr)
+	}
 	delete(server.neighborMap, addr)
+	server.setListenerTtl()
+	server.updateDampingTicker()
 	server.dropPeerAllRoutes(n, n.configuredRFlist())
 	return nil
 }
@@ -1690,80 +2070,311 @@ func (s *BgpServer) DeleteNeighbor(c *config.Neighbor) error {
 	}, true)
 }
 
-func (s *BgpServer) UpdateNeighbor(c *config.Neighbor) (policyUpdated bool, err error) {
-	err = s.mgmtOperation(func() error {
-		addr := c.Config.NeighborAddress
-		peer, ok := s.neighborMap[addr]
+func (s *BgpServer) deletePeerGroup(c *config.PeerGroup) error {
+	name := c.Config.PeerGroupName
+	pg, y := s.peerGroupMap[name]
+	if !y {
+		return fmt.Errorf("Can't delete a peer-group configuration for %s", name)
+	}
+	if len(pg.members) > 0 {
+		return fmt.Errorf("peer-group %s still has %d members", name, len(pg.members))
+	}
+	if len(pg.dynamicNeighbors) > 0 {
+		return fmt.Errorf("peer-group %s still has %d dynamic neighbor prefixes", name, len(pg.dynamicNeighbors))
+	}
+	log.WithFields(log.Fields{
+		"Topic": "Peer",
+	}).Infof("Delete a peer group configuration for:%s", name)
+	delete(s.peerGroupMap, name)
+	return nil
+}
+
+func (s *BgpServer) DeletePeerGroup(c *config.PeerGroup) error {
+	return s.mgmtOperation(func() error {
+		return s.deletePeerGroup(c)
+	}, true)
+}
+
+func (s *BgpServer) addDynamicNeighbor(c *config.DynamicNeighbor) error {
+	if _, _, err := net.ParseCIDR(c.Config.Prefix); err != nil {
+		return fmt.Errorf("invalid dynamic neighbor prefix: %s", c.Config.Prefix)
+	}
+	pg, ok := s.peerGroupMap[c.Config.PeerGroup]
+	if !ok {
+		return fmt.Errorf("no such peer-group: %s", c.Config.PeerGroup)
+	}
+	log.WithFields(log.Fields{
+		"Topic": "Peer",
+	}).Infof("Add a dynamic neighbor prefix %s for peer group %s", c.Config.Prefix, c.Config.PeerGroup)
+	pg.AddDynamicNeighbor(c)
+	s.setListenerTtl()
+	return nil
+}
+
+func (s *BgpServer) AddDynamicNeighbor(c *config.DynamicNeighbor) error {
+	return s.mgmtOperation(func() error {
+		return s.addDynamicNeighbor(c)
+	}, true)
+}
+
+func (s *BgpServer) deleteDynamicNeighbor(c *config.DynamicNeighbor) error {
+	pg, ok := s.peerGroupMap[c.Config.PeerGroup]
+	if !ok {
+		return fmt.Errorf("no such peer-group: %s", c.Config.PeerGroup)
+	}
+	if _, ok := pg.dynamicNeighbors[c.Config.Prefix]; !ok {
+		return fmt.Errorf("Can't delete a dynamic neighbor prefix %s", c.Config.Prefix)
+	}
+	log.WithFields(log.Fields{
+		"Topic": "Peer",
+	}).Infof("Delete a dynamic neighbor prefix %s for peer group %s", c.Config.Prefix, c.Config.PeerGroup)
+	pg.DeleteDynamicNeighbor(c)
+	s.setListenerTtl()
+	return nil
+}
+
+func (s *BgpServer) DeleteDynamicNeighbor(c *config.DynamicNeighbor) error {
+	return s.mgmtOperation(func() error {
+		return s.deleteDynamicNeighbor(c)
+	}, true)
+}
+
+func (s *BgpServer) updateNeighbor(c *config.Neighbor, keys map[string]bool) (policyUpdated bool, err error) {
+	member := *c
+	if name := c.Config.PeerGroup; name != "" {
+		pg, ok := s.peerGroupMap[name]
 		if !ok {
-			return fmt.Errorf("Neighbor that has %v doesn't exist.", addr)
+			return false, fmt.Errorf("no such peer-group: %s", name)
 		}
+		member.AfiSafis = append([]config.AfiSafi(nil), c.AfiSafis...)
+		if err := config.OverwriteNeighborConfigWithPeerGroup(c, pg.Conf, keys); err != nil {
+			return false, err
+		}
+		if err := config.SetDefaultNeighborConfigValues(c, &s.bgpConfig.Global); err != nil {
+			return false, err
+		}
+	}
+
+	addr := c.Config.NeighborAddress
+	peer, ok := s.neighborMap[addr]
+	if !ok {
+		return false, fmt.Errorf("Neighbor that has %v doesn't exist.", addr)
+	}
+	if pg, ok := s.peerGroupMap[peer.fsm.pConf.Config.PeerGroup]; ok && member.Config.PeerGroup == pg.Conf.Config.PeerGroupName && !peer.isDynamicNeighbor() {
+		member.Config.NeighborAddress = addr
+		pg.AddMember(member, keys)
+	}
+
+	if err := validateConditionalAdvertisements(c); err != nil {
+		return false, err
+	}
 
-		if !peer.fsm.pConf.ApplyPolicy.Equal(&c.ApplyPolicy) {
+	if !peer.fsm.pConf.ApplyPolicy.Equal(&c.ApplyPolicy) {
+		log.WithFields(log.Fields{
+			"Topic": "Peer",
+			"Key":   addr,
+		}).Info("Update ApplyPolicy")
+		s.policy.Reset(nil, map[string]config.ApplyPolicy{peer.ID(): c.ApplyPolicy})
+		peer.fsm.pConf.ApplyPolicy = c.ApplyPolicy
+		policyUpdated = true
+	}
+	original := peer.fsm.pConf
+
+	if !original.Config.Equal(&c.Config) || !original.Transport.Config.Equal(&c.Transport.Config) || config.CheckAfiSafisChange(original.AfiSafis, c.AfiSafis) {
+		sub := uint8(bgp.BGP_ERROR_SUB_OTHER_CONFIGURATION_CHANGE)
+		if original.Config.AdminDown != c.Config.AdminDown {
+			sub = bgp.BGP_ERROR_SUB_ADMINISTRATIVE_SHUTDOWN
+			state := "Admin Down"
+			if c.Config.AdminDown == false {
+				state = "Admin Up"
+			}
 			log.WithFields(log.Fields{
 				"Topic": "Peer",
-				"Key":   addr,
-			}).Info("Update ApplyPolicy")
-			s.policy.Reset(nil, map[string]config.ApplyPolicy{peer.ID(): c.ApplyPolicy})
-			peer.fsm.pConf.ApplyPolicy = c.ApplyPolicy
-			policyUpdated = true
-		}
-		original := peer.fsm.pConf
-
-		if !original.Config.Equal(&c.Config) || !original.Transport.Config.Equal(&c.Transport.Config) || config.CheckAfiSafisChange(original.AfiSafis, c.AfiSafis) {
-			sub := uint8(bgp.BGP_ERROR_SUB_OTHER_CONFIGURATION_CHANGE)
-			if original.Config.AdminDown != c.Config.AdminDown {
-				sub = bgp.BGP_ERROR_SUB_ADMINISTRATIVE_SHUTDOWN
-				state := "Admin Down"
-				if c.Config.AdminDown == false {
-					state = "Admin Up"
-				}
-				log.WithFields(log.Fields{
-					"Topic": "Peer",
-					"Key":   peer.ID(),
-					"State": state,
-				}).Info("update admin-state configuration")
-			} else if original.Config.PeerAs != c.Config.PeerAs {
-				sub = bgp.BGP_ERROR_SUB_PEER_DECONFIGURED
-			}
-			if err = s.deleteNeighbor(peer.fsm.pConf, bgp.BGP_ERROR_CEASE, sub); err != nil {
-				log.WithFields(log.Fields{
-					"Topic": "Peer",
-					"Key":   addr,
-				}).Error(err)
-				return err
-			}
-			err = s.addNeighbor(c)
-			if err != nil {
-				log.WithFields(log.Fields{
-					"Topic": "Peer",
-					"Key":   addr,
-				}).Error(err)
-			}
-			return err
+				"Key":   peer.ID(),
+				"State": state,
+			}).Info("update admin-state configuration")
+		} else if original.Config.PeerAs != c.Config.PeerAs {
+			sub = bgp.BGP_ERROR_SUB_PEER_DECONFIGURED
 		}
-
-		if !original.Timers.Config.Equal(&c.Timers.Config) {
+		if err = s.deleteNeighbor(peer.fsm.pConf, bgp.BGP_ERROR_CEASE, sub); err != nil {
 			log.WithFields(log.Fields{
 				"Topic": "Peer",
-				"Key":   peer.ID(),
-			}).Info("update timer configuration")
-			peer.fsm.pConf.Timers.Config = c.Timers.Config
+				"Key":   addr,
+			}).Error(err)
+			return policyUpdated, err
 		}
-
-		err = peer.updatePrefixLimitConfig(c.AfiSafis)
+		if peer.isDynamicNeighbor() {
+			// created again with the new configuration when the
+			// neighbor connects.
+			return policyUpdated, nil
+		}
+		err = s.addNeighbor(&member, keys)
 		if err != nil {
 			log.WithFields(log.Fields{
 				"Topic": "Peer",
 				"Key":   addr,
 			}).Error(err)
-			// rollback to original state
-			peer.fsm.pConf = original
 		}
+		return policyUpdated, err
+	}
+
+	if !original.Timers.Config.Equal(&c.Timers.Config) {
+		log.WithFields(log.Fields{
+			"Topic": "Peer",
+			"Key":   peer.ID(),
+		}).Info("update timer configuration")
+		peer.fsm.pConf.Timers.Config = c.Timers.Config
+	}
+
+	if !original.Bfd.Config.Equal(&c.Bfd.Config) {
+		log.WithFields(log.Fields{
+			"Topic": "Peer",
+			"Key":   peer.ID(),
+		}).Info("update bfd configuration")
+		s.bfdManager.deleteSession(addr)
+		peer.fsm.pConf.Bfd.Config = c.Bfd.Config
+		s.addBfdSession(peer)
+	}
+
+	if !original.RouteFlapDamping.Config.Equal(&c.RouteFlapDamping.Config) {
+		log.WithFields(log.Fields{
+			"Topic": "Peer",
+			"Key":   peer.ID(),
+		}).Info("update route flap damping configuration")
+		peer.fsm.pConf.RouteFlapDamping.Config = c.RouteFlapDamping.Config
+		if peer.damping != nil {
+			peer.damping.SetConfig(&c.RouteFlapDamping.Config)
+		}
+	}
+
+	err = peer.updatePrefixLimitConfig(c.AfiSafis)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Topic": "Peer",
+			"Key":   addr,
+		}).Error(err)
+		// rollback to original state
+		peer.fsm.pConf = original
+		return policyUpdated, err
+	}
+	s.sendOutgoingPaths(peer, s.setDefaultOriginate(peer), nil)
+
+	if conditionalAdvertisementsChanged(original.ConditionalAdvertisements, c.ConditionalAdvertisements) {
+		log.WithFields(log.Fields{
+			"Topic": "Peer",
+			"Key":   peer.ID(),
+		}).Info("update conditional advertisement configuration")
+		peer.fsm.pConf.ConditionalAdvertisements = c.ConditionalAdvertisements
+		s.setConditionalAdvertisements(peer)
+		s.softResetOut(peer.ID(), bgp.RouteFamily(0), false)
+	}
+	return policyUpdated, err
+}
+
+func (s *BgpServer) UpdateNeighbor(c *config.Neighbor) (policyUpdated bool, err error) {
+	err = s.mgmtOperation(func() error {
+		policyUpdated, err = s.updateNeighbor(c, nil)
+		return err
+	}, true)
+	return policyUpdated, err
+}
+
+// UpdatePeerGroup replaces the configuration of the peer group and applies
+// it to all the member neighbors.
+func (s *BgpServer) updatePeerGroup(c *config.PeerGroup) (policyUpdated bool, err error) {
+	name := c.Config.PeerGroupName
+	pg, ok := s.peerGroupMap[name]
+	if !ok {
+		return false, fmt.Errorf("Peer-group that has %s doesn't exist.", name)
+	}
+	conf := copyPeerGroup(c)
+
+	// members returns the configurations of the members with the settings
+	// of the peer group pc, which are merged again by updateNeighbor.
+	members := func(pc *config.PeerGroup) ([]peerGroupMember, error) {
+		l := make([]peerGroupMember, 0, len(pg.members))
+		for _, m := range pg.members {
+			n := copyNeighbor(&m.conf)
+			if err := config.OverwriteNeighborConfigWithPeerGroup(n, pc, m.keys); err != nil {
+				return nil, err
+			}
+			if err := config.SetDefaultNeighborConfigValues(n, &s.bgpConfig.Global); err != nil {
+				return nil, err
+			}
+			if err := validateConditionalAdvertisements(n); err != nil {
+				return nil, err
+			}
+			l = append(l, peerGroupMember{conf: *copyNeighbor(&m.conf), keys: m.keys})
+		}
+		for _, peer := range s.neighborMap {
+			if !peer.isDynamicNeighbor() || peer.fsm.pConf.Config.PeerGroup != name {
+				continue
+			}
+			n, err := dynamicNeighborConfig(&s.bgpConfig.Global, peer.ID(), pc)
+			if err != nil {
+				return nil, err
+			}
+			if err := validateConditionalAdvertisements(n); err != nil {
+				return nil, err
+			}
+			l = append(l, peerGroupMember{conf: *n})
+		}
+		return l, nil
+	}
+	// nothing is changed unless the new settings are valid for all the
+	// members.
+	l, err := members(conf)
+	if err != nil {
+		return false, err
+	}
+
+	old := pg.Conf
+	pg.Conf = conf
+	for i := range l {
+		u, err := s.updateNeighbor(&l[i].conf, l[i].keys)
+		policyUpdated = policyUpdated || u
+		if err != nil {
+			// restore the members already updated with the new
+			// settings.
+			pg.Conf = old
+			if l, e := members(old); e == nil {
+				for i := range l {
+					u, _ := s.updateNeighbor(&l[i].conf, l[i].keys)
+					policyUpdated = policyUpdated || u
+				}
+			}
+			return policyUpdated, err
+		}
+	}
+	// ttl-security of the dynamic neighbors
+	s.setListenerTtl()
+	return policyUpdated, nil
+}
+
+func (s *BgpServer) UpdatePeerGroup(c *config.PeerGroup) (policyUpdated bool, err error) {
+	err = s.mgmtOperation(func() error {
+		policyUpdated, err = s.updatePeerGroup(c)
 		return err
 	}, true)
 	return policyUpdated, err
 }
 
+func (s *BgpServer) GetPeerGroup() (l []*config.PeerGroup) {
+	s.mgmtOperation(func() error {
+		names := make([]string, 0, len(s.peerGroupMap))
+		for name := range s.peerGroupMap {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		l = make([]*config.PeerGroup, 0, len(names))
+		for _, name := range names {
+			l = append(l, copyPeerGroup(s.peerGroupMap[name].Conf))
+		}
+		return nil
+	}, false)
+	return l
+}
+
 func (s *BgpServer) addrToPeers(addr string) (l []*Peer, err error) {
 	if len(addr) == 0 {
 		for _, p := range s.neighborMap {
@@ -2091,6 +2702,9 @@ const (
 	WATCH_EVENT_TYPE_POST_UPDATE WatchEventType = "postupdate"
 	WATCH_EVENT_TYPE_PEER_STATE  WatchEventType = "peerstate"
 	WATCH_EVENT_TYPE_TABLE       WatchEventType = "table"
+	// the paths sent to the peers before and after the export policy
+	WATCH_EVENT_TYPE_PRE_ADJ_OUT  WatchEventType = "preadjout"
+	WATCH_EVENT_TYPE_POST_ADJ_OUT WatchEventType = "postadjout"
 )
 
 type WatchEvent interface {
@@ -2129,6 +2743,18 @@ type WatchEventAdjIn struct {
 	PathList []*table.Path
 }
 
+// WatchEventAdjOut is the paths sent to the peer. The paths before the
+// export policy are notified with PostPolicy false.
+type WatchEventAdjOut struct {
+	PeerAS      uint32
+	LocalAS     uint32
+	PeerAddress net.IP
+	PeerID      net.IP
+	Timestamp   time.Time
+	PostPolicy  bool
+	PathList    []*table.Path
+}
+
 type WatchEventTable struct {
 	RouterId string
 	PathList map[string][]*table.Path
@@ -2138,6 +2764,13 @@ type WatchEventTable struct {
 type WatchEventBestPath struct {
 	PathList      []*table.Path
 	MultiPathList [][]*table.Path
+	// Sequence numbers the best path changes. The current best paths
+	// have the sequence number of the last change.
+	Sequence uint64
+	// Epoch identifies the gobgpd process numbering the changes.
+	Epoch uint64
+	// Snapshot is true if the event has all the current best paths.
+	Snapshot bool
 }
 
 type watchOptions struct {
@@ -2150,6 +2783,12 @@ type watchOptions struct {
 	initPostUpdate bool
 	initPeerState  bool
 	tableName      string
+	resumeBest     bool
+	bestEpoch      uint64
+	bestSequence   uint64
+	preAdjOut      bool
+	postAdjOut     bool
+	initAdjOut     bool
 }
 
 type WatchOption func(*watchOptions)
@@ -2163,6 +2802,18 @@ func WatchBestPath(current bool) WatchOption {
 	}
 }
 
+// WatchBestPathFrom watches the best path changes after the sequence
+// number of the epoch. The changes are replayed if they are still kept,
+// otherwise the current best paths are sent like WatchBestPath(true).
+func WatchBestPathFrom(epoch, seq uint64) WatchOption {
+	return func(o *watchOptions) {
+		o.bestpath = true
+		o.resumeBest = true
+		o.bestEpoch = epoch
+		o.bestSequence = seq
+	}
+}
+
 func WatchUpdate(current bool) WatchOption {
 	return func(o *watchOptions) {
 		o.preUpdate = true
@@ -2190,6 +2841,19 @@ func WatchPeerState(current bool) WatchOption {
 	}
 }
 
+// WatchAdjOut watches the paths sent to the peers. pre and post select
+// the paths before and after the export policy. If current is true,
+// the paths currently advertised are sent first.
+func WatchAdjOut(pre, post, current bool) WatchOption {
+	return func(o *watchOptions) {
+		o.preAdjOut = pre
+		o.postAdjOut = post
+		if current {
+			o.initAdjOut = true
+		}
+	}
+}
+
 func WatchTableName(name string) WatchOption {
 	return func(o *watchOptions) {
 		o.tableName = name
@@ -2279,6 +2943,9 @@ func (w *Watcher) Stop() {
 				}
 			}
 		}
+		if w.opts.preAdjOut {
+			w.s.updateAdjOutWatch()
+		}
 
 		cleanInfiniteChannel(w.ch)
 		// the loop function goroutine might be blocked for
@@ -2327,6 +2994,13 @@ func (s *BgpServer) Watch(opts ...WatchOption) (w *Watcher) {
 		if w.opts.peerState {
 			register(WATCH_EVENT_TYPE_PEER_STATE, w)
 		}
+		if w.opts.preAdjOut {
+			register(WATCH_EVENT_TYPE_PRE_ADJ_OUT, w)
+			s.updateAdjOutWatch()
+		}
+		if w.opts.postAdjOut {
+			register(WATCH_EVENT_TYPE_POST_ADJ_OUT, w)
+		}
 		if w.opts.initPeerState {
 			for _, peer := range s.neighborMap {
 				if peer.fsm.state != bgp.BGP_FSM_ESTABLISHED {
@@ -2335,10 +3009,22 @@ func (s *BgpServer) Watch(opts ...WatchOption) (w *Watcher) {
 				w.notify(createWatchEventPeerState(peer))
 			}
 		}
+		if w.opts.resumeBest && s.active() == nil {
+			if l, ok := s.bestHistory.since(w.opts.bestEpoch, w.opts.bestSequence); ok {
+				for _, ev := range l {
+					w.notify(ev)
+				}
+			} else {
+				w.opts.initBest = true
+			}
+		}
 		if w.opts.initBest && s.active() == nil {
 			w.notify(&WatchEventBestPath{
 				PathList:      s.globalRib.GetBestPathList(table.GLOBAL_RIB_NAME, nil),
 				MultiPathList: s.globalRib.GetBestMultiPathList(table.GLOBAL_RIB_NAME, nil),
+				Sequence:      s.bestHistory.seq,
+				Epoch:         s.bestHistory.epoch,
+				Snapshot:      true,
 			})
 		}
 		if w.opts.initUpdate {
@@ -2382,6 +3068,20 @@ func (s *BgpServer) Watch(opts ...WatchOption) (w *Watcher) {
 				}
 			}
 		}
+		if w.opts.initAdjOut && (w.opts.preAdjOut || w.opts.postAdjOut) {
+			for _, peer := range s.neighborMap {
+				if peer.fsm.state != bgp.BGP_FSM_ESTABLISHED {
+					continue
+				}
+				pathList, _, pre := peer.getBestFromLocal(peer.configuredRFlist())
+				if w.opts.preAdjOut && len(pre) > 0 {
+					w.notify(createWatchEventAdjOut(peer, pre, false))
+				}
+				if w.opts.postAdjOut && len(pathList) > 0 {
+					w.notify(createWatchEventAdjOut(peer, pathList, true))
+				}
+			}
+		}
 		if w.opts.initPostUpdate && s.active() == nil {
 			for _, rf := range s.globalRib.GetRFlist() {
 				if len(s.globalRib.Tables[rf].GetDestinations()) == 0 {
@@ -281,3 +281,705 @@ func TestFilterpathWithiBGP(t *testing.T) {
 	assert.Nil(t, path)
 
 }
+
+func TestPeerGroup(test *testing.T) {
+	assert := assert.New(test)
+	s := NewBgpServer()
+	go s.Serve()
+	s.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       1,
+			RouterId: "1.1.1.1",
+			Port:     -1,
+		},
+	})
+
+	g := &config.PeerGroup{
+		Config: config.PeerGroupConfig{
+			PeerAs:        2,
+			PeerGroupName: "g",
+		},
+		Timers: config.Timers{
+			Config: config.TimersConfig{
+				HoldTime: 30,
+			},
+		},
+	}
+	assert.Nil(s.AddPeerGroup(g))
+	assert.NotNil(s.AddPeerGroup(g))
+
+	n := &config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: "127.0.0.1",
+			PeerGroup:       "g",
+		},
+		Transport: config.Transport{
+			Config: config.TransportConfig{
+				PassiveMode: true,
+			},
+		},
+	}
+	assert.Nil(s.AddNeighbor(n))
+	m := &config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: "127.0.0.2",
+			PeerGroup:       "g",
+		},
+		Timers: config.Timers{
+			Config: config.TimersConfig{
+				HoldTime: 90,
+			},
+		},
+		Transport: config.Transport{
+			Config: config.TransportConfig{
+				PassiveMode: true,
+			},
+		},
+	}
+	assert.Nil(s.AddNeighbor(m))
+	assert.NotNil(s.AddNeighbor(&config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: "127.0.0.3",
+			PeerGroup:       "h",
+		},
+	}))
+
+	get := func(addr string) *config.Neighbor {
+		for _, n := range s.GetNeighbor(false) {
+			if n.Config.NeighborAddress == addr {
+				return n
+			}
+		}
+		return nil
+	}
+	assert.Equal(uint32(2), get("127.0.0.1").Config.PeerAs)
+	assert.Equal(float64(30), get("127.0.0.1").Timers.Config.HoldTime)
+	assert.Equal(uint32(2), get("127.0.0.2").Config.PeerAs)
+	assert.Equal(float64(90), get("127.0.0.2").Timers.Config.HoldTime)
+
+	g = &config.PeerGroup{
+		Config: config.PeerGroupConfig{
+			PeerAs:        3,
+			PeerGroupName: "g",
+		},
+		Timers: config.Timers{
+			Config: config.TimersConfig{
+				HoldTime: 60,
+			},
+		},
+	}
+	_, err := s.UpdatePeerGroup(g)
+	assert.Nil(err)
+	assert.Equal(uint32(3), get("127.0.0.1").Config.PeerAs)
+	assert.Equal(float64(60), get("127.0.0.1").Timers.Config.HoldTime)
+	assert.Equal(uint32(3), get("127.0.0.2").Config.PeerAs)
+	assert.Equal(float64(90), get("127.0.0.2").Timers.Config.HoldTime)
+
+	// the peer group keeps its own copy of the configuration.
+	g.Config.PeerAs = 4
+	assert.Equal(uint32(3), s.GetPeerGroup()[0].Config.PeerAs)
+
+	// neither the peer group nor the members are changed with the
+	// settings invalid for the members.
+	_, err = s.UpdatePeerGroup(&config.PeerGroup{
+		Config: config.PeerGroupConfig{
+			PeerAs:           5,
+			PeerGroupName:    "g",
+			RouteFlapDamping: true,
+		},
+		RouteFlapDamping: config.RouteFlapDamping{
+			Config: config.RouteFlapDampingConfig{
+				ReuseThreshold:    3000,
+				SuppressThreshold: 2000,
+			},
+		},
+	})
+	assert.NotNil(err)
+	assert.Equal(uint32(3), s.GetPeerGroup()[0].Config.PeerAs)
+	assert.Equal(uint32(3), get("127.0.0.1").Config.PeerAs)
+	assert.Equal(uint32(3), get("127.0.0.2").Config.PeerAs)
+
+	assert.NotNil(s.DeletePeerGroup(g))
+	assert.Nil(s.DeleteNeighbor(n))
+	assert.Nil(s.DeleteNeighbor(m))
+	assert.Nil(s.DeletePeerGroup(g))
+}
+
+func TestPeerGroupMemberKeys(t *testing.T) {
+	assert := assert.New(t)
+	newServer := func() *BgpServer {
+		s := NewBgpServer()
+		go s.Serve()
+		s.Start(&config.Global{
+			Config: config.GlobalConfig{
+				As:       1,
+				RouterId: "1.1.1.1",
+				Port:     -1,
+			},
+		})
+		assert.Nil(s.AddPeerGroup(&config.PeerGroup{
+			Config: config.PeerGroupConfig{
+				PeerAs:        2,
+				PeerGroupName: "g",
+			},
+			Transport: config.Transport{
+				Config: config.TransportConfig{
+					PassiveMode: true,
+				},
+			},
+		}))
+		return s
+	}
+	n := config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: "127.0.0.1",
+			PeerGroup:       "g",
+		},
+	}
+	keys := map[string]bool{"transport.config.passive-mode": true}
+
+	s1 := newServer()
+	x := n
+	assert.Nil(s1.mgmtOperation(func() error {
+		return s1.addNeighbor(&x, keys)
+	}, true))
+	assert.False(s1.GetNeighbor(false)[0].Transport.Config.PassiveMode)
+
+	// the keys are given only to the neighbor of s1.
+	s2 := newServer()
+	y := n
+	assert.Nil(s2.AddNeighbor(&y))
+	assert.True(s2.GetNeighbor(false)[0].Transport.Config.PassiveMode)
+
+	// the keys of the member are kept when the peer group is updated.
+	_, err := s1.UpdatePeerGroup(&config.PeerGroup{
+		Config: config.PeerGroupConfig{
+			PeerAs:        3,
+			PeerGroupName: "g",
+		},
+		Transport: config.Transport{
+			Config: config.TransportConfig{
+				PassiveMode: true,
+			},
+		},
+	})
+	assert.Nil(err)
+	l := s1.GetNeighbor(false)
+	assert.Equal(uint32(3), l[0].Config.PeerAs)
+	assert.False(l[0].Transport.Config.PassiveMode)
+	s1.mgmtOperation(func() error {
+		assert.Equal(map[string]map[string]bool{"127.0.0.1": keys}, s1.runningConfig().NeighborKeys)
+		return nil
+	}, false)
+}
+
+func TestDynamicNeighbor(t *testing.T) {
+	assert := assert.New(t)
+	s1 := NewBgpServer()
+	go s1.Serve()
+	s1.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       1,
+			RouterId: "1.1.1.1",
+			Port:     10180,
+		},
+	})
+	g := &config.PeerGroup{
+		Config: config.PeerGroupConfig{
+			PeerAs:        2,
+			PeerGroupName: "g",
+		},
+	}
+	assert.Nil(s1.AddPeerGroup(g))
+	d := &config.DynamicNeighbor{
+		Config: config.DynamicNeighborConfig{
+			Prefix:    "127.0.0.0/24",
+			PeerGroup: "g",
+		},
+	}
+	assert.Nil(s1.AddDynamicNeighbor(d))
+	assert.NotNil(s1.DeletePeerGroup(g))
+
+	s2 := NewBgpServer()
+	go s2.Serve()
+	s2.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       2,
+			RouterId: "2.2.2.2",
+			Port:     -1,
+		},
+	})
+	m := &config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: "127.0.0.1",
+			PeerAs:          1,
+		},
+		Transport: config.Transport{
+			Config: config.TransportConfig{
+				RemotePort: 10180,
+			},
+		},
+	}
+	assert.Nil(s2.AddNeighbor(m))
+
+	for {
+		time.Sleep(time.Second)
+		if s2.GetNeighbor(false)[0].State.SessionState == config.SESSION_STATE_ESTABLISHED {
+			break
+		}
+	}
+	l := s1.GetNeighbor(false)
+	assert.Equal(1, len(l))
+	assert.Equal("127.0.0.1", l[0].Config.NeighborAddress)
+	assert.Equal("g", l[0].Config.PeerGroup)
+	assert.Equal(uint32(2), l[0].Config.PeerAs)
+	assert.Equal(config.SESSION_STATE_ESTABLISHED, l[0].State.SessionState)
+
+	// the update of the peer group is applied to the dynamic neighbor
+	// without making it a member.
+	u := *g
+	u.Timers.Config.HoldTime = 60
+	_, err := s1.UpdatePeerGroup(&u)
+	assert.Nil(err)
+	l = s1.GetNeighbor(false)
+	assert.Equal(1, len(l))
+	assert.Equal(float64(60), l[0].Timers.Config.HoldTime)
+	assert.Equal(config.SESSION_STATE_ESTABLISHED, l[0].State.SessionState)
+	s1.mgmtOperation(func() error {
+		assert.Equal(0, len(s1.peerGroupMap["g"].members))
+		return nil
+	}, false)
+}
+
+func TestDeleteDynamicNeighbor(t *testing.T) {
+	assert := assert.New(t)
+	s1 := NewBgpServer()
+	go s1.Serve()
+	s1.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       1,
+			RouterId: "1.1.1.1",
+			Port:     10185,
+		},
+	})
+	// iBGP so that the session can be closed; the TTL set for eBGP
+	// makes the socket blocking.
+	g := &config.PeerGroup{
+		Config: config.PeerGroupConfig{
+			PeerAs:        1,
+			PeerGroupName: "g",
+		},
+		ApplyPolicy: config.ApplyPolicy{
+			Config: config.ApplyPolicyConfig{
+				DefaultImportPolicy: config.DEFAULT_POLICY_TYPE_REJECT_ROUTE,
+			},
+		},
+	}
+	assert.Nil(s1.AddPeerGroup(g))
+	assert.Nil(s1.AddDynamicNeighbor(&config.DynamicNeighbor{
+		Config: config.DynamicNeighborConfig{
+			Prefix:    "127.0.0.0/24",
+			PeerGroup: "g",
+		},
+	}))
+
+	s2 := NewBgpServer()
+	go s2.Serve()
+	s2.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       1,
+			RouterId: "2.2.2.2",
+			Port:     -1,
+		},
+	})
+	assert.Nil(s2.AddNeighbor(&config.Neighbor{
+		Config: config.NeighborConfig{
+			NeighborAddress: "127.0.0.1",
+			PeerAs:          1,
+		},
+		Transport: config.Transport{
+			Config: config.TransportConfig{
+				RemotePort: 10185,
+			},
+		},
+	}))
+
+	for s2.GetNeighbor(false)[0].State.SessionState != config.SESSION_STATE_ESTABLISHED {
+		time.Sleep(time.Second)
+	}
+	rt, _, _ := s1.policy.GetPolicyAssignment("127.0.0.1", table.POLICY_DIRECTION_IMPORT)
+	assert.Equal(table.ROUTE_TYPE_REJECT, rt)
+
+	// the policy assignment is deleted with the dynamic neighbor.
+	assert.Nil(s2.DisableNeighbor("127.0.0.1", ""))
+	for len(s1.GetNeighbor(false)) > 0 {
+		time.Sleep(time.Millisecond * 100)
+	}
+	rt, _, _ = s1.policy.GetPolicyAssignment("127.0.0.1", table.POLICY_DIRECTION_IMPORT)
+	assert.Equal(table.ROUTE_TYPE_NONE, rt)
+}
+
+func TestOwnASLoop(t *testing.T) {
+	assert := assert.New(t)
+	rib := table.NewTableManager([]bgp.RouteFamily{bgp.RF_IPv4_UC})
+	p := NewPeer(
+		&config.Global{Config: config.GlobalConfig{As: 65000}},
+		&config.Neighbor{Config: config.NeighborConfig{PeerAs: 65001, LocalAs: 65000, NeighborAddress: "192.168.0.1"}},
+		rib,
+		&table.RoutingPolicy{})
+	attrs := []bgp.PathAttributeInterface{bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(2, []uint32{65001, 65000, 65002})})}
+	path := table.NewPath(nil, bgp.NewIPAddrPrefix(24, "10.10.10.0"), false, attrs, time.Now(), false)
+	assert.True(p.fsm.isOwnASLoop(path))
+
+	p.fsm.pConf.AsPathOptions.Config.AllowOwnAs = 1
+	assert.False(p.fsm.isOwnASLoop(path))
+}
+
+func TestRevisedErrorHandling(t *testing.T) {
+	assert := assert.New(t)
+	rib := table.NewTableManager([]bgp.RouteFamily{bgp.RF_IPv4_UC, bgp.RF_IPv6_UC})
+	p := NewPeer(
+		&config.Global{Config: config.GlobalConfig{As: 65000}},
+		&config.Neighbor{
+			Config: config.NeighborConfig{PeerAs: 65001, LocalAs: 65000, NeighborAddress: "192.168.0.1"},
+			AfiSafis: []config.AfiSafi{
+				{Config: config.AfiSafiConfig{AfiSafiName: config.AFI_SAFI_TYPE_IPV4_UNICAST}},
+				{Config: config.AfiSafiConfig{AfiSafiName: config.AFI_SAFI_TYPE_IPV6_UNICAST}},
+			},
+		},
+		rib,
+		&table.RoutingPolicy{})
+	attrs := []bgp.PathAttributeInterface{bgp.NewPathAttributeOrigin(0)}
+	v4 := table.NewPath(nil, bgp.NewIPAddrPrefix(24, "10.10.10.0"), false, attrs, time.Now(), false)
+	v6 := table.NewPath(nil, bgp.NewIPv6AddrPrefix(64, "2001:db8::"), false, attrs, time.Now(), false)
+	p.adjRibIn.Update([]*table.Path{v6})
+
+	update := func(handling bgp.ErrorHandling, attr bgp.PathAttributeInterface, pathList []*table.Path) []*table.Path {
+		paths, _, _ := p.handleUpdate(&FsmMsg{
+			MsgData:  bgp.NewBGPUpdateMessage(nil, nil, nil),
+			PathList: pathList,
+			handling: bgp.NewMessageErrorWithErrorHandling(bgp.BGP_ERROR_UPDATE_MESSAGE_ERROR, 0, nil, handling, attr, "").(*bgp.MessageError),
+		})
+		return paths
+	}
+
+	// ipv6 paths received are withdrawn and the following are ignored
+	mpReach := bgp.NewPathAttributeMpReachNLRI("2001:db8::1", []bgp.AddrPrefixInterface{bgp.NewIPv6AddrPrefix(64, "2001:db8:1::")})
+	paths := update(bgp.ERROR_HANDLING_AFISAFI_DISABLE, mpReach, []*table.Path{v4, v6})
+	assert.Equal(2, len(paths))
+	assert.False(paths[0].IsWithdraw)
+	assert.Equal(bgp.RF_IPv6_UC, paths[1].GetRouteFamily())
+	assert.True(paths[1].IsWithdraw)
+	assert.Equal(0, p.adjRibIn.Count([]bgp.RouteFamily{bgp.RF_IPv6_UC}))
+	assert.True(p.disabledRfs[bgp.RF_IPv6_UC])
+
+	paths = update(bgp.ERROR_HANDLING_TREAT_AS_WITHDRAW, nil, []*table.Path{v4, v6})
+	assert.Equal(1, len(paths))
+	assert.True(paths[0].IsWithdraw)
+	assert.Equal(0, p.adjRibIn.Count([]bgp.RouteFamily{bgp.RF_IPv4_UC}))
+
+	update(bgp.ERROR_HANDLING_ATTRIBUTE_DISCARD, nil, []*table.Path{v4})
+	assert.Equal(1, p.adjRibIn.Count([]bgp.RouteFamily{bgp.RF_IPv4_UC}))
+
+	state := p.fsm.pConf.ErrorHandling.State
+	assert.Equal(uint32(3), state.ErroneousUpdateMessages)
+	assert.Equal(uint32(1), state.AfiSafiDisableCount)
+	assert.Equal(uint32(1), state.TreatAsWithdrawCount)
+	assert.Equal(uint32(1), state.AttributeDiscardCount)
+}
+
+func TestConfederationASLoop(t *testing.T) {
+	assert := assert.New(t)
+	rib := table.NewTableManager([]bgp.RouteFamily{bgp.RF_IPv4_UC})
+	g := &config.Global{
+		Config: config.GlobalConfig{As: 65001},
+		Confederation: config.Confederation{
+			Config: config.ConfederationConfig{Enabled: true, Identifier: 10, MemberAsList: []uint32{65002}},
+		},
+	}
+	n := &config.Neighbor{Config: config.NeighborConfig{PeerAs: 65002, NeighborAddress: "192.168.0.1"}}
+	assert.Nil(config.SetDefaultNeighborConfigValues(n, g))
+	p := NewPeer(g, n, rib, &table.RoutingPolicy{})
+	assert.True(p.fsm.peerInfo.Confederation)
+
+	newPath := func(params ...bgp.AsPathParamInterface) *table.Path {
+		attrs := []bgp.PathAttributeInterface{bgp.NewPathAttributeAsPath(params)}
+		return table.NewPath(nil, bgp.NewIPAddrPrefix(24, "10.10.10.0"), false, attrs, time.Now(), false)
+	}
+	// looping through the local member AS
+	assert.True(p.fsm.isOwnASLoop(newPath(bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ, []uint32{65002, 65001}))))
+	// coming back into the confederation
+	assert.True(p.fsm.isOwnASLoop(newPath(bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ, []uint32{65002}), bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{2, 10}))))
+	assert.False(p.fsm.isOwnASLoop(newPath(bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ, []uint32{65002}), bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{2}))))
+	// not sent back to the member AS in the path
+	assert.True(isASLoop(p, newPath(bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ, []uint32{65003, 65002}))))
+}
+
+func TestUpdateGlobalConfig(t *testing.T) {
+	assert := assert.New(t)
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       1,
+			RouterId: "1.1.1.1",
+			Port:     -1,
+		},
+	})
+	assert.Nil(err)
+	defer s.Stop()
+
+	g := s.GetServer()
+	g.Config.As = 2
+	s.UpdateConfig(&config.BgpConfigSetChanges{Global: g})
+	assert.Equal([]string{"global as"}, s.GetServer().State.PendingRestartList)
+
+	// reverting the change clears the pending restart.
+	g.Config.As = 1
+	s.UpdateConfig(&config.BgpConfigSetChanges{Global: g})
+	assert.Empty(s.GetServer().State.PendingRestartList)
+}
+
+func TestDampingTicker(t *testing.T) {
+	assert := assert.New(t)
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:       1,
+			RouterId: "1.1.1.1",
+			Port:     -1,
+		},
+	})
+	assert.Nil(err)
+	defer s.Stop()
+	running := func() bool {
+		r := false
+		s.mgmtOperation(func() error {
+			r = s.dampingTicker != nil
+			return nil
+		}, false)
+		return r
+	}
+
+	newNeighbor := func(address string, damping bool) *config.Neighbor {
+		return &config.Neighbor{
+			Config: config.NeighborConfig{
+				NeighborAddress:  address,
+				PeerAs:           2,
+				RouteFlapDamping: damping,
+			},
+			Transport: config.Transport{
+				Config: config.TransportConfig{
+					PassiveMode: true,
+				},
+			},
+		}
+	}
+	n1 := newNeighbor("10.0.0.1", false)
+	assert.Nil(s.AddNeighbor(n1))
+	assert.False(running())
+
+	n2 := newNeighbor("10.0.0.2", true)
+	assert.Nil(s.AddNeighbor(n2))
+	assert.True(running())
+
+	assert.Nil(s.DeleteNeighbor(n2))
+	assert.False(running())
+	assert.Nil(s.DeleteNeighbor(n1))
+}
+
+func TestAddPathLocalIdentifier(t *testing.T) {
+	assert := assert.New(t)
+	rib := table.NewTableManager([]bgp.RouteFamily{bgp.RF_IPv4_UC})
+	nlri := "10.10.10.0/24"
+	newPath := func(as uint32, address string) *table.Path {
+		_, pi := newPeerandInfo(65000, as, address, rib)
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(2, []uint32{as})}),
+			bgp.NewPathAttributeNextHop(address),
+		}
+		return table.NewPath(pi, bgp.NewIPAddrPrefix(24, "10.10.10.0"), false, attrs, time.Now(), false)
+	}
+	ids := func() map[string]uint32 {
+		m := make(map[string]uint32)
+		for _, dst := range rib.Tables[bgp.RF_IPv4_UC].GetDestinations() {
+			if dst.GetNlri().String() != nlri {
+				continue
+			}
+			for _, path := range dst.GetKnownPathList(table.GLOBAL_RIB_NAME) {
+				m[path.GetSource().Address.String()] = path.GetLocalIdentifier()
+			}
+		}
+		return m
+	}
+
+	path1 := newPath(65001, "192.168.0.1")
+	path2 := newPath(65002, "192.168.0.2")
+	path3 := newPath(65003, "192.168.0.3")
+	rib.ProcessPaths([]string{table.GLOBAL_RIB_NAME}, []*table.Path{path1, path2, path3})
+	assert.Equal(map[string]uint32{"192.168.0.1": 1, "192.168.0.2": 2, "192.168.0.3": 3}, ids())
+
+	// the identifier of the withdrawn path is reused by a new path.
+	rib.ProcessPaths([]string{table.GLOBAL_RIB_NAME}, []*table.Path{path2.Clone(true)})
+	rib.ProcessPaths([]string{table.GLOBAL_RIB_NAME}, []*table.Path{newPath(65004, "192.168.0.4")})
+	assert.Equal(map[string]uint32{"192.168.0.1": 1, "192.168.0.3": 3, "192.168.0.4": 2}, ids())
+
+	// the identifier is kept when the path is replaced.
+	rib.ProcessPaths([]string{table.GLOBAL_RIB_NAME}, []*table.Path{newPath(65001, "192.168.0.1")})
+	assert.Equal(map[string]uint32{"192.168.0.1": 1, "192.168.0.3": 3, "192.168.0.4": 2}, ids())
+}
+
+func TestProcessOutgoingAddPaths(t *testing.T) {
+	assert := assert.New(t)
+	rib := table.NewTableManager([]bgp.RouteFamily{bgp.RF_IPv4_UC})
+	p, _ := newPeerandInfo(65000, 65100, "192.168.0.100", rib)
+	p.fsm.pConf.Config.PeerType = config.PEER_TYPE_EXTERNAL
+	p.policy = table.NewRoutingPolicy()
+	p.policy.Reset(&config.RoutingPolicy{}, map[string]config.ApplyPolicy{
+		table.GLOBAL_RIB_NAME: {
+			Config: config.ApplyPolicyConfig{
+				DefaultExportPolicy: config.DEFAULT_POLICY_TYPE_ACCEPT_ROUTE,
+			},
+		},
+	})
+	p.fsm.pConf.AfiSafis = []config.AfiSafi{{
+		Config:   config.AfiSafiConfig{AfiSafiName: config.AFI_SAFI_TYPE_IPV4_UNICAST},
+		AddPaths: config.AddPaths{Config: config.AddPathsConfig{SendMax: 2}},
+	}}
+
+	newPath := func(as uint32, address string, pref uint32) *table.Path {
+		_, pi := newPeerandInfo(65000, as, address, rib)
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(2, []uint32{as})}),
+			bgp.NewPathAttributeNextHop(address),
+			bgp.NewPathAttributeLocalPref(pref),
+		}
+		return table.NewPath(pi, bgp.NewIPAddrPrefix(24, "10.10.10.0"), false, attrs, time.Now(), false)
+	}
+	path1 := newPath(65001, "192.168.0.1", 100)
+	path2 := newPath(65002, "192.168.0.2", 300)
+	path3 := newPath(65003, "192.168.0.3", 200)
+	news, _, _ := rib.ProcessPaths([]string{table.GLOBAL_RIB_NAME}, []*table.Path{path1, path2, path3})
+	best := news[table.GLOBAL_RIB_NAME]
+
+	// nothing is sent until the session is established.
+	paths, _ := p.processOutgoingAddPaths(best)
+	assert.Len(paths, 0)
+
+	// nor without ADD-PATH negotiated.
+	p.fsm.state = bgp.BGP_FSM_ESTABLISHED
+	paths, _ = p.processOutgoingAddPaths(best)
+	assert.Len(paths, 0)
+
+	// the best send-max paths are sent.
+	p.fsm.marshallingOptions = &bgp.MarshallingOption{
+		AddPath: map[bgp.RouteFamily]bgp.BGPAddPathMode{bgp.RF_IPv4_UC: bgp.BGP_ADD_PATH_SEND},
+	}
+	sent := func(paths []*table.Path) (map[string]bool, []string) {
+		paths, _ = p.processOutgoingAddPaths(paths)
+		p.updateAdvertised(paths)
+		advertised := make(map[string]bool)
+		withdrawn := []string{}
+		for _, path := range paths {
+			if path.IsWithdraw {
+				withdrawn = append(withdrawn, path.GetSource().Address.String())
+			} else {
+				advertised[path.GetSource().Address.String()] = true
+			}
+		}
+		return advertised, withdrawn
+	}
+	advertised, withdrawn := sent(best)
+	assert.Equal(map[string]bool{"192.168.0.2": true, "192.168.0.3": true}, advertised)
+	assert.Empty(withdrawn)
+
+	// nothing is sent again without changes.
+	advertised, withdrawn = sent(best)
+	assert.Empty(advertised)
+	assert.Empty(withdrawn)
+
+	// only the changed path is sent.
+	path3 = table.NewPath(path3.GetSource(), path3.GetNlri(), false, []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(2, []uint32{65003, 65013})}),
+		bgp.NewPathAttributeNextHop("192.168.0.3"),
+		bgp.NewPathAttributeLocalPref(200),
+	}, time.Now(), false)
+	rib.ProcessPaths([]string{table.GLOBAL_RIB_NAME}, []*table.Path{path3})
+	advertised, withdrawn = sent([]*table.Path{path3})
+	assert.Equal(map[string]bool{"192.168.0.3": true}, advertised)
+	assert.Empty(withdrawn)
+
+	// only the path sent before is withdrawn.
+	p.fsm.pConf.AfiSafis[0].AddPaths.Config.SendMax = 1
+	advertised, withdrawn = sent(best)
+	assert.Empty(advertised)
+	assert.Equal([]string{"192.168.0.3"}, withdrawn)
+
+	// the withdrawn path is replaced by the next one.
+	p.fsm.pConf.AfiSafis[0].AddPaths.Config.SendMax = 2
+	withdrawal := path2.Clone(true)
+	rib.ProcessPaths([]string{table.GLOBAL_RIB_NAME}, []*table.Path{withdrawal})
+	advertised, withdrawn = sent([]*table.Path{withdrawal})
+	assert.Equal(map[string]bool{"192.168.0.3": true, "192.168.0.1": true}, advertised)
+	assert.Equal([]string{"192.168.0.2"}, withdrawn)
+	assert.Len(p.sentAddPaths[bgp.RF_IPv4_UC]["10.10.10.0/24"], 2)
+}
+
+func TestProcessOutgoingPathsPrePolicy(t *testing.T) {
+	assert := assert.New(t)
+	rib := table.NewTableManager([]bgp.RouteFamily{bgp.RF_IPv4_UC})
+	p, _ := newPeerandInfo(65000, 65100, "192.168.0.100", rib)
+	p.fsm.pConf.Config.PeerType = config.PEER_TYPE_EXTERNAL
+	p.fsm.state = bgp.BGP_FSM_ESTABLISHED
+	p.policy = table.NewRoutingPolicy()
+	p.policy.Reset(&config.RoutingPolicy{}, map[string]config.ApplyPolicy{
+		table.GLOBAL_RIB_NAME: {
+			Config: config.ApplyPolicyConfig{
+				DefaultExportPolicy: config.DEFAULT_POLICY_TYPE_REJECT_ROUTE,
+			},
+		},
+	})
+	_, pi := newPeerandInfo(65000, 65001, "192.168.0.1", rib)
+	newPath := func(prefix string) *table.Path {
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(2, []uint32{65001})}),
+			bgp.NewPathAttributeNextHop("192.168.0.1"),
+		}
+		return table.NewPath(pi, bgp.NewIPAddrPrefix(24, prefix), false, attrs, time.Now(), false)
+	}
+	process := func(path *table.Path) ([]*table.Path, []*table.Path) {
+		best, old, _ := rib.ProcessPaths([]string{table.GLOBAL_RIB_NAME}, []*table.Path{path})
+		return p.processOutgoingPaths(best[table.GLOBAL_RIB_NAME], old[table.GLOBAL_RIB_NAME])
+	}
+
+	// the paths before the export policy aren't returned unless watched.
+	paths, pre := process(newPath("10.1.0.0"))
+	assert.Empty(paths)
+	assert.Empty(pre)
+
+	// the rejected path is returned with the attributes updated for the
+	// peer before the export policy.
+	p.watchAdjOut = true
+	paths, pre = process(newPath("10.2.0.0"))
+	assert.Empty(paths)
+	if assert.Len(pre, 1) {
+		assert.Equal("10.2.0.0/24", pre[0].GetNlri().String())
+		assert.Len(pre[0].GetAsSeqList(), 2)
+	}
+
+	// all the best paths are returned by getBestFromLocal, which doesn't
+	// affect the following updates.
+	paths, _, pre = p.getBestFromLocal([]bgp.RouteFamily{bgp.RF_IPv4_UC})
+	assert.Empty(paths)
+	assert.Len(pre, 2)
+	paths, pre = process(newPath("10.3.0.0"))
+	assert.Empty(paths)
+	if assert.Len(pre, 1) {
+		assert.Equal("10.3.0.0/24", pre[0].GetNlri().String())
+	}
+}
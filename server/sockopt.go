@@ -19,6 +19,7 @@ package server
 import (
 	"fmt"
 	"net"
+	"syscall"
 )
 
 func SetTcpMD5SigSockopts(l *net.TCPListener, address string, key string) error {
@@ -29,6 +30,36 @@ func SetTcpTTLSockopts(conn *net.TCPConn, ttl int) error {
 	return fmt.Errorf("setting ttl is not supported")
 }
 
-func DialTCPTimeoutWithMD5Sig(host string, port int, localAddr, key string, msec int) (*net.TCPConn, error) {
+func SetTcpMinTTLSockopts(conn *net.TCPConn, ttl int) error {
+	return fmt.Errorf("setting min ttl is not supported")
+}
+
+func SetTcpListenerTTLSockopts(l *net.TCPListener, ttl int) error {
+	return fmt.Errorf("setting ttl is not supported")
+}
+
+func SetTcpListenerMinTTLSockopts(l *net.TCPListener, ttl int) error {
+	return fmt.Errorf("setting min ttl is not supported")
+}
+
+func TcpDialerControl(ttl, minTtl int) func(string, string, syscall.RawConn) error {
+	return func(network, address string, c syscall.RawConn) error {
+		return fmt.Errorf("setting ttl is not supported")
+	}
+}
+
+func DialTCPTimeoutWithMD5Sig(host string, port int, localAddr, key string, ttl, minTtl, msec int) (*net.TCPConn, error) {
 	return nil, fmt.Errorf("md5 active connection unsupported")
 }
+
+func SetUdpTTLSockopts(conn *net.UDPConn, ttl int) error {
+	return fmt.Errorf("setting ttl is not supported")
+}
+
+func SetUdpRecvTTLSockopts(conn *net.UDPConn) error {
+	return fmt.Errorf("receiving ttl is not supported")
+}
+
+func ParseUdpRecvTTL(oob []byte) (int, error) {
+	return 0, fmt.Errorf("receiving ttl is not supported")
+}
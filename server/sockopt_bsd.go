@@ -67,6 +67,119 @@ func SetTcpTTLSockopts(conn *net.TCPConn, ttl int) error {
 	return os.NewSyscallError("setsockopt", syscall.SetsockoptInt(int(fi.Fd()), level, name, ttl))
 }
 
-func DialTCPTimeoutWithMD5Sig(host string, port int, localAddr, key string, msec int) (*net.TCPConn, error) {
+func setsockoptIpTtl(fd int, family int, value int) error {
+	level := syscall.IPPROTO_IP
+	name := syscall.IP_TTL
+	if family == syscall.AF_INET6 {
+		level = syscall.IPPROTO_IPV6
+		name = syscall.IPV6_UNICAST_HOPS
+	}
+	return os.NewSyscallError("setsockopt", syscall.SetsockoptInt(fd, level, name, value))
+}
+
+func setsockoptIpMinTtl(fd int, family int, value int) error {
+	if family == syscall.AF_INET6 {
+		return fmt.Errorf("minimum hop count is not supported")
+	}
+	level := syscall.IPPROTO_IP
+	name := syscall.IP_MINTTL
+	return os.NewSyscallError("setsockopt", syscall.SetsockoptInt(fd, level, name, value))
+}
+
+type fileSocket interface {
+	File() (*os.File, error)
+}
+
+// setsockoptWithFd calls f with the descriptor of the socket of s.
+func setsockoptWithFd(s fileSocket, f func(fd int) error) error {
+	fi, err := s.File()
+	if err != nil {
+		return err
+	}
+	defer fi.Close()
+	fd := int(fi.Fd())
+	// Fd() puts the socket shared with s in the blocking mode.
+	defer syscall.SetNonblock(fd, true)
+	return f(fd)
+}
+
+func SetTcpMinTTLSockopts(conn *net.TCPConn, ttl int) error {
+	family := syscall.AF_INET
+	if strings.Contains(conn.RemoteAddr().String(), "[") {
+		family = syscall.AF_INET6
+	}
+	return setsockoptWithFd(conn, func(fd int) error {
+		return setsockoptIpMinTtl(fd, family, ttl)
+	})
+}
+
+func SetTcpListenerTTLSockopts(l *net.TCPListener, ttl int) error {
+	if ttl == 0 {
+		// IPDEFTTL
+		ttl = 64
+	}
+	family := syscall.AF_INET
+	if l.Addr().(*net.TCPAddr).IP.To4() == nil {
+		family = syscall.AF_INET6
+	}
+	return setsockoptWithFd(l, func(fd int) error {
+		return setsockoptIpTtl(fd, family, ttl)
+	})
+}
+
+func SetTcpListenerMinTTLSockopts(l *net.TCPListener, ttl int) error {
+	family := syscall.AF_INET
+	if l.Addr().(*net.TCPAddr).IP.To4() == nil {
+		family = syscall.AF_INET6
+	}
+	return setsockoptWithFd(l, func(fd int) error {
+		return setsockoptIpMinTtl(fd, family, ttl)
+	})
+}
+
+func TcpDialerControl(ttl, minTtl int) func(string, string, syscall.RawConn) error {
+	return func(network, address string, c syscall.RawConn) error {
+		family := syscall.AF_INET
+		if network == "tcp6" || strings.Contains(address, "[") {
+			family = syscall.AF_INET6
+		}
+		var err error
+		if e := c.Control(func(fd uintptr) {
+			if ttl != 0 {
+				if err = setsockoptIpTtl(int(fd), family, ttl); err != nil {
+					return
+				}
+			}
+			if minTtl != 0 {
+				err = setsockoptIpMinTtl(int(fd), family, minTtl)
+			}
+		}); e != nil {
+			return e
+		}
+		return err
+	}
+}
+
+func DialTCPTimeoutWithMD5Sig(host string, port int, localAddr, key string, ttl, minTtl, msec int) (*net.TCPConn, error) {
 	return nil, fmt.Errorf("md5 active connection unsupported")
 }
+
+func SetUdpTTLSockopts(conn *net.UDPConn, ttl int) error {
+	level := syscall.IPPROTO_IP
+	name := syscall.IP_TTL
+	if conn.LocalAddr().(*net.UDPAddr).IP.To4() == nil {
+		level = syscall.IPPROTO_IPV6
+		name = syscall.IPV6_UNICAST_HOPS
+	}
+	return setsockoptWithFd(conn, func(fd int) error {
+		return os.NewSyscallError("setsockopt", syscall.SetsockoptInt(fd, level, name, ttl))
+	})
+}
+
+func SetUdpRecvTTLSockopts(conn *net.UDPConn) error {
+	return fmt.Errorf("receiving ttl is not supported")
+}
+
+func ParseUdpRecvTTL(oob []byte) (int, error) {
+	return 0, fmt.Errorf("receiving ttl is not supported")
+}
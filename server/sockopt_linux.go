@@ -26,7 +26,8 @@ import (
 )
 
 const (
-	TCP_MD5SIG = 14
+	TCP_MD5SIG       = 14
+	IPV6_MINHOPCOUNT = 73
 )
 
 type tcpmd5sig struct {
@@ -58,10 +59,10 @@ func buildTcpMD5Sig(address string, key string) (tcpmd5sig, error) {
 func SetTcpMD5SigSockopts(l *net.TCPListener, address string, key string) error {
 	t, _ := buildTcpMD5Sig(address, key)
 	fi, err := l.File()
-	defer fi.Close()
 	if err != nil {
 		return err
 	}
+	defer fi.Close()
 	if l, err := net.FileListener(fi); err == nil {
 		defer l.Close()
 	}
@@ -82,17 +83,119 @@ func SetTcpTTLSockopts(conn *net.TCPConn, ttl int) error {
 		name = syscall.IPV6_UNICAST_HOPS
 	}
 	fi, err := conn.File()
-	defer fi.Close()
 	if err != nil {
 		return err
 	}
+	defer fi.Close()
 	if conn, err := net.FileConn(fi); err == nil {
 		defer conn.Close()
 	}
 	return os.NewSyscallError("setsockopt", syscall.SetsockoptInt(int(fi.Fd()), level, name, ttl))
 }
 
-func DialTCPTimeoutWithMD5Sig(host string, port int, localAddr, key string, msec int) (*net.TCPConn, error) {
+func setsockoptIpTtl(fd int, family int, value int) error {
+	level := syscall.IPPROTO_IP
+	name := syscall.IP_TTL
+	if family == syscall.AF_INET6 {
+		level = syscall.IPPROTO_IPV6
+		name = syscall.IPV6_UNICAST_HOPS
+	}
+	return os.NewSyscallError("setsockopt", syscall.SetsockoptInt(fd, level, name, value))
+}
+
+func setsockoptIpMinTtl(fd int, family int, value int) error {
+	level := syscall.IPPROTO_IP
+	name := syscall.IP_MINTTL
+	if family == syscall.AF_INET6 {
+		level = syscall.IPPROTO_IPV6
+		name = IPV6_MINHOPCOUNT
+	}
+	return os.NewSyscallError("setsockopt", syscall.SetsockoptInt(fd, level, name, value))
+}
+
+type fileSocket interface {
+	File() (*os.File, error)
+}
+
+// setsockoptWithFd calls f with the descriptor of the socket of s.
+func setsockoptWithFd(s fileSocket, f func(fd int) error) error {
+	fi, err := s.File()
+	if err != nil {
+		return err
+	}
+	defer fi.Close()
+	fd := int(fi.Fd())
+	// Fd() puts the socket shared with s in the blocking mode, which
+	// makes the goroutine accepting or reading on s hold on to the socket
+	// when s is closed.
+	defer syscall.SetNonblock(fd, true)
+	return f(fd)
+}
+
+func SetTcpMinTTLSockopts(conn *net.TCPConn, ttl int) error {
+	family := syscall.AF_INET
+	if strings.Contains(conn.RemoteAddr().String(), "[") {
+		family = syscall.AF_INET6
+	}
+	return setsockoptWithFd(conn, func(fd int) error {
+		return setsockoptIpMinTtl(fd, family, ttl)
+	})
+}
+
+// SetTcpListenerTTLSockopts sets the TTL of the packets sent by the
+// listener before a connection is accepted, i.e., SYN-ACK. Zero resets
+// the TTL to the system default.
+func SetTcpListenerTTLSockopts(l *net.TCPListener, ttl int) error {
+	if ttl == 0 {
+		ttl = -1
+	}
+	family := syscall.AF_INET
+	if l.Addr().(*net.TCPAddr).IP.To4() == nil {
+		family = syscall.AF_INET6
+	}
+	return setsockoptWithFd(l, func(fd int) error {
+		return setsockoptIpTtl(fd, family, ttl)
+	})
+}
+
+// SetTcpListenerMinTTLSockopts makes the listener drop SYN with TTL less
+// than ttl. The accepted connections inherit it. Zero disables it.
+func SetTcpListenerMinTTLSockopts(l *net.TCPListener, ttl int) error {
+	family := syscall.AF_INET
+	if l.Addr().(*net.TCPAddr).IP.To4() == nil {
+		family = syscall.AF_INET6
+	}
+	return setsockoptWithFd(l, func(fd int) error {
+		return setsockoptIpMinTtl(fd, family, ttl)
+	})
+}
+
+// TcpDialerControl returns the function for net.Dialer to set the TTL
+// sockopts before connecting so that SYN is sent with the TTL.
+func TcpDialerControl(ttl, minTtl int) func(string, string, syscall.RawConn) error {
+	return func(network, address string, c syscall.RawConn) error {
+		family := syscall.AF_INET
+		if network == "tcp6" || strings.Contains(address, "[") {
+			family = syscall.AF_INET6
+		}
+		var err error
+		if e := c.Control(func(fd uintptr) {
+			if ttl != 0 {
+				if err = setsockoptIpTtl(int(fd), family, ttl); err != nil {
+					return
+				}
+			}
+			if minTtl != 0 {
+				err = setsockoptIpMinTtl(int(fd), family, minTtl)
+			}
+		}); e != nil {
+			return e
+		}
+		return err
+	}
+}
+
+func DialTCPTimeoutWithMD5Sig(host string, port int, localAddr, key string, ttl, minTtl, msec int) (*net.TCPConn, error) {
 	var family int
 	var ra, la syscall.Sockaddr
 
@@ -173,6 +276,16 @@ func DialTCPTimeoutWithMD5Sig(host string, port int, localAddr, key string, msec
 	if err = syscall.SetsockoptInt(fd, syscall.IPPROTO_TCP, syscall.TCP_NODELAY, 1); err != nil {
 		return nil, os.NewSyscallError("setsockopt", err)
 	}
+	if ttl != 0 {
+		if err = setsockoptIpTtl(fd, family, ttl); err != nil {
+			return nil, err
+		}
+	}
+	if minTtl != 0 {
+		if err = setsockoptIpMinTtl(fd, family, minTtl); err != nil {
+			return nil, err
+		}
+	}
 	if err = syscall.Bind(fd, la); err != nil {
 		return nil, os.NewSyscallError("bind", err)
 	}
@@ -231,3 +344,42 @@ func DialTCPTimeoutWithMD5Sig(host string, port int, localAddr, key string, msec
 		}
 	}
 }
+
+func setUdpSockopt(conn *net.UDPConn, level, name, value int) error {
+	return setsockoptWithFd(conn, func(fd int) error {
+		return os.NewSyscallError("setsockopt", syscall.SetsockoptInt(fd, level, name, value))
+	})
+}
+
+func SetUdpTTLSockopts(conn *net.UDPConn, ttl int) error {
+	if conn.LocalAddr().(*net.UDPAddr).IP.To4() == nil {
+		return setUdpSockopt(conn, syscall.IPPROTO_IPV6, syscall.IPV6_UNICAST_HOPS, ttl)
+	}
+	return setUdpSockopt(conn, syscall.IPPROTO_IP, syscall.IP_TTL, ttl)
+}
+
+// SetUdpRecvTTLSockopts makes the TTL (or the hop limit) of the received
+// packets available in the control messages of ReadMsgUDP.
+func SetUdpRecvTTLSockopts(conn *net.UDPConn) error {
+	if conn.LocalAddr().(*net.UDPAddr).IP.To4() == nil {
+		return setUdpSockopt(conn, syscall.IPPROTO_IPV6, syscall.IPV6_RECVHOPLIMIT, 1)
+	}
+	return setUdpSockopt(conn, syscall.IPPROTO_IP, syscall.IP_RECVTTL, 1)
+}
+
+func ParseUdpRecvTTL(oob []byte) (int, error) {
+	msgs, err := syscall.ParseSocketControlMessage(oob)
+	if err != nil {
+		return 0, err
+	}
+	for _, m := range msgs {
+		if (m.Header.Level == syscall.IPPROTO_IP && m.Header.Type == syscall.IP_TTL) ||
+			(m.Header.Level == syscall.IPPROTO_IPV6 && m.Header.Type == syscall.IPV6_HOPLIMIT) {
+			if len(m.Data) < 4 {
+				break
+			}
+			return int(*(*int32)(unsafe.Pointer(&m.Data[0]))), nil
+		}
+	}
+	return 0, fmt.Errorf("no ttl in the control message")
+}
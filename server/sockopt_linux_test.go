@@ -18,9 +18,14 @@ package server
 
 import (
 	"bytes"
+	"net"
+	"os"
 	"syscall"
 	"testing"
+	"time"
 	"unsafe"
+
+	"github.com/citizen-insane/gobgp/config"
 )
 
 func Test_buildTcpMD5Sig(t *testing.T) {
@@ -64,3 +69,219 @@ func Test_buildTcpMD5Sigv6(t *testing.T) {
 		t.Error("Something wrong v6")
 	}
 }
+
+// skipIfNotSupported skips the test when the socket option isn't
+// supported, e.g., in a sandbox.
+func skipIfNotSupported(t *testing.T, err error) {
+	t.Helper()
+	e := err
+	if o, ok := e.(*net.OpError); ok {
+		e = o.Err
+	}
+	if s, ok := e.(*os.SyscallError); ok {
+		e = s.Err
+	}
+	if e == syscall.EOPNOTSUPP || e == syscall.ENOPROTOOPT {
+		t.Skip(err)
+	}
+}
+
+func Test_TcpDialerControl(t *testing.T) {
+	l, err := net.ListenTCP("tcp4", &net.TCPAddr{IP: net.ParseIP("127.0.0.1")})
+	if err != nil {
+		t.Skip(err)
+	}
+	defer l.Close()
+	if err := SetTcpListenerTTLSockopts(l, 255); err != nil {
+		skipIfNotSupported(t, err)
+		t.Fatal(err)
+	}
+
+	d := net.Dialer{Timeout: time.Second, Control: TcpDialerControl(255, 254)}
+	conn, err := d.Dial("tcp4", l.Addr().String())
+	if err != nil {
+		skipIfNotSupported(t, err)
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	fi, err := conn.(*net.TCPConn).File()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fi.Close()
+	fd := int(fi.Fd())
+	defer syscall.SetNonblock(fd, true)
+	if ttl, _ := syscall.GetsockoptInt(fd, syscall.IPPROTO_IP, syscall.IP_TTL); ttl != 255 {
+		t.Error("ttl is wrong", ttl)
+	}
+	if ttl, _ := syscall.GetsockoptInt(fd, syscall.IPPROTO_IP, syscall.IP_MINTTL); ttl != 254 {
+		t.Error("min ttl is wrong", ttl)
+	}
+}
+
+func getListenerSockopt(t *testing.T, l *net.TCPListener, level, name int) int {
+	fi, err := l.File()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fi.Close()
+	fd := int(fi.Fd())
+	defer syscall.SetNonblock(fd, true)
+	v, err := syscall.GetsockoptInt(fd, level, name)
+	if err != nil {
+		skipIfNotSupported(t, err)
+		t.Fatal(err)
+	}
+	return v
+}
+
+func Test_TcpDialerControlv6(t *testing.T) {
+	l, err := net.ListenTCP("tcp6", &net.TCPAddr{IP: net.ParseIP("::1")})
+	if err != nil {
+		t.Skip(err)
+	}
+	defer l.Close()
+	hops := getListenerSockopt(t, l, syscall.IPPROTO_IPV6, syscall.IPV6_UNICAST_HOPS)
+	if err := SetTcpListenerTTLSockopts(l, 255); err != nil {
+		skipIfNotSupported(t, err)
+		t.Fatal(err)
+	}
+	if err := SetTcpListenerMinTTLSockopts(l, 254); err != nil {
+		skipIfNotSupported(t, err)
+		t.Fatal(err)
+	}
+	if ttl := getListenerSockopt(t, l, syscall.IPPROTO_IPV6, IPV6_MINHOPCOUNT); ttl != 254 {
+		t.Error("min hop count of the listener is wrong", ttl)
+	}
+
+	d := net.Dialer{Timeout: time.Second, Control: TcpDialerControl(255, 254)}
+	conn, err := d.Dial("tcp6", l.Addr().String())
+	if err != nil {
+		skipIfNotSupported(t, err)
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	fi, err := conn.(*net.TCPConn).File()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fi.Close()
+	fd := int(fi.Fd())
+	defer syscall.SetNonblock(fd, true)
+	if ttl, _ := syscall.GetsockoptInt(fd, syscall.IPPROTO_IPV6, syscall.IPV6_UNICAST_HOPS); ttl != 255 {
+		t.Error("hop limit is wrong", ttl)
+	}
+	if ttl, _ := syscall.GetsockoptInt(fd, syscall.IPPROTO_IPV6, IPV6_MINHOPCOUNT); ttl != 254 {
+		t.Error("min hop count is wrong", ttl)
+	}
+
+	// zero resets the listener to the system default.
+	if err := SetTcpListenerTTLSockopts(l, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetTcpListenerMinTTLSockopts(l, 0); err != nil {
+		t.Fatal(err)
+	}
+	if ttl := getListenerSockopt(t, l, syscall.IPPROTO_IPV6, syscall.IPV6_UNICAST_HOPS); ttl != hops {
+		t.Error("hop limit of the listener isn't reset", ttl)
+	}
+	if ttl := getListenerSockopt(t, l, syscall.IPPROTO_IPV6, IPV6_MINHOPCOUNT); ttl != 0 {
+		t.Error("min hop count of the listener isn't reset", ttl)
+	}
+}
+
+func TestListenerTtlSecurity(t *testing.T) {
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.Start(&config.Global{
+		Config: config.GlobalConfig{
+			As:               1,
+			RouterId:         "1.1.1.1",
+			Port:             10186,
+			LocalAddressList: []string{"127.0.0.1"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+
+	var l *net.TCPListener
+	s.mgmtOperation(func() error {
+		l = s.listeners[0].l
+		return nil
+	}, false)
+	ttl := getListenerSockopt(t, l, syscall.IPPROTO_IP, syscall.IP_TTL)
+	check := func(expectedTtl, expectedMinTtl int) {
+		s.mgmtOperation(func() error {
+			if v := getListenerSockopt(t, l, syscall.IPPROTO_IP, syscall.IP_TTL); v != expectedTtl {
+				t.Error("ttl of the listener is wrong", v, expectedTtl)
+			}
+			if v := getListenerSockopt(t, l, syscall.IPPROTO_IP, syscall.IP_MINTTL); v != expectedMinTtl {
+				t.Error("min ttl of the listener is wrong", v, expectedMinTtl)
+			}
+			return nil
+		}, false)
+	}
+	newNeighbor := func(addr string, ttlSecurity bool) *config.Neighbor {
+		return &config.Neighbor{
+			Config: config.NeighborConfig{
+				NeighborAddress: addr,
+				PeerAs:          2,
+			},
+			Transport: config.Transport{
+				Config: config.TransportConfig{
+					PassiveMode: true,
+					TtlSecurity: ttlSecurity,
+				},
+			},
+		}
+	}
+
+	n1 := newNeighbor("10.0.0.1", true)
+	if err := s.AddNeighbor(n1); err != nil {
+		t.Fatal(err)
+	}
+	check(255, 255)
+
+	// the new listener after the port is changed.
+	s.mgmtOperation(func() error {
+		err := s.updateListeners(&config.GlobalConfig{
+			Port:             10187,
+			LocalAddressList: []string{"127.0.0.1"},
+		})
+		l = s.listeners[0].l
+		return err
+	}, false)
+	check(255, 255)
+
+	// the listener can't drop SYN from the neighbor without ttl-security.
+	n2 := newNeighbor("10.0.0.2", false)
+	if err := s.AddNeighbor(n2); err != nil {
+		t.Fatal(err)
+	}
+	check(255, 0)
+
+	// ttl-security is disabled.
+	n1 = newNeighbor("10.0.0.1", false)
+	if _, err := s.UpdateNeighbor(n1); err != nil {
+		t.Fatal(err)
+	}
+	check(ttl, 0)
+
+	n2 = newNeighbor("10.0.0.2", true)
+	if _, err := s.UpdateNeighbor(n2); err != nil {
+		t.Fatal(err)
+	}
+	check(255, 0)
+	if err := s.DeleteNeighbor(n1); err != nil {
+		t.Fatal(err)
+	}
+	check(255, 255)
+	if err := s.DeleteNeighbor(n2); err != nil {
+		t.Fatal(err)
+	}
+	check(ttl, 0)
+}
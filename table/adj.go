@@ -20,6 +20,15 @@ import (
 	"github.com/citizen-insane/gobgp/packet/bgp"
 )
 
+// paths received with ADD-PATH share the prefix, so the path identifier
+// is a part of the key.
+func adjKey(path *Path) string {
+	if id := path.GetNlri().PathIdentifier(); id > 0 {
+		return fmt.Sprintf("%d:%s", id, path.getPrefix())
+	}
+	return path.getPrefix()
+}
+
 type AdjRib struct {
 	id       string
 	accepted map[bgp.RouteFamily]int
@@ -44,7 +53,7 @@ func (adj *AdjRib) Update(pathList []*Path) {
 			continue
 		}
 		rf := path.GetRouteFamily()
-		key := path.getPrefix()
+		key := adjKey(path)
 
 		old, found := adj.table[rf][key]
 		if path.IsWithdraw {
@@ -135,7 +144,7 @@ func (adj *AdjRib) DropStale(rfList []bgp.RouteFamily) []*Path {
 		if table, ok := adj.table[rf]; ok {
 			for _, p := range table {
 				if p.IsStale() {
-					delete(table, p.getPrefix())
+					delete(table, adjKey(p))
 					if p.Filtered(adj.id) == POLICY_DIRECTION_NONE {
 						adj.accepted[rf]--
 					}
@@ -166,10 +175,19 @@ func (adj *AdjRib) Exists(path *Path) bool {
 	if !ok {
 		return false
 	}
-	_, ok = table[path.getPrefix()]
+	_, ok = table[adjKey(path)]
 	return ok
 }
 
+// Get returns the path kept for the prefix and the path identifier of
+// path, or nil.
+func (adj *AdjRib) Get(path *Path) *Path {
+	if path == nil || path.IsEOR() {
+		return nil
+	}
+	return adj.table[path.GetRouteFamily()][adjKey(path)]
+}
+
 func (adj *AdjRib) Select(family bgp.RouteFamily, accepted bool, option ...TableSelectOption) (*Table, error) {
 	paths := adj.PathList([]bgp.RouteFamily{family}, accepted)
 	dsts := make(map[string]*Destination, len(paths))
@@ -0,0 +1,206 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bgp"
+	"math"
+	"time"
+)
+
+// penalties of route flap damping (RFC 2439)
+const (
+	DAMPING_WITHDRAW_PENALTY         = 1000
+	DAMPING_ATTRIBUTE_CHANGE_PENALTY = 500
+)
+
+type dampingEntry struct {
+	path         *Path
+	penalty      float64
+	updated      time.Time
+	flaps        int
+	withdrawn    bool
+	suppressed   bool
+	suppressedAt time.Time
+}
+
+// DampenedPath is a path suppressed by route flap damping.
+type DampenedPath struct {
+	Path      *Path
+	Penalty   int
+	Flaps     int
+	ReuseTime time.Duration
+}
+
+// Damping keeps the figure of merit of the paths received from a peer.
+type Damping struct {
+	HalfLife          time.Duration
+	ReuseThreshold    float64
+	SuppressThreshold float64
+	MaxSuppressTime   time.Duration
+	table             map[bgp.RouteFamily]map[string]*dampingEntry
+}
+
+func NewDamping(rfList []bgp.RouteFamily, c *config.RouteFlapDampingConfig) *Damping {
+	table := make(map[bgp.RouteFamily]map[string]*dampingEntry)
+	for _, rf := range rfList {
+		table[rf] = make(map[string]*dampingEntry)
+	}
+	d := &Damping{
+		table: table,
+	}
+	d.SetConfig(c)
+	return d
+}
+
+// SetConfig updates the parameters. The penalties accrued so far are
+// kept.
+func (d *Damping) SetConfig(c *config.RouteFlapDampingConfig) {
+	d.HalfLife = time.Duration(c.HalfLife) * time.Second
+	d.ReuseThreshold = float64(c.ReuseThreshold)
+	d.SuppressThreshold = float64(c.SuppressThreshold)
+	d.MaxSuppressTime = time.Duration(c.MaxSuppressTime) * time.Second
+}
+
+// the penalty never exceeds the value which decays to the reuse threshold
+// in the max suppress time.
+func (d *Damping) ceiling() float64 {
+	return d.ReuseThreshold * math.Pow(2, float64(d.MaxSuppressTime)/float64(d.HalfLife))
+}
+
+func (d *Damping) decay(e *dampingEntry, now time.Time) {
+	if elapsed := now.Sub(e.updated); elapsed > 0 {
+		e.penalty *= math.Pow(2, -float64(elapsed)/float64(d.HalfLife))
+	}
+	e.updated = now
+}
+
+func (d *Damping) charge(e *dampingEntry, penalty float64, now time.Time) {
+	e.penalty = math.Min(e.penalty+penalty, d.ceiling())
+	e.flaps++
+	if !e.suppressed && e.penalty >= d.SuppressThreshold {
+		e.suppressed = true
+		e.suppressedAt = now
+	}
+}
+
+// Update accrues the penalty of a received path and returns true when the
+// path must be kept out of the best path selection. old is the path in
+// adj-rib-in replaced or withdrawn by the path, or nil. The paths are
+// tracked from their first penalty.
+func (d *Damping) Update(path, old *Path, now time.Time) bool {
+	if path == nil || path.IsEOR() {
+		return false
+	}
+	rf := path.GetRouteFamily()
+	if _, ok := d.table[rf]; !ok {
+		return false
+	}
+	key := adjKey(path)
+	e, found := d.table[rf][key]
+	var penalty float64
+	if path.IsWithdraw {
+		if old != nil {
+			penalty = DAMPING_WITHDRAW_PENALTY
+		}
+	} else if old != nil && !old.Equal(path) {
+		penalty = DAMPING_ATTRIBUTE_CHANGE_PENALTY
+	}
+	if !found {
+		if penalty == 0 {
+			return false
+		}
+		e = &dampingEntry{updated: now}
+		d.table[rf][key] = e
+	}
+	d.decay(e, now)
+	if penalty > 0 {
+		d.charge(e, penalty, now)
+	}
+	if path.IsWithdraw {
+		e.withdrawn = true
+	} else {
+		e.path = path
+		e.withdrawn = false
+	}
+	return e.suppressed
+}
+
+func (d *Damping) reusable(e *dampingEntry, now time.Time) bool {
+	return e.penalty < d.ReuseThreshold || now.Sub(e.suppressedAt) >= d.MaxSuppressTime
+}
+
+// Reuse decays the penalties and returns the paths in adj which are no
+// longer suppressed. Withdrawn entries decayed enough are forgotten.
+func (d *Damping) Reuse(adj *AdjRib, now time.Time) []*Path {
+	pathList := make([]*Path, 0)
+	for rf, table := range d.table {
+		for key, e := range table {
+			path, ok := adj.table[rf][key]
+			if !e.withdrawn && !ok {
+				// dropped from adj-rib-in without withdrawal
+				delete(table, key)
+				continue
+			}
+			d.decay(e, now)
+			if e.suppressed && d.reusable(e, now) {
+				e.suppressed = false
+				if !e.withdrawn {
+					pathList = append(pathList, path)
+				}
+			}
+			if e.withdrawn && !e.suppressed && e.penalty < d.ReuseThreshold/2 {
+				delete(table, key)
+			}
+		}
+	}
+	return pathList
+}
+
+func (d *Damping) PathList(rfList []bgp.RouteFamily, now time.Time) []*DampenedPath {
+	pathList := make([]*DampenedPath, 0)
+	for _, rf := range rfList {
+		for _, e := range d.table[rf] {
+			if !e.suppressed || e.withdrawn || e.path == nil {
+				continue
+			}
+			penalty := e.penalty * math.Pow(2, -float64(now.Sub(e.updated))/float64(d.HalfLife))
+			reuse := d.MaxSuppressTime - now.Sub(e.suppressedAt)
+			if t := time.Duration(float64(d.HalfLife) * math.Log2(penalty/d.ReuseThreshold)); t < reuse {
+				reuse = t
+			}
+			if reuse < 0 {
+				reuse = 0
+			}
+			pathList = append(pathList, &DampenedPath{
+				Path:      e.path,
+				Penalty:   int(penalty),
+				Flaps:     e.flaps,
+				ReuseTime: reuse,
+			})
+		}
+	}
+	return pathList
+}
+
+func (d *Damping) Drop(rfList []bgp.RouteFamily) {
+	for _, rf := range rfList {
+		if _, ok := d.table[rf]; ok {
+			d.table[rf] = make(map[string]*dampingEntry)
+		}
+	}
+}
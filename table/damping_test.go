@@ -0,0 +1,138 @@
+// Copyright (C) 2017 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"github.com/citizen-insane/gobgp/config"
+	"github.com/citizen-insane/gobgp/packet/bgp"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestDamping(t *testing.T) {
+	assert := assert.New(t)
+	rfList := []bgp.RouteFamily{bgp.RF_IPv4_UC}
+	peer := &PeerInfo{AS: 65001, Address: net.ParseIP("10.0.0.1")}
+	nlri := bgp.NewIPAddrPrefix(24, "10.10.0.0")
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeNextHop("10.0.0.1"),
+	}
+	path := NewPath(peer, nlri, false, attrs, time.Now(), false)
+	withdraw := path.Clone(true)
+
+	adj := NewAdjRib("10.0.0.1", rfList)
+	d := NewDamping(rfList, &config.RouteFlapDampingConfig{
+		HalfLife:          config.DEFAULT_DAMPING_HALF_LIFE,
+		ReuseThreshold:    config.DEFAULT_DAMPING_REUSE_THRESHOLD,
+		SuppressThreshold: config.DEFAULT_DAMPING_SUPPRESS_THRESHOLD,
+		MaxSuppressTime:   config.DEFAULT_DAMPING_MAX_SUPPRESS_TIME,
+	})
+	now := time.Now()
+	update := func(p *Path) bool {
+		old := adj.Get(p)
+		adj.Update([]*Path{p})
+		return d.Update(p, old, now)
+	}
+
+	// nothing is tracked until the first penalty
+	assert.False(update(path))
+	assert.False(update(path))
+	assert.Equal(0, len(d.table[bgp.RF_IPv4_UC]))
+	// two flaps reach the suppress threshold
+	assert.False(update(withdraw))
+	assert.False(update(path))
+	assert.True(update(withdraw))
+	assert.True(update(path))
+
+	l := d.PathList(rfList, now)
+	assert.Equal(1, len(l))
+	assert.Equal(2, l[0].Flaps)
+	assert.Equal(2000, l[0].Penalty)
+	// 15 minutes * log2(2000 / 750)
+	assert.InDelta(float64(21*time.Minute+13*time.Second), float64(l[0].ReuseTime), float64(time.Second))
+
+	// still suppressed after a half life
+	now = now.Add(d.HalfLife)
+	assert.Equal(0, len(d.Reuse(adj, now)))
+
+	// decayed below the reuse threshold
+	now = now.Add(d.HalfLife)
+	l2 := d.Reuse(adj, now)
+	assert.Equal(1, len(l2))
+	assert.Equal(path, l2[0])
+	assert.Equal(0, len(d.PathList(rfList, now)))
+
+	// an attribute change is a half flap
+	changed := NewPath(peer, nlri, false, []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeNextHop("10.0.0.2"),
+	}, time.Now(), false)
+	assert.False(update(changed))
+	assert.Equal(0, len(d.PathList(rfList, now)))
+	assert.Equal(3, d.table[bgp.RF_IPv4_UC][adjKey(path)].flaps)
+
+	// withdrawing an unknown prefix isn't a flap
+	assert.False(update(NewPath(peer, bgp.NewIPAddrPrefix(24, "10.20.0.0"), true, attrs, time.Now(), false)))
+	assert.Equal(1, len(d.table[bgp.RF_IPv4_UC]))
+}
+
+func TestDampingConfig(t *testing.T) {
+	assert := assert.New(t)
+	rfList := []bgp.RouteFamily{bgp.RF_IPv4_UC}
+	peer := &PeerInfo{AS: 65001, Address: net.ParseIP("10.0.0.1")}
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeNextHop("10.0.0.1"),
+	}
+	path := NewPath(peer, bgp.NewIPAddrPrefix(24, "10.10.0.0"), false, attrs, time.Now(), false)
+
+	adj := NewAdjRib("10.0.0.1", rfList)
+	d := NewDamping(rfList, &config.RouteFlapDampingConfig{
+		HalfLife:          60,
+		ReuseThreshold:    500,
+		SuppressThreshold: 1000,
+		MaxSuppressTime:   120,
+	})
+	assert.Equal(time.Minute, d.HalfLife)
+	assert.Equal(2*time.Minute, d.MaxSuppressTime)
+
+	now := time.Now()
+	update := func(p *Path) bool {
+		old := adj.Get(p)
+		adj.Update([]*Path{p})
+		return d.Update(p, old, now)
+	}
+	assert.False(update(path))
+	// a single flap reaches the configured suppress threshold
+	assert.True(update(path.Clone(true)))
+	assert.True(update(path))
+	l := d.PathList(rfList, now)
+	assert.Equal(1000, l[0].Penalty)
+	assert.Equal(time.Minute, l[0].ReuseTime)
+
+	// the penalty is capped to decay to the reuse threshold in the max
+	// suppress time.
+	for i := 0; i < 3; i++ {
+		assert.True(update(path.Clone(true)))
+		assert.True(update(path))
+	}
+	assert.Equal(2000, d.PathList(rfList, now)[0].Penalty)
+	assert.Equal(0, len(d.Reuse(adj, now.Add(time.Minute))))
+	assert.Equal(1, len(d.Reuse(adj, now.Add(d.MaxSuppressTime))))
+}
@@ -41,6 +41,7 @@ const (
 	BPR_HIGHEST_WEIGHT     BestPathReason = "Highest Weight"
 	BPR_LOCAL_PREF         BestPathReason = "Local Pref"
 	BPR_LOCAL_ORIGIN       BestPathReason = "Local Origin"
+	BPR_AIGP               BestPathReason = "AIGP"
 	BPR_ASPATH             BestPathReason = "AS Path"
 	BPR_ORIGIN             BestPathReason = "Origin"
 	BPR_MED                BestPathReason = "MED"
@@ -75,6 +76,8 @@ type PeerInfo struct {
 	RouteReflectorClient    bool
 	RouteReflectorClusterID net.IP
 	MultihopTtl             uint8
+	Confederation           bool
+	AigpEnabled             map[bgp.RouteFamily]bool
 }
 
 func (lhs *PeerInfo) Equal(rhs *PeerInfo) bool {
@@ -109,6 +112,12 @@ func (i *PeerInfo) String() string {
 
 func NewPeerInfo(g *config.Global, p *config.Neighbor) *PeerInfo {
 	id := net.ParseIP(string(p.RouteReflector.Config.RouteReflectorClusterId)).To4()
+	aigp := make(map[bgp.RouteFamily]bool)
+	for _, a := range p.AfiSafis {
+		if rf, err := bgp.GetRouteFamily(string(a.Config.AfiSafiName)); err == nil && a.RouteSelectionOptions.Config.EnableAigp {
+			aigp[rf] = true
+		}
+	}
 	return &PeerInfo{
 		AS:                      p.Config.PeerAs,
 		LocalAS:                 g.Config.As,
@@ -117,6 +126,8 @@ func NewPeerInfo(g *config.Global, p *config.Neighbor) *PeerInfo {
 		RouteReflectorClient:    p.RouteReflector.Config.RouteReflectorClient,
 		RouteReflectorClusterID: id,
 		MultihopTtl:             p.EbgpMultihop.Config.MultihopTtl,
+		Confederation:           p.Config.PeerType == config.PEER_TYPE_CONFED_EXTERNAL,
+		AigpEnabled:             aigp,
 	}
 }
 
@@ -245,6 +256,8 @@ func (dest *Destination) Calculate(ids []string) (map[string]*Path, map[string]*
 	dest.knownPathList = append(dest.knownPathList, dest.newPathList...)
 	// Clear new paths as we copied them.
 	dest.newPathList = make([]*Path, 0)
+	// Assign the local path identifiers used for ADD-PATH.
+	dest.assignLocalIdentifier()
 	// Compute new best path
 	dest.computeKnownBestPath()
 
@@ -305,6 +318,31 @@ func (dest *Destination) Calculate(ids []string) (map[string]*Path, map[string]*
 	return bestList, oldList, multi
 }
 
+// RFC 7911 3. the path identifier of the paths for the same prefix
+// must be unique when advertised, so we allocate the smallest unused one.
+func (dest *Destination) assignLocalIdentifier() {
+	used := make(map[uint32]bool, len(dest.knownPathList))
+	for _, path := range dest.knownPathList {
+		if id := path.localId; id > 0 {
+			if used[id] {
+				path.localId = 0
+			}
+			used[id] = true
+		}
+	}
+	next := uint32(1)
+	for _, path := range dest.knownPathList {
+		if path.localId > 0 {
+			continue
+		}
+		for used[next] {
+			next++
+		}
+		path.localId = next
+		used[next] = true
+	}
+}
+
 // Removes withdrawn paths.
 //
 // Note:
@@ -347,14 +385,18 @@ func (dest *Destination) explicitWithdraw() paths {
 	for _, withdraw := range dest.withdrawList {
 		isFound := false
 		for _, path := range dest.knownPathList {
-			// We have a match if the source are same.
-			if path.GetSource().Equal(withdraw.GetSource()) {
+			// We have a match if the source and the path identifier
+			// are same.
+			if path.GetSource().Equal(withdraw.GetSource()) && path.GetNlri().PathIdentifier() == withdraw.GetNlri().PathIdentifier() {
 				isFound = true
 				// this path is referenced in peer's adj-rib-in
 				// when there was no policy modification applied.
 				// we could flag IsWithdraw down after use to avoid
 				// a path with IsWithdraw flag exists in adj-rib-in
 				path.IsWithdraw = true
+				// the withdrawal is advertised with the local path
+				// identifier of the removed path.
+				withdraw.localId = path.localId
 				matches = append(matches, withdraw)
 			}
 		}
@@ -399,7 +441,10 @@ func (dest *Destination) implicitWithdraw() paths {
 			// version num. as newPaths are implicit withdrawal of old
 			// paths and when doing RouteRefresh (not EnhancedRouteRefresh)
 			// we get same paths again.
-			if newPath.GetSource().Equal(path.GetSource()) {
+			if newPath.GetSource().Equal(path.GetSource()) && newPath.GetNlri().PathIdentifier() == path.GetNlri().PathIdentifier() {
+				// keep advertising the new version with the same local
+				// path identifier.
+				newPath.localId = path.localId
 				log.WithFields(log.Fields{
 					"Topic": "Table",
 					"Key":   dest.GetNlri().String(),
@@ -443,6 +488,9 @@ func (dest *Destination) computeKnownBestPath() (*Path, BestPathReason, error) {
 		}
 		return dest.knownPathList[0], BPR_ONLY_PATH, nil
 	}
+	for _, path := range dest.knownPathList {
+		path.resetIgpCost()
+	}
 	sort.Sort(dest.knownPathList)
 	newBest := dest.knownPathList[0]
 	// If the first path has the invalidated next-hop, which evaluated by IGP,
@@ -478,16 +526,18 @@ func (p paths) Less(i, j int) bool {
 	//	local preference value.
 	//	4.  Prefer locally originated routes (network routes, redistributed
 	//	routes, or aggregated routes) over received routes.
-	//	5.  Select the route with the shortest AS-path length.
-	//	6.  If all paths have the same AS-path length, select the path based
+	//	5.  If AIGP is enabled, select the route with the lowest AIGP
+	//	metric plus the IGP cost to the next hop.
+	//	6.  Select the route with the shortest AS-path length.
+	//	7.  If all paths have the same AS-path length, select the path based
 	//	on origin: IGP is preferred over EGP; EGP is preferred over
 	//	Incomplete.
-	//	7.  If the origins are the same, select the path with lowest MED
+	//	8.  If the origins are the same, select the path with lowest MED
 	//	value.
-	//	8.  If the paths have the same MED values, select the path learned
+	//	9.  If the paths have the same MED values, select the path learned
 	//	via EBGP over one learned via IBGP.
-	//	9.  Select the route with the lowest IGP cost to the next hop.
-	//	10. Select the route received from the peer with the lowest BGP
+	//	10. Select the route with the lowest IGP cost to the next hop.
+	//	11. Select the route received from the peer with the lowest BGP
 	//	router ID.
 	//
 	//	Returns None if best-path among given paths cannot be computed else best
@@ -524,6 +574,10 @@ func (p paths) Less(i, j int) bool {
 		better = compareByLocalOrigin(path1, path2)
 		reason = BPR_LOCAL_ORIGIN
 	}
+	if better == nil {
+		better = compareByAIGP(path1, path2)
+		reason = BPR_AIGP
+	}
 	if better == nil {
 		better = compareByASPath(path1, path2)
 		reason = BPR_ASPATH
@@ -826,6 +880,65 @@ func compareByASNumber(path1, path2 *Path) *Path {
 	return nil
 }
 
+// igpMetric returns the metric of the route toward the nexthop in the
+// kernel routing table.
+var igpMetric = func(nexthop net.IP) (int, bool) {
+	var ipNet *net.IPNet
+	if nexthop.To4() != nil {
+		_, ipNet, _ = net.ParseCIDR(nexthop.String() + "/32")
+	} else {
+		_, ipNet, _ = net.ParseCIDR(nexthop.String() + "/128")
+	}
+	if ipNet == nil {
+		return 0, false
+	}
+	routeFilter := &netlink.Route{Dst: ipNet}
+	routes, _ := netlink.RouteListFiltered(netlink.FAMILY_ALL, routeFilter, netlink.RT_FILTER_DST)
+	if len(routes) > 0 {
+		return routes[0].Priority, true
+	}
+	return 0, false
+}
+
+func compareByAIGP(path1, path2 *Path) *Path {
+	//	Select the route with the lowest AIGP metric plus the IGP cost to
+	//	the next hop (RFC 7311 4.1).
+	//
+	//	A route without AIGP is less preferable than a route with it.
+	//	Return None if AIGP isn't enabled on the sessions both routes came
+	//	from or the metric is same.
+	if !path1.isAigpEnabled() || !path2.isAigpEnabled() {
+		return nil
+	}
+	log.WithFields(log.Fields{
+		"Topic": "Table",
+	}).Debugf("enter compareByAIGP -- path1: %v, path2: %v", path1, path2)
+
+	aigp1, err1 := path1.GetAigp()
+	aigp2, err2 := path2.GetAigp()
+	if err1 != nil && err2 != nil {
+		return nil
+	} else if err1 != nil {
+		return path2
+	} else if err2 != nil {
+		return path1
+	}
+
+	if metric, ok := path1.getIgpCost(); ok {
+		aigp1 += uint64(metric)
+	}
+	if metric, ok := path2.getIgpCost(); ok {
+		aigp2 += uint64(metric)
+	}
+
+	if aigp1 < aigp2 {
+		return path1
+	} else if aigp2 < aigp1 {
+		return path2
+	}
+	return nil
+}
+
 func compareByIGPCost(path1, path2 *Path) *Path {
 	//	Select the route with the lowest IGP cost to the next hop.
 	//
@@ -834,35 +947,12 @@ func compareByIGPCost(path1, path2 *Path) *Path {
 		"Topic": "Table",
 	}).Debugf("enter compareByIGPCost -- path1: %v, path2: %v", path1, path2)
 
-	nexthop1 := path1.GetNexthop()
-	var ipNet1 *net.IPNet
-	var metric1 int
-	if nexthop1.To4() != nil {
-		_, ipNet1, _ = net.ParseCIDR(nexthop1.String() + "/32")
-	} else {
-		_, ipNet1, _ = net.ParseCIDR(nexthop1.String() + "/128")
-	}
-	routeFilter1 := &netlink.Route{ Dst: ipNet1, }
-	routes1, _ := netlink.RouteListFiltered(netlink.FAMILY_ALL, routeFilter1, netlink.RT_FILTER_DST)
-	if len(routes1) > 0 {
-		metric1 = routes1[0].Priority
-	} else {
+	metric1, ok := path1.getIgpCost()
+	if !ok {
 		return nil
 	}
-
-	nexthop2 := path2.GetNexthop()
-	var ipNet2 *net.IPNet
-	var metric2 int
-	if nexthop2.To4() != nil {
-		_, ipNet2, _ = net.ParseCIDR(nexthop2.String() + "/32")
-	} else {
-		_, ipNet2, _ = net.ParseCIDR(nexthop2.String() + "/128")
-	}
-	routeFilter2 := &netlink.Route{ Dst: ipNet2, }
-	routes2, _ := netlink.RouteListFiltered(netlink.FAMILY_ALL, routeFilter2, netlink.RT_FILTER_DST)
-	if len(routes2) > 0 {
-		metric2 = routes2[0].Priority
-	} else {
+	metric2, ok := path2.getIgpCost()
+	if !ok {
 		return nil
 	}
 
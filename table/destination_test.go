@@ -273,6 +273,77 @@ func TestMedTieBreaker(t *testing.T) {
 	assert.Equal(t, compareByMED(p5, p6), p5)
 }
 
+func TestAigpTieBreaker(t *testing.T) {
+	nlri := bgp.NewIPAddrPrefix(24, "10.10.0.0")
+	enabled := &PeerInfo{
+		Address:     net.ParseIP("10.0.0.1"),
+		AigpEnabled: map[bgp.RouteFamily]bool{bgp.RF_IPv4_UC: true},
+	}
+	disabled := &PeerInfo{Address: net.ParseIP("10.0.0.2")}
+
+	newPath := func(source *PeerInfo, attrs ...bgp.PathAttributeInterface) *Path {
+		return NewPath(source, nlri, false, attrs, time.Now(), false)
+	}
+	p0 := newPath(enabled, bgp.NewPathAttributeAigp([]bgp.AigpTLV{bgp.NewAigpTLVIgpMetric(10)}))
+	p1 := newPath(enabled, bgp.NewPathAttributeAigp([]bgp.AigpTLV{bgp.NewAigpTLVIgpMetric(20)}))
+	p2 := newPath(enabled, bgp.NewPathAttributeMultiExitDisc(0))
+	p3 := newPath(disabled, bgp.NewPathAttributeAigp([]bgp.AigpTLV{bgp.NewAigpTLVIgpMetric(10)}))
+	p4 := newPath(nil, bgp.NewPathAttributeAigp([]bgp.AigpTLV{bgp.NewAigpTLVIgpMetric(10)}))
+
+	assert.Equal(t, compareByAIGP(p0, p1), p0)
+	assert.Equal(t, compareByAIGP(p1, p0), p0)
+	// the path without AIGP is less preferable
+	assert.Equal(t, compareByAIGP(p1, p2), p1)
+	assert.Equal(t, compareByAIGP(p2, p2), (*Path)(nil))
+	// not compared unless AIGP is enabled on both sessions
+	assert.Equal(t, compareByAIGP(p1, p3), (*Path)(nil))
+	assert.Equal(t, compareByAIGP(p3, p1), (*Path)(nil))
+	// local paths
+	assert.Equal(t, compareByAIGP(p1, p4), p4)
+
+	p1.SetAigp(10)
+	assert.Equal(t, compareByAIGP(p0, p1), (*Path)(nil))
+}
+
+func TestIgpCostLookup(t *testing.T) {
+	lookups := 0
+	defer func(f func(net.IP) (int, bool)) {
+		igpMetric = f
+	}(igpMetric)
+	igpMetric = func(nexthop net.IP) (int, bool) {
+		lookups++
+		if nexthop.Equal(net.ParseIP("10.0.0.1")) {
+			return 10, true
+		}
+		return 20, true
+	}
+
+	nlri := bgp.NewIPAddrPrefix(24, "10.10.0.0")
+	d := NewDestination(nlri)
+	for i := 1; i <= 3; i++ {
+		address := net.IPv4(10, 0, 0, byte(i)).String()
+		source := &PeerInfo{
+			AS:          65001,
+			LocalAS:     65000,
+			Address:     net.ParseIP(address),
+			ID:          net.ParseIP(address),
+			AigpEnabled: map[bgp.RouteFamily]bool{bgp.RF_IPv4_UC: true},
+		}
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop(address),
+			bgp.NewPathAttributeAigp([]bgp.AigpTLV{bgp.NewAigpTLVIgpMetric(10)}),
+		}
+		d.AddNewPath(NewPath(source, nlri, false, attrs, time.Now(), false))
+	}
+	d.Calculate(nil)
+
+	assert.Equal(t, "10.0.0.1", d.GetBestPath("").GetNexthop().String())
+	assert.Equal(t, BPR_AIGP, d.GetBestPath("").reason)
+	// once per path
+	assert.Equal(t, 3, lookups)
+}
+
 func TestTimeTieBreaker(t *testing.T) {
 	origin := bgp.NewPathAttributeOrigin(0)
 	aspathParam := []bgp.AsPathParamInterface{bgp.NewAs4PathParam(2, []uint32{65001})}
@@ -488,3 +559,33 @@ func TestMultipath(t *testing.T) {
 
 	UseMultiplePaths.Enabled = false
 }
+
+func TestLocalIdentifierSharedNlri(t *testing.T) {
+	nlri := bgp.NewIPAddrPrefix(24, "10.10.0.0")
+	attrs := []bgp.PathAttributeInterface{bgp.NewPathAttributeOrigin(0)}
+	newPath := func(address string) *Path {
+		pi := &PeerInfo{Address: net.ParseIP(address), ID: net.ParseIP(address)}
+		return NewPath(pi, nlri, false, attrs, time.Now(), false)
+	}
+	rib1 := NewTableManager([]bgp.RouteFamily{bgp.RF_IPv4_UC})
+	rib2 := NewTableManager([]bgp.RouteFamily{bgp.RF_IPv4_UC})
+
+	p1 := newPath("192.168.0.1")
+	rib1.ProcessPaths([]string{GLOBAL_RIB_NAME}, []*Path{p1})
+	p2 := newPath("192.168.0.2")
+	rib2.ProcessPaths([]string{GLOBAL_RIB_NAME}, []*Path{newPath("192.168.0.3"), p2})
+
+	// the identifiers assigned in the tables don't affect each other.
+	assert.Equal(t, uint32(1), p1.GetLocalIdentifier())
+	assert.Equal(t, uint32(2), p2.GetLocalIdentifier())
+	assert.Equal(t, uint32(2), p2.Clone(true).GetLocalIdentifier())
+	assert.Equal(t, uint32(0), nlri.PathLocalIdentifier())
+
+	// the identifier is encoded only with ADD-PATH.
+	opt := &bgp.MarshallingOption{AddPath: map[bgp.RouteFamily]bgp.BGPAddPathMode{bgp.RF_IPv4_UC: bgp.BGP_ADD_PATH_SEND}}
+	msgs := CreateUpdateMsgFromPaths([]*Path{p2}, opt)
+	assert.Equal(t, uint32(2), msgs[0].Body.(*bgp.BGPUpdate).NLRI[0].PathLocalIdentifier())
+	msgs = CreateUpdateMsgFromPaths([]*Path{p2})
+	assert.Equal(t, nlri, msgs[0].Body.(*bgp.BGPUpdate).NLRI[0])
+	assert.Equal(t, uint32(0), nlri.PathLocalIdentifier())
+}
@@ -256,26 +256,39 @@ func UpdatePathAggregator4ByteAs(msg *bgp.BGPUpdate) error {
 	return nil
 }
 
-func createUpdateMsgFromPath(path *Path, msg *bgp.BGPMessage) *bgp.BGPMessage {
+func isAddPathSendEnabled(rf bgp.RouteFamily, options []*bgp.MarshallingOption) bool {
+	for _, opt := range options {
+		if opt != nil && opt.AddPath[rf]&bgp.BGP_ADD_PATH_SEND > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func createUpdateMsgFromPath(path *Path, msg *bgp.BGPMessage, options []*bgp.MarshallingOption) *bgp.BGPMessage {
 	rf := path.GetRouteFamily()
+	nlri := path.GetNlri()
+	if isAddPathSendEnabled(rf, options) {
+		nlri = path.nlriWithLocalIdentifier()
+	}
 
 	if rf == bgp.RF_IPv4_UC {
-		nlri := path.GetNlri().(*bgp.IPAddrPrefix)
+		prefix := nlri.(*bgp.IPAddrPrefix)
 		if path.IsWithdraw {
 			if msg != nil {
 				u := msg.Body.(*bgp.BGPUpdate)
-				u.WithdrawnRoutes = append(u.WithdrawnRoutes, nlri)
+				u.WithdrawnRoutes = append(u.WithdrawnRoutes, prefix)
 				return nil
 			} else {
-				return bgp.NewBGPUpdateMessage([]*bgp.IPAddrPrefix{nlri}, nil, nil)
+				return bgp.NewBGPUpdateMessage([]*bgp.IPAddrPrefix{prefix}, nil, nil)
 			}
 		} else {
 			if msg != nil {
 				u := msg.Body.(*bgp.BGPUpdate)
-				u.NLRI = append(u.NLRI, nlri)
+				u.NLRI = append(u.NLRI, prefix)
 			} else {
 				pathAttrs := path.GetPathAttrs()
-				return bgp.NewBGPUpdateMessage(nil, pathAttrs, []*bgp.IPAddrPrefix{nlri})
+				return bgp.NewBGPUpdateMessage(nil, pathAttrs, []*bgp.IPAddrPrefix{prefix})
 			}
 		}
 	} else {
@@ -285,7 +298,7 @@ func createUpdateMsgFromPath(path *Path, msg *bgp.BGPMessage) *bgp.BGPMessage {
 				for _, p := range u.PathAttributes {
 					if p.GetType() == bgp.BGP_ATTR_TYPE_MP_UNREACH_NLRI {
 						unreach := p.(*bgp.PathAttributeMpUnreachNLRI)
-						unreach.Value = append(unreach.Value, path.GetNlri())
+						unreach.Value = append(unreach.Value, nlri)
 					}
 				}
 			} else {
@@ -296,7 +309,7 @@ func createUpdateMsgFromPath(path *Path, msg *bgp.BGPMessage) *bgp.BGPMessage {
 					attr = path.getPathAttr(bgp.BGP_ATTR_TYPE_MP_UNREACH_NLRI)
 					nlris = attr.(*bgp.PathAttributeMpUnreachNLRI).Value
 				} else {
-					nlris = []bgp.AddrPrefixInterface{path.GetNlri()}
+					nlris = []bgp.AddrPrefixInterface{nlri}
 				}
 				return bgp.NewBGPUpdateMessage(nil, []bgp.PathAttributeInterface{bgp.NewPathAttributeMpUnreachNLRI(nlris)}, nil)
 			}
@@ -306,7 +319,7 @@ func createUpdateMsgFromPath(path *Path, msg *bgp.BGPMessage) *bgp.BGPMessage {
 				for _, p := range u.PathAttributes {
 					if p.GetType() == bgp.BGP_ATTR_TYPE_MP_REACH_NLRI {
 						reach := p.(*bgp.PathAttributeMpReachNLRI)
-						reach.Value = append(reach.Value, path.GetNlri())
+						reach.Value = append(reach.Value, nlri)
 					}
 				}
 			} else {
@@ -314,7 +327,7 @@ func createUpdateMsgFromPath(path *Path, msg *bgp.BGPMessage) *bgp.BGPMessage {
 
 				for _, p := range path.GetPathAttrs() {
 					if p.GetType() == bgp.BGP_ATTR_TYPE_MP_REACH_NLRI {
-						attrs = append(attrs, bgp.NewPathAttributeMpReachNLRI(path.GetNexthop().String(), []bgp.AddrPrefixInterface{path.GetNlri()}))
+						attrs = append(attrs, bgp.NewPathAttributeMpReachNLRI(path.GetNexthop().String(), []bgp.AddrPrefixInterface{nlri}))
 					} else {
 						attrs = append(attrs, p)
 					}
@@ -335,7 +348,10 @@ type bucket struct {
 	paths []*Path
 }
 
-func CreateUpdateMsgFromPaths(pathList []*Path) []*bgp.BGPMessage {
+// CreateUpdateMsgFromPaths builds the UPDATE messages of the paths. The
+// paths are encoded with their local path identifiers for the families
+// the options enable sending multiple paths for.
+func CreateUpdateMsgFromPaths(pathList []*Path, options ...*bgp.MarshallingOption) []*bgp.BGPMessage {
 	var msgs []*bgp.BGPMessage
 
 	pathByAttrs := make(map[uint32][]*bucket)
@@ -392,7 +408,7 @@ func CreateUpdateMsgFromPaths(pathList []*Path) []*bgp.BGPMessage {
 				pathByAttrs[key] = []*bucket{nb}
 			}
 		} else {
-			msg := createUpdateMsgFromPath(path, nil)
+			msg := createUpdateMsgFromPath(path, nil, options)
 			msgs = append(msgs, msg)
 		}
 	}
@@ -402,7 +418,7 @@ func CreateUpdateMsgFromPaths(pathList []*Path) []*bgp.BGPMessage {
 			var msg *bgp.BGPMessage
 			for i, path := range b.paths {
 				if i == 0 {
-					msg = createUpdateMsgFromPath(path, nil)
+					msg = createUpdateMsgFromPath(path, nil, options)
 					msgs = append(msgs, msg)
 				} else {
 					msgLen := func(u *bgp.BGPUpdate) int {
@@ -412,16 +428,17 @@ func CreateUpdateMsgFromPaths(pathList []*Path) []*bgp.BGPMessage {
 						}
 						// Header + Update (WithdrawnRoutesLen +
 						// TotalPathAttributeLen + attributes + maxlen of
-						// NLRI). Note that we try to add one NLRI.
-						return 19 + 2 + 2 + attrsLen + (len(u.NLRI)+1)*5
+						// NLRI including the path identifier of
+						// ADD-PATH). Note that we try to add one NLRI.
+						return 19 + 2 + 2 + attrsLen + (len(u.NLRI)+1)*9
 					}(msg.Body.(*bgp.BGPUpdate))
 
 					if msgLen+32 > bgp.BGP_MAX_MESSAGE_LENGTH {
 						// don't marge
-						msg = createUpdateMsgFromPath(path, nil)
+						msg = createUpdateMsgFromPath(path, nil, options)
 						msgs = append(msgs, msg)
 					} else {
-						createUpdateMsgFromPath(path, msg)
+						createUpdateMsgFromPath(path, msg, options)
 					}
 				}
 			}
@@ -90,6 +90,14 @@ type Path struct {
 	VrfIds     []uint16
 	// For BGP Nexthop Tracking, this field shows if nexthop is invalidated by IGP.
 	IsNexthopInvalid bool
+	// the path identifier assigned by the destination to advertise the
+	// path with ADD-PATH. it isn't kept in the nlri, which is shared by
+	// all the paths derived from the received one.
+	localId uint32
+	// the IGP cost to the nexthop, looked up once per best path selection.
+	igpCost       int
+	igpCostOk     bool
+	igpCostCached bool
 }
 
 func NewPath(source *PeerInfo, nlri bgp.AddrPrefixInterface, isWithdraw bool, pattrs []bgp.PathAttributeInterface, timestamp time.Time, noImplicitWithdraw bool) *Path {
@@ -176,6 +184,12 @@ func (path *Path) UpdatePathAttrs(global *config.Global, peer *config.Neighbor)
 		}
 	}
 
+	// RFC 7311 3.1: AIGP isn't sent to the peers where it isn't enabled.
+	aigpEnabled := config.IsAigpEnabled(peer, path.GetRouteFamily())
+	if !aigpEnabled {
+		path.RemoveAigp()
+	}
+
 	localAddress := net.ParseIP(peer.Transport.State.LocalAddress)
 	isZero := func(ip net.IP) bool {
 		return ip.Equal(net.ParseIP("0.0.0.0")) || ip.Equal(net.ParseIP("::"))
@@ -185,9 +199,20 @@ func (path *Path) UpdatePathAttrs(global *config.Global, peer *config.Neighbor)
 		// NEXTHOP handling
 		if !path.IsLocal() || isZero(nexthop) {
 			path.SetNexthop(localAddress)
+			// RFC 7311 3.4: the IGP distance to the previous next hop
+			// is accumulated when the next hop is rewritten.
+			if aigp, err := path.GetAigp(); err == nil && aigpEnabled && !path.IsLocal() {
+				if metric, ok := igpMetric(nexthop); ok {
+					path.SetAigp(aigp + uint64(metric))
+				}
+			}
 		}
 
 		// AS_PATH handling
+		// RFC 5065 5.3: the confederation segments are removed toward
+		// the peers outside of the confederation.
+		path.RemoveConfedAsPath()
+		path.RemovePrivateAS(peer.Config.LocalAs, peer.Config.RemovePrivateAs)
 		path.PrependAsn(peer.Config.LocalAs, 1)
 
 		// MED Handling
@@ -195,6 +220,21 @@ func (path *Path) UpdatePathAttrs(global *config.Global, peer *config.Neighbor)
 			path.delPathAttr(bgp.BGP_ATTR_TYPE_MULTI_EXIT_DISC)
 		}
 
+	} else if peer.Config.PeerType == config.PEER_TYPE_CONFED_EXTERNAL {
+		// the peer in the other member AS of the confederation is
+		// handled like an iBGP peer except for AS_PATH (RFC 5065).
+		// NEXT_HOP, MED and LOCAL_PREF are kept as they are.
+		if path.IsLocal() && isZero(nexthop) {
+			path.SetNexthop(localAddress)
+		}
+
+		// AS_PATH handling
+		path.PrependConfedAsn(peer.Config.LocalAs, 1)
+
+		if pref := path.getPathAttr(bgp.BGP_ATTR_TYPE_LOCAL_PREF); pref == nil {
+			path.setPathAttr(bgp.NewPathAttributeLocalPref(DEFAULT_LOCAL_PREF))
+		}
+
 	} else if peer.Config.PeerType == config.PEER_TYPE_INTERNAL {
 		// NEXTHOP handling for iBGP
 		// if the path generated locally set local address as nexthop.
@@ -271,8 +311,11 @@ func (path *Path) IsLocal() bool {
 	return path.GetSource().Address == nil
 }
 
+// IsIBGP returns true when the path is received from an internal peer.
+// The peers in the other member ASes of the confederation are treated as
+// internal ones (RFC 5065).
 func (path *Path) IsIBGP() bool {
-	return path.GetSource().AS == path.GetSource().LocalAS
+	return path.GetSource().AS == path.GetSource().LocalAS || path.GetSource().Confederation
 }
 
 // create new PathAttributes
@@ -329,6 +372,42 @@ func (path *Path) AssignNewUUID() {
 	path.OriginInfo().uuid = uuid.NewV4()
 }
 
+// GetLocalIdentifier returns the path identifier to advertise the path
+// with, which the clones of the path inherit.
+func (path *Path) GetLocalIdentifier() uint32 {
+	for p := path; p != nil; p = p.parent {
+		if p.localId > 0 {
+			return p.localId
+		}
+	}
+	return 0
+}
+
+func (path *Path) SetLocalIdentifier(id uint32) {
+	path.localId = id
+}
+
+// nlriWithLocalIdentifier returns a copy of the nlri carrying the local
+// path identifier of the path.
+func (path *Path) nlriWithLocalIdentifier() bgp.AddrPrefixInterface {
+	nlri := path.GetNlri()
+	afi, safi := bgp.RouteFamilyToAfiSafi(path.GetRouteFamily())
+	n, err := bgp.NewPrefixFromRouteFamily(afi, safi)
+	if err != nil {
+		return nlri
+	}
+	buf, err := nlri.Serialize()
+	if err != nil {
+		return nlri
+	}
+	if err := n.DecodeFromBytes(buf); err != nil {
+		return nlri
+	}
+	n.SetPathIdentifier(nlri.PathIdentifier())
+	n.SetPathLocalIdentifier(path.GetLocalIdentifier())
+	return n
+}
+
 func (path *Path) Filter(id string, reason PolicyDirection) {
 	path.filtered[id] = reason
 }
@@ -344,6 +423,31 @@ func (path *Path) GetRouteFamily() bgp.RouteFamily {
 func (path *Path) SetSource(source *PeerInfo) {
 	path.OriginInfo().source = source
 }
+
+// isAigpEnabled reports whether the path came from a session on which AIGP
+// is enabled for its family. Locally originated paths always are.
+func (path *Path) isAigpEnabled() bool {
+	source := path.GetSource()
+	if source == nil || source.Address == nil {
+		return true
+	}
+	return source.AigpEnabled[path.GetRouteFamily()]
+}
+
+// getIgpCost returns the IGP cost to the nexthop, which is looked up only
+// once until resetIgpCost is called.
+func (path *Path) getIgpCost() (int, bool) {
+	if !path.igpCostCached {
+		path.igpCost, path.igpCostOk = igpMetric(path.GetNexthop())
+		path.igpCostCached = true
+	}
+	return path.igpCost, path.igpCostOk
+}
+
+func (path *Path) resetIgpCost() {
+	path.igpCostCached = false
+}
+
 func (path *Path) GetSource() *PeerInfo {
 	return path.OriginInfo().source
 }
@@ -590,6 +694,21 @@ func (path *Path) GetAsList() []uint32 {
 
 }
 
+// GetConfedAsList returns the member AS numbers of the confederation in
+// AS_CONFED_SEQUENCE and AS_CONFED_SET segments.
+func (path *Path) GetConfedAsList() []uint32 {
+	asList := []uint32{}
+	if aspath := path.GetAsPath(); aspath != nil {
+		for _, paramIf := range aspath.Value {
+			segment := paramIf.(*bgp.As4PathParam)
+			if segment.Type == bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ || segment.Type == bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SET {
+				asList = append(asList, segment.AS...)
+			}
+		}
+	}
+	return asList
+}
+
 func (path *Path) GetAsSeqList() []uint32 {
 	return path.getAsListofSpecificType(true, false)
 
@@ -635,6 +754,16 @@ func (path *Path) getAsListofSpecificType(getAsSeq, getAsSet bool) []uint32 {
 //     segment of type AS_SEQUENCE, places the specified AS number
 //     into that segment, and places that segment into the AS_PATH.
 func (path *Path) PrependAsn(asn uint32, repeat uint8) {
+	path.prependAsn(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, asn, repeat)
+}
+
+// PrependConfedAsn prepends the member AS number of the confederation to
+// AS_CONFED_SEQUENCE in the same way as PrependAsn (RFC 5065 section 6.1).
+func (path *Path) PrependConfedAsn(asn uint32, repeat uint8) {
+	path.prependAsn(bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ, asn, repeat)
+}
+
+func (path *Path) prependAsn(segType uint8, asn uint32, repeat uint8) {
 
 	original := path.GetAsPath()
 
@@ -652,7 +781,7 @@ func (path *Path) PrependAsn(asn uint32, repeat uint8) {
 
 	if len(asPath.Value) > 0 {
 		fst := asPath.Value[0].(*bgp.As4PathParam)
-		if fst.Type == bgp.BGP_ASPATH_ATTR_TYPE_SEQ {
+		if fst.Type == segType {
 			if len(fst.AS)+int(repeat) > 255 {
 				repeat = uint8(255 - len(fst.AS))
 			}
@@ -663,12 +792,72 @@ func (path *Path) PrependAsn(asn uint32, repeat uint8) {
 	}
 
 	if len(asns) > 0 {
-		p := bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, asns)
+		p := bgp.NewAs4PathParam(segType, asns)
 		asPath.Value = append([]bgp.AsPathParamInterface{p}, asPath.Value...)
 	}
 	path.setPathAttr(asPath)
 }
 
+// RemoveConfedAsPath removes AS_CONFED_SEQUENCE and AS_CONFED_SET segments
+// from the AS_PATH attribute.
+func (path *Path) RemoveConfedAsPath() {
+	original := path.GetAsPath()
+	if original == nil {
+		return
+	}
+	newASParams := make([]bgp.AsPathParamInterface, 0, len(original.Value))
+	for _, param := range original.Value {
+		asParam := param.(*bgp.As4PathParam)
+		if asParam.Type == bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ || asParam.Type == bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SET {
+			continue
+		}
+		newASParams = append(newASParams, asParam)
+	}
+	if len(newASParams) != len(original.Value) {
+		path.setPathAttr(bgp.NewPathAttributeAsPath(newASParams))
+	}
+}
+
+func isPrivateAS(as uint32) bool {
+	// RFC 6996
+	return (as >= 64512 && as <= 65534) || (as >= 4200000000 && as <= 4294967294)
+}
+
+// RemovePrivateAS removes private AS numbers from the AS_PATH attribute.
+// With the "replace" option, they are replaced with the specified AS
+// number instead.
+func (path *Path) RemovePrivateAS(localAS uint32, option config.RemovePrivateAsOption) {
+	original := path.GetAsPath()
+	if original == nil {
+		return
+	}
+	switch option {
+	case config.REMOVE_PRIVATE_AS_OPTION_ALL, config.REMOVE_PRIVATE_AS_OPTION_REPLACE:
+		newASParams := make([]bgp.AsPathParamInterface, 0, len(original.Value))
+		for _, param := range original.Value {
+			asParam := param.(*bgp.As4PathParam)
+			if asParam.Type != bgp.BGP_ASPATH_ATTR_TYPE_SEQ && asParam.Type != bgp.BGP_ASPATH_ATTR_TYPE_SET {
+				newASParams = append(newASParams, asParam)
+				continue
+			}
+			newASParam := make([]uint32, 0, len(asParam.AS))
+			for _, as := range asParam.AS {
+				if isPrivateAS(as) {
+					if option == config.REMOVE_PRIVATE_AS_OPTION_REPLACE {
+						newASParam = append(newASParam, localAS)
+					}
+				} else {
+					newASParam = append(newASParam, as)
+				}
+			}
+			if len(newASParam) > 0 {
+				newASParams = append(newASParams, bgp.NewAs4PathParam(asParam.Type, newASParam))
+			}
+		}
+		path.setPathAttr(bgp.NewPathAttributeAsPath(newASParams))
+	}
+}
+
 func (path *Path) GetCommunities() []uint32 {
 	communityList := []uint32{}
 	if attr := path.getPathAttr(bgp.BGP_ATTR_TYPE_COMMUNITIES); attr != nil {
@@ -839,6 +1028,38 @@ func (path *Path) RemoveLocalPref() {
 	}
 }
 
+// GetAigp returns the accumulated IGP metric carried in the AIGP attribute.
+func (path *Path) GetAigp() (uint64, error) {
+	if attr := path.getPathAttr(bgp.BGP_ATTR_TYPE_AIGP); attr != nil {
+		for _, t := range attr.(*bgp.PathAttributeAigp).Values {
+			if m, ok := t.(*bgp.AigpTLVIgpMetric); ok {
+				return m.Metric, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("no aigp path attr")
+}
+
+// SetAigp replaces the IGP metric TLV of the AIGP attribute, keeping any
+// other TLVs as they are. The attribute is added if the path has none.
+func (path *Path) SetAigp(metric uint64) {
+	values := []bgp.AigpTLV{bgp.NewAigpTLVIgpMetric(metric)}
+	if attr := path.getPathAttr(bgp.BGP_ATTR_TYPE_AIGP); attr != nil {
+		for _, t := range attr.(*bgp.PathAttributeAigp).Values {
+			if t.Type() != bgp.AIGP_TLV_IGP_METRIC {
+				values = append(values, t)
+			}
+		}
+	}
+	path.setPathAttr(bgp.NewPathAttributeAigp(values))
+}
+
+func (path *Path) RemoveAigp() {
+	if path.getPathAttr(bgp.BGP_ATTR_TYPE_AIGP) != nil {
+		path.delPathAttr(bgp.BGP_ATTR_TYPE_AIGP)
+	}
+}
+
 func (path *Path) GetOriginatorID() net.IP {
 	if attr := path.getPathAttr(bgp.BGP_ATTR_TYPE_ORIGINATOR_ID); attr != nil {
 		return attr.(*bgp.PathAttributeOriginatorId).Value
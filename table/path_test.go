@@ -3,9 +3,11 @@ package table
 
 import (
 	"fmt"
+	"net"
 	"testing"
 	"time"
 
+	"github.com/citizen-insane/gobgp/config"
 	"github.com/citizen-insane/gobgp/packet/bgp"
 	"github.com/stretchr/testify/assert"
 )
@@ -241,6 +243,131 @@ func TestPathPrependAsnToFullPathAttr(t *testing.T) {
 	fmt.Printf("asns: %v", p.GetAsSeqList())
 }
 
+func TestRemovePrivateAS(t *testing.T) {
+	aspathParam := []bgp.AsPathParamInterface{bgp.NewAs4PathParam(2, []uint32{64512, 64513, 1, 2})}
+	aspath := bgp.NewPathAttributeAsPath(aspathParam)
+	nlri := bgp.NewIPAddrPrefix(24, "30.30.30.0")
+	path := NewPath(nil, nlri, false, []bgp.PathAttributeInterface{aspath}, time.Now(), false)
+	path.RemovePrivateAS(10, config.REMOVE_PRIVATE_AS_OPTION_ALL)
+	list := path.GetAsList()
+	assert.Equal(t, len(list), 2)
+	assert.Equal(t, list[0], uint32(1))
+	assert.Equal(t, list[1], uint32(2))
+
+	path = NewPath(nil, nlri, false, []bgp.PathAttributeInterface{aspath}, time.Now(), false)
+	path.RemovePrivateAS(10, config.REMOVE_PRIVATE_AS_OPTION_REPLACE)
+	list = path.GetAsList()
+	assert.Equal(t, len(list), 4)
+	assert.Equal(t, list[0], uint32(10))
+	assert.Equal(t, list[1], uint32(10))
+	assert.Equal(t, list[2], uint32(1))
+	assert.Equal(t, list[3], uint32(2))
+}
+
+func TestUpdatePathAttrsConfederation(t *testing.T) {
+	aspath := bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{
+		bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ, []uint32{65002}),
+		bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{1}),
+	})
+	attrs := []bgp.PathAttributeInterface{
+		aspath,
+		bgp.NewPathAttributeNextHop("10.0.0.2"),
+		bgp.NewPathAttributeMultiExitDisc(10),
+		bgp.NewPathAttributeLocalPref(200),
+	}
+	source := &PeerInfo{AS: 65002, LocalAS: 65001, Address: net.ParseIP("10.0.0.2"), Confederation: true}
+	nlri := bgp.NewIPAddrPrefix(24, "30.30.30.0")
+	global := &config.Global{
+		Config: config.GlobalConfig{As: 65001},
+		Confederation: config.Confederation{
+			Config: config.ConfederationConfig{Enabled: true, Identifier: 10, MemberAsList: []uint32{65002, 65003}},
+		},
+	}
+	newNeighbor := func(as uint32) *config.Neighbor {
+		n := &config.Neighbor{Config: config.NeighborConfig{PeerAs: as, NeighborAddress: "10.0.0.3"}}
+		config.SetDefaultNeighborConfigValues(n, global)
+		n.Transport.State.LocalAddress = "10.0.0.1"
+		return n
+	}
+	path := NewPath(source, nlri, false, attrs, time.Now(), false)
+	assert.True(t, path.IsIBGP())
+
+	// to the other member AS
+	peer := newNeighbor(65003)
+	assert.Equal(t, config.PEER_TYPE_CONFED_EXTERNAL, peer.Config.PeerType)
+	p := path.Clone(false)
+	p.UpdatePathAttrs(global, peer)
+	assert.Equal(t, []uint32{65001, 65002}, p.GetConfedAsList())
+	// the confederation segments aren't counted in the path length
+	assert.Equal(t, 1, p.GetAsPathLen())
+	assert.Equal(t, "10.0.0.2", p.GetNexthop().String())
+	med, _ := p.GetMed()
+	assert.Equal(t, uint32(10), med)
+	pref, _ := p.GetLocalPref()
+	assert.Equal(t, uint32(200), pref)
+
+	// to the outside of the confederation
+	peer = newNeighbor(2)
+	assert.Equal(t, config.PEER_TYPE_EXTERNAL, peer.Config.PeerType)
+	assert.Equal(t, uint32(10), peer.Config.LocalAs)
+	p = path.Clone(false)
+	p.UpdatePathAttrs(global, peer)
+	assert.Equal(t, 0, len(p.GetConfedAsList()))
+	assert.Equal(t, []uint32{10, 1}, p.GetAsSeqList())
+	assert.Equal(t, "10.0.0.1", p.GetNexthop().String())
+}
+
+func TestUpdatePathAttrsAigp(t *testing.T) {
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{1})}),
+		bgp.NewPathAttributeNextHop("10.0.0.2"),
+		bgp.NewPathAttributeAigp([]bgp.AigpTLV{bgp.NewAigpTLVIgpMetric(100)}),
+	}
+	source := &PeerInfo{AS: 1, LocalAS: 65001, Address: net.ParseIP("10.0.0.2")}
+	nlri := bgp.NewIPAddrPrefix(24, "30.30.30.0")
+	global := &config.Global{Config: config.GlobalConfig{As: 65001}}
+	newNeighbor := func(enabled bool) *config.Neighbor {
+		n := &config.Neighbor{
+			Config: config.NeighborConfig{PeerAs: 2, NeighborAddress: "10.0.0.3"},
+			AfiSafis: []config.AfiSafi{
+				{
+					Config: config.AfiSafiConfig{AfiSafiName: config.AFI_SAFI_TYPE_IPV4_UNICAST},
+					RouteSelectionOptions: config.RouteSelectionOptions{
+						Config: config.RouteSelectionOptionsConfig{EnableAigp: enabled},
+					},
+				},
+			},
+		}
+		config.SetDefaultNeighborConfigValues(n, global)
+		n.Transport.State.LocalAddress = "10.0.0.1"
+		return n
+	}
+	path := NewPath(source, nlri, false, attrs, time.Now(), false)
+
+	defer func(f func(net.IP) (int, bool)) {
+		igpMetric = f
+	}(igpMetric)
+	igpMetric = func(nexthop net.IP) (int, bool) {
+		return 10, nexthop.Equal(net.ParseIP("10.0.0.2"))
+	}
+
+	// the IGP distance to the previous next hop 10.0.0.2 is accumulated.
+	p := path.Clone(false)
+	p.UpdatePathAttrs(global, newNeighbor(true))
+	aigp, err := p.GetAigp()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(110), aigp)
+
+	p = path.Clone(false)
+	p.UpdatePathAttrs(global, newNeighbor(false))
+	_, err = p.GetAigp()
+	assert.NotNil(t, err)
+	// the original path is kept as it is
+	aigp, err = path.GetAigp()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(100), aigp)
+}
+
 func TestGetPathAttrs(t *testing.T) {
 	paths := PathCreatePath(PathCreatePeer())
 	path0 := paths[0]
@@ -34,6 +34,8 @@ import (
 
 type PolicyOptions struct {
 	Info *PeerInfo
+	// AIGP is enabled for the peer where the path is exported.
+	AigpEnabled bool
 }
 
 type DefinedType int
@@ -151,6 +153,12 @@ const (
 	CONDITION_RPKI
 	CONDITION_ROUTE_TYPE
 	CONDITION_LARGE_COMMUNITY
+	CONDITION_MED
+	CONDITION_ORIGIN
+	CONDITION_NEXT_HOP
+	CONDITION_AFI_SAFI_IN
+	CONDITION_LOCAL_PREF
+	CONDITION_COMMUNITY_COUNT
 )
 
 type ActionType int
@@ -164,6 +172,8 @@ const (
 	ACTION_NEXTHOP
 	ACTION_LOCAL_PREF
 	ACTION_LARGE_COMMUNITY
+	ACTION_AIGP
+	ACTION_ORIGIN
 )
 
 func NewMatchOption(c interface{}) (MatchOption, error) {
@@ -445,6 +455,12 @@ func (s *PrefixSet) List() []string {
 	return list
 }
 
+// Match returns true if the prefix of the path is in the set.
+func (s *PrefixSet) Match(path *Path) bool {
+	c := &PrefixCondition{set: s, option: MATCH_OPTION_ANY}
+	return c.Evaluate(path, nil)
+}
+
 func (s *PrefixSet) ToConfig() *config.PrefixSet {
 	list := make([]config.Prefix, 0, s.tree.Len())
 	s.tree.Walk(func(s string, v interface{}) bool {
@@ -1765,6 +1781,285 @@ func NewRouteTypeCondition(c config.RouteType) (*RouteTypeCondition, error) {
 	}, nil
 }
 
+type MedCondition struct {
+	med uint32
+}
+
+func (c *MedCondition) Type() ConditionType {
+	return CONDITION_MED
+}
+
+func (c *MedCondition) Evaluate(path *Path, _ *PolicyOptions) bool {
+	med, err := path.GetMed()
+	return err == nil && c.med == med
+}
+
+func (c *MedCondition) Set() DefinedSet {
+	return nil
+}
+
+func (c *MedCondition) Name() string { return "" }
+
+func (c *MedCondition) String() string {
+	return fmt.Sprintf("%d", c.med)
+}
+
+func NewMedCondition(med uint32) (*MedCondition, error) {
+	// 0 means no condition, so the value 0 can't be matched.
+	if med == 0 {
+		return nil, nil
+	}
+	return &MedCondition{
+		med: med,
+	}, nil
+}
+
+type OriginCondition struct {
+	origin config.BgpOriginAttrType
+}
+
+func (c *OriginCondition) Type() ConditionType {
+	return CONDITION_ORIGIN
+}
+
+func (c *OriginCondition) Evaluate(path *Path, _ *PolicyOptions) bool {
+	origin, err := path.GetOrigin()
+	return err == nil && c.origin.ToInt() == int(origin)
+}
+
+func (c *OriginCondition) Set() DefinedSet {
+	return nil
+}
+
+func (c *OriginCondition) Name() string { return "" }
+
+func (c *OriginCondition) String() string {
+	return string(c.origin)
+}
+
+func NewOriginCondition(c config.BgpOriginAttrType) (*OriginCondition, error) {
+	if string(c) == "" {
+		return nil, nil
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return &OriginCondition{
+		origin: c,
+	}, nil
+}
+
+// NextHopCondition matches the paths whose next hop is one of the
+// addresses or is covered by one of the prefixes.
+type NextHopCondition struct {
+	list []*net.IPNet
+}
+
+func (c *NextHopCondition) Type() ConditionType {
+	return CONDITION_NEXT_HOP
+}
+
+func (c *NextHopCondition) Evaluate(path *Path, _ *PolicyOptions) bool {
+	nexthop := path.GetNexthop()
+	if len(nexthop) == 0 {
+		return false
+	}
+	for _, n := range c.list {
+		if n.Contains(nexthop) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *NextHopCondition) Set() DefinedSet {
+	return nil
+}
+
+func (c *NextHopCondition) Name() string { return "" }
+
+func (c *NextHopCondition) ToConfig() []string {
+	l := make([]string, 0, len(c.list))
+	for _, n := range c.list {
+		if ones, bits := n.Mask.Size(); ones == bits {
+			l = append(l, n.IP.String())
+		} else {
+			l = append(l, n.String())
+		}
+	}
+	return l
+}
+
+func (c *NextHopCondition) String() string {
+	return strings.Join(c.ToConfig(), ", ")
+}
+
+func NewNextHopCondition(c []string) (*NextHopCondition, error) {
+	if len(c) == 0 {
+		return nil, nil
+	}
+	list := make([]*net.IPNet, 0, len(c))
+	for _, s := range c {
+		if !strings.Contains(s, "/") {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid next hop: %s", s)
+			}
+			if ip.To4() != nil {
+				s += "/32"
+			} else {
+				s += "/128"
+			}
+		}
+		_, n, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid next hop: %s", s)
+		}
+		list = append(list, n)
+	}
+	return &NextHopCondition{
+		list: list,
+	}, nil
+}
+
+type AfiSafiInCondition struct {
+	routeFamilies []bgp.RouteFamily
+}
+
+func (c *AfiSafiInCondition) Type() ConditionType {
+	return CONDITION_AFI_SAFI_IN
+}
+
+func (c *AfiSafiInCondition) Evaluate(path *Path, _ *PolicyOptions) bool {
+	for _, rf := range c.routeFamilies {
+		if path.GetRouteFamily() == rf {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *AfiSafiInCondition) Set() DefinedSet {
+	return nil
+}
+
+func (c *AfiSafiInCondition) Name() string { return "" }
+
+func (c *AfiSafiInCondition) ToConfig() []config.AfiSafiType {
+	l := make([]config.AfiSafiType, 0, len(c.routeFamilies))
+	for _, rf := range c.routeFamilies {
+		l = append(l, config.AfiSafiType(rf.String()))
+	}
+	return l
+}
+
+func (c *AfiSafiInCondition) String() string {
+	l := make([]string, 0, len(c.routeFamilies))
+	for _, rf := range c.routeFamilies {
+		l = append(l, rf.String())
+	}
+	return strings.Join(l, ", ")
+}
+
+func NewAfiSafiInCondition(c []config.AfiSafiType) (*AfiSafiInCondition, error) {
+	if len(c) == 0 {
+		return nil, nil
+	}
+	routeFamilies := make([]bgp.RouteFamily, 0, len(c))
+	for _, afiSafi := range c {
+		rf, err := bgp.GetRouteFamily(string(afiSafi))
+		if err != nil {
+			return nil, err
+		}
+		routeFamilies = append(routeFamilies, rf)
+	}
+	return &AfiSafiInCondition{
+		routeFamilies: routeFamilies,
+	}, nil
+}
+
+type LocalPrefCondition struct {
+	localPref uint32
+}
+
+func (c *LocalPrefCondition) Type() ConditionType {
+	return CONDITION_LOCAL_PREF
+}
+
+// the paths without LOCAL_PREF are compared with the default value.
+func (c *LocalPrefCondition) Evaluate(path *Path, _ *PolicyOptions) bool {
+	localPref, _ := path.GetLocalPref()
+	return c.localPref == localPref
+}
+
+func (c *LocalPrefCondition) Set() DefinedSet {
+	return nil
+}
+
+func (c *LocalPrefCondition) Name() string { return "" }
+
+func (c *LocalPrefCondition) String() string {
+	return fmt.Sprintf("%d", c.localPref)
+}
+
+func NewLocalPrefCondition(localPref uint32) (*LocalPrefCondition, error) {
+	// 0 means no condition, so the value 0 can't be matched.
+	if localPref == 0 {
+		return nil, nil
+	}
+	return &LocalPrefCondition{
+		localPref: localPref,
+	}, nil
+}
+
+type CommunityCountCondition struct {
+	count    uint32
+	operator AttributeComparison
+}
+
+func (c *CommunityCountCondition) Type() ConditionType {
+	return CONDITION_COMMUNITY_COUNT
+}
+
+// compare the number of the communities in the message's COMMUNITIES
+// attribute with the one in condition.
+func (c *CommunityCountCondition) Evaluate(path *Path, _ *PolicyOptions) bool {
+	count := uint32(len(path.GetCommunities()))
+	switch c.operator {
+	case ATTRIBUTE_EQ:
+		return c.count == count
+	case ATTRIBUTE_GE:
+		return c.count <= count
+	case ATTRIBUTE_LE:
+		return c.count >= count
+	}
+	return false
+}
+
+func (c *CommunityCountCondition) Set() DefinedSet {
+	return nil
+}
+
+func (c *CommunityCountCondition) Name() string { return "" }
+
+func (c *CommunityCountCondition) String() string {
+	return fmt.Sprintf("%s%d", c.operator, c.count)
+}
+
+func NewCommunityCountCondition(c config.CommunityCount) (*CommunityCountCondition, error) {
+	if c.Value == 0 && c.Operator == "" {
+		return nil, nil
+	}
+	i := c.Operator.ToInt()
+	if i < 0 {
+		return nil, fmt.Errorf("invalid community count operator: %s", c.Operator)
+	}
+	return &CommunityCountCondition{
+		count:    c.Value,
+		operator: AttributeComparison(i % 3),
+	}, nil
+}
+
 type Action interface {
 	Type() ActionType
 	Apply(*Path, *PolicyOptions) *Path
@@ -2248,6 +2543,96 @@ func NewLocalPrefAction(value uint32) (*LocalPrefAction, error) {
 	}, nil
 }
 
+type OriginAction struct {
+	origin config.BgpOriginAttrType
+}
+
+func (a *OriginAction) Type() ActionType {
+	return ACTION_ORIGIN
+}
+
+func (a *OriginAction) Apply(path *Path, _ *PolicyOptions) *Path {
+	path.setPathAttr(bgp.NewPathAttributeOrigin(uint8(a.origin.ToInt())))
+	return path
+}
+
+func (a *OriginAction) ToConfig() config.BgpOriginAttrType {
+	return a.origin
+}
+
+func (a *OriginAction) String() string {
+	return string(a.origin)
+}
+
+func (a *OriginAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.ToConfig())
+}
+
+func NewOriginAction(c config.BgpOriginAttrType) (*OriginAction, error) {
+	if string(c) == "" {
+		return nil, nil
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return &OriginAction{
+		origin: c,
+	}, nil
+}
+
+type AigpAction struct {
+	value  uint64
+	remove bool
+}
+
+func (a *AigpAction) Type() ActionType {
+	return ACTION_AIGP
+}
+
+func (a *AigpAction) Apply(path *Path, _ *PolicyOptions) *Path {
+	if a.remove {
+		path.RemoveAigp()
+	} else {
+		path.SetAigp(a.value)
+	}
+	return path
+}
+
+func (a *AigpAction) ToConfig() string {
+	if a.remove {
+		return "remove"
+	}
+	return fmt.Sprintf("%d", a.value)
+}
+
+func (a *AigpAction) String() string {
+	return a.ToConfig()
+}
+
+func (a *AigpAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.ToConfig())
+}
+
+// NewAigpAction creates an action which sets the AIGP metric to the given
+// value, or removes the AIGP attribute when "remove" is given.
+func NewAigpAction(c string) (*AigpAction, error) {
+	switch c {
+	case "":
+		return nil, nil
+	case "remove":
+		return &AigpAction{
+			remove: true,
+		}, nil
+	}
+	value, err := strconv.ParseUint(c, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid aigp action format")
+	}
+	return &AigpAction{
+		value: value,
+	}, nil
+}
+
 type AsPathPrependAction struct {
 	asn         uint32
 	useLeftMost bool
@@ -2343,7 +2728,15 @@ func (a *NexthopAction) Type() ActionType {
 func (a *NexthopAction) Apply(path *Path, options *PolicyOptions) *Path {
 	if a.self {
 		if options != nil && options.Info != nil && options.Info.LocalAddress != nil {
+			nexthop := path.GetNexthop()
 			path.SetNexthop(options.Info.LocalAddress)
+			// RFC 7311 3.4: the IGP distance to the previous next hop
+			// is accumulated when the next hop is rewritten.
+			if aigp, err := path.GetAigp(); err == nil && options.AigpEnabled && !path.IsLocal() && !nexthop.Equal(options.Info.LocalAddress) {
+				if metric, ok := igpMetric(nexthop); ok {
+					path.SetAigp(aigp + uint64(metric))
+				}
+			}
 		}
 		return path
 	}
@@ -2458,6 +2851,19 @@ func (s *Statement) ToConfig() *config.Statement {
 				case *RouteTypeCondition:
 					v := c.(*RouteTypeCondition)
 					cond.BgpConditions.RouteType = v.typ
+				case *MedCondition:
+					cond.BgpConditions.MedEq = c.(*MedCondition).med
+				case *OriginCondition:
+					cond.BgpConditions.OriginEq = c.(*OriginCondition).origin
+				case *NextHopCondition:
+					cond.BgpConditions.NextHopInList = c.(*NextHopCondition).ToConfig()
+				case *AfiSafiInCondition:
+					cond.BgpConditions.AfiSafiInList = c.(*AfiSafiInCondition).ToConfig()
+				case *LocalPrefCondition:
+					cond.BgpConditions.LocalPrefEq = c.(*LocalPrefCondition).localPref
+				case *CommunityCountCondition:
+					v := c.(*CommunityCountCondition)
+					cond.BgpConditions.CommunityCount = config.CommunityCount{Operator: config.IntToAttributeComparisonMap[int(v.operator)], Value: v.count}
 				}
 			}
 			return cond
@@ -2490,6 +2896,10 @@ func (s *Statement) ToConfig() *config.Statement {
 					act.BgpActions.SetLocalPref = a.(*LocalPrefAction).ToConfig()
 				case *NexthopAction:
 					act.BgpActions.SetNextHop = a.(*NexthopAction).ToConfig()
+				case *AigpAction:
+					act.BgpActions.SetAigp = a.(*AigpAction).ToConfig()
+				case *OriginAction:
+					act.BgpActions.SetRouteOrigin = a.(*OriginAction).ToConfig()
 				}
 			}
 			return act
@@ -2656,6 +3066,24 @@ func NewStatement(c config.Statement) (*Statement, error) {
 		func() (Condition, error) {
 			return NewLargeCommunityCondition(c.Conditions.BgpConditions.MatchLargeCommunitySet)
 		},
+		func() (Condition, error) {
+			return NewMedCondition(c.Conditions.BgpConditions.MedEq)
+		},
+		func() (Condition, error) {
+			return NewOriginCondition(c.Conditions.BgpConditions.OriginEq)
+		},
+		func() (Condition, error) {
+			return NewNextHopCondition(c.Conditions.BgpConditions.NextHopInList)
+		},
+		func() (Condition, error) {
+			return NewAfiSafiInCondition(c.Conditions.BgpConditions.AfiSafiInList)
+		},
+		func() (Condition, error) {
+			return NewLocalPrefCondition(c.Conditions.BgpConditions.LocalPrefEq)
+		},
+		func() (Condition, error) {
+			return NewCommunityCountCondition(c.Conditions.BgpConditions.CommunityCount)
+		},
 	}
 	cs = make([]Condition, 0, len(cfs))
 	for _, f := range cfs {
@@ -2693,6 +3121,12 @@ func NewStatement(c config.Statement) (*Statement, error) {
 		func() (Action, error) {
 			return NewNexthopAction(c.Actions.BgpActions.SetNextHop)
 		},
+		func() (Action, error) {
+			return NewAigpAction(c.Actions.BgpActions.SetAigp)
+		},
+		func() (Action, error) {
+			return NewOriginAction(c.Actions.BgpActions.SetRouteOrigin)
+		},
 	}
 	as = make([]Action, 0, len(afs))
 	for _, f := range afs {
@@ -2875,6 +3309,37 @@ func (r *RoutingPolicy) ApplyPolicy(id string, dir PolicyDirection, before *Path
 	}
 }
 
+// EvaluatePolicy returns the result of the policy definition for the
+// path. The path isn't modified by the actions of the policy.
+func (r *RoutingPolicy) EvaluatePolicy(name string, path *Path, options *PolicyOptions) (RouteType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	p, ok := r.policyMap[name]
+	if !ok {
+		return ROUTE_TYPE_NONE, fmt.Errorf("not found policy %s", name)
+	}
+	result, _ := p.Apply(path, options)
+	return result, nil
+}
+
+// MatchPrefixSet returns true if the prefix of the path is in the prefix
+// set.
+func (r *RoutingPolicy) MatchPrefixSet(name string, path *Path) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	d, ok := r.definedSetMap[DEFINED_TYPE_PREFIX][name]
+	if !ok {
+		return false, fmt.Errorf("not found prefix set %s", name)
+	}
+	c := &PrefixCondition{
+		set:    d.(*PrefixSet),
+		option: MATCH_OPTION_ANY,
+	}
+	return c.Evaluate(path, nil), nil
+}
+
 func (r *RoutingPolicy) getPolicy(id string, dir PolicyDirection) []*Policy {
 	a, ok := r.assignmentMap[id]
 	if !ok {
@@ -3042,7 +3507,7 @@ func (r *RoutingPolicy) inUse(d DefinedSet) bool {
 	for _, p := range r.policyMap {
 		for _, s := range p.Statements {
 			for _, c := range s.Conditions {
-				if c.Set().Name() == name {
+				if set := c.Set(); set != nil && set.Name() == name {
 					return true
 				}
 			}
@@ -3656,6 +4121,14 @@ func (r *RoutingPolicy) Reset(rp *config.RoutingPolicy, ap map[string]config.App
 	return nil
 }
 
+// DeleteAssignment removes the policy assignments of the id, e.g. when
+// the dynamic neighbor is deleted.
+func (r *RoutingPolicy) DeleteAssignment(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.assignmentMap, id)
+}
+
 func NewRoutingPolicy() *RoutingPolicy {
 	return &RoutingPolicy{
 		definedSetMap: make(map[DefinedType]map[string]DefinedSet),
@@ -2480,6 +2480,134 @@ func TestPolicyMatchWhenPathHaveNotMed(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestPolicyMatchAndSetAigp(t *testing.T) {
+
+	// create path
+	peer := &PeerInfo{AS: 65001, Address: net.ParseIP("10.0.0.1")}
+	origin := bgp.NewPathAttributeOrigin(0)
+	aspathParam := []bgp.AsPathParamInterface{bgp.NewAsPathParam(2, []uint16{65001})}
+	aspath := bgp.NewPathAttributeAsPath(aspathParam)
+	nexthop := bgp.NewPathAttributeNextHop("10.0.0.1")
+	aigp := bgp.NewPathAttributeAigp([]bgp.AigpTLV{bgp.NewAigpTLVIgpMetric(100)})
+
+	pathAttributes := []bgp.PathAttributeInterface{origin, aspath, nexthop, aigp}
+	nlri := []*bgp.IPAddrPrefix{bgp.NewIPAddrPrefix(24, "10.10.0.101")}
+	updateMsg := bgp.NewBGPUpdateMessage(nil, pathAttributes, nlri)
+	path := ProcessMessage(updateMsg, peer, time.Now())[0]
+	// create policy
+	ps := createPrefixSet("ps1", "10.10.0.0/16", "21..24")
+	ns := createNeighborSet("ns1", "10.0.0.1")
+
+	ds := config.DefinedSets{}
+	ds.PrefixSets = []config.PrefixSet{ps}
+	ds.NeighborSets = []config.NeighborSet{ns}
+
+	s := createStatement("statement1", "ps1", "ns1", true)
+	s.Actions.BgpActions.SetAigp = "200"
+
+	pd := createPolicyDefinition("pd1", s)
+	pl := createRoutingPolicy(ds, pd)
+	//test
+	r := NewRoutingPolicy()
+	err := r.reload(pl)
+	assert.Nil(t, err)
+	p := r.policyMap["pd1"]
+
+	pType, newPath := p.Apply(path.Clone(false), nil)
+	assert.Equal(t, ROUTE_TYPE_ACCEPT, pType)
+	v, err := newPath.GetAigp()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(200), v)
+
+	s.Actions.BgpActions.SetAigp = "remove"
+	pd = createPolicyDefinition("pd1", s)
+	pl = createRoutingPolicy(ds, pd)
+	err = r.reload(pl)
+	assert.Nil(t, err)
+	p = r.policyMap["pd1"]
+
+	pType, newPath = p.Apply(path.Clone(false), nil)
+	assert.Equal(t, ROUTE_TYPE_ACCEPT, pType)
+	_, err = newPath.GetAigp()
+	assert.NotNil(t, err)
+	assert.Equal(t, "remove", p.Statements[0].ToConfig().Actions.BgpActions.SetAigp)
+
+	_, err = NewAigpAction("-1")
+	assert.NotNil(t, err)
+}
+
+func TestPolicyNextHopSelfAigp(t *testing.T) {
+
+	// create path
+	peer := &PeerInfo{AS: 65001, Address: net.ParseIP("10.0.0.1")}
+	origin := bgp.NewPathAttributeOrigin(0)
+	aspathParam := []bgp.AsPathParamInterface{bgp.NewAsPathParam(2, []uint16{65001})}
+	aspath := bgp.NewPathAttributeAsPath(aspathParam)
+	nexthop := bgp.NewPathAttributeNextHop("10.0.0.1")
+	aigp := bgp.NewPathAttributeAigp([]bgp.AigpTLV{bgp.NewAigpTLVIgpMetric(100)})
+
+	pathAttributes := []bgp.PathAttributeInterface{origin, aspath, nexthop, aigp}
+	nlri := []*bgp.IPAddrPrefix{bgp.NewIPAddrPrefix(24, "10.10.0.101")}
+	updateMsg := bgp.NewBGPUpdateMessage(nil, pathAttributes, nlri)
+	path := ProcessMessage(updateMsg, peer, time.Now())[0]
+	// create policy
+	ps := createPrefixSet("ps1", "10.10.0.0/16", "21..24")
+	ns := createNeighborSet("ns1", "10.0.0.1")
+
+	ds := config.DefinedSets{}
+	ds.PrefixSets = []config.PrefixSet{ps}
+	ds.NeighborSets = []config.NeighborSet{ns}
+
+	s := createStatement("statement1", "ps1", "ns1", true)
+	s.Actions.BgpActions.SetNextHop = "self"
+
+	pd := createPolicyDefinition("pd1", s)
+	pl := createRoutingPolicy(ds, pd)
+	//test
+	r := NewRoutingPolicy()
+	err := r.reload(pl)
+	assert.Nil(t, err)
+	p := r.policyMap["pd1"]
+
+	defer func(f func(net.IP) (int, bool)) {
+		igpMetric = f
+	}(igpMetric)
+	igpMetric = func(nexthop net.IP) (int, bool) {
+		return 10, nexthop.Equal(net.ParseIP("10.0.0.1"))
+	}
+
+	// the IGP distance to the previous next hop is accumulated.
+	options := &PolicyOptions{
+		Info:        &PeerInfo{LocalAddress: net.ParseIP("10.0.0.254")},
+		AigpEnabled: true,
+	}
+	pType, newPath := p.Apply(path.Clone(false), options)
+	assert.Equal(t, ROUTE_TYPE_ACCEPT, pType)
+	assert.Equal(t, "10.0.0.254", newPath.GetNexthop().String())
+	v, err := newPath.GetAigp()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(110), v)
+
+	// not accumulated unless AIGP is enabled for the peer.
+	options.AigpEnabled = false
+	pType, newPath = p.Apply(path.Clone(false), options)
+	assert.Equal(t, ROUTE_TYPE_ACCEPT, pType)
+	v, err = newPath.GetAigp()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(100), v)
+
+	// nor when the next hop isn't changed.
+	options = &PolicyOptions{
+		Info:        &PeerInfo{LocalAddress: net.ParseIP("10.0.0.1")},
+		AigpEnabled: true,
+	}
+	pType, newPath = p.Apply(path.Clone(false), options)
+	assert.Equal(t, ROUTE_TYPE_ACCEPT, pType)
+	v, err = newPath.GetAigp()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(100), v)
+}
+
 func TestPolicyAsPathPrepend(t *testing.T) {
 
 	assert := assert.New(t)
@@ -3037,3 +3165,153 @@ func TestMultipleStatementPolicy(t *testing.T) {
 	localPref, _ := newPath.GetLocalPref()
 	assert.Equal(t, localPref, uint32(100))
 }
+
+func TestAttributeConditions(t *testing.T) {
+	nlri := bgp.NewIPAddrPrefix(24, "10.10.0.0")
+	pattrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(bgp.BGP_ORIGIN_ATTR_TYPE_EGP),
+		bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAsPathParam(2, []uint16{65001})}),
+		bgp.NewPathAttributeNextHop("10.0.0.1"),
+		bgp.NewPathAttributeMultiExitDisc(100),
+		bgp.NewPathAttributeCommunities([]uint32{65001<<16 | 1, 65001<<16 | 2}),
+	}
+	path := NewPath(nil, nlri, false, pattrs, time.Now(), false)
+
+	med, err := NewMedCondition(100)
+	assert.Nil(t, err)
+	assert.True(t, med.Evaluate(path, nil))
+	med, _ = NewMedCondition(200)
+	assert.False(t, med.Evaluate(path, nil))
+
+	origin, err := NewOriginCondition(config.BGP_ORIGIN_ATTR_TYPE_EGP)
+	assert.Nil(t, err)
+	assert.True(t, origin.Evaluate(path, nil))
+	origin, _ = NewOriginCondition(config.BGP_ORIGIN_ATTR_TYPE_IGP)
+	assert.False(t, origin.Evaluate(path, nil))
+	_, err = NewOriginCondition("unknown")
+	assert.NotNil(t, err)
+
+	// the default value is used without LOCAL_PREF.
+	localPref, err := NewLocalPrefCondition(100)
+	assert.Nil(t, err)
+	assert.True(t, localPref.Evaluate(path, nil))
+	path.setPathAttr(bgp.NewPathAttributeLocalPref(200))
+	assert.False(t, localPref.Evaluate(path, nil))
+	localPref, _ = NewLocalPrefCondition(200)
+	assert.True(t, localPref.Evaluate(path, nil))
+
+	count, err := NewCommunityCountCondition(config.CommunityCount{Operator: "eq", Value: 2})
+	assert.Nil(t, err)
+	assert.True(t, count.Evaluate(path, nil))
+	count, _ = NewCommunityCountCondition(config.CommunityCount{Operator: "ge", Value: 3})
+	assert.False(t, count.Evaluate(path, nil))
+	count, _ = NewCommunityCountCondition(config.CommunityCount{Operator: "le", Value: 3})
+	assert.True(t, count.Evaluate(path, nil))
+	_, err = NewCommunityCountCondition(config.CommunityCount{Operator: "gt", Value: 3})
+	assert.NotNil(t, err)
+}
+
+func TestNextHopCondition(t *testing.T) {
+	nlri := bgp.NewIPAddrPrefix(24, "10.10.0.0")
+	pattrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeNextHop("10.0.0.1"),
+	}
+	path := NewPath(nil, nlri, false, pattrs, time.Now(), false)
+
+	c, err := NewNextHopCondition([]string{"10.0.0.2", "10.0.0.1"})
+	assert.Nil(t, err)
+	assert.True(t, c.Evaluate(path, nil))
+	c, _ = NewNextHopCondition([]string{"10.0.0.2"})
+	assert.False(t, c.Evaluate(path, nil))
+	c, _ = NewNextHopCondition([]string{"10.0.0.0/24"})
+	assert.True(t, c.Evaluate(path, nil))
+	assert.Equal(t, []string{"10.0.0.0/24"}, c.ToConfig())
+	c, _ = NewNextHopCondition([]string{"2001:db8::1"})
+	assert.False(t, c.Evaluate(path, nil))
+	assert.Equal(t, []string{"2001:db8::1"}, c.ToConfig())
+
+	_, err = NewNextHopCondition([]string{"10.0.0"})
+	assert.NotNil(t, err)
+}
+
+func TestAfiSafiInCondition(t *testing.T) {
+	attrs := []bgp.PathAttributeInterface{bgp.NewPathAttributeOrigin(0)}
+	v4 := NewPath(nil, bgp.NewIPAddrPrefix(24, "10.10.0.0"), false, append(attrs, bgp.NewPathAttributeNextHop("10.0.0.1")), time.Now(), false)
+	v6 := NewPath(nil, bgp.NewIPv6AddrPrefix(64, "2001:db8::"), false, append(attrs, bgp.NewPathAttributeMpReachNLRI("2001:db8::1", []bgp.AddrPrefixInterface{bgp.NewIPv6AddrPrefix(64, "2001:db8::")})), time.Now(), false)
+
+	c, err := NewAfiSafiInCondition([]config.AfiSafiType{config.AFI_SAFI_TYPE_IPV6_UNICAST})
+	assert.Nil(t, err)
+	assert.False(t, c.Evaluate(v4, nil))
+	assert.True(t, c.Evaluate(v6, nil))
+	c, _ = NewAfiSafiInCondition([]config.AfiSafiType{config.AFI_SAFI_TYPE_IPV4_UNICAST, config.AFI_SAFI_TYPE_IPV6_UNICAST})
+	assert.True(t, c.Evaluate(v4, nil))
+	assert.True(t, c.Evaluate(v6, nil))
+
+	_, err = NewAfiSafiInCondition([]config.AfiSafiType{"ipv4-unknown"})
+	assert.NotNil(t, err)
+}
+
+func TestOriginAction(t *testing.T) {
+	nlri := bgp.NewIPAddrPrefix(24, "10.10.0.0")
+	pattrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(bgp.BGP_ORIGIN_ATTR_TYPE_IGP),
+		bgp.NewPathAttributeNextHop("10.0.0.1"),
+	}
+	path := NewPath(nil, nlri, false, pattrs, time.Now(), false)
+
+	a, err := NewOriginAction(config.BGP_ORIGIN_ATTR_TYPE_INCOMPLETE)
+	assert.Nil(t, err)
+	p := a.Apply(path, nil)
+	origin, err := p.GetOrigin()
+	assert.Nil(t, err)
+	assert.Equal(t, uint8(bgp.BGP_ORIGIN_ATTR_TYPE_INCOMPLETE), origin)
+
+	_, err = NewOriginAction("unknown")
+	assert.NotNil(t, err)
+}
+
+func TestAttributeConditionStatement(t *testing.T) {
+	s := createStatement("statement1", "ps1", "ns1", true)
+	s.Conditions.BgpConditions.MedEq = 100
+	s.Conditions.BgpConditions.OriginEq = config.BGP_ORIGIN_ATTR_TYPE_IGP
+	s.Conditions.BgpConditions.NextHopInList = []string{"10.0.0.1"}
+	s.Conditions.BgpConditions.AfiSafiInList = []config.AfiSafiType{config.AFI_SAFI_TYPE_IPV4_UNICAST}
+	s.Conditions.BgpConditions.LocalPrefEq = 100
+	s.Conditions.BgpConditions.CommunityCount = config.CommunityCount{Operator: config.ATTRIBUTE_COMPARISON_ATTRIBUTE_GE, Value: 1}
+	s.Actions.BgpActions.SetRouteOrigin = config.BGP_ORIGIN_ATTR_TYPE_INCOMPLETE
+
+	stmt, err := NewStatement(s)
+	assert.Nil(t, err)
+	assert.Len(t, stmt.Conditions, 8)
+	assert.Len(t, stmt.ModActions, 1)
+	assert.Equal(t, s.Conditions.BgpConditions, stmt.ToConfig().Conditions.BgpConditions)
+	assert.Equal(t, s.Actions.BgpActions, stmt.ToConfig().Actions.BgpActions)
+
+	ds := config.DefinedSets{
+		PrefixSets:   []config.PrefixSet{createPrefixSet("ps1", "10.10.0.0/16", "21..24")},
+		NeighborSets: []config.NeighborSet{createNeighborSet("ns1", "10.0.0.1")},
+	}
+	r := NewRoutingPolicy()
+	assert.Nil(t, r.reload(createRoutingPolicy(ds, createPolicyDefinition("pd1", s))))
+
+	peer := &PeerInfo{AS: 65001, Address: net.ParseIP("10.0.0.1")}
+	pattrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(bgp.BGP_ORIGIN_ATTR_TYPE_IGP),
+		bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAsPathParam(2, []uint16{65001})}),
+		bgp.NewPathAttributeNextHop("10.0.0.1"),
+		bgp.NewPathAttributeMultiExitDisc(100),
+		bgp.NewPathAttributeCommunities([]uint32{65001<<16 | 1}),
+	}
+	updateMsg := bgp.NewBGPUpdateMessage(nil, pattrs, []*bgp.IPAddrPrefix{bgp.NewIPAddrPrefix(24, "10.10.0.101")})
+	path := ProcessMessage(updateMsg, peer, time.Now())[0]
+	pType, newPath := r.policyMap["pd1"].Apply(path, nil)
+	assert.Equal(t, ROUTE_TYPE_ACCEPT, pType)
+	origin, _ := newPath.GetOrigin()
+	assert.Equal(t, uint8(bgp.BGP_ORIGIN_ATTR_TYPE_INCOMPLETE), origin)
+
+	// the conditions without a defined set are skipped.
+	ps, _ := NewPrefixSet(createPrefixSet("ps2", "10.20.0.0/16", "16..24"))
+	assert.False(t, r.inUse(ps))
+	assert.True(t, r.inUse(r.definedSetMap[DEFINED_TYPE_PREFIX]["ps1"]))
+}